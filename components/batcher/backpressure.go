@@ -0,0 +1,55 @@
+package batcher
+
+// BackpressureConfig accelerates batch submission while the safe head lags
+// behind the unsafe head, so that the safe head latency regulates itself.
+type BackpressureConfig struct {
+	// SafeLagThreshold is the number of L2 blocks the safe head may lag behind
+	// the unsafe head before submission is accelerated. Submission is relaxed
+	// again once the lag dropped to half of it. Disabled if 0.
+	SafeLagThreshold uint64
+	// MaxChannelDuration is the maximum duration (in #L1-blocks) of channels
+	// while lagging. If 0, the regular maximum channel duration applies.
+	MaxChannelDuration uint64
+}
+
+// Channel returns the channel config to use while lagging: channels are closed
+// after a single frame or the shorter max channel duration, so that their frames
+// are submitted sooner.
+func (c BackpressureConfig) Channel(cfg ChannelConfig) ChannelConfig {
+	cfg.TargetNumFrames = 1
+	if c.MaxChannelDuration != 0 && (cfg.MaxChannelDuration == 0 || c.MaxChannelDuration < cfg.MaxChannelDuration) {
+		cfg.MaxChannelDuration = c.MaxChannelDuration
+	}
+	return cfg
+}
+
+// applyBackpressure switches the channel config of new channels depending on the
+// safe head lag. The pending channel is flushed once the lag exceeds the threshold.
+func (b *Batcher) applyBackpressure() {
+	bp := b.cfg.Backpressure
+	if bp.SafeLagThreshold == 0 {
+		return
+	}
+	lag := b.batchSubmitter.safeLag
+	state := b.batchSubmitter.state
+
+	switch {
+	case !b.lagging && lag > bp.SafeLagThreshold:
+		b.lagging = true
+		b.l.Warn("safe head lags behind, accelerating batch submission", "lag", lag, "threshold", bp.SafeLagThreshold)
+		b.updateChannelConfig()
+		l1tip, err := b.batchSubmitter.l1Tip(b.killCtx)
+		if err != nil {
+			b.l.Error("failed to query L1 tip", "err", err)
+			break
+		}
+		if err := state.Flush(l1tip.ID()); err != nil {
+			b.l.Error("failed to flush pending channel", "err", err)
+		}
+	case b.lagging && lag <= bp.SafeLagThreshold/2:
+		b.lagging = false
+		b.l.Info("safe head caught up, relaxing batch submission", "lag", lag)
+		b.updateChannelConfig()
+	}
+	b.cfg.metr.RecordSafeLag(lag, b.lagging)
+}
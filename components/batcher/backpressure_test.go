@@ -0,0 +1,29 @@
+package batcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackpressureChannelConfig(t *testing.T) {
+	cfg := ChannelConfig{
+		TargetNumFrames:    4,
+		MaxChannelDuration: 10,
+	}
+
+	lagging := BackpressureConfig{MaxChannelDuration: 2}.Channel(cfg)
+	require.Equal(t, 1, lagging.TargetNumFrames)
+	require.Equal(t, uint64(2), lagging.MaxChannelDuration)
+
+	lagging = BackpressureConfig{MaxChannelDuration: 20}.Channel(cfg)
+	require.Equal(t, uint64(10), lagging.MaxChannelDuration, "regular duration is shorter")
+
+	lagging = BackpressureConfig{}.Channel(cfg)
+	require.Equal(t, uint64(10), lagging.MaxChannelDuration)
+
+	cfg.MaxChannelDuration = 0
+	lagging = BackpressureConfig{MaxChannelDuration: 20}.Channel(cfg)
+	require.Equal(t, uint64(20), lagging.MaxChannelDuration, "regular duration is disabled")
+	require.Equal(t, 4, cfg.TargetNumFrames, "regular config is unchanged")
+}
@@ -7,11 +7,13 @@ import (
 	"math/big"
 	_ "net/http/pprof"
 
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/kroma-network/kroma/components/batcher/metrics"
 	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/utils/service/txmgr"
 )
 
 // BatchSubmitter encapsulates a service responsible for submitting L2 tx
@@ -22,16 +24,36 @@ type BatchSubmitter struct {
 	// lastStoredBlock is the last block loaded into `state`. If it is empty it should be set to the l2 safe head.
 	lastStoredBlock eth.BlockID
 	lastL1Tip       eth.L1BlockRef
+	// safeLag is the number of L2 blocks the safe head lags behind the unsafe head.
+	safeLag uint64
 
 	state *channelManager
+
+	// journal persists the pending channel, it is nil if journaling is disabled.
+	journal *ChannelJournal
+	// restored is set once the journaled channel, if any, got restored.
+	restored bool
+
+	// inclusions tracks the L1 inclusion of the submitted L2 blocks.
+	inclusions *inclusionTracker
 }
 
 // NewBatchSubmitter initializes the BatchSubmitter, gathering any resources
 // that will be needed during operation.
 func NewBatchSubmitter(cfg Config, l log.Logger, m metrics.Metricer) (*BatchSubmitter, error) {
+	state := NewChannelManager(l, m, cfg.Channel)
+	var journal *ChannelJournal
+	if cfg.ChannelJournal != "" {
+		journal = NewChannelJournal(cfg.ChannelJournal)
+		state.SetJournal(journal)
+	}
+	inclusions := newInclusionTracker(m, cfg.Channel.ChannelTimeout)
+	state.SetInclusionTracker(inclusions)
 	return &BatchSubmitter{
-		Config: cfg,
-		state:  NewChannelManager(l, m, cfg.Channel),
+		Config:     cfg,
+		state:      state,
+		journal:    journal,
+		inclusions: inclusions,
 	}, nil
 }
 
@@ -42,8 +64,20 @@ func NewBatchSubmitter(cfg Config, l log.Logger, m metrics.Metricer) (*BatchSubm
 // 3. Check if it needs to initialize state OR it is lagging (todo: lagging just means race condition?)
 // 4. Load all new blocks into the local state.
 func (b *BatchSubmitter) LoadBlocksIntoState(ctx context.Context) {
+	if b.journal != nil && !b.restored {
+		if err := b.restoreChannel(ctx); err != nil {
+			b.log.Warn("unable to restore channel from journal", "err", err)
+			return
+		}
+		b.restored = true
+	}
+
 	start, end, err := b.calculateL2BlockRangeToStore(ctx)
-	if err != nil {
+	if errors.Is(err, ErrReorg) {
+		b.log.Warn("found L2 reorg below the last stored block", "last", b.lastStoredBlock)
+		b.rewindState(ctx)
+		return
+	} else if err != nil {
 		b.log.Trace("unable to calculate L2 block range", "err", err)
 		return
 	}
@@ -53,8 +87,7 @@ func (b *BatchSubmitter) LoadBlocksIntoState(ctx context.Context) {
 		id, err := b.loadBlockIntoState(ctx, i)
 		if errors.Is(err, ErrReorg) {
 			b.log.Warn("found L2 reorg", "block_number", i)
-			b.state.Clear()
-			b.lastStoredBlock = eth.BlockID{}
+			b.rewindState(ctx)
 			return
 		} else if err != nil {
 			b.log.Warn("failed to load block into state", "err", err)
@@ -80,6 +113,104 @@ func (b *BatchSubmitter) loadBlockIntoState(ctx context.Context, blockNumber uin
 	return id, nil
 }
 
+// rewindState rewinds the state to the last loaded block that is still canonical
+// after an L2 reorg, so that loading continues from there. If none of the
+// buffered blocks is canonical anymore, the state is cleared and loading
+// continues from the safe head.
+func (b *BatchSubmitter) rewindState(ctx context.Context) {
+	blocks := b.state.BufferedBlocks()
+	for i := len(blocks) - 1; i >= 0; i-- {
+		tctx, cancel := context.WithTimeout(ctx, b.NetworkTimeout)
+		header, err := b.L2Client.HeaderByNumber(tctx, blocks[i].Number())
+		cancel()
+		if err != nil {
+			b.log.Warn("failed to find last canonical block, clearing state", "err", err)
+			break
+		}
+		if header.Hash() != blocks[i].Hash() {
+			continue
+		}
+		ancestor := eth.ToBlockID(blocks[i])
+		if b.state.Rewind(ancestor) {
+			b.log.Info("rewound state to last canonical block", "block", ancestor, "dropped_blocks", len(blocks)-i-1)
+			b.lastStoredBlock = ancestor
+			return
+		}
+		break
+	}
+	b.state.Clear()
+	b.lastStoredBlock = eth.BlockID{}
+}
+
+// restoreChannel rebuilds the channel that was pending before a restart from the
+// journal. The journal is dropped if its channel was already derived or its blocks
+// got reorged out, in which case submission starts at the safe head as usual.
+// It only returns an error if the channel could not be restored for now, e.g.
+// because of network errors.
+func (b *BatchSubmitter) restoreChannel(ctx context.Context) error {
+	entry, err := b.journal.Read()
+	if err != nil {
+		b.log.Warn("dropping unreadable channel journal", "err", err)
+		return b.journal.Clear()
+	}
+	if entry == nil || len(entry.Blocks) == 0 {
+		return b.journal.Clear()
+	}
+
+	tctx, cancel := context.WithTimeout(ctx, b.NetworkTimeout)
+	syncStatus, err := b.RollupClient.SyncStatus(tctx)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("failed to get sync status: %w", err)
+	}
+	last := entry.Blocks[len(entry.Blocks)-1]
+	if last.Number <= syncStatus.SafeL2.Number {
+		b.log.Info("journaled channel is already derived", "id", entry.ID, "last", last, "safe", syncStatus.SafeL2)
+		return b.journal.Clear()
+	}
+
+	blocks := make([]*types.Block, 0, len(entry.Blocks))
+	for _, id := range entry.Blocks {
+		tctx, cancel := context.WithTimeout(ctx, b.NetworkTimeout)
+		block, err := b.L2Client.BlockByNumber(tctx, new(big.Int).SetUint64(id.Number))
+		cancel()
+		if err != nil {
+			return fmt.Errorf("failed to fetch journaled block %d: %w", id.Number, err)
+		}
+		if block.Hash() != id.Hash {
+			b.log.Warn("journaled channel got reorged out", "id", entry.ID, "block", id, "canonical", block.Hash())
+			return b.journal.Clear()
+		}
+		blocks = append(blocks, block)
+	}
+
+	l1tip, err := b.l1Tip(ctx)
+	if err != nil {
+		return err
+	}
+	// The channel is rebuilt with the dictionary it was compressed with, so that its frames are identical.
+	var dict []byte
+	if entry.ZstdDictionaryID != 0 {
+		for _, d := range b.Rollup.ZstdDictionaries {
+			if d.ID() == entry.ZstdDictionaryID {
+				dict = d.Dictionary
+			}
+		}
+		if dict == nil {
+			b.log.Warn("dropping channel journal with unknown zstd dictionary", "id", entry.ID, "dictionary", entry.ZstdDictionaryID)
+			return b.journal.Clear()
+		}
+	}
+	b.state.SetZstdDictionary(dict)
+	if err := b.state.Restore(entry, blocks, l1tip.ID()); err != nil {
+		b.log.Warn("dropping channel journal that could not be restored", "id", entry.ID, "err", err)
+		b.state.Clear()
+		return nil
+	}
+	b.lastStoredBlock = last
+	return nil
+}
+
 // calculateL2BlockRangeToStore determines the range (start,end) that should be loaded into the local state.
 // It also takes care of initializing some local state (i.e. will modify b.lastStoredBlock in certain conditions)
 func (b *BatchSubmitter) calculateL2BlockRangeToStore(ctx context.Context) (eth.BlockID, eth.BlockID, error) {
@@ -93,6 +224,10 @@ func (b *BatchSubmitter) calculateL2BlockRangeToStore(ctx context.Context) (eth.
 	if syncStatus.HeadL1 == (eth.L1BlockRef{}) {
 		return eth.BlockID{}, eth.BlockID{}, errors.New("empty sync status")
 	}
+	b.safeLag = 0
+	if syncStatus.UnsafeL2.Number > syncStatus.SafeL2.Number {
+		b.safeLag = syncStatus.UnsafeL2.Number - syncStatus.SafeL2.Number
+	}
 
 	// Check last stored to see if it needs to be set on startup OR set if is lagged behind.
 	// It lagging implies that the kroma-node processed some batches that where submitted prior to the current instance of the kroma-batcher being alive.
@@ -104,6 +239,12 @@ func (b *BatchSubmitter) calculateL2BlockRangeToStore(ctx context.Context) (eth.
 		b.lastStoredBlock = syncStatus.SafeL2.ID()
 	}
 
+	// The unsafe head got reorged if it is not ahead of the last stored block anymore.
+	if unsafe := syncStatus.UnsafeL2; unsafe.Number < b.lastStoredBlock.Number ||
+		(unsafe.Number == b.lastStoredBlock.Number && unsafe.Hash != b.lastStoredBlock.Hash) {
+		return eth.BlockID{}, eth.BlockID{}, ErrReorg
+	}
+
 	// Check if we should even attempt to load any blocks. TODO: May not need this check
 	if syncStatus.SafeL2.Number >= syncStatus.UnsafeL2.Number {
 		return eth.BlockID{}, eth.BlockID{}, errors.New("L2 safe head ahead of L2 unsafe head")
@@ -122,13 +263,39 @@ func (b *BatchSubmitter) recordL1Tip(l1tip eth.L1BlockRef) {
 
 func (b *BatchSubmitter) recordFailedTx(id txID, err error) {
 	b.log.Warn("Failed to send transaction", "err", err)
+	b.metr.RecordBatchTxFailureReason(txFailureReason(err))
 	b.state.TxFailed(id)
 }
 
-func (b *BatchSubmitter) recordConfirmedTx(id txID, receipt *types.Receipt) {
+// txFailureReason classifies the error of a failed batcher tx for metrics.
+func txFailureReason(err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, txmgr.ErrTxReceiptNotSucceed):
+		return "reverted"
+	case errors.Is(err, txmgr.ErrTxAbandoned):
+		return "abandoned"
+	case errors.Is(err, core.ErrNonceTooLow), errors.Is(err, core.ErrNonceTooHigh):
+		return "nonce"
+	case errors.Is(err, core.ErrInsufficientFunds):
+		return "insufficient_funds"
+	case errors.Is(err, core.ErrIntrinsicGas), errors.Is(err, core.ErrGasLimitReached):
+		return "gas"
+	case errors.Is(err, errDAFailure):
+		return "da"
+	default:
+		return "other"
+	}
+}
+
+func (b *BatchSubmitter) recordConfirmedTx(txdata txData, receipt *types.Receipt) {
 	b.log.Info("Transaction confirmed", "tx_hash", receipt.TxHash, "status", receipt.Status, "block_hash", receipt.BlockHash, "block_number", receipt.BlockNumber)
 	l1block := eth.BlockID{Number: receipt.BlockNumber.Uint64(), Hash: receipt.BlockHash}
-	b.state.TxConfirmed(id, l1block)
+	b.inclusions.TxConfirmed(txdata, receipt)
+	b.state.TxConfirmed(txdata.ID(), l1block)
 }
 
 // l1Tip gets the current L1 tip as a L1BlockRef. The passed context is assumed
@@ -0,0 +1,34 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+)
+
+func TestTxFailureReason(t *testing.T) {
+	tests := []struct {
+		err    error
+		reason string
+	}{
+		{context.DeadlineExceeded, "timeout"},
+		{fmt.Errorf("failed to send tx: %w", context.Canceled), "canceled"},
+		{txmgr.ErrTxReceiptNotSucceed, "reverted"},
+		{core.ErrNonceTooLow, "nonce"},
+		{core.ErrNonceTooHigh, "nonce"},
+		{core.ErrInsufficientFunds, "insufficient_funds"},
+		{core.ErrIntrinsicGas, "gas"},
+		{core.ErrGasLimitReached, "gas"},
+		{fmt.Errorf("%w: %v", errDAFailure, errors.New("unavailable")), "da"},
+		{errors.New("boom"), "other"},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.reason, txFailureReason(test.err), test.err.Error())
+	}
+}
@@ -5,15 +5,22 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/urfave/cli"
 
 	"github.com/kroma-network/kroma/components/batcher/metrics"
+	"github.com/kroma-network/kroma/components/batcher/rpc"
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
 	"github.com/kroma-network/kroma/utils"
 	"github.com/kroma-network/kroma/utils/monitoring"
 	klog "github.com/kroma-network/kroma/utils/service/log"
@@ -42,8 +49,26 @@ func Main(version string, cliCtx *cli.Context) error {
 	defer cancel()
 
 	monitoring.MaybeStartPprof(ctx, cliCfg.PprofConfig, l)
-	monitoring.MaybeStartMetrics(ctx, cliCfg.MetricsConfig, l, m, batcherCfg.L1Client, batcherCfg.TxManager.From())
-	server, err := monitoring.StartRPC(cliCfg.RPCConfig.ToServiceCLIConfig(), version, krpc.WithLogger(l))
+	monitoring.MaybeStartMetrics(ctx, cliCfg.MetricsConfig, l, m, batcherCfg.L1Client.Primary(), batcherCfg.TxManager.From())
+
+	batcher, err := NewBatcher(ctx, *batcherCfg, l, m)
+	if err != nil {
+		l.Error("Unable to create batcher", "err", err)
+		return err
+	}
+
+	rpcOpts := []krpc.ServerOption{krpc.WithLogger(l)}
+	if cliCfg.RPCConfig.EnableAdmin {
+		secret, err := krpc.ReadJWTSecret(cliCfg.RPCConfig.AdminJWTSecret)
+		if err != nil {
+			return err
+		}
+		rpcOpts = append(rpcOpts,
+			krpc.WithAPIs([]gethrpc.API{rpc.GetAdminAPI(rpc.NewAdminAPI(batcher))}),
+			krpc.WithAdminJWTSecret(secret),
+		)
+	}
+	server, err := monitoring.StartRPC(cliCfg.RPCConfig.ToServiceCLIConfig(), version, rpcOpts...)
 	if err != nil {
 		return err
 	}
@@ -56,12 +81,6 @@ func Main(version string, cliCtx *cli.Context) error {
 	m.RecordInfo(version)
 	m.RecordUp()
 
-	batcher, err := NewBatcher(ctx, *batcherCfg, l, m)
-	if err != nil {
-		l.Error("Unable to create batcher", "err", err)
-		return err
-	}
-
 	if err := batcher.Start(); err != nil {
 		l.Error("Unable to start batcher", "err", err)
 		return err
@@ -83,6 +102,31 @@ type Batcher struct {
 	l              log.Logger
 	batchSubmitter *BatchSubmitter
 
+	// inFlight is the number of sent transactions whose receipt wasn't handled yet.
+	inFlight int
+
+	costs      *CostAccountant
+	overBudget bool
+
+	// paused holds back submissions on request of the admin API.
+	paused atomic.Bool
+	// flushReqs and statusReqs pass admin requests to the loop, which owns the state.
+	flushReqs  chan chan error
+	statusReqs chan chan *rpc.BatcherStatus
+
+	// rotation is the key rotation in progress, nil if there is none.
+	rotation *keyRotation
+
+	// lagging is set while submission is accelerated because the safe head lags behind.
+	lagging bool
+
+	// baseFees tracks the L1 base fee to adapt the channel duration to.
+	baseFees baseFeeTracker
+	feeLevel l1FeeLevel
+
+	// dryRun accumulates the projected txs in dry-run mode.
+	dryRun dryRunReport
+
 	wg sync.WaitGroup
 }
 
@@ -103,11 +147,27 @@ func NewBatcher(parentCtx context.Context, cfg Config, l log.Logger, m metrics.M
 	}
 
 	l.Info("creating batcher", "batcher_addr", cfg.TxManager.From(), "batcher_bal", balance)
+	if cfg.DryRun {
+		l.Warn("batcher runs in dry-run mode, no batcher txs are sent")
+	}
+
+	var rotation *keyRotation
+	if cfg.NextTxManager != nil {
+		if rotation, err = newKeyRotation(cfg, l); err != nil {
+			return nil, fmt.Errorf("failed to init key rotation: %w", err)
+		}
+	}
 
 	return &Batcher{
 		cfg:            cfg,
 		l:              l,
 		batchSubmitter: batchSubmitter,
+		costs:          NewCostAccountant(cfg.Budget),
+		flushReqs:      make(chan chan error),
+		statusReqs:     make(chan chan *rpc.BatcherStatus),
+		rotation:       rotation,
+		feeLevel:       l1FeeNormal,
+		dryRun:         dryRunReport{fees: new(big.Int)},
 	}, nil
 }
 
@@ -125,6 +185,14 @@ func (b *Batcher) Start() error {
 	b.wg.Add(1)
 	go b.loop()
 
+	if b.cfg.L1HealthCheckInterval != 0 {
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+			b.cfg.L1Client.HealthCheckLoop(b.shutdownCtx, b.cfg.L1HealthCheckInterval, b.cfg.NetworkTimeout)
+		}()
+	}
+
 	b.l.Info("Batcher started")
 
 	return nil
@@ -173,93 +241,314 @@ func (b *Batcher) loop() {
 	ticker := time.NewTicker(b.cfg.PollInterval)
 	defer ticker.Stop()
 
+	receiptsCh := make(chan txmgr.TxReceipt[txData])
+	queue := txmgr.NewQueue[txData](b.killCtx, b.cfg.TxManager, b.cfg.MaxPendingTransactions)
+
 	for {
 		select {
 		case <-ticker.C:
 			b.batchSubmitter.LoadBlocksIntoState(b.shutdownCtx)
-			if err := b.submitBatch(b.killCtx); err != nil {
-				b.l.Error("failed to submit batch channel frame", "err", err)
+			b.applyBackpressure()
+			b.adaptToL1Fees()
+			if b.rotateKey() {
+				queue = txmgr.NewQueue[txData](b.killCtx, b.cfg.TxManager, b.cfg.MaxPendingTransactions)
 			}
+			b.publishStateToL1(queue, receiptsCh)
+		case r := <-receiptsCh:
+			b.handleReceipt(r)
+			b.publishStateToL1(queue, receiptsCh)
+		case resCh := <-b.flushReqs:
+			resCh <- b.flush()
+			b.publishStateToL1(queue, receiptsCh)
+		case resCh := <-b.statusReqs:
+			resCh <- b.status()
 		case <-b.shutdownCtx.Done():
-			if err := b.submitBatch(b.killCtx); err != nil {
-				b.l.Error("failed to submit batch channel frame", "err", err)
+			// Stop loading new blocks, but submit the buffered ones to not leave a gap
+			// behind the safe head until the next batcher is started.
+			b.drainBlocks(queue, receiptsCh)
+			// Gracefully terminate the current channel, ensuring that no new frames will be
+			// produced. Any remaining frames must still be published to the L1 to prevent stalling.
+			if err := b.batchSubmitter.state.Close(); err != nil {
+				b.l.Error("failed to close the channel manager", "err", err)
+			}
+			b.drainState(queue, receiptsCh)
+			if b.cfg.DryRun {
+				b.logDryRunReport()
 			}
 			return
 		}
 	}
 }
 
-// submitBatch loops through the block data loaded into `state` and
-// submits the associated data to the L1 in the form of channel frames.
-func (b *Batcher) submitBatch(ctx context.Context) error {
-	for {
-		// Attempt to gracefully terminate the current channel, ensuring that no new frames will be
-		// produced. Any remaining frames must still be published to the L1 to prevent stalling.
+// drainBlocks submits all buffered blocks on shutdown, flushing them into channels,
+// and waits for their confirmation, until the shutdown drain timeout expires.
+func (b *Batcher) drainBlocks(queue *txmgr.Queue[txData], receiptsCh chan txmgr.TxReceipt[txData]) {
+	state := b.batchSubmitter.state
+	if b.cfg.ShutdownDrainTimeout == 0 || len(state.BufferedBlocks()) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(b.killCtx, b.cfg.ShutdownDrainTimeout)
+	defer cancel()
+	ticker := time.NewTicker(b.cfg.PollInterval)
+	defer ticker.Stop()
+
+	b.l.Info("draining buffered blocks before shutdown", "blocks", len(state.BufferedBlocks()), "timeout", b.cfg.ShutdownDrainTimeout)
+	for len(state.BufferedBlocks()) > 0 {
+		l1tip, err := b.batchSubmitter.l1Tip(ctx)
+		if err != nil {
+			b.l.Error("failed to query L1 tip", "err", err)
+		} else if err := state.Flush(l1tip.ID()); err != nil {
+			b.l.Error("failed to flush buffered blocks", "err", err)
+		}
+		b.publishStateToL1(queue, receiptsCh)
+
 		select {
+		case r := <-receiptsCh:
+			b.handleReceipt(r)
+		case <-ticker.C:
 		case <-ctx.Done():
-			err := b.batchSubmitter.state.Close()
-			if err != nil {
-				b.l.Error("failed to close the channel manager", "err", err)
-			}
-		case <-b.shutdownCtx.Done():
-			err := b.batchSubmitter.state.Close()
-			if err != nil {
-				b.l.Error("failed to close the channel manager", "err", err)
-			}
-		default:
+			b.l.Warn("timed out draining buffered blocks", "remaining_blocks", len(state.BufferedBlocks()))
+			return
 		}
+	}
+	b.l.Info("drained all buffered blocks")
+}
 
-		l1tip, err := b.batchSubmitter.l1Tip(ctx)
+// drainState publishes all remaining frames of the closed channel manager and handles
+// the receipts of all pending transactions. It stops publishing once the kill context is done.
+func (b *Batcher) drainState(queue *txmgr.Queue[txData], receiptsCh chan txmgr.TxReceipt[txData]) {
+	for {
+		b.publishStateToL1(queue, receiptsCh)
+		if b.inFlight == 0 {
+			return
+		}
+		b.handleReceipt(<-receiptsCh)
+	}
+}
+
+// publishStateToL1 loops through the block data loaded into `state` and
+// submits the associated data to the L1 in the form of channel frames,
+// until there is no more data or the maximum number of pending transactions is reached.
+func (b *Batcher) publishStateToL1(queue *txmgr.Queue[txData], receiptsCh chan txmgr.TxReceipt[txData]) {
+	for !queue.Full() {
+		// Transactions can't be sent anymore once the kill context is done.
+		if b.killCtx.Err() != nil {
+			return
+		}
+		if b.paused.Load() {
+			b.l.Debug("batch submission is paused")
+			return
+		}
+
+		l1tip, err := b.batchSubmitter.l1Tip(b.killCtx)
 		if err != nil {
 			b.l.Error("failed to query L1 tip", "err", err)
-			break
+			return
 		}
 		b.batchSubmitter.recordL1Tip(l1tip)
 
+		// Channels must not be compressed with zstd before rollup nodes accept them.
+		if b.cfg.Channel.Compression.Algo == derive.CompressionZstd && !b.cfg.Rollup.IsZstdCompression(l1tip.Time) {
+			b.l.Warn("zstd compression is not active yet, waiting for its activation", "l1tip", l1tip)
+			return
+		}
+		// New channels are compressed with the latest shared dictionary that rollup nodes accept.
+		if b.cfg.Channel.Compression.Algo == derive.CompressionZstd {
+			dict := b.cfg.Rollup.LatestZstdDictionary(l1tip.Time)
+			if b.batchSubmitter.state.SetZstdDictionary(dict) && dict != nil {
+				b.l.Info("compressing new channels with zstd dictionary",
+					"dictionary", (&rollup.ZstdDictionary{Dictionary: dict}).ID(), "l1tip", l1tip)
+			}
+		}
+
+		// No new channels are opened while the pending channel is drained for a key rotation.
+		if b.rotating() && !b.batchSubmitter.state.HasPendingChannel() {
+			b.l.Debug("holding back new channels during batcher key rotation")
+			return
+		}
+
+		if b.costs.Throttled() {
+			b.l.Warn("batch submission cost exceeds the budget, holding back submission")
+			return
+		}
+
 		// Collect next transaction data
 		txdata, err := b.batchSubmitter.state.TxData(l1tip.ID())
 		if err == io.EOF {
 			b.l.Trace("no transaction data available")
-			break
+			return
 		} else if err != nil {
 			b.l.Error("unable to get tx data", "err", err)
-			break
+			return
 		}
 
-		// Record TX Status
-		receipt, err := b.sendTransaction(ctx, txdata.Bytes())
-		if err != nil {
+		if b.cfg.DryRun {
+			if err := b.dryRunTx(txdata, l1tip); err != nil {
+				b.l.Error("unable to project tx", "err", err)
+				b.batchSubmitter.recordFailedTx(txdata.ID(), err)
+				return
+			}
+			continue
+		}
+
+		if err := b.sendTransaction(txdata, queue, receiptsCh); err != nil {
+			b.l.Error("unable to send tx", "err", err)
 			b.batchSubmitter.recordFailedTx(txdata.ID(), err)
-			return fmt.Errorf("failed to send batch submit transaction: %w", err)
+			return
 		}
-		b.batchSubmitter.recordConfirmedTx(txdata.ID(), receipt)
 	}
-
-	return nil
 }
 
-// sendTransaction creates & submits a transaction to the batch inbox address with the given `data`.
-// It currently uses the underlying `txmgr` to handle transaction sending & price management.
-// This is a blocking method. It should not be called concurrently.
-func (b *Batcher) sendTransaction(ctx context.Context, data []byte) (*types.Receipt, error) {
+// sendTransaction creates & queues a transaction to the batch inbox address with the given `txdata`.
+// It uses the underlying `txmgr` to handle transaction sending & price management, so that
+// multiple transactions can be pending at once. The result is sent to receiptsCh.
+func (b *Batcher) sendTransaction(txdata txData, queue *txmgr.Queue[txData], receiptsCh chan txmgr.TxReceipt[txData]) error {
+	data := txdata.Bytes()
+	// Commitments are only submitted once the rollup node resolves them: L1 includes the tx after now.
+	if b.cfg.DA != nil && b.cfg.Rollup.IsDACommitment(uint64(time.Now().Unix())) {
+		ctx, cancel := context.WithTimeout(b.killCtx, b.cfg.NetworkTimeout)
+		var err error
+		data, err = b.cfg.DA.PutBatchData(ctx, data)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("%w: %v", errDAFailure, err)
+		}
+	}
+
 	// Do the gas estimation offline. A value of 0 will cause the [txmgr] to estimate the gas limit.
 	intrinsicGas, err := core.IntrinsicGas(data, nil, false, true, true, false)
 	if err != nil {
-		return nil, fmt.Errorf("failed to calculate intrinsic gas: %w", err)
+		return fmt.Errorf("failed to calculate intrinsic gas: %w", err)
 	}
 
-	// Send the transaction through the txmgr
-	receipt, err := b.cfg.TxManager.Send(ctx, txmgr.TxCandidate{
+	b.inFlight++
+	b.cfg.metr.RecordBatchTxData(len(data), len(txdata.Frames()))
+	queue.Send(txdata, txmgr.TxCandidate{
 		To:       &b.batchSubmitter.Rollup.BatchInboxAddress,
 		TxData:   data,
 		GasLimit: intrinsicGas,
-	})
+	}, receiptsCh)
+	return nil
+}
+
+// handleReceipt records the result of a sent transaction in the channel manager.
+func (b *Batcher) handleReceipt(r txmgr.TxReceipt[txData]) {
+	b.inFlight--
+	if r.Receipt != nil {
+		b.recordCost(r.Receipt)
+	}
+	if r.Err != nil {
+		b.l.Error("batcher unable to publish tx", "err", r.Err)
+		b.batchSubmitter.recordFailedTx(r.ID.ID(), r.Err)
+		return
+	}
+	// The transaction was successfully submitted
+	b.l.Info("batcher tx successfully published", "tx_hash", r.Receipt.TxHash)
+	b.batchSubmitter.recordConfirmedTx(r.ID, r.Receipt)
+}
+
+// recordCost accounts the fee of the receipt and alerts once the spent ETH exceeds the budget.
+func (b *Batcher) recordCost(receipt *types.Receipt) {
+	b.costs.Record(receipt)
+	hour, day := b.costs.Spent()
+	overBudget := b.costs.OverBudget()
+	b.cfg.metr.RecordCost(hour, day, overBudget)
+
+	if overBudget && !b.overBudget {
+		b.l.Error("batch submission cost exceeds the budget", "last_hour", hour, "last_day", day,
+			"budget_per_hour", b.cfg.Budget.PerHour, "budget_per_day", b.cfg.Budget.PerDay, "throttle", b.cfg.Budget.Throttle)
+	} else if !overBudget && b.overBudget {
+		b.l.Info("batch submission cost is within the budget again", "last_hour", hour, "last_day", day)
+	}
+	b.overBudget = overBudget
+}
+
+// Pause holds back batch submission until Resume is called. L2 blocks are still loaded meanwhile.
+func (b *Batcher) Pause() {
+	if !b.paused.Swap(true) {
+		b.l.Info("pausing batch submission")
+	}
+}
+
+// Resume resumes batch submission after Pause.
+func (b *Batcher) Resume() {
+	if b.paused.Swap(false) {
+		b.l.Info("resuming batch submission")
+	}
+}
+
+// Flush closes the pending channel with all loaded L2 blocks, so that it gets submitted
+// without waiting for it to get full or to time out.
+func (b *Batcher) Flush(ctx context.Context) error {
+	if !b.running {
+		return errors.New("batcher is not running")
+	}
+	resCh := make(chan error, 1)
+	select {
+	case b.flushReqs <- resCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-resCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Status returns the detailed status of the batcher.
+// BlockInclusion returns where the data of the recently submitted L2 block with
+// the given number landed on L1.
+func (b *Batcher) BlockInclusion(number uint64) (*rpc.L2BlockInclusion, error) {
+	return b.batchSubmitter.inclusions.Inclusion(number)
+}
+
+func (b *Batcher) Status(ctx context.Context) (*rpc.BatcherStatus, error) {
+	if !b.running {
+		return &rpc.BatcherStatus{Paused: b.paused.Load()}, nil
+	}
+	resCh := make(chan *rpc.BatcherStatus, 1)
+	select {
+	case b.statusReqs <- resCh:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	select {
+	case status := <-resCh:
+		return status, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (b *Batcher) flush() error {
+	b.batchSubmitter.LoadBlocksIntoState(b.shutdownCtx)
+	l1tip, err := b.batchSubmitter.l1Tip(b.killCtx)
 	if err != nil {
-		b.l.Error("batcher unable to publish tx", "err", err)
-		return nil, err
+		return err
 	}
+	b.l.Info("flushing pending channel", "l1tip", l1tip)
+	return b.batchSubmitter.state.Flush(l1tip.ID())
+}
 
-	// The transaction was successfully submitted
-	b.l.Info("batcher tx successfully published", "tx_hash", receipt.TxHash)
-	return receipt, nil
+func (b *Batcher) status() *rpc.BatcherStatus {
+	blocks := b.batchSubmitter.state.BufferedBlocks()
+	status := &rpc.BatcherStatus{
+		Running:         true,
+		Paused:          b.paused.Load(),
+		BlocksBuffered:  len(blocks),
+		LastStoredBlock: b.batchSubmitter.lastStoredBlock,
+		PendingTxs:      b.inFlight,
+		OverBudget:      b.overBudget,
+		BatcherAddress:  b.cfg.TxManager.From(),
+	}
+	if b.rotating() {
+		status.KeyRotation = b.rotation.state.String()
+	}
+	if len(blocks) > 0 {
+		oldest := eth.ToBlockID(blocks[0])
+		status.OldestUnsubmittedBlock = &oldest
+	}
+	return status
 }
@@ -0,0 +1,138 @@
+package batcher
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/batcher/metrics"
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/testlog"
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+	"github.com/kroma-network/kroma/utils/service/txmgr/mocks"
+)
+
+// testL1 serves the latest L1 header and the suggested gas tip cap to the batcher.
+type testL1 struct {
+	head *types.Header
+}
+
+func (l *testL1) GetBlockByNumber(number string, full bool) (*types.Header, error) {
+	return l.head, nil
+}
+
+func (l *testL1) MaxPriorityFeePerGas() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(2))
+}
+
+// newTestBatcher returns a batcher with two buffered blocks, sending with the given tx manager.
+func newTestBatcher(t *testing.T, txMgr txmgr.TxManager) (*Batcher, context.CancelFunc) {
+	srv := rpc.NewServer()
+	t.Cleanup(srv.Stop)
+	l1 := &testL1{head: &types.Header{
+		Number:     big.NewInt(100),
+		Difficulty: common.Big0,
+		Time:       uint64(time.Now().Unix()),
+		BaseFee:    big.NewInt(10),
+	}}
+	require.NoError(t, srv.RegisterName("eth", l1))
+	l := testlog.Logger(t, log.LvlError)
+
+	cfg := Config{
+		log:                  l,
+		metr:                 metrics.NoopMetrics,
+		L1Client:             NewFailoverL1Client(l, metrics.NoopMetrics, ethclient.NewClient(rpc.DialInProc(srv))),
+		TxManager:            txMgr,
+		NetworkTimeout:       time.Second,
+		PollInterval:         10 * time.Millisecond,
+		Rollup:               &rollup.Config{BatchInboxAddress: common.HexToAddress("0xff")},
+		ShutdownDrainTimeout: 5 * time.Second,
+		Channel: ChannelConfig{
+			ChannelTimeout:   100,
+			MaxFrameSize:     120_000,
+			ApproxComprRatio: 1.0,
+		},
+	}
+	batchSubmitter, err := NewBatchSubmitter(cfg, l, metrics.NoopMetrics)
+	require.NoError(t, err)
+
+	a := newMiniL2Block(0)
+	require.NoError(t, batchSubmitter.state.AddL2Block(a))
+	require.NoError(t, batchSubmitter.state.AddL2Block(newMiniL2BlockWithNumberParent(0, big.NewInt(1), a.Hash())))
+
+	killCtx, cancelKill := context.WithCancel(context.Background())
+	t.Cleanup(cancelKill)
+	return &Batcher{
+		killCtx:        killCtx,
+		cancelKillCtx:  cancelKill,
+		cfg:            cfg,
+		l:              l,
+		batchSubmitter: batchSubmitter,
+		costs:          NewCostAccountant(CostBudget{}),
+	}, cancelKill
+}
+
+func TestBatcherDrainBlocks(t *testing.T) {
+	txMgr := new(mocks.TxManager)
+	txMgr.On("Send", mock.Anything, mock.Anything).Return(&types.Receipt{
+		Status:            types.ReceiptStatusSuccessful,
+		BlockNumber:       big.NewInt(101),
+		BlockHash:         common.Hash{0x01},
+		EffectiveGasPrice: big.NewInt(1),
+	}, nil)
+	b, _ := newTestBatcher(t, txMgr)
+	require.Len(t, b.batchSubmitter.state.BufferedBlocks(), 2)
+
+	receiptsCh := make(chan txmgr.TxReceipt[txData])
+	queue := txmgr.NewQueue[txData](b.killCtx, b.cfg.TxManager, 0)
+	b.drainBlocks(queue, receiptsCh)
+
+	require.Empty(t, b.batchSubmitter.state.BufferedBlocks(), "buffered blocks are flushed and submitted")
+	require.Zero(t, b.inFlight)
+	txMgr.AssertCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestBatcherDrainBlocksCancelled(t *testing.T) {
+	sent := make(chan struct{}, 1)
+	txMgr := new(mocks.TxManager)
+	txMgr.On("Send", mock.Anything, mock.Anything).Return(func(ctx context.Context, _ txmgr.TxCandidate) (*types.Receipt, error) {
+		select {
+		case sent <- struct{}{}:
+		default:
+		}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	b, cancelKill := newTestBatcher(t, txMgr)
+
+	receiptsCh := make(chan txmgr.TxReceipt[txData])
+	queue := txmgr.NewQueue[txData](b.killCtx, b.cfg.TxManager, 0)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		b.drainBlocks(queue, receiptsCh)
+	}()
+
+	<-sent
+	cancelKill()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("drain did not stop once the kill context was cancelled")
+	}
+	// the receipt of the cancelled tx is handled by drainState afterwards
+	for b.inFlight > 0 {
+		b.handleReceipt(<-receiptsCh)
+	}
+	require.Len(t, b.batchSubmitter.state.BufferedBlocks(), 2, "unsubmitted blocks are kept")
+}
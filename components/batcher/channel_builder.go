@@ -14,12 +14,15 @@ import (
 
 var (
 	ErrInvalidChannelTimeout = errors.New("channel timeout is less than the safety margin")
+	ErrInvalidProposerWindow = errors.New("proposer window size is not larger than the safety margin")
+	ErrUnsafeChannelDuration = errors.New("max channel duration exceeds the safe channel duration")
 	ErrInputTargetReached    = errors.New("target amount of input data reached")
 	ErrMaxFrameIndex         = errors.New("max frame index reached (uint16)")
 	ErrMaxDurationReached    = errors.New("max channel duration reached")
 	ErrChannelTimeoutClose   = errors.New("close to channel timeout")
 	ErrProposerWindowClose   = errors.New("close to proposer window timeout")
 	ErrTerminated            = errors.New("channel terminated")
+	ErrFlushed               = errors.New("channel flushed")
 )
 
 type ChannelFullError struct {
@@ -42,6 +45,15 @@ type ChannelConfig struct {
 	// channel's frames can span.
 	ChannelTimeout uint64
 
+	// MaxConcurrentChannels is the maximum number of channels whose frames are
+	// submitted at once. The next channel is opened once all frames of the
+	// previous channel were handed out, without waiting for their confirmation.
+	// If 0 or 1, only a single channel is submitted at a time.
+	MaxConcurrentChannels uint64
+	// FramesPerTx is the maximum number of frames of a channel packed into a
+	// single batcher tx. If 0, a single frame is sent per tx.
+	FramesPerTx int
+
 	// Builder Config
 
 	// MaxChannelDuration is the maximum duration (in #L1-blocks) to keep the
@@ -69,6 +81,9 @@ type ChannelConfig struct {
 	// average from experiments to avoid the chances of creating a small
 	// additional leftover frame.
 	ApproxComprRatio float64
+	// Compression of the channel data. If the algorithm is unset, the default
+	// zlib compression is used.
+	Compression derive.CompressionConfig
 }
 
 // Check validates the [ChannelConfig] parameters.
@@ -94,9 +109,54 @@ func (cc *ChannelConfig) Check() error {
 		return fmt.Errorf("max frame size %d is less than the minimum 23", cc.MaxFrameSize)
 	}
 
+	if cc.Compression.Algo != "" {
+		if err := cc.Compression.Check(); err != nil {
+			return err
+		}
+	}
+
+	// The proposer window is only unset when replaying blocks offline.
+	if cc.ProposerWindowSize != 0 {
+		// Otherwise, new channels would always be closed at the proposer window timeout.
+		if cc.ProposerWindowSize <= cc.SubSafetyMargin {
+			return ErrInvalidProposerWindow
+		}
+		if err := cc.CheckChannelDuration(cc.MaxChannelDuration); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// SafeMaxChannelDuration returns the longest duration (in #L1-blocks) a
+// channel may be kept open, so that the batches of its first L1 origin can
+// still be included within the proposer window, minus the safety margin.
+func (cc ChannelConfig) SafeMaxChannelDuration() uint64 {
+	if cc.ProposerWindowSize <= cc.SubSafetyMargin {
+		return 0
+	}
+	return cc.ProposerWindowSize - cc.SubSafetyMargin
+}
+
+// CheckChannelDuration returns an error if the max channel duration exceeds the
+// safe max channel duration derived from the rollup config.
+func (cc ChannelConfig) CheckChannelDuration(duration uint64) error {
+	if safe := cc.SafeMaxChannelDuration(); duration > safe {
+		return fmt.Errorf("%w: %d > %d", ErrUnsafeChannelDuration, duration, safe)
+	}
+	return nil
+}
+
+// CompressionConfig returns the compression of the channel data, which is the
+// default compression if unset.
+func (c ChannelConfig) CompressionConfig() derive.CompressionConfig {
+	if c.Compression.Algo == "" {
+		return derive.DefaultCompression
+	}
+	return c.Compression
+}
+
 // InputThreshold calculates the input data threshold in bytes from the given
 // parameters.
 func (c ChannelConfig) InputThreshold() uint64 {
@@ -126,6 +186,12 @@ type channelBuilder struct {
 	timeout uint64
 	// reason for currently set timeout
 	timeoutReason error
+	// L1 block number by which all frames must be included on L1, derived from
+	// the proposer window of the first batch and the channel timeout of the
+	// first published frame. 0 if not set yet.
+	deadline uint64
+	// set once a warning about the approaching deadline got logged
+	deadlineWarned bool
 
 	// Reason for the channel being full. Set by setFullErr so it's always
 	// guaranteed to be a ChannelFullError wrapping the specific reason.
@@ -143,7 +209,21 @@ type channelBuilder struct {
 // newChannelBuilder creates a new channel builder or returns an error if the
 // channel out could not be created.
 func newChannelBuilder(cfg ChannelConfig) (*channelBuilder, error) {
-	co, err := derive.NewChannelOut()
+	co, err := derive.NewChannelOutWithCompression(cfg.CompressionConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	return &channelBuilder{
+		cfg: cfg,
+		co:  co,
+	}, nil
+}
+
+// newChannelBuilderWithID creates a new channel builder with the given channel
+// id, so that a channel can be rebuilt after a restart.
+func newChannelBuilderWithID(cfg ChannelConfig, id derive.ChannelID) (*channelBuilder, error) {
+	co, err := derive.NewChannelOutWithID(id, cfg.CompressionConfig())
 	if err != nil {
 		return nil, err
 	}
@@ -244,6 +324,7 @@ func (c *channelBuilder) RegisterL1Block(l1BlockNum uint64) {
 func (c *channelBuilder) FramePublished(l1BlockNum uint64) {
 	timeout := l1BlockNum + c.cfg.ChannelTimeout - c.cfg.SubSafetyMargin
 	c.updateTimeout(timeout, ErrChannelTimeoutClose)
+	c.updateDeadline(l1BlockNum + c.cfg.ChannelTimeout)
 }
 
 // updateDurationTimeout updates the block timeout with the channel duration
@@ -266,6 +347,21 @@ func (c *channelBuilder) updateDurationTimeout(l1BlockNum uint64) {
 func (c *channelBuilder) updatePwTimeout(batch *derive.BatchData) {
 	timeout := uint64(batch.EpochNum) + c.cfg.ProposerWindowSize - c.cfg.SubSafetyMargin
 	c.updateTimeout(timeout, ErrProposerWindowClose)
+	c.updateDeadline(uint64(batch.EpochNum) + c.cfg.ProposerWindowSize)
+}
+
+// updateDeadline moves the submission deadline to the given block number if it
+// is earlier than the current deadline, or if it is still unset.
+func (c *channelBuilder) updateDeadline(deadlineBlockNum uint64) {
+	if c.deadline == 0 || c.deadline > deadlineBlockNum {
+		c.deadline = deadlineBlockNum
+	}
+}
+
+// Deadline returns the L1 block number by which all frames of the channel must
+// be included, or 0 if it is not set yet.
+func (c *channelBuilder) Deadline() uint64 {
+	return c.deadline
 }
 
 // updateTimeout updates the timeout block to the given block number if it is
@@ -319,7 +415,8 @@ func (c *channelBuilder) IsFull() bool {
 //   - ErrMaxDurationReached if the max channel duration got reached,
 //   - ErrChannelTimeoutClose if the consensus channel timeout got too close,
 //   - ErrProposerWindowClose if the end of the proposer window got too close,
-//   - ErrTerminated if the channel was explicitly terminated.
+//   - ErrTerminated if the channel was explicitly terminated,
+//   - ErrFlushed if the channel was flushed on request.
 func (c *channelBuilder) FullErr() error {
 	return c.fullErr
 }
@@ -413,6 +510,14 @@ func (c *channelBuilder) Close() {
 	}
 }
 
+// Flush immediately marks the channel as full with an ErrFlushed
+// if the channel is not already full.
+func (c *channelBuilder) Flush() {
+	if !c.IsFull() {
+		c.setFullErr(ErrFlushed)
+	}
+}
+
 // HasFrame returns whether there's any available frame. If true, it can be
 // popped using NextFrame().
 //
@@ -45,6 +45,10 @@ func TestChannelConfig_Check(t *testing.T) {
 	timeoutChannelConfig := defaultTestChannelConfig
 	timeoutChannelConfig.ChannelTimeout = 0
 	timeoutChannelConfig.SubSafetyMargin = 1
+	pwChannelConfig := defaultTestChannelConfig
+	pwChannelConfig.ProposerWindowSize = pwChannelConfig.SubSafetyMargin
+	durationChannelConfig := defaultTestChannelConfig
+	durationChannelConfig.MaxChannelDuration = durationChannelConfig.ProposerWindowSize - durationChannelConfig.SubSafetyMargin + 1
 	tests := []test{
 		{
 			input: defaultTestChannelConfig,
@@ -64,6 +68,18 @@ func TestChannelConfig_Check(t *testing.T) {
 				require.EqualError(t, output, "max frame size cannot be zero")
 			},
 		},
+		{
+			input: pwChannelConfig,
+			assertion: func(output error) {
+				require.ErrorIs(t, output, ErrInvalidProposerWindow)
+			},
+		},
+		{
+			input: durationChannelConfig,
+			assertion: func(output error) {
+				require.ErrorIs(t, output, ErrUnsafeChannelDuration)
+			},
+		},
 	}
 	for i := 1; i < derive.FrameV0OverHeadSize; i++ {
 		smallChannelConfig := defaultTestChannelConfig
@@ -365,6 +381,24 @@ func FuzzProposerWindowZeroTimeoutClose(f *testing.F) {
 	})
 }
 
+// TestChannelBuilder_Deadline tests that the submission deadline is the earlier
+// of the proposer window end of the first batch and the channel timeout of the
+// first published frame.
+func TestChannelBuilder_Deadline(t *testing.T) {
+	cb, err := newChannelBuilder(defaultTestChannelConfig)
+	require.NoError(t, err)
+	require.Zero(t, cb.Deadline())
+
+	cb.updatePwTimeout(&derive.BatchData{BatchV1: derive.BatchV1{EpochNum: 100}})
+	require.Equal(t, uint64(100)+defaultTestChannelConfig.ProposerWindowSize, cb.Deadline())
+
+	cb.FramePublished(60)
+	require.Equal(t, uint64(60)+defaultTestChannelConfig.ChannelTimeout, cb.Deadline())
+
+	cb.updatePwTimeout(&derive.BatchData{BatchV1: derive.BatchV1{EpochNum: 110}})
+	require.Equal(t, uint64(60)+defaultTestChannelConfig.ChannelTimeout, cb.Deadline(), "deadline is only moved forward")
+}
+
 // TestChannelBuilder_NextFrame tests calling NextFrame on a channelBuilder with only one frame.
 func TestChannelBuilder_NextFrame(t *testing.T) {
 	channelConfig := defaultTestChannelConfig
@@ -0,0 +1,95 @@
+package batcher
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+)
+
+// channelJournalEntry is the persisted state of the pending channel.
+// Channels are compressed deterministically, so the id and the L2 blocks that
+// were added suffice to rebuild the exact same frames after a restart.
+type channelJournalEntry struct {
+	ID derive.ChannelID `json:"id"`
+	// Blocks are the L2 blocks added to the channel, in order.
+	Blocks []eth.BlockID `json:"blocks"`
+	// Full is set if the channel was closed, so that no more blocks are added
+	// to it after it got rebuilt.
+	Full bool `json:"full"`
+	// Confirmed maps the frame numbers that got included on L1 to their
+	// inclusion blocks. Frames that were pending are resubmitted.
+	Confirmed map[uint16]eth.BlockID `json:"confirmed"`
+	// ZstdDictionaryID is the ID of the zstd dictionary the channel is
+	// compressed with, or 0 if it is compressed without a dictionary.
+	ZstdDictionaryID uint32 `json:"zstd_dictionary_id,omitempty"`
+}
+
+// ChannelJournal persists the state of the pending channel of the batcher, so
+// that a restart can resume the channel instead of resubmitting its data or
+// leaving it to time out.
+type ChannelJournal struct {
+	path string
+}
+
+func NewChannelJournal(path string) *ChannelJournal {
+	return &ChannelJournal{path: path}
+}
+
+// Write persists the entry, replacing any previously journaled entry.
+// The entry is synced to disk before it replaces the previous one, so a crash
+// never leaves a partial entry.
+func (j *ChannelJournal) Write(entry *channelJournalEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode channel journal: %w", err)
+	}
+	tmp := j.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open channel journal: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write channel journal: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to sync channel journal: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close channel journal: %w", err)
+	}
+	if err := os.Rename(tmp, j.path); err != nil {
+		return fmt.Errorf("failed to replace channel journal: %w", err)
+	}
+	return nil
+}
+
+// Read returns the journaled entry, or nil if there is none.
+func (j *ChannelJournal) Read() (*channelJournalEntry, error) {
+	data, err := os.ReadFile(j.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read channel journal: %w", err)
+	}
+	var entry channelJournalEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to decode channel journal: %w", err)
+	}
+	return &entry, nil
+}
+
+// Clear removes the journaled entry, after the channel got fully submitted or
+// dropped.
+func (j *ChannelJournal) Clear() error {
+	if err := os.Remove(j.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to clear channel journal: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package batcher
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/batcher/metrics"
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+// TestChannelManagerRestore tests that a channel restored from the journal
+// resumes with the frames that were not confirmed before the restart.
+func TestChannelManagerRestore(t *testing.T) {
+	require := require.New(t)
+	log := testlog.Logger(t, log.LvlCrit)
+	cfg := ChannelConfig{
+		TargetNumFrames:  100,
+		TargetFrameSize:  1000,
+		MaxFrameSize:     1000,
+		ApproxComprRatio: 1.0,
+		ChannelTimeout:   1000,
+	}
+	journal := NewChannelJournal(filepath.Join(t.TempDir(), "channel.json"))
+
+	m := NewChannelManager(log, metrics.NoopMetrics, cfg)
+	m.SetJournal(journal)
+	a := newMiniL2Block(50_000)
+	require.NoError(m.AddL2Block(a))
+
+	first, err := m.TxData(eth.BlockID{})
+	require.NoError(err)
+	inclusion := eth.BlockID{Number: 1}
+	m.TxConfirmed(first.ID(), inclusion)
+	second, err := m.TxData(eth.BlockID{})
+	require.NoError(err)
+
+	entry, err := journal.Read()
+	require.NoError(err)
+	require.NotNil(entry)
+	require.Equal(m.pendingChannel.ID(), entry.ID)
+	require.Equal([]eth.BlockID{eth.ToBlockID(a)}, entry.Blocks)
+	require.Equal(inclusion, entry.Confirmed[first.ID().frameNumber])
+
+	// Restart: the pending frame is rebuilt and resubmitted, the confirmed one is not.
+	restored := NewChannelManager(log, metrics.NoopMetrics, cfg)
+	restored.SetJournal(journal)
+	require.NoError(restored.Restore(entry, []*types.Block{a}, eth.BlockID{}))
+	require.Equal(inclusion, restored.confirmedTransactions[first.ID()])
+
+	resumed, err := restored.TxData(eth.BlockID{})
+	require.NoError(err)
+	require.Equal(second, resumed)
+
+	// The journal is cleared once the channel got dropped.
+	restored.Clear()
+	entry, err = journal.Read()
+	require.NoError(err)
+	require.Nil(entry)
+}
@@ -1,10 +1,12 @@
 package batcher
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/kroma-network/kroma/components/batcher/metrics"
 	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup"
 	"github.com/kroma-network/kroma/components/node/rollup/derive"
 )
 
@@ -20,9 +23,16 @@ var ErrReorg = errors.New("block does not extend existing chain")
 // channelManager stores a contiguous set of blocks & turns them into channels.
 // Upon receiving tx confirmation (or a tx failure), it does channel error handling.
 //
-// For simplicity, it only creates a single pending channel at a time & waits for
-// the channel to either successfully be submitted or timeout before creating a new
-// channel.
+// It builds a single pending channel at a time. Once all frames of the pending
+// channel were handed out, it becomes a submitted channel waiting for its txs to
+// be confirmed, and the next channel is opened, up to MaxConcurrentChannels
+// channels at once. Otherwise, it waits for the pending channel to either be
+// successfully submitted or time out before creating a new channel.
+//
+// The channels hold disjoint, consecutive ranges of blocks. Since all txs are
+// sent from the same account, the nonce order makes the first frames of the
+// channels land on L1 in the order of their blocks, and frames of older channels
+// are always resubmitted first.
 // Functions on channelManager are not safe for concurrent access.
 type channelManager struct {
 	log  log.Logger
@@ -38,13 +48,40 @@ type channelManager struct {
 
 	// pending channel builder
 	pendingChannel *channelBuilder
+	// time the pending channel was opened at
+	pendingChannelOpened time.Time
+	// whether the closing of the pending channel was recorded already
+	pendingChannelClosed bool
 	// Set of unconfirmed txID -> frame data. For tx resubmission
 	pendingTransactions map[txID]txData
 	// Set of confirmed txID -> inclusion block. For determining if the channel is timed out
 	confirmedTransactions map[txID]eth.BlockID
 
+	// channels that got fully handed out before the pending channel, oldest first
+	submittedChannels []*submittedChannel
+
 	// if set to true, prevents production of any new channel frames
 	closed bool
+
+	// optional journal to persist the pending channel across restarts
+	journal *ChannelJournal
+	// optional tracker of the L1 inclusion of the submitted L2 blocks
+	inclusions *inclusionTracker
+	// shared dictionary that zstd compressed channels are compressed with, if any
+	zstdDict []byte
+}
+
+// submittedChannel is a full channel whose frames were all handed out, waiting
+// for its txs to be confirmed while the next channels are submitted.
+type submittedChannel struct {
+	builder               *channelBuilder
+	pendingTransactions   map[txID]txData
+	confirmedTransactions map[txID]eth.BlockID
+}
+
+// isFullySubmitted returns true if all frames of the channel got confirmed.
+func (s *submittedChannel) isFullySubmitted() bool {
+	return len(s.pendingTransactions)+s.builder.NumFrames() == 0
 }
 
 func NewChannelManager(log log.Logger, metr metrics.Metricer, cfg ChannelConfig) *channelManager {
@@ -58,6 +95,43 @@ func NewChannelManager(log log.Logger, metr metrics.Metricer, cfg ChannelConfig)
 	}
 }
 
+// SetChannelConfig sets the config of channels opened from now on.
+// The pending channel keeps its config.
+func (c *channelManager) SetChannelConfig(cfg ChannelConfig) {
+	c.cfg = cfg
+}
+
+// SetZstdDictionary sets the dictionary that zstd compressed channels opened
+// from now on are compressed with. It returns true if the dictionary changed.
+func (c *channelManager) SetZstdDictionary(dict []byte) bool {
+	if bytes.Equal(c.zstdDict, dict) {
+		return false
+	}
+	c.zstdDict = dict
+	return true
+}
+
+// channelConfig returns the config of channels opened now.
+func (c *channelManager) channelConfig() ChannelConfig {
+	cfg := c.cfg
+	if cfg.Compression.Algo == derive.CompressionZstd {
+		cfg.Compression.Dictionary = c.zstdDict
+	}
+	return cfg
+}
+
+// SetJournal sets the journal that the pending channel is persisted to whenever
+// its state changes.
+func (c *channelManager) SetJournal(journal *ChannelJournal) {
+	c.journal = journal
+}
+
+// SetInclusionTracker sets the tracker that the L1 inclusion of the blocks of
+// fully submitted channels is reported to.
+func (c *channelManager) SetInclusionTracker(inclusions *inclusionTracker) {
+	c.inclusions = inclusions
+}
+
 // Clear clears the entire state of the channel manager.
 // It is intended to be used after an L2 reorg.
 func (c *channelManager) Clear() {
@@ -65,18 +139,30 @@ func (c *channelManager) Clear() {
 	c.blocks = c.blocks[:0]
 	c.tip = common.Hash{}
 	c.closed = false
+	c.submittedChannels = nil
 	c.clearPendingChannel()
 }
 
 // TxFailed records a transaction as failed. It will attempt to resubmit the data
 // in the failed transaction.
 func (c *channelManager) TxFailed(id txID) {
+	if s := c.submittedChannel(id.chID); s != nil {
+		if data, ok := s.pendingTransactions[id]; ok {
+			c.log.Trace("marked transaction of submitted channel as failed", "id", id)
+			for _, frame := range data.Frames() {
+				s.builder.PushFrame(frame)
+			}
+			delete(s.pendingTransactions, id)
+		}
+		c.metr.RecordBatchTxFailed()
+		return
+	}
+
 	if data, ok := c.pendingTransactions[id]; ok {
 		c.log.Trace("marked transaction as failed", "id", id)
-		// Note: when the batcher is changed to send multiple frames per tx,
-		// this needs to be changed to iterate over all frames of the tx data
-		// and re-queue them.
-		c.pendingChannel.PushFrame(data.Frame())
+		for _, frame := range data.Frames() {
+			c.pendingChannel.PushFrame(frame)
+		}
 		delete(c.pendingTransactions, id)
 	} else {
 		c.log.Warn("unknown transaction marked as failed", "id", id)
@@ -96,14 +182,22 @@ func (c *channelManager) TxFailed(id txID) {
 func (c *channelManager) TxConfirmed(id txID, inclusionBlock eth.BlockID) {
 	c.metr.RecordBatchTxSubmitted()
 	c.log.Debug("marked transaction as confirmed", "id", id, "block", inclusionBlock)
-	if _, ok := c.pendingTransactions[id]; !ok {
+	if s := c.submittedChannel(id.chID); s != nil {
+		c.submittedTxConfirmed(s, id, inclusionBlock)
+		c.persist()
+		return
+	}
+	data, ok := c.pendingTransactions[id]
+	if !ok {
 		c.log.Warn("unknown transaction marked as confirmed", "id", id, "block", inclusionBlock)
 		// TODO: This can occur if we clear the channel while there are still pending transactions
 		// We need to keep track of stale transactions instead
 		return
 	}
 	delete(c.pendingTransactions, id)
-	c.confirmedTransactions[id] = inclusionBlock
+	for _, frame := range data.Frames() {
+		c.confirmedTransactions[frame.id] = inclusionBlock
+	}
 	c.pendingChannel.FramePublished(inclusionBlock.Number)
 
 	// If this channel timed out, put the pending blocks back into the local saved blocks
@@ -116,18 +210,202 @@ func (c *channelManager) TxConfirmed(id txID, inclusionBlock eth.BlockID) {
 	}
 	// If we are done with this channel, record that.
 	if c.pendingChannelIsFullySubmitted() {
-		c.metr.RecordChannelFullySubmitted(c.pendingChannel.ID())
-		c.log.Info("Channel is fully submitted", "id", c.pendingChannel.ID())
+		c.recordFullySubmitted(c.pendingChannel, c.confirmedTransactions)
+		c.clearPendingChannel()
+	}
+	c.persist()
+}
+
+// submittedTxConfirmed marks a transaction of a submitted channel as confirmed.
+// If the channel timed out, it is resubmitted along with all later channels,
+// whose blocks can't be derived before it.
+func (c *channelManager) submittedTxConfirmed(s *submittedChannel, id txID, inclusionBlock eth.BlockID) {
+	data, ok := s.pendingTransactions[id]
+	if !ok {
+		c.log.Warn("unknown transaction marked as confirmed", "id", id, "block", inclusionBlock)
+		return
+	}
+	delete(s.pendingTransactions, id)
+	for _, frame := range data.Frames() {
+		s.confirmedTransactions[frame.id] = inclusionBlock
+	}
+	s.builder.FramePublished(inclusionBlock.Number)
+
+	idx := c.submittedChannelIndex(s)
+	if c.isTimedOut(s.confirmedTransactions) {
+		c.metr.RecordChannelTimedOut(s.builder.ID())
+		c.log.Warn("Submitted channel timed out", "id", s.builder.ID(), "later_channels", len(c.submittedChannels)-idx-1)
+		c.requeueChannels(idx)
+		return
+	}
+	if s.isFullySubmitted() {
+		c.recordFullySubmitted(s.builder, s.confirmedTransactions)
+		c.submittedChannels = append(c.submittedChannels[:idx], c.submittedChannels[idx+1:]...)
+	}
+}
+
+// requeueChannels puts the blocks of the submitted channels from the given index
+// on, and of the pending channel, back in front of the blocks queue and drops
+// these channels, so that their blocks are added to new channels again.
+func (c *channelManager) requeueChannels(idx int) {
+	var blocks []*types.Block
+	for _, s := range c.submittedChannels[idx:] {
+		blocks = append(blocks, s.builder.Blocks()...)
+	}
+	c.submittedChannels = c.submittedChannels[:idx]
+	if c.pendingChannel != nil {
+		blocks = append(blocks, c.pendingChannel.Blocks()...)
 		c.clearPendingChannel()
 	}
+	c.blocks = append(blocks, c.blocks...)
+}
+
+func (c *channelManager) recordFullySubmitted(cb *channelBuilder, confirmed map[txID]eth.BlockID) {
+	c.metr.RecordChannelFullySubmitted(cb.ID())
+	for _, block := range cb.Blocks() {
+		c.metr.RecordL2BlockInclusionLatency(time.Since(time.Unix(int64(block.Time()), 0)))
+	}
+	if c.inclusions != nil {
+		c.inclusions.ChannelSubmitted(cb.Blocks(), confirmed)
+	}
+	c.log.Info("Channel is fully submitted", "id", cb.ID())
+}
+
+// submittedChannel returns the submitted channel with the given id, or nil.
+func (c *channelManager) submittedChannel(id derive.ChannelID) *submittedChannel {
+	for _, s := range c.submittedChannels {
+		if s.builder.ID() == id {
+			return s
+		}
+	}
+	return nil
+}
+
+func (c *channelManager) submittedChannelIndex(s *submittedChannel) int {
+	for i := range c.submittedChannels {
+		if c.submittedChannels[i] == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// submitPendingChannel turns the pending channel into a submitted channel once
+// all its frames were handed out, so that the next channel can be submitted
+// while its txs are still waiting for confirmation.
+func (c *channelManager) submitPendingChannel() {
+	if c.pendingChannel == nil || !c.pendingChannel.IsFull() || c.pendingChannel.HasFrame() ||
+		uint64(len(c.submittedChannels)+1) >= c.cfg.MaxConcurrentChannels {
+		return
+	}
+	c.log.Debug("Pending channel is submitted, opening next channel", "id", c.pendingChannel.ID(),
+		"submitted_channels", len(c.submittedChannels)+1)
+	c.submittedChannels = append(c.submittedChannels, &submittedChannel{
+		builder:               c.pendingChannel,
+		pendingTransactions:   c.pendingTransactions,
+		confirmedTransactions: c.confirmedTransactions,
+	})
+	c.clearPendingChannel()
 }
 
 // clearPendingChannel resets all pending state back to an initialized but empty state.
 // TODO: Create separate "pending" state
 func (c *channelManager) clearPendingChannel() {
 	c.pendingChannel = nil
+	c.pendingChannelClosed = false
 	c.pendingTransactions = make(map[txID]txData)
 	c.confirmedTransactions = make(map[txID]eth.BlockID)
+	c.persist()
+}
+
+// persist writes the state of the oldest channel to the journal, or clears the
+// journal if there is no channel. Channels after it are rebuilt from its last
+// block after a restart. Failures are only logged, since the batcher can still
+// fall back to resubmitting the channel from the safe head.
+func (c *channelManager) persist() {
+	if c.journal == nil {
+		return
+	}
+	cb, confirmed := c.pendingChannel, c.confirmedTransactions
+	if len(c.submittedChannels) > 0 {
+		cb, confirmed = c.submittedChannels[0].builder, c.submittedChannels[0].confirmedTransactions
+	}
+	if cb == nil {
+		if err := c.journal.Clear(); err != nil {
+			c.log.Warn("Failed to clear channel journal", "err", err)
+		}
+		return
+	}
+	entry := &channelJournalEntry{
+		ID:        cb.ID(),
+		Full:      cb.IsFull(),
+		Confirmed: make(map[uint16]eth.BlockID, len(confirmed)),
+	}
+	for _, block := range cb.Blocks() {
+		entry.Blocks = append(entry.Blocks, eth.ToBlockID(block))
+	}
+	for id, inclusionBlock := range confirmed {
+		entry.Confirmed[id.frameNumber] = inclusionBlock
+	}
+	if dict := cb.cfg.Compression.Dictionary; len(dict) > 0 {
+		entry.ZstdDictionaryID = (&rollup.ZstdDictionary{Dictionary: dict}).ID()
+	}
+	if err := c.journal.Write(entry); err != nil {
+		c.log.Warn("Failed to write channel journal", "id", cb.ID(), "err", err)
+	}
+}
+
+// Restore rebuilds the pending channel of a journal entry from the L2 blocks it
+// lists, after a restart. Frames that got confirmed before the restart are not
+// resubmitted, all other frames of the channel are submitted again.
+// It must be called before any L2 blocks are added to the channel manager.
+func (c *channelManager) Restore(entry *channelJournalEntry, blocks []*types.Block, l1Head eth.BlockID) error {
+	cb, err := newChannelBuilderWithID(c.channelConfig(), entry.ID)
+	if err != nil {
+		return fmt.Errorf("creating channel: %w", err)
+	}
+	for i, block := range blocks {
+		if _, err := cb.AddBlock(block); err != nil {
+			return fmt.Errorf("adding block[%d] to channel builder: %w", i, err)
+		}
+	}
+	if entry.Full {
+		cb.Close()
+	}
+	if err := cb.OutputFrames(); err != nil {
+		return fmt.Errorf("creating frames with channel builder: %w", err)
+	}
+	confirmed := make(map[txID]eth.BlockID, len(entry.Confirmed))
+	for i, n := 0, cb.NumFrames(); i < n; i++ {
+		frame := cb.NextFrame()
+		if inclusionBlock, ok := entry.Confirmed[frame.id.frameNumber]; ok {
+			confirmed[frame.id] = inclusionBlock
+			cb.FramePublished(inclusionBlock.Number)
+		} else {
+			cb.PushFrame(frame)
+		}
+	}
+	cb.RegisterL1Block(l1Head.Number)
+
+	c.pendingChannel = cb
+	c.pendingChannelOpened = time.Now()
+	c.pendingChannelClosed = cb.IsFull()
+	c.pendingTransactions = make(map[txID]txData)
+	c.confirmedTransactions = confirmed
+	if len(blocks) > 0 {
+		c.tip = blocks[len(blocks)-1].Hash()
+	}
+	c.log.Info("Restored channel",
+		"id", cb.ID(),
+		"l1Head", l1Head,
+		"blocks", len(blocks),
+		"confirmed_frames", len(confirmed),
+		"pending_frames", cb.NumFrames(),
+		"channel_full", cb.IsFull(),
+	)
+	c.metr.RecordChannelOpened(cb.ID(), len(blocks))
+	c.persist()
+	return nil
 }
 
 // pendingChannelIsTimedOut returns true if submitted channel has timed out.
@@ -137,14 +415,20 @@ func (c *channelManager) pendingChannelIsTimedOut() bool {
 	if c.pendingChannel == nil {
 		return false // no channel to be timed out
 	}
+	return c.isTimedOut(c.confirmedTransactions)
+}
+
+// isTimedOut returns true if the inclusion blocks of the confirmed transactions
+// of a channel span at least the channel timeout.
+func (c *channelManager) isTimedOut(confirmed map[txID]eth.BlockID) bool {
 	// No confirmed transactions => not timed out
-	if len(c.confirmedTransactions) == 0 {
+	if len(confirmed) == 0 {
 		return false
 	}
 	// If there are confirmed transactions, find the first + last confirmed block numbers
 	min := uint64(math.MaxUint64)
 	max := uint64(0)
-	for _, inclusionBlock := range c.confirmedTransactions {
+	for _, inclusionBlock := range confirmed {
 		if inclusionBlock.Number < min {
 			min = inclusionBlock.Number
 		}
@@ -163,15 +447,24 @@ func (c *channelManager) pendingChannelIsFullySubmitted() bool {
 	return c.pendingChannel.IsFull() && len(c.pendingTransactions)+c.pendingChannel.NumFrames() == 0
 }
 
-// nextTxData pops off c.datas & handles updating the internal state
+// nextTxData pops off c.datas & handles updating the internal state.
+// Frames of submitted channels, which were resubmitted after failures, come first.
 func (c *channelManager) nextTxData() (txData, error) {
+	for _, s := range c.submittedChannels {
+		if s.builder.HasFrame() {
+			txdata := c.nextFrames(s.builder)
+			c.log.Trace("returning next tx data of submitted channel", "id", txdata.ID())
+			s.pendingTransactions[txdata.ID()] = txdata
+			return txdata, nil
+		}
+	}
+
 	if c.pendingChannel == nil || !c.pendingChannel.HasFrame() {
 		c.log.Trace("no next tx data")
 		return txData{}, io.EOF // TODO: not enough data error instead
 	}
 
-	frame := c.pendingChannel.NextFrame()
-	txdata := txData{frame}
+	txdata := c.nextFrames(c.pendingChannel)
 	id := txdata.ID()
 
 	c.log.Trace("returning next tx data", "id", id)
@@ -179,14 +472,29 @@ func (c *channelManager) nextTxData() (txData, error) {
 	return txdata, nil
 }
 
+// nextFrames pops up to FramesPerTx frames off the channel into the data of a
+// single tx. The channel must have a frame.
+func (c *channelManager) nextFrames(cb *channelBuilder) txData {
+	n := c.cfg.FramesPerTx
+	if n < 1 {
+		n = 1
+	}
+	var txdata txData
+	for len(txdata.frames) < n && cb.HasFrame() {
+		txdata.frames = append(txdata.frames, cb.NextFrame())
+	}
+	return txdata
+}
+
 // TxData returns the next tx data that should be submitted to L1.
 //
-// It currently only uses one frame per transaction. If the pending channel is
+// It packs up to FramesPerTx frames of a channel into a transaction. If the pending channel is
 // full, it only returns the remaining frames of this channel until it got
 // successfully fully sent to L1. It returns io.EOF if there's no pending frame.
 func (c *channelManager) TxData(l1Head eth.BlockID) (txData, error) {
-	dataPending := c.pendingChannel != nil && c.pendingChannel.HasFrame()
+	dataPending := c.hasSubmittedFrame() || c.pendingChannel != nil && c.pendingChannel.HasFrame()
 	c.log.Debug("Requested tx data", "l1Head", l1Head, "data_pending", dataPending, "blocks_pending", len(c.blocks))
+	c.checkDeadlines(l1Head)
 
 	// Short circuit if there is a pending frame or the channel manager is closed.
 	if dataPending || c.closed {
@@ -200,6 +508,7 @@ func (c *channelManager) TxData(l1Head eth.BlockID) (txData, error) {
 		return txData{}, io.EOF
 	}
 
+	c.submitPendingChannel()
 	if err := c.ensurePendingChannel(l1Head); err != nil {
 		return txData{}, err
 	}
@@ -216,20 +525,32 @@ func (c *channelManager) TxData(l1Head eth.BlockID) (txData, error) {
 	if err := c.outputFrames(); err != nil {
 		return txData{}, err
 	}
+	c.persist()
 
 	return c.nextTxData()
 }
 
+// hasSubmittedFrame returns whether a submitted channel has a frame to resubmit.
+func (c *channelManager) hasSubmittedFrame() bool {
+	for _, s := range c.submittedChannels {
+		if s.builder.HasFrame() {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *channelManager) ensurePendingChannel(l1Head eth.BlockID) error {
 	if c.pendingChannel != nil {
 		return nil
 	}
 
-	cb, err := newChannelBuilder(c.cfg)
+	cb, err := newChannelBuilder(c.channelConfig())
 	if err != nil {
 		return fmt.Errorf("creating new channel: %w", err)
 	}
 	c.pendingChannel = cb
+	c.pendingChannelOpened = time.Now()
 	c.log.Info("Created channel",
 		"id", cb.ID(),
 		"l1Head", l1Head,
@@ -239,6 +560,27 @@ func (c *channelManager) ensurePendingChannel(l1Head eth.BlockID) error {
 	return nil
 }
 
+// checkDeadlines warns once per channel if the submission deadline of a channel
+// whose frames are not all confirmed yet is within the safety margin.
+func (c *channelManager) checkDeadlines(l1Head eth.BlockID) {
+	builders := make([]*channelBuilder, 0, len(c.submittedChannels)+1)
+	for _, s := range c.submittedChannels {
+		builders = append(builders, s.builder)
+	}
+	if c.pendingChannel != nil {
+		builders = append(builders, c.pendingChannel)
+	}
+	for _, cb := range builders {
+		deadline := cb.Deadline()
+		if deadline == 0 || cb.deadlineWarned || l1Head.Number+cb.cfg.SubSafetyMargin < deadline {
+			continue
+		}
+		cb.deadlineWarned = true
+		c.log.Warn("Channel submission deadline is approaching", "id", cb.ID(), "l1Head", l1Head,
+			"deadline", deadline, "pending_frames", cb.NumFrames())
+	}
+}
+
 // registerL1Block registers the given block at the pending channel.
 func (c *channelManager) registerL1Block(l1Head eth.BlockID) {
 	c.pendingChannel.RegisterL1Block(l1Head.Number)
@@ -300,19 +642,22 @@ func (c *channelManager) outputFrames() error {
 	if err := c.pendingChannel.OutputFrames(); err != nil {
 		return fmt.Errorf("creating frames with channel builder: %w", err)
 	}
-	if !c.pendingChannel.IsFull() {
+	if !c.pendingChannel.IsFull() || c.pendingChannelClosed {
 		return nil
 	}
+	c.pendingChannelClosed = true
 
 	inBytes, outBytes := c.pendingChannel.InputBytes(), c.pendingChannel.OutputBytes()
 	c.metr.RecordChannelClosed(
 		c.pendingChannel.ID(),
+		string(c.cfg.CompressionConfig().Algo),
 		len(c.blocks),
 		c.pendingChannel.NumFrames(),
 		inBytes,
 		outBytes,
 		c.pendingChannel.FullErr(),
 	)
+	c.metr.RecordChannelOpenDuration(time.Since(c.pendingChannelOpened))
 
 	var comprRatio float64
 	if inBytes > 0 {
@@ -330,6 +675,112 @@ func (c *channelManager) outputFrames() error {
 	return nil
 }
 
+// Flush adds all pending blocks to the pending channel, opening one if needed,
+// and closes it, so that it is submitted without waiting for it to get full or
+// to time out. Blocks that don't fit into the pending channel remain pending.
+func (c *channelManager) Flush(l1Head eth.BlockID) error {
+	if c.closed {
+		return nil
+	}
+
+	if len(c.blocks) > 0 {
+		if err := c.ensurePendingChannel(l1Head); err != nil {
+			return err
+		}
+		if err := c.processBlocks(); err != nil {
+			return err
+		}
+		c.registerL1Block(l1Head)
+	}
+
+	if c.pendingChannel == nil {
+		return nil
+	}
+
+	c.pendingChannel.Flush()
+
+	if err := c.outputFrames(); err != nil {
+		return err
+	}
+	c.persist()
+	return nil
+}
+
+// BufferedBlocks returns the blocks that are not fully submitted yet, which are
+// the blocks of the submitted and pending channels followed by the pending blocks.
+func (c *channelManager) BufferedBlocks() []*types.Block {
+	var blocks []*types.Block
+	for _, s := range c.submittedChannels {
+		blocks = append(blocks, s.builder.Blocks()...)
+	}
+	if c.pendingChannel != nil {
+		blocks = append(blocks, c.pendingChannel.Blocks()...)
+	}
+	return append(blocks, c.blocks...)
+}
+
+// Rewind drops all buffered blocks after the given block, which is the last
+// buffered block that is still canonical after an L2 reorg. If blocks of a
+// channel got reorged out, the channel and all later channels are invalidated and
+// their remaining blocks are added to new channels again. It returns false if the
+// block is not buffered, in which case the state is left unchanged.
+func (c *channelManager) Rewind(ancestor eth.BlockID) bool {
+	blocks := c.BufferedBlocks()
+	idx := -1
+	for i, block := range blocks {
+		if block.Hash() == ancestor.Hash {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return false
+	}
+
+	c.tip = ancestor.Hash
+
+	// start is the index of the first block of the channel in the buffered blocks.
+	start := 0
+	for i, s := range c.submittedChannels {
+		end := start + len(s.builder.Blocks())
+		if idx+1 < end {
+			c.log.Warn("Submitted channel got reorged out", "id", s.builder.ID(), "ancestor", ancestor)
+			c.submittedChannels = c.submittedChannels[:i]
+			c.clearPendingChannel()
+			c.blocks = blocks[start : idx+1]
+			return true
+		}
+		start = end
+	}
+
+	var channelBlocks int
+	if c.pendingChannel != nil {
+		channelBlocks = len(c.pendingChannel.Blocks())
+	}
+	if idx+1 < start+channelBlocks {
+		c.log.Warn("Pending channel got reorged out", "id", c.pendingChannel.ID(), "ancestor", ancestor)
+		c.clearPendingChannel()
+		c.blocks = blocks[start : idx+1]
+	} else {
+		c.blocks = c.blocks[:idx+1-start-channelBlocks]
+	}
+	return true
+}
+
+// HasPendingChannel returns whether there is a channel that is not fully submitted yet.
+func (c *channelManager) HasPendingChannel() bool {
+	return c.pendingChannel != nil || len(c.submittedChannels) > 0
+}
+
+// PendingTxs returns the number of txs that were neither confirmed nor failed yet.
+func (c *channelManager) PendingTxs() int {
+	n := len(c.pendingTransactions)
+	for _, s := range c.submittedChannels {
+		n += len(s.pendingTransactions)
+	}
+	return n
+}
+
 // AddL2Block adds an L2 block to the internal blocks queue. It returns ErrReorg
 // if the block does not extend the last block loaded into the state. If no
 // blocks were added yet, the parent hash check is skipped.
@@ -375,5 +826,9 @@ func (c *channelManager) Close() error {
 
 	c.pendingChannel.Close()
 
-	return c.outputFrames()
+	if err := c.outputFrames(); err != nil {
+		return err
+	}
+	c.persist()
+	return nil
 }
@@ -149,7 +149,7 @@ func TestChannelManagerNextTxData(t *testing.T) {
 
 	// Now the nextTxData function should return the frame
 	returnedTxData, err = m.nextTxData()
-	expectedTxData := txData{frame}
+	expectedTxData := singleFrameTxData(frame)
 	expectedChannelID := expectedTxData.ID()
 	require.NoError(t, err)
 	require.Equal(t, expectedTxData, returnedTxData)
@@ -256,7 +256,7 @@ func TestChannelManagerTxConfirmed(t *testing.T) {
 	m.pendingChannel.PushFrame(frame)
 	require.Equal(t, 1, m.pendingChannel.NumFrames())
 	returnedTxData, err := m.nextTxData()
-	expectedTxData := txData{frame}
+	expectedTxData := singleFrameTxData(frame)
 	expectedChannelID := expectedTxData.ID()
 	require.NoError(t, err)
 	require.Equal(t, expectedTxData, returnedTxData)
@@ -304,7 +304,7 @@ func TestChannelManagerTxFailed(t *testing.T) {
 	m.pendingChannel.PushFrame(frame)
 	require.Equal(t, 1, m.pendingChannel.NumFrames())
 	returnedTxData, err := m.nextTxData()
-	expectedTxData := txData{frame}
+	expectedTxData := singleFrameTxData(frame)
 	expectedChannelID := expectedTxData.ID()
 	require.NoError(t, err)
 	require.Equal(t, expectedTxData, returnedTxData)
@@ -469,6 +469,52 @@ func TestChannelManagerClosePendingChannel(t *testing.T) {
 	require.ErrorIs(err, io.EOF, "Expected closed channel manager to produce no more tx data")
 }
 
+// channelClosedMetrics counts the recorded channel closings.
+type channelClosedMetrics struct {
+	metrics.Metricer
+	closed        int
+	openDurations int
+}
+
+func (m *channelClosedMetrics) RecordChannelClosed(derive.ChannelID, string, int, int, int, int, error) {
+	m.closed++
+}
+
+func (m *channelClosedMetrics) RecordChannelOpenDuration(time.Duration) {
+	m.openDurations++
+}
+
+// TestChannelManagerRecordsChannelClosedOnce ensures that the closing of a channel
+// is recorded once, while its frames are submitted.
+func TestChannelManagerRecordsChannelClosedOnce(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	metr := &channelClosedMetrics{Metricer: metrics.NoopMetrics}
+	m := NewChannelManager(log, metr,
+		ChannelConfig{
+			TargetNumFrames:  1,
+			TargetFrameSize:  100,
+			MaxFrameSize:     100,
+			ApproxComprRatio: 1.0,
+			ChannelTimeout:   1000,
+		})
+
+	require.NoError(t, m.AddL2Block(newMiniL2Block(50_000)))
+
+	var frames int
+	for {
+		txdata, err := m.TxData(eth.BlockID{})
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		frames++
+		m.TxConfirmed(txdata.ID(), eth.BlockID{})
+	}
+	require.Greater(t, frames, 1)
+	require.Equal(t, 1, metr.closed)
+	require.Equal(t, 1, metr.openDurations)
+}
+
 // TestChannelManagerCloseAllTxsFailed ensures that the channel manager
 // can gracefully close after producing transaction frames if none of these
 // have successfully landed on chain.
@@ -506,3 +552,174 @@ func TestChannelManagerCloseAllTxsFailed(t *testing.T) {
 	_, err = m.TxData(eth.BlockID{})
 	require.ErrorIs(err, io.EOF, "Expected closed channel manager to produce no more tx data")
 }
+
+// TestChannelManagerFlush ensures that flushing closes the pending channel
+// with all pending blocks, so that its frames can be submitted right away.
+func TestChannelManagerFlush(t *testing.T) {
+	require := require.New(t)
+	log := testlog.Logger(t, log.LvlCrit)
+	m := NewChannelManager(log, metrics.NoopMetrics,
+		ChannelConfig{
+			TargetNumFrames:  100,
+			TargetFrameSize:  1000,
+			MaxFrameSize:     1000,
+			ApproxComprRatio: 1.0,
+			ChannelTimeout:   1000,
+		})
+
+	a := newMiniL2Block(0)
+	require.NoError(m.AddL2Block(a))
+
+	_, err := m.TxData(eth.BlockID{})
+	require.ErrorIs(err, io.EOF, "Expected channel to wait for more blocks")
+
+	b := newMiniL2BlockWithNumberParent(0, big.NewInt(1), a.Hash())
+	require.NoError(m.AddL2Block(b))
+	require.Len(m.BufferedBlocks(), 2)
+	require.Equal(a.Hash(), m.BufferedBlocks()[0].Hash())
+
+	require.NoError(m.Flush(eth.BlockID{}))
+	require.ErrorIs(m.pendingChannel.FullErr(), ErrFlushed)
+	require.Len(m.pendingChannel.Blocks(), 2)
+
+	txdata, err := m.TxData(eth.BlockID{})
+	require.NoError(err, "Expected flushed channel to produce tx data")
+	require.Equal(1, m.PendingTxs())
+
+	m.TxConfirmed(txdata.ID(), eth.BlockID{})
+	require.Empty(m.BufferedBlocks())
+}
+
+// TestChannelManagerRewind ensures that rewinding to the last canonical block
+// only invalidates the pending channel if some of its blocks got reorged out.
+func TestChannelManagerRewind(t *testing.T) {
+	require := require.New(t)
+	log := testlog.Logger(t, log.LvlCrit)
+	cfg := ChannelConfig{
+		TargetNumFrames:  100,
+		TargetFrameSize:  1000,
+		MaxFrameSize:     1000,
+		ApproxComprRatio: 1.0,
+		ChannelTimeout:   1000,
+	}
+
+	a := newMiniL2Block(0)
+	b := newMiniL2BlockWithNumberParent(0, big.NewInt(1), a.Hash())
+	c := newMiniL2BlockWithNumberParent(0, big.NewInt(2), b.Hash())
+	d := newMiniL2BlockWithNumberParent(0, big.NewInt(3), c.Hash())
+
+	setup := func() *channelManager {
+		m := NewChannelManager(log, metrics.NoopMetrics, cfg)
+		require.NoError(m.AddL2Block(a))
+		require.NoError(m.AddL2Block(b))
+		_, err := m.TxData(eth.BlockID{})
+		require.ErrorIs(err, io.EOF)
+		require.Len(m.pendingChannel.Blocks(), 2)
+		require.NoError(m.AddL2Block(c))
+		require.NoError(m.AddL2Block(d))
+		return m
+	}
+
+	m := setup()
+	require.True(m.Rewind(eth.ToBlockID(c)))
+	require.True(m.HasPendingChannel(), "channel blocks are still canonical")
+	require.Len(m.BufferedBlocks(), 3)
+	alt := newMiniL2BlockWithNumberParent(1, big.NewInt(3), c.Hash())
+	require.NoError(m.AddL2Block(alt))
+
+	m = setup()
+	require.True(m.Rewind(eth.ToBlockID(a)))
+	require.False(m.HasPendingChannel(), "channel got reorged out")
+	require.Len(m.BufferedBlocks(), 1)
+	alt = newMiniL2BlockWithNumberParent(1, big.NewInt(1), a.Hash())
+	require.NoError(m.AddL2Block(alt))
+
+	m = setup()
+	require.False(m.Rewind(eth.BlockID{Hash: common.Hash{0xff}, Number: 1}))
+	require.Len(m.BufferedBlocks(), 4)
+}
+
+// TestChannelManagerConcurrentChannels ensures that the next channel is opened
+// once all frames of the pending channel were handed out, up to the maximum
+// number of concurrent channels, and that failed frames of older channels are
+// resubmitted first.
+func TestChannelManagerConcurrentChannels(t *testing.T) {
+	require := require.New(t)
+	log := testlog.Logger(t, log.LvlCrit)
+	m := NewChannelManager(log, metrics.NoopMetrics,
+		ChannelConfig{
+			TargetNumFrames:       100,
+			TargetFrameSize:       1000,
+			MaxFrameSize:          1000,
+			ApproxComprRatio:      1.0,
+			ChannelTimeout:        1000,
+			MaxConcurrentChannels: 2,
+		})
+
+	a := newMiniL2Block(0)
+	b := newMiniL2BlockWithNumberParent(0, big.NewInt(1), a.Hash())
+	c := newMiniL2BlockWithNumberParent(0, big.NewInt(2), b.Hash())
+
+	require.NoError(m.AddL2Block(a))
+	require.NoError(m.Flush(eth.BlockID{}))
+	first, err := m.TxData(eth.BlockID{})
+	require.NoError(err)
+
+	require.NoError(m.AddL2Block(b))
+	_, err = m.TxData(eth.BlockID{})
+	require.ErrorIs(err, io.EOF, "Expected next channel to wait for more blocks")
+	require.Len(m.submittedChannels, 1)
+	require.NoError(m.Flush(eth.BlockID{}))
+	second, err := m.TxData(eth.BlockID{})
+	require.NoError(err)
+	require.NotEqual(first.ID().chID, second.ID().chID)
+	require.Equal(2, m.PendingTxs())
+
+	require.NoError(m.AddL2Block(c))
+	_, err = m.TxData(eth.BlockID{})
+	require.ErrorIs(err, io.EOF, "Expected no third channel to be opened")
+	require.Len(m.submittedChannels, 1)
+
+	m.TxFailed(first.ID())
+	resubmitted, err := m.TxData(eth.BlockID{})
+	require.NoError(err)
+	require.Equal(first.ID(), resubmitted.ID(), "Expected failed frame of older channel first")
+
+	m.TxConfirmed(resubmitted.ID(), eth.BlockID{Number: 1})
+	require.Empty(m.submittedChannels)
+	m.TxConfirmed(second.ID(), eth.BlockID{Number: 2})
+	require.False(m.HasPendingChannel())
+	require.Len(m.BufferedBlocks(), 1)
+	require.Equal(c.Hash(), m.BufferedBlocks()[0].Hash())
+}
+
+// TestChannelManagerFramesPerTx ensures that up to FramesPerTx frames of a
+// channel are packed into a single tx and handled together.
+func TestChannelManagerFramesPerTx(t *testing.T) {
+	require := require.New(t)
+	log := testlog.Logger(t, log.LvlCrit)
+	m := NewChannelManager(log, metrics.NoopMetrics, ChannelConfig{FramesPerTx: 2})
+
+	require.NoError(m.ensurePendingChannel(eth.BlockID{}))
+	for i := 0; i < 3; i++ {
+		m.pendingChannel.PushFrame(frameData{
+			data: []byte{byte(i)},
+			id:   frameID{chID: m.pendingChannel.ID(), frameNumber: uint16(i)},
+		})
+	}
+
+	txdata, err := m.nextTxData()
+	require.NoError(err)
+	require.Len(txdata.Frames(), 2)
+	require.Equal([]byte{derive.DerivationVersion0, 0, 1}, txdata.Bytes())
+	require.Equal(1, m.pendingChannel.NumFrames())
+
+	m.TxFailed(txdata.ID())
+	require.Equal(3, m.pendingChannel.NumFrames(), "Expected all frames of the failed tx to be requeued")
+
+	last, err := m.nextTxData()
+	require.NoError(err)
+	require.Len(last.Frames(), 2)
+	m.TxConfirmed(last.ID(), eth.BlockID{Number: 1})
+	require.Len(m.confirmedTransactions, 2)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/kroma-network/kroma/components/batcher"
+	"github.com/kroma-network/kroma/components/batcher/cmd/replay"
 	"github.com/kroma-network/kroma/components/batcher/flags"
 	klog "github.com/kroma-network/kroma/utils/service/log"
 )
@@ -28,6 +29,7 @@ func main() {
 	app.Description = "Service for generating and submitting L2 tx batches to L1."
 
 	app.Action = curryMain(Version)
+	app.Commands = []cli.Command{replay.Command}
 	err := app.Run(os.Args)
 	if err != nil {
 		log.Crit("Application failed", "message", err)
@@ -0,0 +1,205 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+
+	"github.com/kroma-network/kroma/components/batcher"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+)
+
+var Command = cli.Command{
+	Name:  "replay",
+	Usage: "Replays historical L2 blocks through the channel builder and compares the output sizes of channel configs",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:     "l2-eth-rpc",
+			Required: true,
+			Usage:    "HTTP provider URL for L2 execution engine",
+		},
+		cli.Uint64Flag{
+			Name:     "start",
+			Required: true,
+			Usage:    "First L2 block (inclusive) to replay",
+		},
+		cli.Uint64Flag{
+			Name:     "end",
+			Required: true,
+			Usage:    "Last L2 block (exclusive) to replay",
+		},
+		cli.StringSliceFlag{
+			Name:  "compression",
+			Usage: "Compression to compare, as algo[:level], e.g. zlib, zstd:3. Can be repeated (default: zlib)",
+		},
+		cli.StringFlag{
+			Name:  "zstd-dictionary",
+			Usage: "(Optional) Path of the zstd dictionary to compress zstd channels with",
+		},
+		cli.IntSliceFlag{
+			Name:  "target-num-frames",
+			Usage: "Target number of frames per channel to compare. Can be repeated (default: 1)",
+		},
+		cli.Uint64Flag{
+			Name:  "max-l1-tx-size-bytes",
+			Value: 120_000,
+			Usage: "The maximum size of a batch tx submitted to L1",
+		},
+		cli.Uint64Flag{
+			Name:  "target-l1-tx-size-bytes",
+			Value: 100_000,
+			Usage: "The target size of a batch tx submitted to L1",
+		},
+		cli.IntFlag{
+			Name:  "frames-per-tx",
+			Value: 1,
+			Usage: "The maximum number of frames packed into a single batch tx",
+		},
+		cli.Float64Flag{
+			Name:  "approx-compr-ratio",
+			Value: 1.0,
+			Usage: "The approximate compression ratio (<= 1.0)",
+		},
+	},
+	Action: Replay,
+}
+
+// Replay fetches the L2 blocks of the range and prints the channel output of
+// each combination of the compared configs.
+func Replay(ctx *cli.Context) error {
+	start, end := ctx.Uint64("start"), ctx.Uint64("end")
+	if end <= start {
+		return fmt.Errorf("empty block range [%d, %d)", start, end)
+	}
+	framesPerTx := ctx.Int("frames-per-tx")
+	if framesPerTx <= 0 {
+		return fmt.Errorf("invalid frames per tx %d", framesPerTx)
+	}
+
+	compressions, err := parseCompressions(ctx.StringSlice("compression"))
+	if err != nil {
+		return err
+	}
+	if path := ctx.String("zstd-dictionary"); path != "" {
+		dict, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read zstd dictionary: %w", err)
+		}
+		for i := range compressions {
+			if compressions[i].Algo == derive.CompressionZstd {
+				compressions[i].Dictionary = dict
+			}
+		}
+	}
+	targetNumFrames := ctx.IntSlice("target-num-frames")
+	if len(targetNumFrames) == 0 {
+		targetNumFrames = []int{1}
+	}
+
+	blocks, err := fetchBlocks(ctx.String("l2-eth-rpc"), start, end)
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+	table.SetCenterSeparator("|")
+	table.SetAutoWrapText(false)
+	table.SetHeader([]string{"Compression", "Target Frames", "Channels", "Frames", "Txs", "Input Bytes", "L1 Bytes", "Ratio"})
+	for _, comp := range compressions {
+		for _, numFrames := range targetNumFrames {
+			cfg := batcher.ChannelConfig{
+				FramesPerTx:      framesPerTx,
+				MaxFrameSize:     (ctx.Uint64("max-l1-tx-size-bytes") - 1) / uint64(framesPerTx),
+				TargetFrameSize:  (ctx.Uint64("target-l1-tx-size-bytes") - 1) / uint64(framesPerTx),
+				TargetNumFrames:  numFrames,
+				ApproxComprRatio: ctx.Float64("approx-compr-ratio"),
+				Compression:      comp,
+			}
+			if err := cfg.Check(); err != nil {
+				return fmt.Errorf("invalid channel config: %w", err)
+			}
+			res, err := batcher.ReplayBlocks(cfg, blocks)
+			if err != nil {
+				return err
+			}
+			table.Append([]string{
+				compressionName(comp),
+				strconv.Itoa(numFrames),
+				strconv.Itoa(res.Channels),
+				strconv.Itoa(res.Frames),
+				strconv.Itoa(res.Txs),
+				strconv.Itoa(res.InputBytes),
+				strconv.Itoa(res.L1Bytes),
+				fmt.Sprintf("%.4f", res.ComprRatio()),
+			})
+		}
+	}
+	fmt.Printf("Replayed %d L2 blocks [%d, %d)\n", len(blocks), start, end)
+	table.Render()
+	return nil
+}
+
+// parseCompressions parses compressions in the form algo[:level].
+func parseCompressions(specs []string) ([]derive.CompressionConfig, error) {
+	if len(specs) == 0 {
+		specs = []string{string(derive.CompressionZlib)}
+	}
+	var out []derive.CompressionConfig
+	for _, spec := range specs {
+		algo, levelStr, hasLevel := strings.Cut(spec, ":")
+		comp := derive.CompressionConfig{Algo: derive.CompressionAlgo(algo)}
+		if hasLevel {
+			level, err := strconv.Atoi(levelStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid compression level in %q: %w", spec, err)
+			}
+			comp.Level = level
+		}
+		if err := comp.Check(); err != nil {
+			return nil, err
+		}
+		out = append(out, comp)
+	}
+	return out, nil
+}
+
+func compressionName(comp derive.CompressionConfig) string {
+	name := string(comp.Algo)
+	if comp.Level != 0 {
+		name += ":" + strconv.Itoa(comp.Level)
+	}
+	if len(comp.Dictionary) > 0 {
+		name += "+dict"
+	}
+	return name
+}
+
+func fetchBlocks(url string, start, end uint64) ([]*types.Block, error) {
+	client, err := ethclient.Dial(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial L2 RPC: %w", err)
+	}
+	defer client.Close()
+
+	blocks := make([]*types.Block, 0, end-start)
+	for n := start; n < end; n++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		block, err := client.BlockByNumber(ctx, new(big.Int).SetUint64(n))
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch L2 block %d: %w", n, err)
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
@@ -2,41 +2,86 @@ package batcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/urfave/cli"
 
 	"github.com/kroma-network/kroma/components/batcher/flags"
 	"github.com/kroma-network/kroma/components/batcher/metrics"
 	"github.com/kroma-network/kroma/components/batcher/rpc"
+	"github.com/kroma-network/kroma/components/node/client"
 	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
 	"github.com/kroma-network/kroma/components/node/sources"
 	"github.com/kroma-network/kroma/utils"
 	klog "github.com/kroma-network/kroma/utils/service/log"
 	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
 	kpprof "github.com/kroma-network/kroma/utils/service/pprof"
 	"github.com/kroma-network/kroma/utils/service/txmgr"
+	ksigner "github.com/kroma-network/kroma/utils/signer/client"
 )
 
 type Config struct {
 	log          log.Logger
 	metr         metrics.Metricer
-	L1Client     *ethclient.Client
+	L1Client     *FailoverL1Client
 	L2Client     *ethclient.Client
 	RollupClient *sources.RollupClient
 	TxManager    txmgr.TxManager
+	// NextTxManager sends with the next batcher key to rotate to, nil if no key rotation is configured.
+	NextTxManager txmgr.TxManager
+	// SystemConfigOwnerTxManager sends with the key of the SystemConfig owner, to update the
+	// batcher address on a key rotation. If nil, the address is updated by the owner separately.
+	SystemConfigOwnerTxManager txmgr.TxManager
+	// RotationConfirmations is the number of L1 blocks the batcher address update of a key rotation
+	// has to be confirmed by, before the batcher switches to the next key.
+	RotationConfirmations uint64
+	// DA makes the batcher data available. If nil, the data is submitted as L1 calldata.
+	DA DAClient
 
 	NetworkTimeout time.Duration
 	PollInterval   time.Duration
+	// L1HealthCheckInterval is the interval of health checks of the L1 endpoints. Disabled if 0.
+	L1HealthCheckInterval time.Duration
+
+	// MaxPendingTransactions is the maximum number of batcher txs pending on L1 at once.
+	// If 0, the number of pending txs is unlimited.
+	MaxPendingTransactions uint64
 
 	// Rollup config is queried at startup
 	Rollup *rollup.Config
 
 	// Channel builder parameters
 	Channel ChannelConfig
+
+	// ChannelJournal is the file the pending channel is persisted to, so that
+	// it can be resumed after a restart. Journaling is disabled if empty.
+	ChannelJournal string
+
+	// Budget limits the ETH spent on submissions.
+	Budget CostBudget
+
+	// Backpressure accelerates submission while the safe head lags behind.
+	Backpressure BackpressureConfig
+
+	// FeeAdaptive adapts the channel duration to the L1 base fee.
+	FeeAdaptive FeeAdaptiveConfig
+
+	// DryRun runs the batcher pipeline without sending batcher txs, only
+	// reporting the projected txs.
+	DryRun bool
+
+	// ShutdownDrainTimeout is the maximum duration to keep submitting the buffered
+	// blocks on shutdown. If 0, only the frames of the pending channel are submitted.
+	ShutdownDrainTimeout time.Duration
 }
 
 // Check ensures that the [Config] is valid.
@@ -47,6 +92,22 @@ func (c *Config) Check() error {
 	if err := c.Channel.Check(); err != nil {
 		return err
 	}
+	if err := c.FeeAdaptive.Check(); err != nil {
+		return err
+	}
+	// Durations that replace the max channel duration at runtime must be safe too.
+	for name, duration := range map[string]uint64{
+		"high fee":     c.FeeAdaptive.MaxChannelDuration,
+		"low fee":      c.FeeAdaptive.MinChannelDuration,
+		"backpressure": c.Backpressure.MaxChannelDuration,
+	} {
+		if err := c.Channel.CheckChannelDuration(duration); err != nil {
+			return fmt.Errorf("%s channel duration: %w", name, err)
+		}
+	}
+	if c.Channel.Compression.Algo == derive.CompressionZstd && c.Rollup.ZstdCompressionTime == nil {
+		return errors.New("zstd compression is not activated in the rollup config")
+	}
 	return nil
 }
 
@@ -54,6 +115,12 @@ type CLIConfig struct {
 	// L1EthRpc is the HTTP provider URL for L1.
 	L1EthRpc string
 
+	// L1EthRpcFallbacks are the HTTP provider URLs of fallback L1 endpoints, in order of preference.
+	L1EthRpcFallbacks []string
+
+	// L1HealthCheckInterval is the interval of health checks of the L1 endpoints.
+	L1HealthCheckInterval time.Duration
+
 	// L2EthRpc is the HTTP provider URL for the L2 execution engine.
 	L2EthRpc string
 
@@ -79,7 +146,12 @@ type CLIConfig struct {
 	// and creating a new batch.
 	PollInterval time.Duration
 
-	// MaxL1TxSize is the maximum size of a batch tx submitted to L1.
+	// MaxPendingTransactions is the maximum number of concurrent pending
+	// transactions sent to L1. If 0, the number of pending txs is unlimited.
+	MaxPendingTransactions uint64
+
+	// MaxL1TxSize is the maximum size of a batch tx submitted to L1. If 0, it is
+	// detected from the L1 node.
 	MaxL1TxSize uint64
 
 	// TargetL1TxSize is the target size of a batch tx submitted to L1.
@@ -88,10 +160,71 @@ type CLIConfig struct {
 	// TargetNumFrames is the target number of frames per channel.
 	TargetNumFrames int
 
+	// FramesPerTx is the number of frames packed into a single batch tx. The
+	// frame sizes are divided by it, so that the tx sizes stay the same.
+	// If 0, a single frame is sent per tx.
+	FramesPerTx uint64
+
+	// MaxConcurrentChannels is the maximum number of channels submitted at once.
+	MaxConcurrentChannels uint64
+
 	// ApproxComprRatio is the approximate compression ratio (<= 1.0) of the used
 	// compression algorithm.
 	ApproxComprRatio float64
 
+	// CompressionAlgo is the compression algorithm of channels, zlib or zstd.
+	CompressionAlgo string
+
+	// CompressionLevel is the compression level of the algorithm. If 0, the
+	// best compression of the algorithm is used.
+	CompressionLevel int
+
+	// ChannelJournal is the file to persist the pending channel to, so that it
+	// can be resumed after a restart. If empty, the channel is not persisted.
+	ChannelJournal string
+
+	// DAServerAddr is the RPC address of an external DA provider to store the
+	// batcher data on. If empty, the batcher data is submitted to L1.
+	DAServerAddr string
+
+	// BudgetPerHour and BudgetPerDay are the maximum amounts of ETH to spend on
+	// submissions per hour and day. If 0, the amount is unlimited.
+	BudgetPerHour float64
+	BudgetPerDay  float64
+
+	// BudgetThrottle holds back submissions while the budget is exceeded.
+	BudgetThrottle bool
+
+	// SafeLagThreshold is the number of L2 blocks the safe head may lag behind the
+	// unsafe head before submission is accelerated. Disabled if 0.
+	SafeLagThreshold uint64
+
+	// LaggingMaxChannelDuration is the maximum channel duration while the safe head
+	// lags behind. If 0, MaxChannelDuration applies.
+	LaggingMaxChannelDuration uint64
+
+	// FeeSpikeRatio is the ratio of the L1 base fee to its moving average above which
+	// channels stay open for up to HighFeeMaxChannelDuration, and below whose inverse
+	// they stay open for up to LowFeeMaxChannelDuration. Disabled if 0.
+	FeeSpikeRatio             float64
+	HighFeeMaxChannelDuration uint64
+	LowFeeMaxChannelDuration  uint64
+
+	// DryRun runs the batcher pipeline without sending batcher txs.
+	DryRun bool
+
+	// ShutdownDrainTimeout is the maximum duration to submit the buffered blocks on shutdown.
+	ShutdownDrainTimeout time.Duration
+
+	// RotationPrivateKey is the private key of the next batcher sender key. If set, the
+	// batcher rotates to it without halting batch submission.
+	RotationPrivateKey string
+
+	// RotationOwnerPrivateKey is the private key of the SystemConfig owner, used to update
+	// the batcher address on a key rotation. If empty, the batcher waits for the owner to
+	// update it.
+	RotationOwnerPrivateKey string
+
 	TxMgrConfig   txmgr.CLIConfig
 	RPCConfig     rpc.CLIConfig
 	LogConfig     klog.CLIConfig
@@ -122,23 +255,44 @@ func (c CLIConfig) Check() error {
 func NewCLIConfig(ctx *cli.Context) CLIConfig {
 	return CLIConfig{
 		// Required Flags
-		L1EthRpc:        ctx.GlobalString(flags.L1EthRpcFlag.Name),
-		L2EthRpc:        ctx.GlobalString(flags.L2EthRpcFlag.Name),
-		RollupRpc:       ctx.GlobalString(flags.RollupRpcFlag.Name),
-		SubSafetyMargin: ctx.GlobalUint64(flags.SubSafetyMarginFlag.Name),
-		PollInterval:    ctx.GlobalDuration(flags.PollIntervalFlag.Name),
+		L1EthRpc:              ctx.GlobalString(flags.L1EthRpcFlag.Name),
+		L1EthRpcFallbacks:     ctx.GlobalStringSlice(flags.L1EthRpcFallbacksFlag.Name),
+		L1HealthCheckInterval: ctx.GlobalDuration(flags.L1HealthCheckIntervalFlag.Name),
+		L2EthRpc:              ctx.GlobalString(flags.L2EthRpcFlag.Name),
+		RollupRpc:             ctx.GlobalString(flags.RollupRpcFlag.Name),
+		SubSafetyMargin:       ctx.GlobalUint64(flags.SubSafetyMarginFlag.Name),
+		PollInterval:          ctx.GlobalDuration(flags.PollIntervalFlag.Name),
 
 		// Optional Flags
-		MaxChannelDuration: ctx.GlobalUint64(flags.MaxChannelDurationFlag.Name),
-		MaxL1TxSize:        ctx.GlobalUint64(flags.MaxL1TxSizeBytesFlag.Name),
-		TargetL1TxSize:     ctx.GlobalUint64(flags.TargetL1TxSizeBytesFlag.Name),
-		TargetNumFrames:    ctx.GlobalInt(flags.TargetNumFramesFlag.Name),
-		ApproxComprRatio:   ctx.GlobalFloat64(flags.ApproxComprRatioFlag.Name),
-		TxMgrConfig:        txmgr.ReadCLIConfig(ctx),
-		RPCConfig:          rpc.ReadCLIConfig(ctx),
-		LogConfig:          klog.ReadCLIConfig(ctx),
-		MetricsConfig:      kmetrics.ReadCLIConfig(ctx),
-		PprofConfig:        kpprof.ReadCLIConfig(ctx),
+		MaxPendingTransactions:    ctx.GlobalUint64(flags.MaxPendingTransactionsFlag.Name),
+		MaxChannelDuration:        ctx.GlobalUint64(flags.MaxChannelDurationFlag.Name),
+		MaxL1TxSize:               ctx.GlobalUint64(flags.MaxL1TxSizeBytesFlag.Name),
+		TargetL1TxSize:            ctx.GlobalUint64(flags.TargetL1TxSizeBytesFlag.Name),
+		TargetNumFrames:           ctx.GlobalInt(flags.TargetNumFramesFlag.Name),
+		MaxConcurrentChannels:     ctx.GlobalUint64(flags.MaxConcurrentChannelsFlag.Name),
+		FramesPerTx:               ctx.GlobalUint64(flags.FramesPerTxFlag.Name),
+		ApproxComprRatio:          ctx.GlobalFloat64(flags.ApproxComprRatioFlag.Name),
+		CompressionAlgo:           ctx.GlobalString(flags.CompressionAlgoFlag.Name),
+		CompressionLevel:          ctx.GlobalInt(flags.CompressionLevelFlag.Name),
+		ChannelJournal:            ctx.GlobalString(flags.ChannelJournalFlag.Name),
+		DAServerAddr:              ctx.GlobalString(flags.DAServerAddrFlag.Name),
+		BudgetPerHour:             ctx.GlobalFloat64(flags.BudgetPerHourFlag.Name),
+		BudgetPerDay:              ctx.GlobalFloat64(flags.BudgetPerDayFlag.Name),
+		BudgetThrottle:            ctx.GlobalBool(flags.BudgetThrottleFlag.Name),
+		SafeLagThreshold:          ctx.GlobalUint64(flags.SafeLagThresholdFlag.Name),
+		LaggingMaxChannelDuration: ctx.GlobalUint64(flags.LaggingMaxChannelDurationFlag.Name),
+		FeeSpikeRatio:             ctx.GlobalFloat64(flags.FeeSpikeRatioFlag.Name),
+		HighFeeMaxChannelDuration: ctx.GlobalUint64(flags.HighFeeMaxChannelDurationFlag.Name),
+		LowFeeMaxChannelDuration:  ctx.GlobalUint64(flags.LowFeeMaxChannelDurationFlag.Name),
+		DryRun:                    ctx.GlobalBool(flags.DryRunFlag.Name),
+		ShutdownDrainTimeout:      ctx.GlobalDuration(flags.ShutdownDrainTimeoutFlag.Name),
+		RotationPrivateKey:        ctx.GlobalString(flags.RotationPrivateKeyFlag.Name),
+		RotationOwnerPrivateKey:   ctx.GlobalString(flags.RotationOwnerPrivateKeyFlag.Name),
+		TxMgrConfig:               txmgr.ReadCLIConfig(ctx),
+		RPCConfig:                 rpc.ReadCLIConfig(ctx),
+		LogConfig:                 klog.ReadCLIConfig(ctx),
+		MetricsConfig:             kmetrics.ReadCLIConfig(ctx),
+		PprofConfig:               kpprof.ReadCLIConfig(ctx),
 	}
 }
 
@@ -147,7 +301,7 @@ func NewBatcherConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Config,
 	ctx := context.Background()
 
 	// Connect to L1 and L2 providers. Perform these last since they are the most expensive.
-	l1Client, err := utils.DialEthClientWithTimeout(ctx, cfg.L1EthRpc)
+	l1Client, err := DialFailoverL1Client(ctx, l, m, cfg.L1EthRpc, cfg.L1EthRpcFallbacks)
 	if err != nil {
 		return nil, err
 	}
@@ -167,30 +321,158 @@ func NewBatcherConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Config,
 		return nil, fmt.Errorf("querying rollup config: %w", err)
 	}
 
-	txManager, err := txmgr.NewSimpleTxManager("batcher", l, m, cfg.TxMgrConfig)
+	txManager, err := newTxManager("batcher", l, m, cfg.TxMgrConfig, l1Client)
 	if err != nil {
 		return nil, err
 	}
 
+	var nextTxManager, ownerTxManager txmgr.TxManager
+	if cfg.RotationPrivateKey != "" {
+		nextTxManager, err = newTxManager("batcher_next", l, m, txMgrConfigWithKey(cfg.TxMgrConfig, cfg.RotationPrivateKey), l1Client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to init tx manager of the next batcher key: %w", err)
+		}
+		if cfg.RotationOwnerPrivateKey != "" {
+			ownerTxManager, err = newTxManager("batcher_owner", l, m, txMgrConfigWithKey(cfg.TxMgrConfig, cfg.RotationOwnerPrivateKey), l1Client)
+			if err != nil {
+				return nil, fmt.Errorf("failed to init tx manager of the SystemConfig owner: %w", err)
+			}
+		}
+	}
+
+	maxL1TxSize := cfg.MaxL1TxSize
+	if maxL1TxSize == 0 {
+		maxL1TxSize = detectMaxL1TxSize(ctx, cfg.L1EthRpc, l)
+	}
+	targetL1TxSize := cfg.TargetL1TxSize
+	if targetL1TxSize > maxL1TxSize {
+		targetL1TxSize = maxL1TxSize
+	}
+	framesPerTx := cfg.FramesPerTx
+	if framesPerTx == 0 {
+		framesPerTx = 1
+	}
+
+	var da DAClient = CalldataDA{}
+	if cfg.DAServerAddr != "" {
+		if rcfg.DACommitmentTime == nil {
+			return nil, errors.New("DA provider is configured, but the rollup config does not activate DA commitments")
+		}
+		daRPC, err := client.NewRPC(ctx, l, cfg.DAServerAddr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial DA provider: %w", err)
+		}
+		da = NewExternalDA(sources.NewDAClient(daRPC))
+	}
+
 	return &Config{
-		log:            l,
-		metr:           m,
-		L1Client:       l1Client,
-		L2Client:       l2Client,
-		RollupClient:   rollupClient,
-		PollInterval:   cfg.PollInterval,
-		NetworkTimeout: cfg.TxMgrConfig.NetworkTimeout,
-		TxManager:      txManager,
-		Rollup:         rcfg,
+		log:                        l,
+		metr:                       m,
+		L1Client:                   l1Client,
+		L2Client:                   l2Client,
+		RollupClient:               rollupClient,
+		PollInterval:               cfg.PollInterval,
+		NetworkTimeout:             cfg.TxMgrConfig.NetworkTimeout,
+		L1HealthCheckInterval:      cfg.L1HealthCheckInterval,
+		MaxPendingTransactions:     cfg.MaxPendingTransactions,
+		TxManager:                  txManager,
+		NextTxManager:              nextTxManager,
+		SystemConfigOwnerTxManager: ownerTxManager,
+		RotationConfirmations:      cfg.TxMgrConfig.NumConfirmations,
+		DA:                         da,
+		Rollup:                     rcfg,
 		Channel: ChannelConfig{
-			ProposerWindowSize: rcfg.ProposerWindowSize,
-			ChannelTimeout:     rcfg.ChannelTimeout,
-			MaxChannelDuration: cfg.MaxChannelDuration,
-			SubSafetyMargin:    cfg.SubSafetyMargin,
-			MaxFrameSize:       cfg.MaxL1TxSize - 1,    // subtract 1 byte for version
-			TargetFrameSize:    cfg.TargetL1TxSize - 1, // subtract 1 byte for version
-			TargetNumFrames:    cfg.TargetNumFrames,
-			ApproxComprRatio:   cfg.ApproxComprRatio,
+			ProposerWindowSize:    rcfg.ProposerWindowSize,
+			ChannelTimeout:        rcfg.ChannelTimeout,
+			MaxConcurrentChannels: cfg.MaxConcurrentChannels,
+			MaxChannelDuration:    cfg.MaxChannelDuration,
+			SubSafetyMargin:       cfg.SubSafetyMargin,
+			FramesPerTx:           int(framesPerTx),
+			MaxFrameSize:          (maxL1TxSize - 1) / framesPerTx,    // subtract 1 byte for version
+			TargetFrameSize:       (targetL1TxSize - 1) / framesPerTx, // subtract 1 byte for version
+			TargetNumFrames:       cfg.TargetNumFrames,
+			ApproxComprRatio:      cfg.ApproxComprRatio,
+			Compression: derive.CompressionConfig{
+				Algo:  derive.CompressionAlgo(cfg.CompressionAlgo),
+				Level: cfg.CompressionLevel,
+			},
 		},
+		ChannelJournal: cfg.ChannelJournal,
+		Budget: CostBudget{
+			PerHour:  etherToWei(cfg.BudgetPerHour),
+			PerDay:   etherToWei(cfg.BudgetPerDay),
+			Throttle: cfg.BudgetThrottle,
+		},
+		Backpressure: BackpressureConfig{
+			SafeLagThreshold:   cfg.SafeLagThreshold,
+			MaxChannelDuration: cfg.LaggingMaxChannelDuration,
+		},
+		FeeAdaptive: FeeAdaptiveConfig{
+			FeeSpikeRatio:      cfg.FeeSpikeRatio,
+			MaxChannelDuration: cfg.HighFeeMaxChannelDuration,
+			MinChannelDuration: cfg.LowFeeMaxChannelDuration,
+		},
+		DryRun:               cfg.DryRun,
+		ShutdownDrainTimeout: cfg.ShutdownDrainTimeout,
 	}, nil
 }
+
+// newTxManager creates a tx manager that sends through the L1 client, so that
+// tx submission fails over along with the reads of the batcher.
+func newTxManager(name string, l log.Logger, m metrics.Metricer, cfg txmgr.CLIConfig, l1Client *FailoverL1Client) (txmgr.TxManager, error) {
+	conf, err := txmgr.NewConfig(cfg, l)
+	if err != nil {
+		return nil, err
+	}
+	conf.Backend = l1Client
+	return txmgr.NewSimpleTxManagerFromConfig(name, l, m, conf), nil
+}
+
+// txMgrConfigWithKey returns the tx manager config with its signer replaced by the private key.
+func txMgrConfigWithKey(cfg txmgr.CLIConfig, privateKey string) txmgr.CLIConfig {
+	cfg.PrivateKey = privateKey
+	cfg.Mnemonic = ""
+	cfg.HDPath = ""
+	cfg.SignerCLIConfig = ksigner.CLIConfig{}
+	return cfg
+}
+
+// etherToWei converts the amount of ether to wei. It returns nil for non-positive amounts.
+func etherToWei(ether float64) *big.Int {
+	if ether <= 0 {
+		return nil
+	}
+	wei, _ := new(big.Float).Mul(big.NewFloat(ether), big.NewFloat(params.Ether)).Int(nil)
+	return wei
+}
+
+const (
+	// defaultMaxL1TxSize is the maximum batch tx size if the L1 node is unknown.
+	defaultMaxL1TxSize = 120_000
+	// gethMaxL1TxSize is the maximum batch tx size accepted by the geth tx pool,
+	// which rejects txs larger than 128KiB, leaving room for the rest of the tx.
+	gethMaxL1TxSize = 128*1024 - 1024
+)
+
+// detectMaxL1TxSize returns the maximum batch tx size that the tx pool of the L1
+// node accepts, based on its client version.
+func detectMaxL1TxSize(ctx context.Context, l1EthRpc string, l log.Logger) uint64 {
+	ctx, cancel := context.WithTimeout(ctx, utils.DefaultDialTimeout)
+	defer cancel()
+	var version string
+	l1RPC, err := gethrpc.DialContext(ctx, l1EthRpc)
+	if err == nil {
+		defer l1RPC.Close()
+		err = l1RPC.CallContext(ctx, &version, "web3_clientVersion")
+	}
+	if err != nil {
+		l.Warn("failed to detect L1 client, using default max L1 tx size", "size", defaultMaxL1TxSize, "err", err)
+		return defaultMaxL1TxSize
+	}
+	size := uint64(defaultMaxL1TxSize)
+	if strings.HasPrefix(strings.ToLower(version), "geth/") {
+		size = gethMaxL1TxSize
+	}
+	l.Info("detected max L1 tx size", "client", version, "size", size)
+	return size
+}
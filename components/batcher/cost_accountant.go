@@ -0,0 +1,84 @@
+package batcher
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// CostBudget is the maximum amount of ETH (in wei) the batcher may spend on
+// submissions. A nil limit is unlimited.
+type CostBudget struct {
+	PerHour *big.Int
+	PerDay  *big.Int
+	// Throttle holds back submissions while the budget is exceeded, instead of
+	// only alerting.
+	Throttle bool
+}
+
+type costEntry struct {
+	time time.Time
+	cost *big.Int
+}
+
+// CostAccountant tracks the ETH spent on submissions over the last hour and day
+// and compares it against a budget.
+type CostAccountant struct {
+	budget CostBudget
+	// entries are the costs of the last day, oldest first.
+	entries []costEntry
+	now     func() time.Time
+}
+
+func NewCostAccountant(budget CostBudget) *CostAccountant {
+	return &CostAccountant{
+		budget: budget,
+		now:    time.Now,
+	}
+}
+
+// Record adds the fee paid for the transaction of the receipt.
+func (a *CostAccountant) Record(receipt *types.Receipt) {
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
+	a.entries = append(a.entries, costEntry{time: a.now(), cost: cost})
+}
+
+// Spent returns the ETH (in wei) spent over the last hour and day.
+func (a *CostAccountant) Spent() (hour *big.Int, day *big.Int) {
+	now := a.now()
+	a.prune(now)
+
+	hour, day = new(big.Int), new(big.Int)
+	for _, e := range a.entries {
+		day.Add(day, e.cost)
+		if now.Sub(e.time) < time.Hour {
+			hour.Add(hour, e.cost)
+		}
+	}
+	return hour, day
+}
+
+// OverBudget returns whether the ETH spent over the last hour or day exceeds the budget.
+func (a *CostAccountant) OverBudget() bool {
+	hour, day := a.Spent()
+	return exceeds(hour, a.budget.PerHour) || exceeds(day, a.budget.PerDay)
+}
+
+// Throttled returns whether submissions have to be held back to stay within the budget.
+func (a *CostAccountant) Throttled() bool {
+	return a.budget.Throttle && a.OverBudget()
+}
+
+// prune drops the entries that are older than a day.
+func (a *CostAccountant) prune(now time.Time) {
+	i := 0
+	for i < len(a.entries) && now.Sub(a.entries[i].time) >= 24*time.Hour {
+		i++
+	}
+	a.entries = a.entries[i:]
+}
+
+func exceeds(spent *big.Int, limit *big.Int) bool {
+	return limit != nil && spent.Cmp(limit) > 0
+}
@@ -0,0 +1,55 @@
+package batcher
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCostAccountant(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	a := NewCostAccountant(CostBudget{
+		PerHour:  big.NewInt(2500),
+		PerDay:   big.NewInt(4000),
+		Throttle: true,
+	})
+	a.now = func() time.Time { return now }
+	receipt := &types.Receipt{GasUsed: 100, EffectiveGasPrice: big.NewInt(10)}
+
+	a.Record(receipt)
+	now = now.Add(30 * time.Minute)
+	a.Record(receipt)
+	hour, day := a.Spent()
+	require.Equal(t, big.NewInt(2000), hour)
+	require.Equal(t, big.NewInt(2000), day)
+	require.False(t, a.Throttled())
+
+	a.Record(receipt)
+	require.True(t, a.OverBudget(), "hourly budget exceeded")
+	require.True(t, a.Throttled())
+
+	now = now.Add(time.Hour)
+	hour, day = a.Spent()
+	require.Zero(t, hour.Sign())
+	require.Equal(t, big.NewInt(3000), day)
+	require.False(t, a.OverBudget())
+
+	a.Record(receipt)
+	a.Record(receipt)
+	require.True(t, a.OverBudget(), "daily budget exceeded")
+
+	now = now.Add(24 * time.Hour)
+	_, day = a.Spent()
+	require.Zero(t, day.Sign())
+	require.False(t, a.OverBudget())
+}
+
+func TestCostAccountantAlertOnly(t *testing.T) {
+	a := NewCostAccountant(CostBudget{PerHour: big.NewInt(1)})
+	a.Record(&types.Receipt{GasUsed: 100, EffectiveGasPrice: big.NewInt(10)})
+	require.True(t, a.OverBudget())
+	require.False(t, a.Throttled())
+}
@@ -0,0 +1,46 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+	"github.com/kroma-network/kroma/components/node/sources"
+)
+
+// errDAFailure is returned if the batcher data could not be made available.
+var errDAFailure = errors.New("failed to put batch data")
+
+// DAClient makes batcher data available and returns the data of the L1 transaction
+// that the rollup node derives the batcher data from.
+type DAClient interface {
+	// PutBatchData makes the batcher data available, e.g. by storing it on an external
+	// DA provider, and returns the data to submit to the batch inbox on L1.
+	PutBatchData(ctx context.Context, data []byte) ([]byte, error)
+}
+
+// CalldataDA submits the batcher data itself as L1 calldata.
+type CalldataDA struct{}
+
+func (CalldataDA) PutBatchData(_ context.Context, data []byte) ([]byte, error) {
+	return data, nil
+}
+
+// ExternalDA stores the batcher data on an external DA provider and only submits a
+// commitment to it to L1, which the rollup node resolves with the same DA provider.
+type ExternalDA struct {
+	client *sources.DAClient
+}
+
+func NewExternalDA(client *sources.DAClient) *ExternalDA {
+	return &ExternalDA{client: client}
+}
+
+func (d *ExternalDA) PutBatchData(ctx context.Context, data []byte) ([]byte, error) {
+	comm := derive.NewKeccak256DACommitment(data)
+	if err := d.client.SetInput(ctx, comm, data); err != nil {
+		return nil, fmt.Errorf("failed to store batch data on DA provider: %w", err)
+	}
+	return comm.TxData(), nil
+}
@@ -0,0 +1,74 @@
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+)
+
+// dryRunReport accumulates the batcher txs projected in dry-run mode.
+type dryRunReport struct {
+	txs   int
+	bytes int
+	fees  *big.Int
+}
+
+// dryRunTx projects the batcher tx of the tx data instead of sending it, and marks
+// the tx data as confirmed at the L1 tip, so that the pipeline continues as if the
+// tx got included right away.
+func (b *Batcher) dryRunTx(txdata txData, l1tip eth.L1BlockRef) error {
+	data := txdata.Bytes()
+	if _, ok := b.cfg.DA.(*ExternalDA); ok {
+		data = derive.NewKeccak256DACommitment(data).TxData()
+	}
+	gas, err := core.IntrinsicGas(data, nil, false, true, true, false)
+	if err != nil {
+		return fmt.Errorf("failed to calculate intrinsic gas: %w", err)
+	}
+	gasPrice, err := b.estimateGasPrice()
+	if err != nil {
+		return err
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(gas), gasPrice)
+
+	b.dryRun.txs++
+	b.dryRun.bytes += len(data)
+	b.dryRun.fees.Add(b.dryRun.fees, fee)
+	b.l.Info("projected batcher tx (dry-run)",
+		"id", txdata.ID(), "size", len(data), "gas", gas, "gas_price", gasPrice, "fee", fee,
+		"total_txs", b.dryRun.txs, "total_bytes", b.dryRun.bytes, "total_fees", b.dryRun.fees)
+
+	b.cfg.metr.RecordBatchTxData(len(data), len(txdata.Frames()))
+	b.recordCost(&types.Receipt{GasUsed: gas, EffectiveGasPrice: gasPrice})
+	b.batchSubmitter.state.TxConfirmed(txdata.ID(), l1tip.ID())
+	return nil
+}
+
+// estimateGasPrice estimates the gas price of a batcher tx included in the next L1 block.
+func (b *Batcher) estimateGasPrice() (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(b.killCtx, b.cfg.NetworkTimeout)
+	defer cancel()
+	tip, err := b.cfg.L1Client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the suggested gas tip cap: %w", err)
+	}
+	head, err := b.cfg.L1Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the L1 head: %w", err)
+	}
+	if head.BaseFee == nil {
+		return tip, nil
+	}
+	return new(big.Int).Add(tip, head.BaseFee), nil
+}
+
+// logDryRunReport logs the totals of the batcher txs projected in dry-run mode.
+func (b *Batcher) logDryRunReport() {
+	b.l.Info("dry-run report", "txs", b.dryRun.txs, "bytes", b.dryRun.bytes, "fees", b.dryRun.fees)
+}
@@ -0,0 +1,55 @@
+package batcher
+
+import (
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/utils/service/txmgr/mocks"
+)
+
+func TestBatcherDryRunTx(t *testing.T) {
+	txMgr := new(mocks.TxManager)
+	b, _ := newTestBatcher(t, txMgr)
+	b.dryRun = dryRunReport{fees: new(big.Int)}
+	state := b.batchSubmitter.state
+
+	l1tip := eth.L1BlockRef{Number: 100}
+	require.NoError(t, state.Flush(l1tip.ID()))
+
+	var (
+		txs   int
+		bytes int
+		gas   uint64
+	)
+	for {
+		txdata, err := state.TxData(l1tip.ID())
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		txGas, err := core.IntrinsicGas(txdata.Bytes(), nil, false, true, true, false)
+		require.NoError(t, err)
+		txs++
+		bytes += len(txdata.Bytes())
+		gas += txGas
+
+		require.NoError(t, b.dryRunTx(txdata, l1tip))
+	}
+
+	require.NotZero(t, txs)
+	require.Equal(t, txs, b.dryRun.txs)
+	require.Equal(t, bytes, b.dryRun.bytes)
+	// the gas price is the suggested tip cap of 2 plus the base fee of 10.
+	fees := new(big.Int).SetUint64(gas * 12)
+	require.Equal(t, fees, b.dryRun.fees)
+	hour, _ := b.costs.Spent()
+	require.Equal(t, fees, hour, "projected fees count towards the budget")
+	require.Empty(t, state.BufferedBlocks(), "projected txs are confirmed right away")
+	txMgr.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,123 @@
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+)
+
+// FeeAdaptiveConfig adapts the duration of new channels to the L1 base fee:
+// channels stay open longer while the base fee spikes above its moving average,
+// so that fewer batcher txs are sent, and are closed sooner while it is low.
+// The channel timeout and the proposer window still close channels in time.
+type FeeAdaptiveConfig struct {
+	// FeeSpikeRatio is the ratio of the base fee to its moving average above
+	// which the base fee is considered high, and below whose inverse it is
+	// considered low. Disabled if 0, must be greater than 1 otherwise.
+	FeeSpikeRatio float64
+	// MaxChannelDuration is the maximum channel duration (in #L1-blocks) while
+	// the base fee is high.
+	MaxChannelDuration uint64
+	// MinChannelDuration is the maximum channel duration (in #L1-blocks) while
+	// the base fee is low. If 0, the regular maximum channel duration applies.
+	MinChannelDuration uint64
+}
+
+func (c FeeAdaptiveConfig) Check() error {
+	if c.FeeSpikeRatio != 0 && c.FeeSpikeRatio <= 1 {
+		return fmt.Errorf("fee spike ratio %v must be greater than 1", c.FeeSpikeRatio)
+	}
+	return nil
+}
+
+type l1FeeLevel string
+
+const (
+	l1FeeNormal l1FeeLevel = "normal"
+	l1FeeHigh   l1FeeLevel = "high"
+	l1FeeLow    l1FeeLevel = "low"
+)
+
+// Channel returns the channel config to use at the given L1 fee level.
+func (c FeeAdaptiveConfig) Channel(cfg ChannelConfig, level l1FeeLevel) ChannelConfig {
+	switch level {
+	case l1FeeHigh:
+		if c.MaxChannelDuration != 0 {
+			cfg.MaxChannelDuration = c.MaxChannelDuration
+		}
+	case l1FeeLow:
+		if c.MinChannelDuration != 0 {
+			cfg.MaxChannelDuration = c.MinChannelDuration
+		}
+	}
+	return cfg
+}
+
+// baseFeeAvgWeight is the weight of a new base fee in the moving average.
+const baseFeeAvgWeight = 0.1
+
+// baseFeeTracker tracks the exponential moving average of the L1 base fee.
+type baseFeeTracker struct {
+	avg       float64
+	lastBlock uint64
+}
+
+// update adds the base fee of the L1 block to the moving average and returns the
+// level of the base fee relative to the average before the update.
+func (t *baseFeeTracker) update(number uint64, baseFee *big.Int, spikeRatio float64) l1FeeLevel {
+	fee, _ := new(big.Float).SetInt(baseFee).Float64()
+	if t.avg == 0 {
+		t.avg, t.lastBlock = fee, number
+		return l1FeeNormal
+	}
+
+	level := l1FeeNormal
+	if fee > t.avg*spikeRatio {
+		level = l1FeeHigh
+	} else if fee < t.avg/spikeRatio {
+		level = l1FeeLow
+	}
+	if number > t.lastBlock {
+		t.avg += baseFeeAvgWeight * (fee - t.avg)
+		t.lastBlock = number
+	}
+	return level
+}
+
+// adaptToL1Fees updates the L1 fee level from the base fee of the latest L1 block
+// and switches the channel config of new channels if the level changed.
+func (b *Batcher) adaptToL1Fees() {
+	fa := b.cfg.FeeAdaptive
+	if fa.FeeSpikeRatio == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(b.killCtx, b.cfg.NetworkTimeout)
+	head, err := b.cfg.L1Client.HeaderByNumber(ctx, nil)
+	cancel()
+	if err != nil {
+		b.l.Error("failed to fetch the L1 head", "err", err)
+		return
+	}
+	if head.BaseFee == nil {
+		return
+	}
+
+	level := b.baseFees.update(head.Number.Uint64(), head.BaseFee, fa.FeeSpikeRatio)
+	if level == b.feeLevel {
+		return
+	}
+	b.l.Info("L1 fee level changed, adapting channel duration", "level", level, "previous", b.feeLevel,
+		"base_fee", head.BaseFee, "avg_base_fee", b.baseFees.avg)
+	b.feeLevel = level
+	b.updateChannelConfig()
+}
+
+// updateChannelConfig sets the config of new channels depending on the L1 fee level
+// and the safe head lag, where accelerating submission for the lag takes precedence.
+func (b *Batcher) updateChannelConfig() {
+	cfg := b.cfg.FeeAdaptive.Channel(b.cfg.Channel, b.feeLevel)
+	if b.lagging {
+		cfg = b.cfg.Backpressure.Channel(cfg)
+	}
+	b.batchSubmitter.state.SetChannelConfig(cfg)
+}
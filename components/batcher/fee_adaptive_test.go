@@ -0,0 +1,32 @@
+package batcher
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseFeeTracker(t *testing.T) {
+	var tr baseFeeTracker
+	require.Equal(t, l1FeeNormal, tr.update(1, big.NewInt(100), 2))
+	require.Equal(t, l1FeeNormal, tr.update(2, big.NewInt(150), 2))
+	require.Equal(t, l1FeeHigh, tr.update(3, big.NewInt(300), 2))
+	require.Equal(t, l1FeeLow, tr.update(4, big.NewInt(40), 2))
+
+	avg := tr.avg
+	tr.update(4, big.NewInt(1000), 2)
+	require.Equal(t, avg, tr.avg, "same block is only averaged once")
+}
+
+func TestFeeAdaptiveChannelConfig(t *testing.T) {
+	cfg := ChannelConfig{MaxChannelDuration: 10}
+	fa := FeeAdaptiveConfig{FeeSpikeRatio: 1.5, MaxChannelDuration: 50, MinChannelDuration: 2}
+
+	require.Equal(t, uint64(10), fa.Channel(cfg, l1FeeNormal).MaxChannelDuration)
+	require.Equal(t, uint64(50), fa.Channel(cfg, l1FeeHigh).MaxChannelDuration)
+	require.Equal(t, uint64(2), fa.Channel(cfg, l1FeeLow).MaxChannelDuration)
+
+	require.NoError(t, fa.Check())
+	require.Error(t, FeeAdaptiveConfig{FeeSpikeRatio: 0.5}.Check())
+}
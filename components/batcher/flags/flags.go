@@ -1,9 +1,12 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli"
 
 	"github.com/kroma-network/kroma/components/batcher/rpc"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
 	kservice "github.com/kroma-network/kroma/utils/service"
 	klog "github.com/kroma-network/kroma/utils/service/log"
 	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
@@ -54,15 +57,22 @@ var (
 	// Optional flags
 
 	MaxChannelDurationFlag = cli.Uint64Flag{
-		Name:   "max-channel-duration",
-		Usage:  "The maximum duration of L1-blocks to keep a channel open. 0 to disable.",
+		Name: "max-channel-duration",
+		Usage: "The maximum duration of L1-blocks to keep a channel open. 0 to disable. " +
+			"Must not exceed the proposer window size of the rollup config minus the sub safety margin.",
 		Value:  0,
 		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "MAX_CHANNEL_DURATION"),
 	}
+	MaxPendingTransactionsFlag = cli.Uint64Flag{
+		Name:   "max-pending-tx",
+		Usage:  "The maximum number of pending transactions. 0 for no limit.",
+		Value:  1,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "MAX_PENDING_TX"),
+	}
 	MaxL1TxSizeBytesFlag = cli.Uint64Flag{
 		Name:   "max-l1-tx-size-bytes",
-		Usage:  "The maximum size of a batch tx submitted to L1.",
-		Value:  120_000,
+		Usage:  "The maximum size of a batch tx submitted to L1. If 0, it is detected from the L1 node.",
+		Value:  0,
 		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "MAX_L1_TX_SIZE_BYTES"),
 	}
 	TargetL1TxSizeBytesFlag = cli.Uint64Flag{
@@ -77,12 +87,135 @@ var (
 		Value:  1,
 		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "TARGET_NUM_FRAMES"),
 	}
+	FramesPerTxFlag = cli.Uint64Flag{
+		Name:   "frames-per-tx",
+		Usage:  "The number of frames packed into a single batch tx. The frame size is the tx size divided by it.",
+		Value:  1,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "FRAMES_PER_TX"),
+	}
+	MaxConcurrentChannelsFlag = cli.Uint64Flag{
+		Name: "max-concurrent-channels",
+		Usage: "The maximum number of channels whose frames are submitted at once. The next channel is opened " +
+			"once all frames of the previous channel were sent, without waiting for their confirmation.",
+		Value:  1,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "MAX_CONCURRENT_CHANNELS"),
+	}
 	ApproxComprRatioFlag = cli.Float64Flag{
 		Name:   "approx-compr-ratio",
 		Usage:  "The approximate compression ratio (<= 1.0)",
 		Value:  1.0,
 		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "APPROX_COMPR_RATIO"),
 	}
+	CompressionAlgoFlag = cli.StringFlag{
+		Name: "compression-algo",
+		Usage: "The compression algorithm of channels: zlib or zstd. zstd is only accepted by " +
+			"rollup nodes after the zstd compression activation of the rollup config.",
+		Value:  string(derive.CompressionZlib),
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "COMPRESSION_ALGO"),
+	}
+	CompressionLevelFlag = cli.IntFlag{
+		Name:   "compression-level",
+		Usage:  "The compression level of the algorithm: 1-9 for zlib, 1-22 for zstd. 0 for the best compression.",
+		Value:  0,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "COMPRESSION_LEVEL"),
+	}
+	DAServerAddrFlag = cli.StringFlag{
+		Name: "da-server-addr",
+		Usage: "RPC address of an external DA provider to store the batcher data on. " +
+			"Only a commitment to the data is submitted to L1 then. Disabled if empty.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "DA_SERVER_ADDR"),
+	}
+	ChannelJournalFlag = cli.StringFlag{
+		Name: "channel-journal",
+		Usage: "File to persist the pending channel to, so that it can be resumed " +
+			"after a restart. Disabled if empty.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "CHANNEL_JOURNAL"),
+	}
+	BudgetPerHourFlag = cli.Float64Flag{
+		Name:   "budget-per-hour",
+		Usage:  "The maximum amount of ETH to spend on batch submission per hour. Unlimited if 0.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BUDGET_PER_HOUR"),
+	}
+	BudgetPerDayFlag = cli.Float64Flag{
+		Name:   "budget-per-day",
+		Usage:  "The maximum amount of ETH to spend on batch submission per day. Unlimited if 0.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BUDGET_PER_DAY"),
+	}
+	SafeLagThresholdFlag = cli.Uint64Flag{
+		Name: "safe-lag-threshold",
+		Usage: "The number of L2 blocks the safe head may lag behind the unsafe head before batch submission " +
+			"is accelerated with smaller channels. 0 to disable.",
+		Value:  0,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "SAFE_LAG_THRESHOLD"),
+	}
+	LaggingMaxChannelDurationFlag = cli.Uint64Flag{
+		Name:   "lagging-max-channel-duration",
+		Usage:  "The maximum duration of L1-blocks to keep a channel open while the safe head lags behind. 0 to use max-channel-duration.",
+		Value:  0,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "LAGGING_MAX_CHANNEL_DURATION"),
+	}
+	FeeSpikeRatioFlag = cli.Float64Flag{
+		Name: "fee-spike-ratio",
+		Usage: "The ratio of the L1 base fee to its moving average above which the base fee is considered high, " +
+			"and below whose inverse it is considered low, to adapt the channel duration to. 0 to disable.",
+		Value:  0,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "FEE_SPIKE_RATIO"),
+	}
+	HighFeeMaxChannelDurationFlag = cli.Uint64Flag{
+		Name:   "high-fee-max-channel-duration",
+		Usage:  "The maximum duration of L1-blocks to keep a channel open while the L1 base fee is high. 0 to use max-channel-duration.",
+		Value:  0,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "HIGH_FEE_MAX_CHANNEL_DURATION"),
+	}
+	LowFeeMaxChannelDurationFlag = cli.Uint64Flag{
+		Name:   "low-fee-max-channel-duration",
+		Usage:  "The maximum duration of L1-blocks to keep a channel open while the L1 base fee is low. 0 to use max-channel-duration.",
+		Value:  0,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "LOW_FEE_MAX_CHANNEL_DURATION"),
+	}
+	ShutdownDrainTimeoutFlag = cli.DurationFlag{
+		Name: "shutdown-drain-timeout",
+		Usage: "The maximum duration to keep submitting all buffered L2 blocks and waiting for their confirmation " +
+			"on shutdown. 0 to only submit the frames of the pending channel.",
+		Value:  2 * time.Minute,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "SHUTDOWN_DRAIN_TIMEOUT"),
+	}
+	DryRunFlag = cli.BoolFlag{
+		Name: "dry-run",
+		Usage: "Run the batcher pipeline against the live chain without sending batcher txs, " +
+			"logging the projected tx counts, sizes and fees instead.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "DRY_RUN"),
+	}
+	RotationPrivateKeyFlag = cli.StringFlag{
+		Name: "rotation.private-key",
+		Usage: "The private key of the next batcher sender key. If set, the batcher drains its pending " +
+			"channel and continues with this key once the SystemConfig batcher address is updated to it.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "ROTATION_PRIVATE_KEY"),
+	}
+	RotationOwnerPrivateKeyFlag = cli.StringFlag{
+		Name: "rotation.owner-private-key",
+		Usage: "The private key of the SystemConfig owner, to update the batcher address on a key rotation. " +
+			"If empty, the batcher waits for the owner to update it.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "ROTATION_OWNER_PRIVATE_KEY"),
+	}
+	L1EthRpcFallbacksFlag = cli.StringSliceFlag{
+		Name: "l1-eth-rpc-fallbacks",
+		Usage: "HTTP provider URLs of fallback L1 endpoints, in order of preference. Reads and tx submissions " +
+			"fail over to them while the L1 endpoint is unavailable.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "L1_ETH_RPC_FALLBACKS"),
+	}
+	L1HealthCheckIntervalFlag = cli.DurationFlag{
+		Name:   "l1-health-check-interval",
+		Usage:  "The interval of health checks of the L1 endpoints, if fallback endpoints are configured. 0 to disable.",
+		Value:  10 * time.Second,
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "L1_HEALTH_CHECK_INTERVAL"),
+	}
+	BudgetThrottleFlag = cli.BoolFlag{
+		Name: "budget-throttle",
+		Usage: "Hold back batch submission while the budget is exceeded. " +
+			"If not set, exceeding the budget is only alerted.",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BUDGET_THROTTLE"),
+	}
 )
 
 var requiredFlags = []cli.Flag{
@@ -95,10 +228,31 @@ var requiredFlags = []cli.Flag{
 
 var optionalFlags = []cli.Flag{
 	MaxChannelDurationFlag,
+	MaxPendingTransactionsFlag,
 	MaxL1TxSizeBytesFlag,
 	TargetL1TxSizeBytesFlag,
 	TargetNumFramesFlag,
+	MaxConcurrentChannelsFlag,
+	FramesPerTxFlag,
 	ApproxComprRatioFlag,
+	CompressionAlgoFlag,
+	CompressionLevelFlag,
+	ChannelJournalFlag,
+	DAServerAddrFlag,
+	BudgetPerHourFlag,
+	BudgetPerDayFlag,
+	BudgetThrottleFlag,
+	SafeLagThresholdFlag,
+	LaggingMaxChannelDurationFlag,
+	FeeSpikeRatioFlag,
+	HighFeeMaxChannelDurationFlag,
+	LowFeeMaxChannelDurationFlag,
+	DryRunFlag,
+	ShutdownDrainTimeoutFlag,
+	RotationPrivateKeyFlag,
+	RotationOwnerPrivateKeyFlag,
+	L1EthRpcFallbacksFlag,
+	L1HealthCheckIntervalFlag,
 }
 
 func init() {
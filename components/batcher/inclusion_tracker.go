@@ -0,0 +1,146 @@
+package batcher
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/kroma-network/kroma/components/batcher/metrics"
+	"github.com/kroma-network/kroma/components/batcher/rpc"
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// maxTrackedInclusions is the number of L2 blocks whose inclusion is tracked.
+const maxTrackedInclusions = 10_000
+
+// inclusionTracker tracks the batcher txs that carry the data of the recently
+// submitted L2 blocks, so that operators can prove their data posting SLAs.
+// It is safe for concurrent access.
+type inclusionTracker struct {
+	mu   sync.Mutex
+	metr metrics.Metricer
+	now  func() time.Time
+
+	// channelTimeout bounds how long confirmed txs of channels that are not
+	// fully submitted yet are kept.
+	channelTimeout uint64
+	// txs maps the frames of confirmed txs to their tx.
+	txs map[txID]rpc.L1TxInclusion
+
+	// blocks are the inclusions of the tracked L2 blocks, oldest first.
+	blocks   []*rpc.L2BlockInclusion
+	byNumber map[uint64]*rpc.L2BlockInclusion
+}
+
+func newInclusionTracker(metr metrics.Metricer, channelTimeout uint64) *inclusionTracker {
+	return &inclusionTracker{
+		metr:           metr,
+		now:            time.Now,
+		channelTimeout: channelTimeout,
+		txs:            make(map[txID]rpc.L1TxInclusion),
+		byNumber:       make(map[uint64]*rpc.L2BlockInclusion),
+	}
+}
+
+// TxConfirmed records the batcher tx carrying the frames of the tx data.
+func (t *inclusionTracker) TxConfirmed(txdata txData, receipt *types.Receipt) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	inclusion := rpc.L1TxInclusion{
+		TxHash:  receipt.TxHash,
+		L1Block: eth.BlockID{Number: receipt.BlockNumber.Uint64(), Hash: receipt.BlockHash},
+	}
+	for _, frame := range txdata.Frames() {
+		t.txs[frame.id] = inclusion
+	}
+	// Drop the txs of channels that timed out or got cleared.
+	for id, tx := range t.txs {
+		if tx.L1Block.Number+t.channelTimeout < inclusion.L1Block.Number {
+			delete(t.txs, id)
+		}
+	}
+}
+
+// ChannelSubmitted records the inclusion of the blocks of a fully submitted
+// channel, whose frames got confirmed in the given L1 blocks.
+func (t *inclusionTracker) ChannelSubmitted(blocks []*types.Block, confirmed map[txID]eth.BlockID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var txs []rpc.L1TxInclusion
+	seen := make(map[common.Hash]bool)
+	for id := range confirmed {
+		tx, ok := t.txs[id]
+		delete(t.txs, id)
+		// Unknown txs were confirmed before a restart. Multiple frames can share a tx.
+		if !ok || seen[tx.TxHash] {
+			continue
+		}
+		seen[tx.TxHash] = true
+		txs = append(txs, tx)
+	}
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].L1Block.Number != txs[j].L1Block.Number {
+			return txs[i].L1Block.Number < txs[j].L1Block.Number
+		}
+		return bytes.Compare(txs[i].TxHash[:], txs[j].TxHash[:]) < 0
+	})
+
+	now := t.now()
+	for _, block := range blocks {
+		inclusion := &rpc.L2BlockInclusion{
+			L2Block:      eth.ToBlockID(block),
+			Txs:          txs,
+			DelaySeconds: now.Sub(time.Unix(int64(block.Time()), 0)).Seconds(),
+		}
+		t.blocks = append(t.blocks, inclusion)
+		t.byNumber[block.NumberU64()] = inclusion
+	}
+	if n := len(t.blocks) - maxTrackedInclusions; n > 0 {
+		for _, old := range t.blocks[:n] {
+			if t.byNumber[old.L2Block.Number] == old {
+				delete(t.byNumber, old.L2Block.Number)
+			}
+		}
+		t.blocks = t.blocks[n:]
+	}
+	t.recordDelays()
+}
+
+// recordDelays records the maximum and average inclusion delay of the tracked blocks.
+func (t *inclusionTracker) recordDelays() {
+	if len(t.blocks) == 0 {
+		return
+	}
+	var max, sum float64
+	for _, b := range t.blocks {
+		sum += b.DelaySeconds
+		if b.DelaySeconds > max {
+			max = b.DelaySeconds
+		}
+	}
+	avg := sum / float64(len(t.blocks))
+	t.metr.RecordDAInclusionDelay(secondsToDuration(max), secondsToDuration(avg))
+}
+
+// Inclusion returns the inclusion of the L2 block with the given number.
+func (t *inclusionTracker) Inclusion(number uint64) (*rpc.L2BlockInclusion, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	inclusion, ok := t.byNumber[number]
+	if !ok {
+		return nil, fmt.Errorf("inclusion of L2 block %d is not tracked", number)
+	}
+	return inclusion, nil
+}
+
+func secondsToDuration(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
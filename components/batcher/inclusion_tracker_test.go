@@ -0,0 +1,63 @@
+package batcher
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/batcher/metrics"
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+)
+
+func TestInclusionTracker(t *testing.T) {
+	tr := newInclusionTracker(metrics.NoopMetrics, 10)
+	tr.now = func() time.Time { return time.Unix(130, 0) }
+
+	chID := derive.ChannelID{0x01}
+	frame0 := frameData{id: txID{chID: chID, frameNumber: 0}}
+	frame1 := frameData{id: txID{chID: chID, frameNumber: 1}}
+	frame2 := frameData{id: txID{chID: chID, frameNumber: 2}}
+	receiptA := &types.Receipt{TxHash: common.Hash{0xa}, BlockHash: common.Hash{0x1}, BlockNumber: big.NewInt(5)}
+	receiptB := &types.Receipt{TxHash: common.Hash{0xb}, BlockHash: common.Hash{0x2}, BlockNumber: big.NewInt(6)}
+	tr.TxConfirmed(txData{frames: []frameData{frame0, frame1}}, receiptA)
+	tr.TxConfirmed(singleFrameTxData(frame2), receiptB)
+
+	blockA := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1), Time: 100})
+	blockB := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(2), Time: 110})
+	confirmed := map[txID]eth.BlockID{
+		frame0.id: {Number: 5, Hash: common.Hash{0x1}},
+		frame1.id: {Number: 5, Hash: common.Hash{0x1}},
+		frame2.id: {Number: 6, Hash: common.Hash{0x2}},
+	}
+	tr.ChannelSubmitted([]*types.Block{blockA, blockB}, confirmed)
+
+	inclusion, err := tr.Inclusion(1)
+	require.NoError(t, err)
+	require.Equal(t, eth.ToBlockID(blockA), inclusion.L2Block)
+	require.Equal(t, 30.0, inclusion.DelaySeconds)
+	require.Len(t, inclusion.Txs, 2, "frames of the same tx are deduplicated")
+	require.Equal(t, receiptA.TxHash, inclusion.Txs[0].TxHash)
+	require.Equal(t, eth.BlockID{Number: 6, Hash: common.Hash{0x2}}, inclusion.Txs[1].L1Block)
+
+	inclusion, err = tr.Inclusion(2)
+	require.NoError(t, err)
+	require.Equal(t, 20.0, inclusion.DelaySeconds)
+	require.Empty(t, tr.txs, "txs of submitted channels are dropped")
+
+	_, err = tr.Inclusion(3)
+	require.Error(t, err)
+}
+
+func TestInclusionTrackerDropsStaleTxs(t *testing.T) {
+	tr := newInclusionTracker(metrics.NoopMetrics, 10)
+	stale := frameData{id: txID{chID: derive.ChannelID{0x01}}}
+	tr.TxConfirmed(singleFrameTxData(stale), &types.Receipt{BlockNumber: big.NewInt(1)})
+	tr.TxConfirmed(singleFrameTxData(frameData{id: txID{chID: derive.ChannelID{0x02}}}), &types.Receipt{BlockNumber: big.NewInt(20)})
+	require.NotContains(t, tr.txs, stale.id)
+	require.Len(t, tr.txs, 1)
+}
@@ -0,0 +1,265 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+)
+
+// errUpdateReverted is returned if the batcher address update tx of the SystemConfig owner reverted.
+var errUpdateReverted = errors.New("batcher address update reverted")
+
+// rotationState is the state of a batcher key rotation.
+type rotationState int
+
+const (
+	// rotationIdle is the state before the pending channel got flushed.
+	rotationIdle rotationState = iota
+	// rotationDraining is the state while the pending channel is drained with the current key.
+	rotationDraining
+	// rotationUpdating is the state while the batcher address update tx of the SystemConfig owner is in flight.
+	rotationUpdating
+	// rotationConfirming is the state while waiting for the batcher address update to be confirmed on L1.
+	rotationConfirming
+	// rotationDone is the state once the batcher address update is confirmed, and the next key can be used.
+	rotationDone
+)
+
+func (s rotationState) String() string {
+	switch s {
+	case rotationIdle:
+		return "idle"
+	case rotationDraining:
+		return "draining"
+	case rotationUpdating:
+		return "updating"
+	case rotationConfirming:
+		return "confirming"
+	case rotationDone:
+		return "done"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+// batcherHashCaller reads the batcher hash of the SystemConfig.
+type batcherHashCaller interface {
+	BatcherHash(opts *bind.CallOpts) ([32]byte, error)
+}
+
+// keyRotation rotates the batcher sender key without halting batch submission.
+// The pending channel is flushed and drained with the current key, while no new
+// channels are opened. Once drained, the batcher address of the SystemConfig is
+// updated to the next key, and submission continues with the next key once the
+// update is confirmed. Rollup nodes only accept batcher txs of the new key in L1
+// blocks after the update, and the txs of the current key are all confirmed before it.
+type keyRotation struct {
+	log log.Logger
+
+	// next is the tx manager of the next batcher key.
+	next txmgr.TxManager
+	// owner is the tx manager of the SystemConfig owner. If nil, the batcher
+	// address is expected to be updated by the owner separately.
+	owner txmgr.TxManager
+
+	sysCfg     batcherHashCaller
+	sysCfgABI  *abi.ABI
+	sysCfgAddr common.Address
+	// confirmations is the number of L1 blocks the batcher address update has to be confirmed by,
+	// before the batcher switches to the next key.
+	confirmations uint64
+
+	state rotationState
+	// updateResult receives the result of the batcher address update tx while updating.
+	updateResult chan error
+}
+
+func newKeyRotation(cfg Config, l log.Logger) (*keyRotation, error) {
+	sysCfg, err := bindings.NewSystemConfigCaller(cfg.Rollup.L1SystemConfigAddress, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := bindings.SystemConfigMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &keyRotation{
+		log:           l,
+		next:          cfg.NextTxManager,
+		owner:         cfg.SystemConfigOwnerTxManager,
+		sysCfg:        sysCfg,
+		sysCfgABI:     parsed,
+		sysCfgAddr:    cfg.Rollup.L1SystemConfigAddress,
+		confirmations: cfg.RotationConfirmations,
+	}, nil
+}
+
+// batcherAddr returns the batcher address of the SystemConfig at the confirmation depth of the given L1 block.
+func (r *keyRotation) batcherAddr(ctx context.Context, l1Head uint64) (common.Address, error) {
+	var number uint64
+	if l1Head > r.confirmations {
+		number = l1Head - r.confirmations
+	}
+	hash, err := r.sysCfg.BatcherHash(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(number)})
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get batcher hash: %w", err)
+	}
+	return common.BytesToAddress(hash[:]), nil
+}
+
+// sendUpdate sends the tx updating the batcher address of the SystemConfig to the next key in the background.
+// The result is received by advance.
+func (r *keyRotation) sendUpdate(ctx context.Context) error {
+	data, err := r.sysCfgABI.Pack("setBatcherHash", common.BytesToHash(r.next.From().Bytes()))
+	if err != nil {
+		return fmt.Errorf("failed to pack set batcher hash: %w", err)
+	}
+	owner, to := r.owner, r.sysCfgAddr
+	result := make(chan error, 1)
+	r.updateResult = result
+	go func() {
+		receipt, err := owner.Send(ctx, txmgr.TxCandidate{
+			TxData: data,
+			To:     &to,
+		})
+		if err == nil && receipt.Status != types.ReceiptStatusSuccessful {
+			err = fmt.Errorf("%w: tx %s", errUpdateReverted, receipt.TxHash)
+		}
+		result <- err
+	}()
+	return nil
+}
+
+// advance moves the rotation forward, given the confirmed batcher address of the SystemConfig
+// and whether the pending channel of the current key is drained.
+func (r *keyRotation) advance(ctx context.Context, batcherAddr common.Address, drained bool) {
+	switch r.state {
+	case rotationDraining:
+		if batcherAddr == r.next.From() {
+			// updated by the owner separately, before the pending channel was drained.
+			r.state = rotationDone
+			return
+		}
+		if !drained {
+			return
+		}
+		if r.owner == nil {
+			r.log.Info("waiting for the SystemConfig batcher address update", "current", batcherAddr, "next", r.next.From())
+			r.state = rotationConfirming
+			return
+		}
+		r.log.Info("updating SystemConfig batcher address", "current", batcherAddr, "next", r.next.From())
+		if err := r.sendUpdate(ctx); err != nil {
+			r.log.Error("failed to update SystemConfig batcher address", "err", err)
+			return
+		}
+		r.state = rotationUpdating
+	case rotationUpdating:
+		select {
+		case err := <-r.updateResult:
+			r.updateResult = nil
+			switch {
+			case errors.Is(err, errUpdateReverted):
+				// Retrying cannot succeed, e.g. if the owner key is not the SystemConfig owner.
+				r.log.Error("SystemConfig batcher address update reverted, waiting for the owner to update it", "err", err)
+				r.owner = nil
+				r.state = rotationConfirming
+			case err != nil:
+				r.log.Error("failed to update SystemConfig batcher address, retrying", "err", err)
+				r.state = rotationDraining
+			default:
+				r.log.Info("SystemConfig batcher address updated, waiting for confirmation", "next", r.next.From())
+				r.state = rotationConfirming
+			}
+		default:
+		}
+	case rotationConfirming:
+		if batcherAddr == r.next.From() {
+			r.state = rotationDone
+		}
+	}
+}
+
+// rotating returns whether a key rotation is in progress.
+func (b *Batcher) rotating() bool {
+	return b.rotation != nil
+}
+
+// rotateKey advances the key rotation, if any. It returns true once the batcher
+// switched to the next key, in which case the tx queue has to be recreated.
+// The current key is kept until the batcher address update is confirmed on L1.
+func (b *Batcher) rotateKey() bool {
+	if !b.rotating() {
+		return false
+	}
+	r := b.rotation
+	state := b.batchSubmitter.state
+
+	l1tip, err := b.batchSubmitter.l1Tip(b.killCtx)
+	if err != nil {
+		b.l.Error("failed to check batcher key rotation", "err", err)
+		return false
+	}
+	ctx, cancel := context.WithTimeout(b.killCtx, b.cfg.NetworkTimeout)
+	batcherAddr, err := r.batcherAddr(ctx, l1tip.Number)
+	cancel()
+	if err != nil {
+		b.l.Error("failed to check batcher key rotation", "err", err)
+		return false
+	}
+
+	if r.state == rotationIdle {
+		if batcherAddr == r.next.From() {
+			// The frames of the pending channel, e.g. restored from the journal after a restart,
+			// would be ignored if they were sent by the current key now, so resubmit everything.
+			b.l.Warn("batcher address is already updated, resubmitting pending blocks with the next key", "next", batcherAddr)
+			b.clearPendingBlocks()
+			r.state = rotationDone
+		} else {
+			b.l.Info("starting batcher key rotation, draining pending channel",
+				"current", b.cfg.TxManager.From(), "next", r.next.From())
+			if err := state.Flush(l1tip.ID()); err != nil {
+				b.l.Error("failed to flush pending channel for key rotation", "err", err)
+			}
+			r.state = rotationDraining
+		}
+	}
+
+	r.advance(b.killCtx, batcherAddr, !state.HasPendingChannel() && b.inFlight == 0)
+	if r.state != rotationDone {
+		b.l.Debug("batcher key rotation in progress", "state", r.state)
+		return false
+	}
+
+	if b.inFlight > 0 {
+		return false
+	}
+	if state.HasPendingChannel() {
+		// The address got updated before the pending channel was drained, frames sent by
+		// the current key after the update are ignored, so resubmit from the safe head.
+		b.l.Warn("batcher address updated before the pending channel was drained, resubmitting pending blocks")
+		b.clearPendingBlocks()
+	}
+	b.l.Info("batcher key rotated, continuing submission with the next key", "batcher", batcherAddr)
+	b.cfg.TxManager = r.next
+	b.cfg.metr.RecordBatcherAddress(r.next.From())
+	b.rotation = nil
+	return true
+}
+
+// clearPendingBlocks drops the pending channels and blocks, so that they are resubmitted from the safe head.
+func (b *Batcher) clearPendingBlocks() {
+	b.batchSubmitter.state.Clear()
+	b.batchSubmitter.lastStoredBlock = eth.BlockID{}
+}
@@ -0,0 +1,148 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/node/testlog"
+	"github.com/kroma-network/kroma/utils/service/txmgr/mocks"
+)
+
+type mockBatcherHashCaller struct {
+	addr        common.Address
+	blockNumber uint64
+}
+
+func (m *mockBatcherHashCaller) BatcherHash(opts *bind.CallOpts) ([32]byte, error) {
+	m.blockNumber = opts.BlockNumber.Uint64()
+	return common.BytesToHash(m.addr.Bytes()), nil
+}
+
+var (
+	rotationCurrentAddr = common.HexToAddress("0x1111")
+	rotationNextAddr    = common.HexToAddress("0x2222")
+)
+
+func newTestKeyRotation(t *testing.T, owner *mocks.TxManager) *keyRotation {
+	parsed, err := bindings.SystemConfigMetaData.GetAbi()
+	require.NoError(t, err)
+	next := new(mocks.TxManager)
+	next.On("From").Return(rotationNextAddr)
+	r := &keyRotation{
+		log:           testlog.Logger(t, log.LvlError),
+		next:          next,
+		sysCfg:        &mockBatcherHashCaller{addr: rotationCurrentAddr},
+		sysCfgABI:     parsed,
+		sysCfgAddr:    common.HexToAddress("0x3333"),
+		confirmations: 10,
+		state:         rotationDraining,
+	}
+	if owner != nil {
+		r.owner = owner
+	}
+	return r
+}
+
+// waitUpdate advances the rotation until the result of the batcher address update is received.
+func waitUpdate(t *testing.T, r *keyRotation) {
+	require.Eventually(t, func() bool {
+		r.advance(context.Background(), rotationCurrentAddr, true)
+		return r.state != rotationUpdating
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestKeyRotationBatcherAddr(t *testing.T) {
+	r := newTestKeyRotation(t, nil)
+	sysCfg := r.sysCfg.(*mockBatcherHashCaller)
+
+	addr, err := r.batcherAddr(context.Background(), 100)
+	require.NoError(t, err)
+	require.Equal(t, rotationCurrentAddr, addr)
+	require.Equal(t, uint64(90), sysCfg.blockNumber, "read at the confirmation depth")
+
+	_, err = r.batcherAddr(context.Background(), 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), sysCfg.blockNumber)
+}
+
+func TestKeyRotationAdvance(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("update by owner key", func(t *testing.T) {
+		owner := new(mocks.TxManager)
+		owner.On("Send", mock.Anything, mock.Anything).Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil).Once()
+		r := newTestKeyRotation(t, owner)
+
+		r.advance(ctx, rotationCurrentAddr, false)
+		require.Equal(t, rotationDraining, r.state, "pending channel is not drained")
+
+		r.advance(ctx, rotationCurrentAddr, true)
+		require.Equal(t, rotationUpdating, r.state)
+		waitUpdate(t, r)
+		require.Equal(t, rotationConfirming, r.state)
+		owner.AssertExpectations(t)
+
+		r.advance(ctx, rotationCurrentAddr, true)
+		require.Equal(t, rotationConfirming, r.state, "update is not confirmed yet")
+		r.advance(ctx, rotationNextAddr, true)
+		require.Equal(t, rotationDone, r.state)
+	})
+
+	t.Run("update by owner separately", func(t *testing.T) {
+		r := newTestKeyRotation(t, nil)
+		r.advance(ctx, rotationCurrentAddr, true)
+		require.Equal(t, rotationConfirming, r.state)
+		r.advance(ctx, rotationNextAddr, true)
+		require.Equal(t, rotationDone, r.state)
+	})
+
+	t.Run("updated before drained", func(t *testing.T) {
+		r := newTestKeyRotation(t, nil)
+		r.advance(ctx, rotationNextAddr, false)
+		require.Equal(t, rotationDone, r.state)
+	})
+
+	t.Run("failed update", func(t *testing.T) {
+		owner := new(mocks.TxManager)
+		owner.On("Send", mock.Anything, mock.Anything).Return(nil, errors.New("boom")).Once()
+		owner.On("Send", mock.Anything, mock.Anything).Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil).Once()
+		r := newTestKeyRotation(t, owner)
+
+		r.advance(ctx, rotationCurrentAddr, true)
+		waitUpdate(t, r)
+		require.Equal(t, rotationDraining, r.state, "failed update is retried")
+
+		r.advance(ctx, rotationCurrentAddr, true)
+		require.Equal(t, rotationUpdating, r.state)
+		waitUpdate(t, r)
+		require.Equal(t, rotationConfirming, r.state)
+		owner.AssertExpectations(t)
+	})
+
+	t.Run("reverted update", func(t *testing.T) {
+		owner := new(mocks.TxManager)
+		owner.On("Send", mock.Anything, mock.Anything).Return(&types.Receipt{Status: types.ReceiptStatusFailed}, nil).Once()
+		r := newTestKeyRotation(t, owner)
+
+		r.advance(ctx, rotationCurrentAddr, true)
+		waitUpdate(t, r)
+		require.Equal(t, rotationConfirming, r.state, "waiting for the owner after a revert")
+		require.Nil(t, r.owner)
+
+		r.advance(ctx, rotationCurrentAddr, true)
+		require.Equal(t, rotationConfirming, r.state)
+		r.advance(ctx, rotationNextAddr, true)
+		require.Equal(t, rotationDone, r.state)
+		owner.AssertExpectations(t)
+	})
+}
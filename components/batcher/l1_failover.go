@@ -0,0 +1,270 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/kroma-network/kroma/utils"
+)
+
+// l1FailoverThreshold is the number of consecutive failed requests to the
+// active L1 endpoint after which the next healthy endpoint takes over.
+const l1FailoverThreshold = 3
+
+type L1FailoverMetrics interface {
+	RecordL1Failover(active string)
+}
+
+// l1Endpoint is an L1 RPC endpoint and its health, as determined by the last
+// health check.
+type l1Endpoint struct {
+	client  *ethclient.Client
+	healthy bool
+}
+
+// FailoverL1Client is an L1 client backed by multiple L1 RPC endpoints, in
+// order of preference. Requests, both reads and tx submissions, are sent to the
+// active endpoint. After consecutive request failures of the active endpoint,
+// or if it fails a health check, the next healthy endpoint takes over. The
+// active endpoint falls back to a preferred endpoint once it is healthy again.
+type FailoverL1Client struct {
+	log     log.Logger
+	metrics L1FailoverMetrics
+
+	mu        sync.Mutex
+	endpoints []*l1Endpoint
+	active    int
+	failures  int
+}
+
+// DialFailoverL1Client dials all L1 RPC endpoints. The primary endpoint must be
+// reachable, while fallback endpoints that can't be dialed are skipped.
+func DialFailoverL1Client(ctx context.Context, l log.Logger, m L1FailoverMetrics, primary string, fallbacks []string) (*FailoverL1Client, error) {
+	client, err := utils.DialEthClientWithTimeout(ctx, primary)
+	if err != nil {
+		return nil, err
+	}
+	clients := []*ethclient.Client{client}
+	for i, url := range fallbacks {
+		client, err := utils.DialEthClientWithTimeout(ctx, url)
+		if err != nil {
+			l.Warn("skipping unreachable fallback L1 endpoint", "endpoint", i+1, "err", err)
+			continue
+		}
+		clients = append(clients, client)
+	}
+	return NewFailoverL1Client(l, m, clients...), nil
+}
+
+func NewFailoverL1Client(l log.Logger, m L1FailoverMetrics, clients ...*ethclient.Client) *FailoverL1Client {
+	endpoints := make([]*l1Endpoint, 0, len(clients))
+	for _, client := range clients {
+		endpoints = append(endpoints, &l1Endpoint{client: client, healthy: true})
+	}
+	return &FailoverL1Client{
+		log:       l,
+		metrics:   m,
+		endpoints: endpoints,
+	}
+}
+
+// Primary returns the client of the primary endpoint.
+func (f *FailoverL1Client) Primary() *ethclient.Client {
+	return f.endpoints[0].client
+}
+
+// current returns the index and the client of the active endpoint.
+func (f *FailoverL1Client) current() (int, *ethclient.Client) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.active, f.endpoints[f.active].client
+}
+
+// isEndpointFailure returns true if the error indicates that the endpoint is
+// unavailable. Errors returned by a responsive node, e.g. a rejected tx or a
+// missing receipt, are not endpoint failures.
+func isEndpointFailure(err error) bool {
+	var rpcErr gethrpc.Error
+	return err != nil && !errors.Is(err, ethereum.NotFound) && !errors.As(err, &rpcErr) &&
+		!errors.Is(err, context.Canceled)
+}
+
+// recordResult tracks the health of the active endpoint, and fails over to the
+// next healthy endpoint if needed.
+func (f *FailoverL1Client) recordResult(idx int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if idx != f.active {
+		return // result of an endpoint that is not active anymore
+	}
+	if !isEndpointFailure(err) {
+		f.failures = 0
+		return
+	}
+	f.failures++
+	if f.failures < l1FailoverThreshold {
+		return
+	}
+	f.endpoints[idx].healthy = false
+	f.failover(err)
+}
+
+// failover switches to the most preferred healthy endpoint, or to the next
+// endpoint if none is healthy. f.mu must be held.
+func (f *FailoverL1Client) failover(err error) {
+	next := (f.active + 1) % len(f.endpoints)
+	for i, e := range f.endpoints {
+		if e.healthy {
+			next = i
+			break
+		}
+	}
+	if next == f.active {
+		return
+	}
+	f.log.Error("active L1 endpoint is unhealthy, failing over", "unhealthy", f.active, "active", next, "err", err)
+	f.switchTo(next)
+}
+
+// switchTo makes the endpoint the active one. f.mu must be held.
+func (f *FailoverL1Client) switchTo(idx int) {
+	f.active = idx
+	f.failures = 0
+	f.metrics.RecordL1Failover(strconv.Itoa(idx))
+}
+
+// HealthCheckLoop checks the health of all endpoints at the given interval
+// until the context is done. The active endpoint is switched to the most
+// preferred healthy endpoint.
+func (f *FailoverL1Client) HealthCheckLoop(ctx context.Context, interval, timeout time.Duration) {
+	if len(f.endpoints) < 2 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			f.checkHealth(ctx, timeout)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (f *FailoverL1Client) checkHealth(ctx context.Context, timeout time.Duration) {
+	healthy := make([]bool, len(f.endpoints))
+	for i, e := range f.endpoints {
+		cctx, cancel := context.WithTimeout(ctx, timeout)
+		_, err := e.client.BlockNumber(cctx)
+		cancel()
+		healthy[i] = err == nil
+		if err != nil {
+			f.log.Warn("L1 endpoint failed health check", "endpoint", i, "err", err)
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, e := range f.endpoints {
+		e.healthy = healthy[i]
+	}
+	for i, e := range f.endpoints {
+		if !e.healthy {
+			continue
+		}
+		if i != f.active {
+			f.log.Info("switching to preferred healthy L1 endpoint", "previous", f.active, "active", i)
+			f.switchTo(i)
+		}
+		return
+	}
+}
+
+func (f *FailoverL1Client) BlockNumber(ctx context.Context) (uint64, error) {
+	idx, client := f.current()
+	num, err := client.BlockNumber(ctx)
+	f.recordResult(idx, err)
+	return num, err
+}
+
+func (f *FailoverL1Client) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	idx, client := f.current()
+	header, err := client.HeaderByNumber(ctx, number)
+	f.recordResult(idx, err)
+	return header, err
+}
+
+func (f *FailoverL1Client) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	idx, client := f.current()
+	receipt, err := client.TransactionReceipt(ctx, txHash)
+	f.recordResult(idx, err)
+	return receipt, err
+}
+
+func (f *FailoverL1Client) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	idx, client := f.current()
+	err := client.SendTransaction(ctx, tx)
+	f.recordResult(idx, err)
+	return err
+}
+
+func (f *FailoverL1Client) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	idx, client := f.current()
+	tip, err := client.SuggestGasTipCap(ctx)
+	f.recordResult(idx, err)
+	return tip, err
+}
+
+func (f *FailoverL1Client) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	idx, client := f.current()
+	nonce, err := client.NonceAt(ctx, account, blockNumber)
+	f.recordResult(idx, err)
+	return nonce, err
+}
+
+func (f *FailoverL1Client) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	idx, client := f.current()
+	nonce, err := client.PendingNonceAt(ctx, account)
+	f.recordResult(idx, err)
+	return nonce, err
+}
+
+func (f *FailoverL1Client) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	idx, client := f.current()
+	gas, err := client.EstimateGas(ctx, msg)
+	f.recordResult(idx, err)
+	return gas, err
+}
+
+func (f *FailoverL1Client) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	idx, client := f.current()
+	balance, err := client.BalanceAt(ctx, account, blockNumber)
+	f.recordResult(idx, err)
+	return balance, err
+}
+
+func (f *FailoverL1Client) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	idx, client := f.current()
+	code, err := client.CodeAt(ctx, contract, blockNumber)
+	f.recordResult(idx, err)
+	return code, err
+}
+
+func (f *FailoverL1Client) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	idx, client := f.current()
+	res, err := client.CallContract(ctx, call, blockNumber)
+	f.recordResult(idx, err)
+	return res, err
+}
@@ -0,0 +1,65 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/batcher/metrics"
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+type jsonRPCError struct{}
+
+func (jsonRPCError) Error() string  { return "nonce too low" }
+func (jsonRPCError) ErrorCode() int { return -32000 }
+
+func TestIsEndpointFailure(t *testing.T) {
+	require.False(t, isEndpointFailure(nil))
+	require.False(t, isEndpointFailure(ethereum.NotFound))
+	require.False(t, isEndpointFailure(jsonRPCError{}), "errors of a responsive node are no failures")
+	require.False(t, isEndpointFailure(context.Canceled))
+	require.True(t, isEndpointFailure(errors.New("connection refused")))
+	require.True(t, isEndpointFailure(context.DeadlineExceeded))
+}
+
+func TestFailoverL1Client(t *testing.T) {
+	f := NewFailoverL1Client(testlog.Logger(t, log.LvlCrit), metrics.NoopMetrics,
+		new(ethclient.Client), new(ethclient.Client), new(ethclient.Client))
+	failure := errors.New("connection refused")
+
+	for i := 0; i < l1FailoverThreshold-1; i++ {
+		f.recordResult(0, failure)
+	}
+	f.recordResult(0, nil)
+	f.recordResult(0, failure)
+	idx, _ := f.current()
+	require.Equal(t, 0, idx, "failures must be consecutive")
+
+	for i := 0; i < l1FailoverThreshold; i++ {
+		f.recordResult(0, failure)
+	}
+	idx, _ = f.current()
+	require.Equal(t, 1, idx, "fails over to the next healthy endpoint")
+
+	f.recordResult(0, failure)
+	idx, _ = f.current()
+	require.Equal(t, 1, idx, "results of inactive endpoints are ignored")
+
+	for i := 0; i < l1FailoverThreshold; i++ {
+		f.recordResult(1, failure)
+	}
+	idx, _ = f.current()
+	require.Equal(t, 2, idx)
+
+	for i := 0; i < l1FailoverThreshold; i++ {
+		f.recordResult(2, failure)
+	}
+	idx, _ = f.current()
+	require.Equal(t, 0, idx, "wraps around if no endpoint is healthy")
+}
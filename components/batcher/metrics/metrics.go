@@ -2,6 +2,9 @@ package metrics
 
 import (
 	"context"
+	"math/big"
+	"sync/atomic"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -19,6 +22,7 @@ const Namespace = "kroma_batcher"
 type Metricer interface {
 	RecordInfo(version string)
 	RecordUp()
+	RecordBatcherAddress(addr common.Address)
 
 	// Records all L1 and L2 block events
 	kmetrics.RefMetricer
@@ -30,13 +34,22 @@ type Metricer interface {
 	RecordL2BlocksLoaded(l2ref eth.L2BlockRef)
 	RecordChannelOpened(id derive.ChannelID, numPendingBlocks int)
 	RecordL2BlocksAdded(l2ref eth.L2BlockRef, numBlocksAdded, numPendingBlocks, inputBytes, outputComprBytes int)
-	RecordChannelClosed(id derive.ChannelID, numPendingBlocks int, numFrames int, inputBytes int, outputComprBytes int, reason error)
+	RecordChannelClosed(id derive.ChannelID, comprAlgo string, numPendingBlocks int, numFrames int, inputBytes int, outputComprBytes int, reason error)
 	RecordChannelFullySubmitted(id derive.ChannelID)
 	RecordChannelTimedOut(id derive.ChannelID)
+	RecordChannelOpenDuration(duration time.Duration)
+	RecordL2BlockInclusionLatency(latency time.Duration)
+	RecordDAInclusionDelay(max, avg time.Duration)
 
 	RecordBatchTxSubmitted()
 	RecordBatchTxSuccess()
 	RecordBatchTxFailed()
+	RecordBatchTxFailureReason(reason string)
+	RecordBatchTxData(numBytes int, numFrames int)
+
+	RecordCost(lastHour, lastDay *big.Int, overBudget bool)
+	RecordSafeLag(lag uint64, lagging bool)
+	RecordL1Failover(active string)
 
 	Document() []kmetrics.DocumentedMetric
 }
@@ -49,8 +62,12 @@ type Metrics struct {
 	kmetrics.RefMetrics
 	txmetrics.TxMetrics
 
-	Info prometheus.GaugeVec
-	Up   prometheus.Gauge
+	Info           prometheus.GaugeVec
+	Up             prometheus.Gauge
+	BatcherAddress prometheus.GaugeVec
+
+	// batcherAddr is the address of the batcher key in use, whose balance is monitored.
+	batcherAddr atomic.Pointer[common.Address]
 
 	// label by opened, closed, fully_submitted, timed_out
 	ChannelEvs kmetrics.EventVec
@@ -63,9 +80,26 @@ type Metrics struct {
 	ChannelOutputBytes  prometheus.Gauge
 	ChannelClosedReason prometheus.Gauge
 	ChannelNumFrames    prometheus.Gauge
-	ChannelComprRatio   prometheus.Histogram
+	ChannelComprRatio   *prometheus.HistogramVec
+	ChannelOpenDuration prometheus.Histogram
+
+	L2BlockInclusionLatency prometheus.Histogram
+	DAInclusionDelayMax     prometheus.Gauge
+	DAInclusionDelayAvg     prometheus.Gauge
+
+	BatcherTxEvs           kmetrics.EventVec
+	BatcherTxFailedReasons *prometheus.CounterVec
+	BatcherTxBytes         prometheus.Counter
+	BatcherTxFrames        prometheus.Histogram
 
-	BatcherTxEvs kmetrics.EventVec
+	CostLastHour prometheus.Gauge
+	CostLastDay  prometheus.Gauge
+	OverBudget   prometheus.Gauge
+
+	SafeLag prometheus.Gauge
+	Lagging prometheus.Gauge
+
+	L1FailoversTotal *prometheus.CounterVec
 }
 
 var _ Metricer = (*Metrics)(nil)
@@ -99,6 +133,13 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "up",
 			Help:      "1 if the kroma-batcher has finished starting up",
 		}),
+		BatcherAddress: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "batcher_address",
+			Help:      "Pseudo-metric tracking the address of the batcher key in use",
+		}, []string{
+			"address",
+		}),
 
 		ChannelEvs: kmetrics.NewEventVec(factory, ns, "channel", "Channel", []string{"stage"}),
 
@@ -138,14 +179,86 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "channel_num_frames",
 			Help:      "Total number of frames of closed channel.",
 		}),
-		ChannelComprRatio: factory.NewHistogram(prometheus.HistogramOpts{
+		ChannelComprRatio: factory.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: ns,
 			Name:      "channel_compr_ratio",
-			Help:      "Compression ratios of closed channel.",
+			Help:      "Compression ratios of closed channel, by compression algorithm.",
 			Buckets:   append([]float64{0.1, 0.2}, prometheus.LinearBuckets(0.3, 0.05, 14)...),
+		}, []string{"algo"}),
+		ChannelOpenDuration: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "channel_open_duration_seconds",
+			Help:      "Duration from opening to closing a channel.",
+			Buckets:   prometheus.ExponentialBuckets(6, 2, 12),
+		}),
+
+		L2BlockInclusionLatency: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "l2_block_inclusion_latency_seconds",
+			Help:      "Duration from the timestamp of L2 blocks to the confirmation of the channel they are part of on L1.",
+			Buckets:   prometheus.ExponentialBuckets(6, 2, 12),
+		}),
+		DAInclusionDelayMax: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "da_inclusion_delay_max_seconds",
+			Help:      "Maximum duration from the timestamp of the tracked L2 blocks to the L1 confirmation of their data.",
+		}),
+		DAInclusionDelayAvg: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "da_inclusion_delay_avg_seconds",
+			Help:      "Average duration from the timestamp of the tracked L2 blocks to the L1 confirmation of their data.",
 		}),
 
 		BatcherTxEvs: kmetrics.NewEventVec(factory, ns, "batcher_tx", "BatcherTx", []string{"stage"}),
+		BatcherTxFailedReasons: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "batcher_tx_failed_reasons_total",
+			Help:      "Number of failed batcher txs, by failure reason.",
+		}, []string{"reason"}),
+		BatcherTxBytes: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "batcher_tx_bytes_total",
+			Help:      "Number of bytes submitted in batcher txs.",
+		}),
+		BatcherTxFrames: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "batcher_tx_frames",
+			Help:      "Number of frames per batcher tx.",
+			Buckets:   prometheus.LinearBuckets(1, 1, 8),
+		}),
+
+		CostLastHour: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "cost_last_hour",
+			Help:      "ETH spent on batcher txs over the last hour.",
+		}),
+		CostLastDay: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "cost_last_day",
+			Help:      "ETH spent on batcher txs over the last day.",
+		}),
+		OverBudget: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "over_budget",
+			Help:      "1 if the ETH spent on batcher txs exceeds the budget",
+		}),
+
+		SafeLag: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "safe_lag",
+			Help:      "Number of L2 blocks the safe head lags behind the unsafe head.",
+		}),
+		Lagging: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "lagging",
+			Help:      "1 if batch submission is accelerated because the safe head lags behind",
+		}),
+
+		L1FailoversTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "l1_failovers_total",
+			Help:      "Count of switches between L1 endpoints, by index of the newly active endpoint",
+		}, []string{"active"}),
 	}
 }
 
@@ -157,10 +270,25 @@ func (m *Metrics) Document() []kmetrics.DocumentedMetric {
 	return m.factory.Document()
 }
 
+// StartBalanceMetrics monitors the balance of the batcher key in use, starting with the given account.
 func (m *Metrics) StartBalanceMetrics(ctx context.Context,
 	l log.Logger, client *ethclient.Client, account common.Address,
 ) {
-	kmetrics.LaunchBalanceMetrics(ctx, l, m.registry, m.ns, client, account)
+	if m.batcherAddr.Load() == nil {
+		m.RecordBatcherAddress(account)
+	}
+	kmetrics.LaunchBalanceMetricsOf(ctx, l, m.registry, m.ns, client, func() common.Address {
+		return *m.batcherAddr.Load()
+	})
+}
+
+// RecordBatcherAddress sets the address of the batcher key in use, e.g. after a key rotation,
+// so that its balance is monitored from then on.
+func (m *Metrics) RecordBatcherAddress(addr common.Address) {
+	if prev := m.batcherAddr.Swap(&addr); prev != nil {
+		m.BatcherAddress.DeleteLabelValues(prev.Hex())
+	}
+	m.BatcherAddress.WithLabelValues(addr.Hex()).Set(1)
 }
 
 // RecordInfo sets a pseudo-metric that contains versioning and
@@ -214,7 +342,7 @@ func (m *Metrics) RecordL2BlocksAdded(l2ref eth.L2BlockRef, numBlocksAdded, numP
 	m.ChannelReadyBytes.Set(float64(outputComprBytes))
 }
 
-func (m *Metrics) RecordChannelClosed(id derive.ChannelID, numPendingBlocks int, numFrames int, inputBytes int, outputComprBytes int, reason error) {
+func (m *Metrics) RecordChannelClosed(id derive.ChannelID, comprAlgo string, numPendingBlocks int, numFrames int, inputBytes int, outputComprBytes int, reason error) {
 	m.ChannelEvs.Record(StageClosed)
 	m.PendingBlocksCount.WithLabelValues(StageClosed).Set(float64(numPendingBlocks))
 	m.ChannelNumFrames.Set(float64(numFrames))
@@ -225,7 +353,7 @@ func (m *Metrics) RecordChannelClosed(id derive.ChannelID, numPendingBlocks int,
 	if inputBytes > 0 {
 		comprRatio = float64(outputComprBytes) / float64(inputBytes)
 	}
-	m.ChannelComprRatio.Observe(comprRatio)
+	m.ChannelComprRatio.WithLabelValues(comprAlgo).Observe(comprRatio)
 
 	m.ChannelClosedReason.Set(float64(ClosedReasonToNum(reason)))
 }
@@ -243,6 +371,23 @@ func (m *Metrics) RecordChannelTimedOut(id derive.ChannelID) {
 	m.ChannelEvs.Record(StageTimedOut)
 }
 
+func (m *Metrics) RecordChannelOpenDuration(duration time.Duration) {
+	m.ChannelOpenDuration.Observe(duration.Seconds())
+}
+
+// RecordL2BlockInclusionLatency should be called for each L2 block of a channel
+// once the channel is fully submitted.
+func (m *Metrics) RecordL2BlockInclusionLatency(latency time.Duration) {
+	m.L2BlockInclusionLatency.Observe(latency.Seconds())
+}
+
+// RecordDAInclusionDelay records the maximum and average inclusion delay of the
+// recently submitted L2 blocks.
+func (m *Metrics) RecordDAInclusionDelay(max, avg time.Duration) {
+	m.DAInclusionDelayMax.Set(max.Seconds())
+	m.DAInclusionDelayAvg.Set(avg.Seconds())
+}
+
 func (m *Metrics) RecordBatchTxSubmitted() {
 	m.BatcherTxEvs.Record(TxStageSubmitted)
 }
@@ -254,3 +399,38 @@ func (m *Metrics) RecordBatchTxSuccess() {
 func (m *Metrics) RecordBatchTxFailed() {
 	m.BatcherTxEvs.Record(TxStageFailed)
 }
+
+func (m *Metrics) RecordBatchTxFailureReason(reason string) {
+	m.BatcherTxFailedReasons.WithLabelValues(reason).Inc()
+}
+
+// RecordBatchTxData should be called when a batcher tx is sent, with the size
+// of its data and the number of frames it contains.
+func (m *Metrics) RecordBatchTxData(numBytes int, numFrames int) {
+	m.BatcherTxBytes.Add(float64(numBytes))
+	m.BatcherTxFrames.Observe(float64(numFrames))
+}
+
+// RecordCost records the ETH (in wei) spent on batcher txs over the last hour and day.
+func (m *Metrics) RecordCost(lastHour, lastDay *big.Int, overBudget bool) {
+	m.CostLastHour.Set(kmetrics.WeiToEther(lastHour))
+	m.CostLastDay.Set(kmetrics.WeiToEther(lastDay))
+	if overBudget {
+		m.OverBudget.Set(1)
+	} else {
+		m.OverBudget.Set(0)
+	}
+}
+
+func (m *Metrics) RecordSafeLag(lag uint64, lagging bool) {
+	m.SafeLag.Set(float64(lag))
+	if lagging {
+		m.Lagging.Set(1)
+	} else {
+		m.Lagging.Set(0)
+	}
+}
+
+func (m *Metrics) RecordL1Failover(active string) {
+	m.L1FailoversTotal.WithLabelValues(active).Inc()
+}
@@ -1,6 +1,11 @@
 package metrics
 
 import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/kroma-network/kroma/components/node/eth"
 	"github.com/kroma-network/kroma/components/node/rollup/derive"
 	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
@@ -19,16 +24,28 @@ func (*noopMetrics) Document() []kmetrics.DocumentedMetric { return nil }
 func (*noopMetrics) RecordInfo(version string) {}
 func (*noopMetrics) RecordUp()                 {}
 
+func (*noopMetrics) RecordBatcherAddress(common.Address) {}
+
 func (*noopMetrics) RecordLatestL1Block(l1ref eth.L1BlockRef)               {}
 func (*noopMetrics) RecordL2BlocksLoaded(eth.L2BlockRef)                    {}
 func (*noopMetrics) RecordChannelOpened(derive.ChannelID, int)              {}
 func (*noopMetrics) RecordL2BlocksAdded(eth.L2BlockRef, int, int, int, int) {}
 
-func (*noopMetrics) RecordChannelClosed(derive.ChannelID, int, int, int, int, error) {}
+func (*noopMetrics) RecordChannelClosed(derive.ChannelID, string, int, int, int, int, error) {}
 
-func (*noopMetrics) RecordChannelFullySubmitted(derive.ChannelID) {}
-func (*noopMetrics) RecordChannelTimedOut(derive.ChannelID)       {}
+func (*noopMetrics) RecordChannelFullySubmitted(derive.ChannelID)        {}
+func (*noopMetrics) RecordChannelTimedOut(derive.ChannelID)              {}
+func (*noopMetrics) RecordChannelOpenDuration(time.Duration)             {}
+func (*noopMetrics) RecordL2BlockInclusionLatency(time.Duration)         {}
+func (*noopMetrics) RecordDAInclusionDelay(time.Duration, time.Duration) {}
 
 func (*noopMetrics) RecordBatchTxSubmitted() {}
 func (*noopMetrics) RecordBatchTxSuccess()   {}
 func (*noopMetrics) RecordBatchTxFailed()    {}
+
+func (*noopMetrics) RecordBatchTxFailureReason(string) {}
+func (*noopMetrics) RecordBatchTxData(int, int)        {}
+
+func (*noopMetrics) RecordCost(*big.Int, *big.Int, bool) {}
+func (*noopMetrics) RecordSafeLag(uint64, bool)          {}
+func (*noopMetrics) RecordL1Failover(string)             {}
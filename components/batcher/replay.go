@@ -0,0 +1,97 @@
+package batcher
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ReplayResult summarizes the channels that a range of L2 blocks got split into
+// by ReplayBlocks.
+type ReplayResult struct {
+	Blocks   int
+	Channels int
+	Frames   int
+	// Txs is the number of batcher txs carrying the frames.
+	Txs int
+	// InputBytes is the size of the uncompressed channel data.
+	InputBytes int
+	// L1Bytes is the size of the tx data posted to L1, including frame headers
+	// and version bytes.
+	L1Bytes int
+}
+
+// ComprRatio returns the ratio of the posted L1 bytes to the uncompressed input.
+func (r ReplayResult) ComprRatio() float64 {
+	if r.InputBytes == 0 {
+		return 0
+	}
+	return float64(r.L1Bytes) / float64(r.InputBytes)
+}
+
+// ReplayBlocks feeds the L2 blocks through channel builders with the given
+// config, the same way the batcher does, for offline evaluation of channel and
+// compression parameters. Channels are only closed once they are full, as
+// duration and L1 inclusion based timeouts depend on L1 and are not replayed.
+func ReplayBlocks(cfg ChannelConfig, blocks []*types.Block) (ReplayResult, error) {
+	var (
+		res        ReplayResult
+		cb         *channelBuilder
+		_chFullErr *ChannelFullError // throw away, just for type checking
+	)
+	framesPerTx := cfg.FramesPerTx
+	if framesPerTx <= 0 {
+		framesPerTx = 1
+	}
+	closeChannel := func() error {
+		cb.Close()
+		if err := cb.OutputFrames(); err != nil {
+			return fmt.Errorf("creating frames of channel %d: %w", res.Channels, err)
+		}
+		frames := cb.NumFrames()
+		txs := (frames + framesPerTx - 1) / framesPerTx
+		res.Channels++
+		res.Frames += frames
+		res.Txs += txs
+		res.InputBytes += cb.InputBytes()
+		res.L1Bytes += cb.OutputBytes() + txs // 1 version byte per tx
+		cb = nil
+		return nil
+	}
+
+	for i := 0; i < len(blocks); {
+		if cb == nil {
+			var err error
+			if cb, err = newChannelBuilder(cfg); err != nil {
+				return res, fmt.Errorf("creating channel: %w", err)
+			}
+		}
+		_, err := cb.AddBlock(blocks[i])
+		if errors.As(err, &_chFullErr) {
+			if len(cb.Blocks()) == 0 {
+				return res, fmt.Errorf("block %d does not fit into an empty channel: %w", blocks[i].NumberU64(), err)
+			}
+			// the block is added to the next channel
+			if err := closeChannel(); err != nil {
+				return res, err
+			}
+			continue
+		} else if err != nil {
+			return res, fmt.Errorf("adding block %d to channel: %w", blocks[i].NumberU64(), err)
+		}
+		res.Blocks++
+		i++
+		if cb.IsFull() {
+			if err := closeChannel(); err != nil {
+				return res, err
+			}
+		}
+	}
+	if cb != nil {
+		if err := closeChannel(); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
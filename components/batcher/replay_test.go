@@ -0,0 +1,38 @@
+package batcher
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	dtest "github.com/kroma-network/kroma/components/node/rollup/derive/test"
+)
+
+func TestReplayBlocks(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	var blocks []*types.Block
+	for i := 0; i < 20; i++ {
+		block, _ := dtest.RandomL2Block(rng, 8)
+		blocks = append(blocks, block)
+	}
+
+	res, err := ReplayBlocks(defaultTestChannelConfig, blocks)
+	require.NoError(t, err)
+	require.Equal(t, len(blocks), res.Blocks)
+	require.Equal(t, 1, res.Channels, "all blocks fit into a single channel")
+	require.Equal(t, res.Frames, res.Txs)
+	require.Greater(t, res.L1Bytes, 0)
+
+	small := defaultTestChannelConfig
+	small.MaxFrameSize = 1000
+	small.TargetFrameSize = 1000
+	small.FramesPerTx = 2
+	res, err = ReplayBlocks(small, blocks)
+	require.NoError(t, err)
+	require.Equal(t, len(blocks), res.Blocks)
+	require.Greater(t, res.Channels, 1, "input target splits the blocks into multiple channels")
+	require.LessOrEqual(t, res.Txs, res.Frames)
+	require.GreaterOrEqual(t, 2*res.Txs, res.Frames, "up to 2 frames per tx")
+}
@@ -2,11 +2,57 @@ package rpc
 
 import (
 	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/kroma-network/kroma/components/node/eth"
 )
 
+// BatcherStatus is the detailed status of the batcher.
+type BatcherStatus struct {
+	Running bool `json:"running"`
+	Paused  bool `json:"paused"`
+	// BlocksBuffered is the number of L2 blocks loaded by the batcher that are not fully submitted yet.
+	BlocksBuffered int `json:"blocks_buffered"`
+	// OldestUnsubmittedBlock is the first buffered L2 block, nil if there is none.
+	OldestUnsubmittedBlock *eth.BlockID `json:"oldest_unsubmitted_block"`
+	// LastStoredBlock is the last L2 block loaded by the batcher.
+	LastStoredBlock eth.BlockID `json:"last_stored_block"`
+	// PendingTxs is the number of batcher txs that are not confirmed yet.
+	PendingTxs int  `json:"pending_txs"`
+	OverBudget bool `json:"over_budget"`
+	// BatcherAddress is the address of the batcher key in use.
+	BatcherAddress common.Address `json:"batcher_address"`
+	// KeyRotation is the state of the batcher key rotation in progress, empty if there is none.
+	KeyRotation string `json:"key_rotation,omitempty"`
+}
+
+// L1TxInclusion is a batcher tx that carries data of an L2 block.
+type L1TxInclusion struct {
+	TxHash  common.Hash `json:"tx_hash"`
+	L1Block eth.BlockID `json:"l1_block"`
+}
+
+// L2BlockInclusion records where the data of an L2 block landed on L1.
+type L2BlockInclusion struct {
+	L2Block eth.BlockID `json:"l2_block"`
+	// Txs are the batcher txs of the channel that the L2 block is part of.
+	Txs []L1TxInclusion `json:"txs"`
+	// DelaySeconds is the duration from the timestamp of the L2 block until its
+	// channel was fully confirmed on L1.
+	DelaySeconds float64 `json:"delay_seconds"`
+}
+
 type batcherClient interface {
 	Start() error
 	Stop(ctx context.Context) error
+	Pause()
+	Resume()
+	Flush(ctx context.Context) error
+	Status(ctx context.Context) (*BatcherStatus, error)
+	BlockInclusion(number uint64) (*L2BlockInclusion, error)
 }
 
 type adminAPI struct {
@@ -19,6 +65,15 @@ func NewAdminAPI(dr batcherClient) *adminAPI {
 	}
 }
 
+// GetAdminAPI returns the admin API of the batcher to register at the RPC server.
+func GetAdminAPI(api *adminAPI) rpc.API {
+	return rpc.API{
+		Namespace:     "admin",
+		Service:       api,
+		Authenticated: true,
+	}
+}
+
 func (a *adminAPI) StartBatcher(_ context.Context) error {
 	return a.b.Start()
 }
@@ -26,3 +81,31 @@ func (a *adminAPI) StartBatcher(_ context.Context) error {
 func (a *adminAPI) StopBatcher(ctx context.Context) error {
 	return a.b.Stop(ctx)
 }
+
+// PauseBatcher stops submitting batcher txs, while L2 blocks are still loaded.
+func (a *adminAPI) PauseBatcher(_ context.Context) error {
+	a.b.Pause()
+	return nil
+}
+
+// ResumeBatcher resumes submitting batcher txs after PauseBatcher.
+func (a *adminAPI) ResumeBatcher(_ context.Context) error {
+	a.b.Resume()
+	return nil
+}
+
+// FlushBatcher closes the current channel, so that it is submitted without
+// waiting for it to get full.
+func (a *adminAPI) FlushBatcher(ctx context.Context) error {
+	return a.b.Flush(ctx)
+}
+
+func (a *adminAPI) BatcherStatus(ctx context.Context) (*BatcherStatus, error) {
+	return a.b.Status(ctx)
+}
+
+// BlockInclusion returns where the data of the L2 block landed on L1. Only the
+// recently submitted L2 blocks are tracked.
+func (a *adminAPI) BlockInclusion(_ context.Context, number hexutil.Uint64) (*L2BlockInclusion, error) {
+	return a.b.BlockInclusion(uint64(number))
+}
@@ -6,41 +6,42 @@ import (
 	"github.com/kroma-network/kroma/components/node/rollup/derive"
 )
 
-// txData represents the data for a single transaction.
-//
-// Note: The batcher currently sends exactly one frame per transaction. This
-// might change in the future to allow for multiple frames from possibly
-// different channels.
+// txData represents the data for a single transaction. It holds one or more
+// frames of the same channel.
 type txData struct {
-	frame frameData
+	frames []frameData
+}
+
+func singleFrameTxData(frame frameData) txData {
+	return txData{frames: []frameData{frame}}
 }
 
 // ID returns the id for this transaction data. It can be used as a map key.
 func (td *txData) ID() txID {
-	return td.frame.id
+	return td.frames[0].id
 }
 
 // Bytes returns the transaction data. It's a version byte (0) followed by the
 // concatenated frames for this transaction.
 func (td *txData) Bytes() []byte {
-	return append([]byte{derive.DerivationVersion0}, td.frame.data...)
+	payloads := make([][]byte, 0, len(td.frames))
+	for _, f := range td.frames {
+		payloads = append(payloads, f.data)
+	}
+	return derive.EncodeBatcherData(derive.DerivationVersion0, payloads...)
 }
 
-// Frame returns the single frame of this tx data.
-//
-// Note: when the batcher is changed to possibly send multiple frames per tx,
-// this should be changed to a func Frames() []frameData.
-func (td *txData) Frame() frameData {
-	return td.frame
+// Frames returns the frames of this tx data.
+func (td *txData) Frames() []frameData {
+	return td.frames
 }
 
 // txID is an opaque identifier for a transaction.
 // It's internal fields should not be inspected after creation & are subject to change.
 // This ID must be trivially comparable & work as a map key.
 //
-// Note: transactions currently only hold a single frame, so it can be
-// identified by the frame. This needs to be changed once the batcher is changed
-// to send multiple frames per tx.
+// Note: the frames of a transaction are never split up, so it is identified by
+// its first frame.
 type txID = frameID
 
 func (id txID) String() string {
@@ -104,12 +104,30 @@ func main() {
 					Value: "/tmp/batch_decoder/channel_cache",
 					Usage: "Cache directory for the found channels",
 				},
+				cli.StringSliceFlag{
+					Name:  "zstd-dictionary",
+					Usage: "(Optional) Path of a zstd dictionary that channels may be compressed with, can be repeated",
+				},
+				cli.StringFlag{
+					Name:  "samples",
+					Usage: "(Optional) Directory to write the uncompressed data of each channel to, for `zstd --train`",
+				},
 			},
 			Action: func(cliCtx *cli.Context) error {
+				var dicts [][]byte
+				for _, file := range cliCtx.StringSlice("zstd-dictionary") {
+					dict, err := os.ReadFile(file)
+					if err != nil {
+						log.Fatal(err)
+					}
+					dicts = append(dicts, dict)
+				}
 				config := reassemble.Config{
-					BatchInbox:   common.HexToAddress(cliCtx.String("inbox")),
-					InDirectory:  cliCtx.String("in"),
-					OutDirectory: cliCtx.String("out"),
+					BatchInbox:       common.HexToAddress(cliCtx.String("inbox")),
+					InDirectory:      cliCtx.String("in"),
+					OutDirectory:     cliCtx.String("out"),
+					ZstdDictionaries: dicts,
+					SamplesDirectory: cliCtx.String("samples"),
 				}
 				reassemble.Channels(config)
 				return nil
@@ -1,6 +1,7 @@
 package reassemble
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
 
 	"github.com/kroma-network/kroma/components/node/cmd/batch_decoder/fetch"
 	"github.com/kroma-network/kroma/components/node/eth"
@@ -37,6 +39,11 @@ type Config struct {
 	BatchInbox   common.Address
 	InDirectory  string
 	OutDirectory string
+	// ZstdDictionaries are the zstd dictionaries that channels may refer to.
+	ZstdDictionaries [][]byte
+	// SamplesDirectory is the directory that the uncompressed data of each channel is written to,
+	// for training zstd dictionaries with `zstd --train`. Samples are not written if empty.
+	SamplesDirectory string
 }
 
 func LoadFrames(directory string, inbox common.Address) []FrameWithMetadata {
@@ -61,17 +68,27 @@ func Channels(config Config) {
 	if err := os.MkdirAll(config.OutDirectory, 0750); err != nil {
 		log.Fatal(err)
 	}
+	if config.SamplesDirectory != "" {
+		if err := os.MkdirAll(config.SamplesDirectory, 0750); err != nil {
+			log.Fatal(err)
+		}
+	}
 	frames := LoadFrames(config.InDirectory, config.BatchInbox)
 	framesByChannel := make(map[derive.ChannelID][]FrameWithMetadata)
 	for _, frame := range frames {
 		framesByChannel[frame.Frame.ID] = append(framesByChannel[frame.Frame.ID], frame)
 	}
 	for id, frames := range framesByChannel {
-		ch := processFrames(id, frames)
+		ch := processFrames(id, frames, config.ZstdDictionaries)
 		filename := path.Join(config.OutDirectory, fmt.Sprintf("%s.json", id.String()))
 		if err := writeChannel(ch, filename); err != nil {
 			log.Fatal(err)
 		}
+		if config.SamplesDirectory != "" && len(ch.Batches) > 0 {
+			if err := writeSample(ch.Batches, path.Join(config.SamplesDirectory, id.String())); err != nil {
+				log.Fatal(err)
+			}
+		}
 	}
 }
 
@@ -85,7 +102,19 @@ func writeChannel(ch ChannelWithMetadata, filename string) error {
 	return enc.Encode(ch)
 }
 
-func processFrames(id derive.ChannelID, frames []FrameWithMetadata) ChannelWithMetadata {
+// writeSample writes the uncompressed channel data of the batches, which is a sample to train
+// zstd dictionaries on.
+func writeSample(batches []derive.BatchV1, filename string) error {
+	var buf bytes.Buffer
+	for _, batch := range batches {
+		if err := rlp.Encode(&buf, &derive.BatchData{BatchV1: batch}); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(filename, buf.Bytes(), 0640)
+}
+
+func processFrames(id derive.ChannelID, frames []FrameWithMetadata, zstdDicts [][]byte) ChannelWithMetadata {
 	ch := derive.NewChannel(id, eth.L1BlockRef{Number: frames[0].InclusionBlock})
 	invalidFrame := false
 
@@ -104,7 +133,7 @@ func processFrames(id derive.ChannelID, frames []FrameWithMetadata) ChannelWithM
 	var batches []derive.BatchV1
 	invalidBatches := false
 	if ch.IsReady() {
-		br, err := derive.BatchReader(ch.Reader(), eth.L1BlockRef{})
+		br, err := derive.BatchReader(ch.Reader(), eth.L1BlockRef{}, true, zstdDicts)
 		if err == nil {
 			for batch, err := br(); err != io.EOF; batch, err = br() {
 				if err != nil {
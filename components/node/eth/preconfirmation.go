@@ -0,0 +1,51 @@
+package eth
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PreconfirmationSize is the size of an encoded Preconfirmation.
+const PreconfirmationSize = 32 + 8 + 8
+
+// Preconfirmation is the commitment of the proposer to a block it sealed,
+// published ahead of the full payload to offer fast confirmations to users.
+type Preconfirmation struct {
+	BlockHash   common.Hash `json:"blockHash"`
+	BlockNumber uint64      `json:"blockNumber"`
+	Timestamp   uint64      `json:"timestamp"`
+}
+
+// PreconfirmationFromPayload creates the preconfirmation of the given payload.
+func PreconfirmationFromPayload(payload *ExecutionPayload) Preconfirmation {
+	return Preconfirmation{
+		BlockHash:   payload.BlockHash,
+		BlockNumber: uint64(payload.BlockNumber),
+		Timestamp:   uint64(payload.Timestamp),
+	}
+}
+
+func (p Preconfirmation) ID() BlockID {
+	return BlockID{Hash: p.BlockHash, Number: p.BlockNumber}
+}
+
+// MarshalBinary encodes the preconfirmation as the block hash, number and timestamp, with big-endian integers.
+func (p Preconfirmation) MarshalBinary() ([]byte, error) {
+	out := make([]byte, PreconfirmationSize)
+	copy(out[:32], p.BlockHash[:])
+	binary.BigEndian.PutUint64(out[32:40], p.BlockNumber)
+	binary.BigEndian.PutUint64(out[40:48], p.Timestamp)
+	return out, nil
+}
+
+func (p *Preconfirmation) UnmarshalBinary(data []byte) error {
+	if len(data) != PreconfirmationSize {
+		return fmt.Errorf("invalid preconfirmation size: %d, expected %d", len(data), PreconfirmationSize)
+	}
+	copy(p.BlockHash[:], data[:32])
+	p.BlockNumber = binary.BigEndian.Uint64(data[32:40])
+	p.Timestamp = binary.BigEndian.Uint64(data[40:48])
+	return nil
+}
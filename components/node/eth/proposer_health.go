@@ -0,0 +1,27 @@
+package eth
+
+import "time"
+
+// ProposerHealth describes the recent block production of the proposer.
+// Durations are encoded in nanoseconds. Values are zeroed if no block was built yet.
+type ProposerHealth struct {
+	// Enabled is true if the node is configured to propose blocks.
+	Enabled bool `json:"enabled"`
+	// Active is true if the proposer is currently running, i.e. not stopped via the admin API.
+	Active bool `json:"active"`
+	// BuiltBlocks is the number of blocks built since the node started.
+	BuiltBlocks uint64 `json:"built_blocks"`
+	// LatencyP50, LatencyP90 and LatencyP99 are percentiles of the time it took to build the recent blocks,
+	// from the start of the block building till the block was sealed.
+	LatencyP50 time.Duration `json:"latency_p50"`
+	LatencyP90 time.Duration `json:"latency_p90"`
+	LatencyP99 time.Duration `json:"latency_p99"`
+	// MissedSlots is the number of block times that passed without a block being sealed in time.
+	MissedSlots uint64 `json:"missed_slots"`
+	// PublishFailures is the number of built blocks that failed to be published to the network.
+	PublishFailures uint64 `json:"publish_failures"`
+	// LastBlockTime is the time the last block was built at, as unix timestamp in seconds.
+	LastBlockTime uint64 `json:"last_block_time"`
+	// SinceLastBlock is the time since the last block was built.
+	SinceLastBlock time.Duration `json:"since_last_block"`
+}
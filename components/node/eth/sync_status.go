@@ -35,4 +35,19 @@ type SyncStatus struct {
 	// UnsafeL2SyncTarget points to the first unprocessed unsafe L2 block.
 	// It may be zeroed if there is no targeted block.
 	UnsafeL2SyncTarget L2BlockRef `json:"queued_unsafe_l2"`
+	// LastReset describes the latest reset of the derivation pipeline.
+	// It may be zeroed if the pipeline has not been reset yet.
+	LastReset ResetInfo `json:"last_reset"`
+}
+
+// ResetInfo describes a reset of the derivation pipeline.
+type ResetInfo struct {
+	// Reason is the cause of the reset, e.g. "l1_reorg" or "manual".
+	Reason string `json:"reason"`
+	// Time is the unix timestamp (in seconds) of the reset.
+	Time uint64 `json:"time"`
+	// Origin is the L1 block the derivation pipeline was at when the reset was requested.
+	Origin BlockID `json:"origin"`
+	// Count is the total number of resets since the node started.
+	Count uint64 `json:"count"`
 }
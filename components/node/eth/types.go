@@ -233,6 +233,9 @@ type PayloadAttributes struct {
 	NoTxPool bool `json:"noTxPool,omitempty"`
 	// GasLimit override
 	GasLimit *Uint64Quantity `json:"gasLimit,omitempty"`
+	// TxOrdering is the policy to order the transactions from the transaction-pool with.
+	// The engine applies its default ordering if empty.
+	TxOrdering string `json:"txOrdering,omitempty"`
 }
 
 type ExecutePayloadStatus string
@@ -290,5 +293,7 @@ type SystemConfig struct {
 	Scalar Bytes32 `json:"scalar"`
 	// GasLimit identifies the L2 block gas limit
 	GasLimit uint64 `json:"gasLimit"`
+	// FeeRecipient identifies the fee recipient (coinbase) of L2 blocks.
+	FeeRecipient common.Address `json:"feeRecipient"`
 	// More fields can be added for future SystemConfig versions.
 }
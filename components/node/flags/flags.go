@@ -8,6 +8,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/kroma-network/kroma/components/node/chaincfg"
+	"github.com/kroma-network/kroma/components/node/rollup/proposer"
 	"github.com/kroma-network/kroma/components/node/sources"
 	klog "github.com/kroma-network/kroma/utils/service/log"
 )
@@ -33,6 +34,17 @@ var (
 		Usage:  "Address of L2 Engine JSON-RPC endpoints to use (engine and eth namespace required)",
 		EnvVar: prefixEnvVar("L2_ENGINE_RPC"),
 	}
+	L2SecondaryEngineAddr = cli.StringFlag{
+		Name:   "l2.secondary",
+		Usage:  "Address of a secondary L2 Engine JSON-RPC endpoint, to cross-validate blocks and to fail over to when the primary engine is unhealthy. Optional.",
+		EnvVar: prefixEnvVar("L2_SECONDARY_ENGINE_RPC"),
+	}
+	L2SecondaryEngineJWTSecret = cli.StringFlag{
+		Name:      "l2.secondary.jwt-secret",
+		Usage:     "Path to JWT secret key of the secondary L2 Engine. Keys are 32 bytes, hex encoded in a file. Required if a secondary engine is configured.",
+		EnvVar:    prefixEnvVar("L2_SECONDARY_ENGINE_AUTH"),
+		TakesFile: true,
+	}
 	RollupConfig = cli.StringFlag{
 		Name:   "rollup.config",
 		Usage:  "Rollup chain parameters",
@@ -58,6 +70,12 @@ var (
 		Usage:  "Enable the admin API (experimental)",
 		EnvVar: prefixEnvVar("RPC_ENABLE_ADMIN"),
 	}
+	RPCAdminJWTSecret = cli.StringFlag{
+		Name:      "rpc.admin-jwt-secret",
+		Usage:     "Path to JWT secret key to authenticate the requests of the admin API. Keys are 32 bytes, hex encoded in a file. Authentication is disabled if left empty.",
+		EnvVar:    prefixEnvVar("RPC_ADMIN_JWT_SECRET"),
+		TakesFile: true,
+	}
 
 	/* Optional Flags */
 	L1TrustRPC = cli.BoolFlag{
@@ -108,6 +126,28 @@ var (
 		Required: false,
 		Value:    0,
 	}
+	SyncerPrefetchDepthFlag = cli.Uint64Flag{
+		Name:   "syncer.prefetch-depth",
+		Usage:  "Number of L1 blocks after the current L1 origin to prefetch the data of in the background. Prefetching is disabled if 0.",
+		EnvVar: prefixEnvVar("SYNCER_PREFETCH_DEPTH"),
+		Value:  1,
+	}
+	SyncerPrefetchConcurrencyFlag = cli.Uint64Flag{
+		Name:   "syncer.prefetch-concurrency",
+		Usage:  "Maximum number of L1 blocks being prefetched concurrently.",
+		EnvVar: prefixEnvVar("SYNCER_PREFETCH_CONCURRENCY"),
+		Value:  1,
+	}
+	SyncerDivergenceWebhook = cli.StringFlag{
+		Name:   "syncer.divergence-webhook",
+		Usage:  "URL to post alerts to when an unsafe block does not match the block derived from L1 at the same height. Only used when the proposer is disabled.",
+		EnvVar: prefixEnvVar("SYNCER_DIVERGENCE_WEBHOOK"),
+	}
+	DAServerAddrFlag = cli.StringFlag{
+		Name:   "da.server-addr",
+		Usage:  "Address of the external DA provider RPC to retrieve batches submitted as DA commitments from. Required if the rollup config activates DA commitments.",
+		EnvVar: prefixEnvVar("DA_SERVER_ADDR"),
+	}
 	ProposerEnabledFlag = cli.BoolFlag{
 		Name:   "proposer.enabled",
 		Usage:  "Enable proposing of new L2 blocks. A separate batch submitter has to be deployed to publish the data for syncers.",
@@ -118,6 +158,11 @@ var (
 		Usage:  "Initialize the proposer in a stopped state. The proposer can be started using the admin_startProposer RPC",
 		EnvVar: prefixEnvVar("PROPOSER_STOPPED"),
 	}
+	ProposerDryRunFlag = cli.BoolFlag{
+		Name:   "proposer.dry-run",
+		Usage:  "Build a local block for every block received from the canonical proposer, and compare the two blocks without inserting the local block. Cannot be used with the proposer enabled.",
+		EnvVar: prefixEnvVar("PROPOSER_DRY_RUN"),
+	}
 	ProposerMaxSafeLagFlag = cli.Uint64Flag{
 		Name:     "proposer.max-safe-lag",
 		Usage:    "Maximum number of L2 blocks for restricting the distance between L2 safe and unsafe. Disabled if 0.",
@@ -132,6 +177,122 @@ var (
 		Required: false,
 		Value:    4,
 	}
+	ProposerL1OriginPolicyFlag = cli.StringFlag{
+		Name: "proposer.l1-origin-policy",
+		Usage: "Policy for selecting the L1 origin of new L2 blocks, trading latency against L1 reorg exposure. Valid options: " +
+			strings.Join(proposer.L1OriginPolicies, ", "),
+		EnvVar: prefixEnvVar("PROPOSER_L1_ORIGIN_POLICY"),
+		Value:  proposer.L1OriginConfirmations,
+	}
+	ProposerGapFillPolicyFlag = cli.StringFlag{
+		Name: "proposer.gap-fill-policy",
+		Usage: "Policy for backfilling the blocks missed by the proposer, e.g. after downtime. Valid options: " +
+			strings.Join(proposer.GapFillPolicies, ", "),
+		EnvVar: prefixEnvVar("PROPOSER_GAP_FILL_POLICY"),
+		Value:  proposer.GapFillImmediate,
+	}
+	ProposerLateBuildPolicyFlag = cli.StringFlag{
+		Name: "proposer.late-build-policy",
+		Usage: "Policy for blocks that are still being built when the next slot passed, to avoid drifting behind the wall-clock. Valid options: " +
+			strings.Join(proposer.LateBuildPolicies, ", "),
+		EnvVar: prefixEnvVar("PROPOSER_LATE_BUILD_POLICY"),
+		Value:  proposer.LateBuildSeal,
+	}
+	ProposerL1OutageTimeoutFlag = cli.DurationFlag{
+		Name:   "proposer.l1-outage-timeout",
+		Usage:  "Time without new L1 head after which the L1 is considered unavailable, and the L1 outage policy is applied. Disabled if 0.",
+		EnvVar: prefixEnvVar("PROPOSER_L1_OUTAGE_TIMEOUT"),
+	}
+	ProposerL1OutagePolicyFlag = cli.StringFlag{
+		Name: "proposer.l1-outage-policy",
+		Usage: "Policy of the proposer while the L1 is unavailable. Valid options: " +
+			strings.Join(proposer.L1OutagePolicies, ", "),
+		EnvVar: prefixEnvVar("PROPOSER_L1_OUTAGE_POLICY"),
+		Value:  proposer.L1OutageContinue,
+	}
+	ProposerTxOrderingFlag = cli.StringFlag{
+		Name: "proposer.tx-ordering",
+		Usage: "Policy to order the tx pool transactions of proposed blocks with, communicated to the engine and external builder. " +
+			"The engine applies its default ordering if empty. Valid options: " + strings.Join(proposer.TxOrderingPolicies, ", "),
+		EnvVar: prefixEnvVar("PROPOSER_TX_ORDERING"),
+	}
+	ProposerPipeliningFlag = cli.BoolFlag{
+		Name:   "proposer.pipelining",
+		Usage:  "Start building the next block together with the insertion of the sealed block when behind schedule, to avoid missed slots.",
+		EnvVar: prefixEnvVar("PROPOSER_PIPELINING"),
+	}
+	ProposerScheduleOffsetFlag = cli.DurationFlag{
+		Name:   "proposer.schedule-offset",
+		Usage:  "Offset added to the start of every block building within the slot, may be negative. For latency experiments and failover drills.",
+		EnvVar: prefixEnvVar("PROPOSER_SCHEDULE_OFFSET"),
+	}
+	ProposerScheduleJitterFlag = cli.DurationFlag{
+		Name:   "proposer.schedule-jitter",
+		Usage:  "Upper bound of a random delay added to the start of every block building within the slot.",
+		EnvVar: prefixEnvVar("PROPOSER_SCHEDULE_JITTER"),
+	}
+	ProposerPayloadJournalFlag = cli.StringFlag{
+		Name:      "proposer.payload-journal",
+		Usage:     "File to persist the latest proposed block in until it is published, to replay it after a crash. Disabled if empty.",
+		EnvVar:    prefixEnvVar("PROPOSER_PAYLOAD_JOURNAL"),
+		TakesFile: true,
+	}
+	ProposerTargetGasPerSecondFlag = cli.Uint64Flag{
+		Name:   "proposer.target-gas-per-second",
+		Usage:  "Sustained gas per second targeted by adjusting the soft gas limit of proposed blocks, within the system config gas limit. Disabled if 0.",
+		EnvVar: prefixEnvVar("PROPOSER_TARGET_GAS_PER_SECOND"),
+	}
+	ProposerEmptyBlockFastPathFlag = cli.BoolFlag{
+		Name:   "proposer.empty-block-fast-path",
+		Usage:  "Seal blocks right away when they are known to be empty, because the tx pool is empty or the block is deposits-only, instead of waiting for the sealing deadline",
+		EnvVar: prefixEnvVar("PROPOSER_EMPTY_BLOCK_FAST_PATH"),
+	}
+	ProposerConditionalTxsFlag = cli.BoolFlag{
+		Name:   "proposer.conditional-txs",
+		Usage:  "Accept conditional transactions via kroma_sendRawTransactionConditional, included by the proposer when their preconditions are met.",
+		EnvVar: prefixEnvVar("PROPOSER_CONDITIONAL_TXS"),
+	}
+	ProposerBuilderAddrFlag = cli.StringFlag{
+		Name:     "proposer.builder-addr",
+		Usage:    "Address of the external block builder RPC to request payloads from. The local engine builds blocks if empty, or as fallback.",
+		EnvVar:   prefixEnvVar("PROPOSER_BUILDER_ADDR"),
+		Required: false,
+	}
+	ProposerBuilderTimeoutFlag = cli.DurationFlag{
+		Name:     "proposer.builder-timeout",
+		Usage:    "Time to wait for the external block builder to return a payload, before using the payload of the local engine.",
+		EnvVar:   prefixEnvVar("PROPOSER_BUILDER_TIMEOUT"),
+		Required: false,
+		Value:    proposer.DefaultBuilderTimeout,
+	}
+	ProposerMaxBlockGasFlag = cli.Uint64Flag{
+		Name:     "proposer.max-block-gas",
+		Usage:    "Soft limit of the gas used by a block built by the proposer, below the block gas limit. The transactions of blocks above it are capped to the limit. Disabled if 0.",
+		EnvVar:   prefixEnvVar("PROPOSER_MAX_BLOCK_GAS"),
+		Required: false,
+		Value:    0,
+	}
+	ProposerMaxBlockTxsFlag = cli.Uint64Flag{
+		Name:     "proposer.max-block-txs",
+		Usage:    "Soft limit of the number of transactions in a block built by the proposer, including deposits. The transactions of blocks above it are capped to the limit. Disabled if 0.",
+		EnvVar:   prefixEnvVar("PROPOSER_MAX_BLOCK_TXS"),
+		Required: false,
+		Value:    0,
+	}
+	HaltL2BlockFlag = cli.Uint64Flag{
+		Name:     "halt.l2-block",
+		Usage:    "Number of the L2 block to stop the derivation at. Unsafe payloads beyond it are refused. Disabled if 0.",
+		EnvVar:   prefixEnvVar("HALT_L2_BLOCK"),
+		Required: false,
+		Value:    0,
+	}
+	HaltL1OriginFlag = cli.Uint64Flag{
+		Name:     "halt.l1-origin",
+		Usage:    "Number of the L1 origin to stop the derivation at. L2 blocks with a later L1 origin are not derived, and unsafe payloads beyond it are refused. Disabled if 0.",
+		EnvVar:   prefixEnvVar("HALT_L1_ORIGIN"),
+		Required: false,
+		Value:    0,
+	}
 	L1EpochPollIntervalFlag = cli.DurationFlag{
 		Name:     "l1.epoch-poll-interval",
 		Usage:    "Poll interval for retrieving new L1 epoch updates such as safe and finalized block changes. Disabled if 0 or negative.",
@@ -225,13 +386,40 @@ var optionalFlags = []cli.Flag{
 	L1RPCMaxBatchSize,
 	L1HTTPPollInterval,
 	L2EngineJWTSecret,
+	L2SecondaryEngineAddr,
+	L2SecondaryEngineJWTSecret,
 	SyncerL1Confs,
+	SyncerPrefetchDepthFlag,
+	SyncerPrefetchConcurrencyFlag,
+	SyncerDivergenceWebhook,
+	DAServerAddrFlag,
 	ProposerEnabledFlag,
 	ProposerStoppedFlag,
+	ProposerDryRunFlag,
 	ProposerMaxSafeLagFlag,
 	ProposerL1Confs,
+	ProposerL1OriginPolicyFlag,
+	ProposerGapFillPolicyFlag,
+	ProposerLateBuildPolicyFlag,
+	ProposerL1OutageTimeoutFlag,
+	ProposerL1OutagePolicyFlag,
+	ProposerTxOrderingFlag,
+	ProposerPipeliningFlag,
+	ProposerScheduleOffsetFlag,
+	ProposerScheduleJitterFlag,
+	ProposerPayloadJournalFlag,
+	ProposerTargetGasPerSecondFlag,
+	ProposerEmptyBlockFastPathFlag,
+	ProposerConditionalTxsFlag,
+	ProposerBuilderAddrFlag,
+	ProposerBuilderTimeoutFlag,
+	ProposerMaxBlockGasFlag,
+	ProposerMaxBlockTxsFlag,
+	HaltL2BlockFlag,
+	HaltL1OriginFlag,
 	L1EpochPollIntervalFlag,
 	RPCEnableAdmin,
+	RPCAdminJWTSecret,
 	MetricsEnabledFlag,
 	MetricsAddrFlag,
 	MetricsPortFlag,
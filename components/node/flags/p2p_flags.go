@@ -51,6 +51,67 @@ var (
 		Required: false,
 		EnvVar:   p2pEnv("PEER_BANNING"),
 	}
+	BanThreshold = cli.Float64Flag{
+		Name:     "p2p.ban.threshold",
+		Usage:    "The minimum score below which peers are banned, if peer banning is enabled.",
+		Required: false,
+		Value:    p2p.PeerScoreThreshold,
+		EnvVar:   p2pEnv("PEER_BANNING_THRESHOLD"),
+	}
+
+	// Peer scoring parameter flags - override the parameters of the peer scoring strategy, if set
+	PeerScoringGossipThreshold = cli.Float64Flag{
+		Name:     "p2p.scoring.gossip-threshold",
+		Usage:    "The score below which gossip is not emitted to or accepted from a peer.",
+		Required: false,
+		Hidden:   true,
+		Value:    p2p.NewPeerScoreThresholds().GossipThreshold,
+		EnvVar:   p2pEnv("PEER_SCORING_GOSSIP_THRESHOLD"),
+	}
+	PeerScoringPublishThreshold = cli.Float64Flag{
+		Name:     "p2p.scoring.publish-threshold",
+		Usage:    "The score below which self-published messages are not propagated to a peer.",
+		Required: false,
+		Hidden:   true,
+		Value:    p2p.NewPeerScoreThresholds().PublishThreshold,
+		EnvVar:   p2pEnv("PEER_SCORING_PUBLISH_THRESHOLD"),
+	}
+	PeerScoringGraylistThreshold = cli.Float64Flag{
+		Name:     "p2p.scoring.graylist-threshold",
+		Usage:    "The score below which all messages of a peer are ignored.",
+		Required: false,
+		Hidden:   true,
+		Value:    p2p.NewPeerScoreThresholds().GraylistThreshold,
+		EnvVar:   p2pEnv("PEER_SCORING_GRAYLIST_THRESHOLD"),
+	}
+	PeerScoringIPColocationWeight = cli.Float64Flag{
+		Name:     "p2p.scoring.ip-colocation.weight",
+		Usage:    "Overrides the weight of the penalty of peers sharing the same IP of the peer scoring strategy. Must be negative or 0 to disable.",
+		Required: false,
+		Hidden:   true,
+		EnvVar:   p2pEnv("PEER_SCORING_IP_COLOCATION_WEIGHT"),
+	}
+	PeerScoringIPColocationThreshold = cli.IntFlag{
+		Name:     "p2p.scoring.ip-colocation.threshold",
+		Usage:    "Overrides the number of peers sharing the same IP that are not penalized of the peer scoring strategy.",
+		Required: false,
+		Hidden:   true,
+		EnvVar:   p2pEnv("PEER_SCORING_IP_COLOCATION_THRESHOLD"),
+	}
+	PeerScoringBehaviourPenaltyWeight = cli.Float64Flag{
+		Name:     "p2p.scoring.behaviour-penalty.weight",
+		Usage:    "Overrides the weight of the penalty of misbehaving peers of the peer scoring strategy. Must be negative or 0 to disable.",
+		Required: false,
+		Hidden:   true,
+		EnvVar:   p2pEnv("PEER_SCORING_BEHAVIOUR_PENALTY_WEIGHT"),
+	}
+	PeerScoringBehaviourPenaltyThreshold = cli.Float64Flag{
+		Name:     "p2p.scoring.behaviour-penalty.threshold",
+		Usage:    "Overrides the amount of misbehaviour that is not penalized of the peer scoring strategy.",
+		Required: false,
+		Hidden:   true,
+		EnvVar:   p2pEnv("PEER_SCORING_BEHAVIOUR_PENALTY_THRESHOLD"),
+	}
 
 	TopicScoring = cli.StringFlag{
 		Name: "p2p.scoring.topics",
@@ -130,6 +191,13 @@ var (
 		Value:    "",
 		EnvVar:   p2pEnv("BOOTNODES"),
 	}
+	DNSDiscovery = cli.StringFlag{
+		Name:     "p2p.discovery.dns",
+		Usage:    "Comma-separated EIP-1459 ENR tree URL list (enrtree://<key>@<domain>). DNS discovery lists to discover other node records from, alongside the bootnodes. Defaults to the lists of the network, if any.",
+		Required: false,
+		Value:    "",
+		EnvVar:   p2pEnv("DISCOVERY_DNS"),
+	}
 	StaticPeers = cli.StringFlag{
 		Name:     "p2p.static",
 		Usage:    "Comma-separated multiaddr-format peer list. Static connections to make and maintain, these peers will be regarded as trusted.",
@@ -180,6 +248,13 @@ var (
 		Required: false,
 		EnvVar:   p2pEnv("NAT"),
 	}
+	NATMethod = cli.StringFlag{
+		Name:     "p2p.nat.method",
+		Usage:    "NAT traversal method used with --p2p.nat to map the discovery port and learn the external IP: any, upnp, pmp, pmp:<gateway IP> or extip:<IP>.",
+		Required: false,
+		Value:    "any",
+		EnvVar:   p2pEnv("NAT_METHOD"),
+	}
 	UserAgent = cli.StringFlag{
 		Name:     "p2p.useragent",
 		Usage:    "User-agent string to share via LibP2P identify. If empty it defaults to 'kroma'.",
@@ -237,6 +312,68 @@ var (
 		Value:    "",
 		EnvVar:   p2pEnv("PROPOSER_KEY"),
 	}
+	ProposerP2PSignerEndpointFlag = cli.StringFlag{
+		Name:     "p2p.proposer.signer.endpoint",
+		Usage:    "Endpoint of the remote signer for signing off on p2p application messages as a proposer, instead of a local key.",
+		Required: false,
+		Value:    "",
+		EnvVar:   p2pEnv("PROPOSER_SIGNER_ENDPOINT"),
+	}
+	ProposerP2PSignerAddressFlag = cli.StringFlag{
+		Name:     "p2p.proposer.signer.address",
+		Usage:    "Address of the proposer p2p key held by the remote signer.",
+		Required: false,
+		Value:    "",
+		EnvVar:   p2pEnv("PROPOSER_SIGNER_ADDRESS"),
+	}
+	ProposerP2PSignerTLSCaFlag = cli.StringFlag{
+		Name:      "p2p.proposer.signer.tls.ca",
+		Usage:     "TLS ca cert path of the remote signer. TLS is disabled if empty.",
+		Required:  false,
+		TakesFile: true,
+		EnvVar:    p2pEnv("PROPOSER_SIGNER_TLS_CA"),
+	}
+	ProposerP2PSignerTLSCertFlag = cli.StringFlag{
+		Name:      "p2p.proposer.signer.tls.cert",
+		Usage:     "TLS client cert path for the remote signer.",
+		Required:  false,
+		TakesFile: true,
+		EnvVar:    p2pEnv("PROPOSER_SIGNER_TLS_CERT"),
+	}
+	ProposerP2PSignerTLSKeyFlag = cli.StringFlag{
+		Name:      "p2p.proposer.signer.tls.key",
+		Usage:     "TLS client key path for the remote signer.",
+		Required:  false,
+		TakesFile: true,
+		EnvVar:    p2pEnv("PROPOSER_SIGNER_TLS_KEY"),
+	}
+	ProposerP2PSignerHealthCheckIntervalFlag = cli.DurationFlag{
+		Name:     "p2p.proposer.signer.health-check-interval",
+		Usage:    "Interval between health checks of the remote signer.",
+		Required: false,
+		Value:    p2p.DefaultSignerHealthCheckInterval,
+		EnvVar:   p2pEnv("PROPOSER_SIGNER_HEALTH_CHECK_INTERVAL"),
+	}
+	ProposerP2PRotationWindowFlag = cli.DurationFlag{
+		Name:     "p2p.proposer.rotation.window",
+		Usage:    "Time the previous p2p proposer address stays accepted after the SystemConfig updates it, to rotate the proposer key without restarting every node at once. Set to 0 to switch immediately.",
+		Required: false,
+		Value:    10 * time.Minute,
+		EnvVar:   p2pEnv("PROPOSER_ROTATION_WINDOW"),
+	}
+	ProposerP2PPreviousAddressFlag = cli.StringFlag{
+		Name:     "p2p.proposer.rotation.previous-address",
+		Usage:    "Previous p2p proposer address that stays accepted until the time of --p2p.proposer.rotation.previous-until.",
+		Required: false,
+		Value:    "",
+		EnvVar:   p2pEnv("PROPOSER_ROTATION_PREVIOUS_ADDRESS"),
+	}
+	ProposerP2PPreviousUntilFlag = cli.Uint64Flag{
+		Name:     "p2p.proposer.rotation.previous-until",
+		Usage:    "Unix timestamp until which the address of --p2p.proposer.rotation.previous-address is accepted.",
+		Required: false,
+		EnvVar:   p2pEnv("PROPOSER_ROTATION_PREVIOUS_UNTIL"),
+	}
 	GossipMeshDFlag = cli.UintFlag{
 		Name:     "p2p.gossip.mesh.d",
 		Usage:    "Configure GossipSub topic stable mesh target count, a.k.a. desired outbound degree, number of peers to gossip to",
@@ -276,12 +413,84 @@ var (
 		Hidden:   true,
 		EnvVar:   p2pEnv("GOSSIP_FLOOD_PUBLISH"),
 	}
+	GossipValidationWorkersFlag = cli.UintFlag{
+		Name:     "p2p.gossip.validation.workers",
+		Usage:    "Number of goroutines validating incoming gossip messages of all topics. Defaults to the number of CPUs if 0.",
+		Required: false,
+		Hidden:   true,
+		Value:    0,
+		EnvVar:   p2pEnv("GOSSIP_VALIDATION_WORKERS"),
+	}
+	GossipValidationQueueFlag = cli.UintFlag{
+		Name:     "p2p.gossip.validation.queue",
+		Usage:    "Number of incoming gossip messages queued for validation, before newer messages are dropped.",
+		Required: false,
+		Hidden:   true,
+		Value:    256,
+		EnvVar:   p2pEnv("GOSSIP_VALIDATION_QUEUE"),
+	}
+	GossipValidationConcurrencyFlag = cli.UintFlag{
+		Name:     "p2p.gossip.validation.concurrency",
+		Usage:    "Number of incoming gossip messages of a topic validated at the same time, before newer messages are dropped.",
+		Required: false,
+		Hidden:   true,
+		Value:    4,
+		EnvVar:   p2pEnv("GOSSIP_VALIDATION_CONCURRENCY"),
+	}
 	SyncReqRespFlag = cli.BoolFlag{
 		Name:     "p2p.sync.req-resp",
 		Usage:    "Enables experimental P2P req-resp alternative sync method, on both server and client side.",
 		Required: false,
 		EnvVar:   p2pEnv("SYNC_REQ_RESP"),
 	}
+	SyncServerPeerRateLimitFlag = cli.Float64Flag{
+		Name:     "p2p.sync.server.peer-rate-limit",
+		Usage:    "Number of req-resp sync requests per second served to a single peer.",
+		Required: false,
+		Hidden:   true,
+		Value:    p2p.DefaultReqRespServerLimits().PeerRateLimit,
+		EnvVar:   p2pEnv("SYNC_SERVER_PEER_RATE_LIMIT"),
+	}
+	SyncServerPeerBurstFlag = cli.UintFlag{
+		Name:     "p2p.sync.server.peer-burst",
+		Usage:    "Number of req-resp sync requests a single peer may burst.",
+		Required: false,
+		Hidden:   true,
+		Value:    uint(p2p.DefaultReqRespServerLimits().PeerBurst),
+		EnvVar:   p2pEnv("SYNC_SERVER_PEER_BURST"),
+	}
+	SyncServerPeerConcurrencyFlag = cli.UintFlag{
+		Name:     "p2p.sync.server.peer-concurrency",
+		Usage:    "Number of req-resp sync requests of a single peer served at the same time.",
+		Required: false,
+		Hidden:   true,
+		Value:    uint(p2p.DefaultReqRespServerLimits().PeerConcurrency),
+		EnvVar:   p2pEnv("SYNC_SERVER_PEER_CONCURRENCY"),
+	}
+	SyncServerBandwidthFlag = cli.Uint64Flag{
+		Name:     "p2p.sync.server.bandwidth",
+		Usage:    "Number of payload bytes per second served by the req-resp sync server to all peers together. 0 to disable the budget.",
+		Required: false,
+		Hidden:   true,
+		Value:    p2p.DefaultReqRespServerLimits().GlobalBandwidth,
+		EnvVar:   p2pEnv("SYNC_SERVER_BANDWIDTH"),
+	}
+	SyncServerBanThresholdFlag = cli.UintFlag{
+		Name:     "p2p.sync.server.ban-threshold",
+		Usage:    "Number of req-resp sync requests of a peer rejected by the limits within a minute after which the peer is temporarily banned from the sync server. 0 to disable the bans.",
+		Required: false,
+		Hidden:   true,
+		Value:    uint(p2p.DefaultReqRespServerLimits().BanThreshold),
+		EnvVar:   p2pEnv("SYNC_SERVER_BAN_THRESHOLD"),
+	}
+	SyncServerBanDurationFlag = cli.DurationFlag{
+		Name:     "p2p.sync.server.ban-duration",
+		Usage:    "Duration of the temporary ban of a peer from the req-resp sync server.",
+		Required: false,
+		Hidden:   true,
+		Value:    p2p.DefaultReqRespServerLimits().BanDuration,
+		EnvVar:   p2pEnv("SYNC_SERVER_BAN_DURATION"),
+	}
 )
 
 // None of these flags are strictly required.
@@ -294,6 +503,14 @@ var p2pFlags = []cli.Flag{
 	PeerScoring,
 	PeerScoreBands,
 	Banning,
+	BanThreshold,
+	PeerScoringGossipThreshold,
+	PeerScoringPublishThreshold,
+	PeerScoringGraylistThreshold,
+	PeerScoringIPColocationWeight,
+	PeerScoringIPColocationThreshold,
+	PeerScoringBehaviourPenaltyWeight,
+	PeerScoringBehaviourPenaltyThreshold,
 	TopicScoring,
 	ListenIP,
 	ListenTCPPort,
@@ -302,6 +519,7 @@ var p2pFlags = []cli.Flag{
 	AdvertiseTCPPort,
 	AdvertiseUDPPort,
 	Bootnodes,
+	DNSDiscovery,
 	StaticPeers,
 	HostMux,
 	HostSecurity,
@@ -309,6 +527,7 @@ var p2pFlags = []cli.Flag{
 	PeersHi,
 	PeersGrace,
 	NAT,
+	NATMethod,
 	UserAgent,
 	TimeoutNegotiation,
 	TimeoutAccept,
@@ -316,10 +535,28 @@ var p2pFlags = []cli.Flag{
 	PeerstorePath,
 	DiscoveryPath,
 	ProposerP2PKeyFlag,
+	ProposerP2PSignerEndpointFlag,
+	ProposerP2PSignerAddressFlag,
+	ProposerP2PSignerTLSCaFlag,
+	ProposerP2PSignerTLSCertFlag,
+	ProposerP2PSignerTLSKeyFlag,
+	ProposerP2PSignerHealthCheckIntervalFlag,
+	ProposerP2PRotationWindowFlag,
+	ProposerP2PPreviousAddressFlag,
+	ProposerP2PPreviousUntilFlag,
 	GossipMeshDFlag,
 	GossipMeshDloFlag,
 	GossipMeshDhiFlag,
 	GossipMeshDlazyFlag,
 	GossipFloodPublishFlag,
+	GossipValidationWorkersFlag,
+	GossipValidationQueueFlag,
+	GossipValidationConcurrencyFlag,
 	SyncReqRespFlag,
+	SyncServerPeerRateLimitFlag,
+	SyncServerPeerBurstFlag,
+	SyncServerPeerConcurrencyFlag,
+	SyncServerBandwidthFlag,
+	SyncServerBanThresholdFlag,
+	SyncServerBanDurationFlag,
 }
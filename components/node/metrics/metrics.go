@@ -40,7 +40,7 @@ type Metricer interface {
 	RecordRPCClientRequest(method string) func(err error)
 	RecordRPCClientResponse(method string, err error)
 	SetDerivationIdle(status bool)
-	RecordPipelineReset()
+	RecordPipelineReset(reason string)
 	RecordSequencingError()
 	RecordPublishingError()
 	RecordDerivationError()
@@ -53,21 +53,41 @@ type Metricer interface {
 	RecordL1ReorgDepth(d uint64)
 	RecordProposerInconsistentL1Origin(from eth.BlockID, to eth.BlockID)
 	RecordProposerReset()
+	RecordProposerLateBuildCancel()
+	RecordProposerL1Outage(policy string, active bool)
+	RecordEngineFailover(active string)
+	RecordThroughputController(gasTarget uint64, gasPerSecond float64)
+	RecordPendingDeposit(age time.Duration, blocksToDeadline int64)
+	RecordEngineCrossValidationFailure()
+	RecordDryRunBlock(result string)
+	RecordUnsafeDivergence()
+	RecordDryRunDivergence(field string)
 	RecordGossipEvent(evType int32)
+	RecordGossipValidationDropped(topic string, reason string)
+	RecordGossipMessage(topic string, direction string, result string)
 	IncPeerCount()
 	DecPeerCount()
 	IncStreamCount()
 	DecStreamCount()
 	RecordBandwidth(ctx context.Context, bwc *libp2pmetrics.BandwidthCounter)
+	RecordDiscoveryTable(ctx context.Context, tableSize func() int)
 	RecordProposerBuildingDiffTime(duration time.Duration)
 	RecordProposerSealingTime(duration time.Duration)
 	Document() []metrics.DocumentedMetric
 	RecordChannelInputBytes(num int)
+	RecordDroppedBatch(reason string)
 	// P2P Metrics
 	SetPeerScores(scores map[string]float64)
+	ObservePeerScores(scores []float64)
 	ClientPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration)
 	ServerPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration)
+	ClientPayloadsByRangeEvent(num uint64, resultCode byte, duration time.Duration)
+	ServerPayloadsByRangeEvent(num uint64, resultCode byte, duration time.Duration)
+	ServerRequestRejected(reason string)
+	ServerPeerBanned()
 	PayloadsQuarantineSize(n int)
+	RecordP2PSignerRequest(duration time.Duration, err error)
+	RecordP2PSignerHealth(healthy bool)
 }
 
 // Metrics tracks all the metrics for the kroma-node.
@@ -86,20 +106,39 @@ type Metrics struct {
 
 	DerivationIdle prometheus.Gauge
 
-	PipelineResets   *EventMetrics
-	UnsafePayloads   *EventMetrics
-	DerivationErrors *EventMetrics
-	SequencingErrors *EventMetrics
-	PublishingErrors *EventMetrics
+	PipelineResets         *EventMetrics
+	PipelineResetsByReason *prometheus.CounterVec
+	UnsafePayloads         *EventMetrics
+	DerivationErrors       *EventMetrics
+	SequencingErrors       *EventMetrics
+	PublishingErrors       *EventMetrics
 
 	P2PReqDurationSeconds *prometheus.HistogramVec
 	P2PReqTotal           *prometheus.CounterVec
 	P2PPayloadByNumber    *prometheus.GaugeVec
 
+	P2PServerRejectedRequestsTotal *prometheus.CounterVec
+	P2PServerPeerBansTotal         prometheus.Counter
+
 	PayloadsQuarantineTotal prometheus.Gauge
 
 	ProposerInconsistentL1Origin *EventMetrics
 	ProposerResets               *EventMetrics
+	ProposerLateBuildCancels     *EventMetrics
+	ProposerL1Outage             *prometheus.GaugeVec
+
+	ProposerThroughputGasTarget    prometheus.Gauge
+	ProposerThroughputGasPerSecond prometheus.Gauge
+
+	ProposerPendingDepositAge      prometheus.Gauge
+	ProposerPendingDepositDeadline prometheus.Gauge
+
+	EngineFailoversTotal              *prometheus.CounterVec
+	EngineCrossValidationFailureTotal prometheus.Counter
+
+	DryRunBlocksTotal      *prometheus.CounterVec
+	UnsafeDivergencesTotal prometheus.Counter
+	DryRunDivergencesTotal *prometheus.CounterVec
 
 	ProposerBuildingDiffDurationSeconds prometheus.Histogram
 	ProposerBuildingDiffTotal           prometheus.Counter
@@ -130,8 +169,20 @@ type Metrics struct {
 	GossipEventsTotal *prometheus.CounterVec
 	BandwidthTotal    *prometheus.GaugeVec
 
+	GossipValidationDroppedTotal *prometheus.CounterVec
+	GossipMessagesTotal          *prometheus.CounterVec
+	PeerScoreDistribution        prometheus.Histogram
+	PeerBandwidthRate            *prometheus.HistogramVec
+	DiscoveryTableSize           prometheus.Gauge
+
+	P2PSignerRequestsTotal          *prometheus.CounterVec
+	P2PSignerRequestDurationSeconds prometheus.Histogram
+	P2PSignerHealthy                prometheus.Gauge
+
 	ChannelInputBytes prometheus.Counter
 
+	DroppedBatchesTotal *prometheus.CounterVec
+
 	registry *prometheus.Registry
 	factory  metrics.Factory
 }
@@ -216,7 +267,14 @@ func NewMetrics(procName string) *Metrics {
 			Help:      "1 if the derivation pipeline is idle",
 		}),
 
-		PipelineResets:   NewEventMetrics(factory, ns, "pipeline_resets", "derivation pipeline resets"),
+		PipelineResets: NewEventMetrics(factory, ns, "pipeline_resets", "derivation pipeline resets"),
+		PipelineResetsByReason: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "pipeline_resets_by_reason_total",
+			Help:      "Count of derivation pipeline resets, by reset cause",
+		}, []string{
+			"reason",
+		}),
 		UnsafePayloads:   NewEventMetrics(factory, ns, "unsafe_payloads", "unsafe payloads"),
 		DerivationErrors: NewEventMetrics(factory, ns, "derivation_errors", "derivation errors"),
 		SequencingErrors: NewEventMetrics(factory, ns, "sequencing_errors", "sequencing errors"),
@@ -224,6 +282,7 @@ func NewMetrics(procName string) *Metrics {
 
 		ProposerInconsistentL1Origin: NewEventMetrics(factory, ns, "proposer_inconsistent_l1_origin", "events when the proposer selects an inconsistent L1 origin"),
 		ProposerResets:               NewEventMetrics(factory, ns, "proposer_resets", "proposer resets"),
+		ProposerLateBuildCancels:     NewEventMetrics(factory, ns, "proposer_late_build_cancels", "proposer cancellations of blocks still being built after the next slot passed"),
 
 		UnsafePayloadsBufferLen: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: ns,
@@ -323,6 +382,48 @@ func NewMetrics(procName string) *Metrics {
 		}, []string{
 			"type",
 		}),
+		GossipValidationDroppedTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "gossip_validation_dropped_total",
+			Help:      "Count of gossip messages dropped because the validation was saturated, by topic and reason",
+		}, []string{
+			"topic",
+			"reason",
+		}),
+		GossipMessagesTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "gossip_messages_total",
+			Help:      "Count of gossip messages by topic, direction and validation result",
+		}, []string{
+			"topic",
+			"direction", // "in" or "out"
+			"result",
+		}),
+		PeerScoreDistribution: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "peer_score_distribution",
+			Buckets:   []float64{-100, -40, -20, -10, -5, -1, 0, 1, 5, 10, 20, 40, 100},
+			Help:      "Histogram of the gossip scores of the connected peers, observed on every score snapshot",
+		}),
+		// Like the peer scores, the bandwidth of the peers is not labeled by peer id
+		PeerBandwidthRate: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "peer_bandwidth_bytes_per_second",
+			Buckets:   prometheus.ExponentialBuckets(256, 4, 9),
+			Help:      "Histogram of the bandwidth of the connected peers by direction, observed every 10 seconds",
+		}, []string{
+			"direction",
+		}),
+		DiscoveryTableSize: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "discovery_table_size",
+			Help:      "Count of nodes in the discv5 table",
+		}),
 		BandwidthTotal: factory.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: ns,
 			Subsystem: "p2p",
@@ -331,11 +432,39 @@ func NewMetrics(procName string) *Metrics {
 		}, []string{
 			"direction",
 		}),
+		P2PSignerRequestsTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "signer_requests_total",
+			Help:      "Count of signing requests to the remote p2p signer, by result",
+		}, []string{
+			"result", // "success" or "failure"
+		}),
+		P2PSignerRequestDurationSeconds: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "signer_request_duration_seconds",
+			Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+			Help:      "Histogram of the latency of signing requests to the remote p2p signer",
+		}),
+		P2PSignerHealthy: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "signer_healthy",
+			Help:      "1 if the latest health check of the remote p2p signer succeeded, 0 otherwise",
+		}),
 		ChannelInputBytes: factory.NewCounter(prometheus.CounterOpts{
 			Namespace: ns,
 			Name:      "channel_input_bytes",
 			Help:      "Number of compressed bytes added to the channel",
 		}),
+		DroppedBatchesTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "dropped_batches_total",
+			Help:      "Count of batches dropped by the derivation pipeline, by drop reason",
+		}, []string{
+			"reason",
+		}),
 
 		P2PReqDurationSeconds: factory.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: ns,
@@ -368,6 +497,21 @@ func NewMetrics(procName string) *Metrics {
 		}, []string{
 			"p2p_role", // "client" or "server"
 		}),
+
+		P2PServerRejectedRequestsTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "server_rejected_requests_total",
+			Help:      "Count of sync requests rejected by the limits of the sync server, by reason",
+		}, []string{
+			"reason",
+		}),
+		P2PServerPeerBansTotal: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: "p2p",
+			Name:      "server_peer_bans_total",
+			Help:      "Count of peers temporarily banned from the sync server for hitting its limits",
+		}),
 		PayloadsQuarantineTotal: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: ns,
 			Subsystem: "p2p",
@@ -388,6 +532,64 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "proposer_building_diff_total",
 			Help:      "Number of proposer block building jobs",
 		}),
+		ProposerL1Outage: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "proposer_l1_outage",
+			Help:      "1 while the proposer applies the labeled L1 outage policy, since no new L1 head was observed for a while",
+		}, []string{
+			"policy",
+		}),
+		ProposerThroughputGasTarget: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "proposer_throughput_gas_target",
+			Help:      "Soft gas limit of the next proposed block, set by the throughput controller",
+		}),
+		ProposerThroughputGasPerSecond: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "proposer_throughput_gas_per_second",
+			Help:      "Moving average of the gas per second of the proposed blocks, as seen by the throughput controller",
+		}),
+		ProposerPendingDepositAge: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "proposer_pending_deposit_age_seconds",
+			Help:      "Age of the oldest L1 deposit that is not yet included in the L2 chain, 0 if there is none",
+		}),
+		ProposerPendingDepositDeadline: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "proposer_pending_deposit_deadline_blocks",
+			Help:      "Number of L1 blocks left before the oldest pending L1 deposit is forcibly included by the derivation",
+		}),
+		EngineFailoversTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "engine_failovers_total",
+			Help:      "Count of failovers between the primary and secondary execution engines, by newly active engine",
+		}, []string{
+			"active",
+		}),
+		EngineCrossValidationFailureTotal: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "engine_cross_validation_failures_total",
+			Help:      "Count of payloads or forkchoice updates accepted by the active engine, but rejected by the standby engine",
+		}),
+		DryRunBlocksTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "dry_run_blocks_total",
+			Help:      "Count of local dry-run blocks compared with received blocks, by result: match, diverged or error",
+		}, []string{
+			"result",
+		}),
+		UnsafeDivergencesTotal: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "unsafe_divergences_total",
+			Help:      "Count of unsafe blocks that did not match the block derived from L1 at the same height",
+		}),
+		DryRunDivergencesTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "dry_run_divergences_total",
+			Help:      "Count of differences between local dry-run blocks and received blocks, by block field",
+		}, []string{
+			"field",
+		}),
 		ProposerSealingDurationSeconds: factory.NewHistogram(prometheus.HistogramOpts{
 			Namespace: ns,
 			Name:      "proposer_sealing_seconds",
@@ -413,6 +615,13 @@ func (m *Metrics) SetPeerScores(scores map[string]float64) {
 	}
 }
 
+// ObservePeerScores adds the scores of a peer score snapshot to the peer score histogram.
+func (m *Metrics) ObservePeerScores(scores []float64) {
+	for _, score := range scores {
+		m.PeerScoreDistribution.Observe(score)
+	}
+}
+
 // RecordInfo sets a pseudo-metric that contains versioning and
 // config info for the kroma-node.
 func (m *Metrics) RecordInfo(version string) {
@@ -480,8 +689,9 @@ func (m *Metrics) SetDerivationIdle(status bool) {
 	m.DerivationIdle.Set(val)
 }
 
-func (m *Metrics) RecordPipelineReset() {
+func (m *Metrics) RecordPipelineReset(reason string) {
 	m.PipelineResets.RecordEvent()
+	m.PipelineResetsByReason.WithLabelValues(reason).Inc()
 }
 
 func (m *Metrics) RecordSequencingError() {
@@ -546,14 +756,67 @@ func (m *Metrics) RecordProposerInconsistentL1Origin(from eth.BlockID, to eth.Bl
 	m.recordRef("l1_origin", "inconsistent_to", to.Number, 0, to.Hash)
 }
 
+// RecordProposerL1Outage tracks whether the proposer applies the given L1 outage policy.
+func (m *Metrics) RecordProposerL1Outage(policy string, active bool) {
+	if active {
+		m.ProposerL1Outage.WithLabelValues(policy).Set(1)
+	} else {
+		m.ProposerL1Outage.WithLabelValues(policy).Set(0)
+	}
+}
+
+// RecordPendingDeposit tracks the oldest L1 deposit that is not yet included in the L2 chain.
+func (m *Metrics) RecordPendingDeposit(age time.Duration, blocksToDeadline int64) {
+	m.ProposerPendingDepositAge.Set(age.Seconds())
+	m.ProposerPendingDepositDeadline.Set(float64(blocksToDeadline))
+}
+
+// RecordThroughputController tracks the state of the proposer throughput controller.
+func (m *Metrics) RecordThroughputController(gasTarget uint64, gasPerSecond float64) {
+	m.ProposerThroughputGasTarget.Set(float64(gasTarget))
+	m.ProposerThroughputGasPerSecond.Set(gasPerSecond)
+}
+
+func (m *Metrics) RecordEngineFailover(active string) {
+	m.EngineFailoversTotal.WithLabelValues(active).Inc()
+}
+
+func (m *Metrics) RecordEngineCrossValidationFailure() {
+	m.EngineCrossValidationFailureTotal.Inc()
+}
+
+func (m *Metrics) RecordUnsafeDivergence() {
+	m.UnsafeDivergencesTotal.Inc()
+}
+
+func (m *Metrics) RecordDryRunBlock(result string) {
+	m.DryRunBlocksTotal.WithLabelValues(result).Inc()
+}
+
+func (m *Metrics) RecordDryRunDivergence(field string) {
+	m.DryRunDivergencesTotal.WithLabelValues(field).Inc()
+}
+
 func (m *Metrics) RecordProposerReset() {
 	m.ProposerResets.RecordEvent()
 }
 
+func (m *Metrics) RecordProposerLateBuildCancel() {
+	m.ProposerLateBuildCancels.RecordEvent()
+}
+
 func (m *Metrics) RecordGossipEvent(evType int32) {
 	m.GossipEventsTotal.WithLabelValues(pb.TraceEvent_Type_name[evType]).Inc()
 }
 
+func (m *Metrics) RecordGossipValidationDropped(topic string, reason string) {
+	m.GossipValidationDroppedTotal.WithLabelValues(topic, reason).Inc()
+}
+
+func (m *Metrics) RecordGossipMessage(topic string, direction string, result string) {
+	m.GossipMessagesTotal.WithLabelValues(topic, direction, result).Inc()
+}
+
 func (m *Metrics) IncPeerCount() {
 	m.PeerCount.Inc()
 }
@@ -580,6 +843,25 @@ func (m *Metrics) RecordBandwidth(ctx context.Context, bwc *libp2pmetrics.Bandwi
 			bwTotals := bwc.GetBandwidthTotals()
 			m.BandwidthTotal.WithLabelValues("in").Set(float64(bwTotals.TotalIn))
 			m.BandwidthTotal.WithLabelValues("out").Set(float64(bwTotals.TotalOut))
+			for _, stats := range bwc.GetBandwidthByPeer() {
+				m.PeerBandwidthRate.WithLabelValues("in").Observe(stats.RateIn)
+				m.PeerBandwidthRate.WithLabelValues("out").Observe(stats.RateOut)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RecordDiscoveryTable periodically records the size of the discovery table, until the context is done.
+func (m *Metrics) RecordDiscoveryTable(ctx context.Context, tableSize func() int) {
+	tick := time.NewTicker(10 * time.Second)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-tick.C:
+			m.DiscoveryTableSize.Set(float64(tableSize()))
 		case <-ctx.Done():
 			return
 		}
@@ -637,6 +919,49 @@ func (m *Metrics) ServerPayloadByNumberEvent(num uint64, resultCode byte, durati
 	m.P2PPayloadByNumber.WithLabelValues("server").Set(float64(num))
 }
 
+func (m *Metrics) ClientPayloadsByRangeEvent(num uint64, resultCode byte, duration time.Duration) {
+	if resultCode > 4 { // summarize all high codes to reduce metrics overhead
+		resultCode = 5
+	}
+	code := strconv.FormatUint(uint64(resultCode), 10)
+	m.P2PReqTotal.WithLabelValues("client", "payloads_by_range", code).Inc()
+	m.P2PReqDurationSeconds.WithLabelValues("client", "payloads_by_range", code).Observe(float64(duration) / float64(time.Second))
+	m.P2PPayloadByNumber.WithLabelValues("client").Set(float64(num))
+}
+
+func (m *Metrics) ServerPayloadsByRangeEvent(num uint64, resultCode byte, duration time.Duration) {
+	code := strconv.FormatUint(uint64(resultCode), 10)
+	m.P2PReqTotal.WithLabelValues("server", "payloads_by_range", code).Inc()
+	m.P2PReqDurationSeconds.WithLabelValues("server", "payloads_by_range", code).Observe(float64(duration) / float64(time.Second))
+	m.P2PPayloadByNumber.WithLabelValues("server").Set(float64(num))
+}
+
+func (m *Metrics) ServerRequestRejected(reason string) {
+	m.P2PServerRejectedRequestsTotal.WithLabelValues(reason).Inc()
+}
+
+func (m *Metrics) ServerPeerBanned() {
+	m.P2PServerPeerBansTotal.Inc()
+}
+
+// RecordP2PSignerRequest tracks the result and latency of a signing request to the remote p2p signer.
+func (m *Metrics) RecordP2PSignerRequest(duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	m.P2PSignerRequestsTotal.WithLabelValues(result).Inc()
+	m.P2PSignerRequestDurationSeconds.Observe(float64(duration) / float64(time.Second))
+}
+
+func (m *Metrics) RecordP2PSignerHealth(healthy bool) {
+	if healthy {
+		m.P2PSignerHealthy.Set(1)
+	} else {
+		m.P2PSignerHealthy.Set(0)
+	}
+}
+
 func (m *Metrics) PayloadsQuarantineSize(n int) {
 	m.PayloadsQuarantineTotal.Set(float64(n))
 }
@@ -645,6 +970,10 @@ func (m *Metrics) RecordChannelInputBytes(inputCompressedBytes int) {
 	m.ChannelInputBytes.Add(float64(inputCompressedBytes))
 }
 
+func (m *Metrics) RecordDroppedBatch(reason string) {
+	m.DroppedBatchesTotal.WithLabelValues(reason).Inc()
+}
+
 type noopMetricer struct{}
 
 var NoopMetrics Metricer = new(noopMetricer)
@@ -669,7 +998,7 @@ func (n *noopMetricer) RecordRPCClientResponse(method string, err error) {
 func (n *noopMetricer) SetDerivationIdle(status bool) {
 }
 
-func (n *noopMetricer) RecordPipelineReset() {
+func (n *noopMetricer) RecordPipelineReset(reason string) {
 }
 
 func (n *noopMetricer) RecordSequencingError() {
@@ -708,12 +1037,48 @@ func (n *noopMetricer) RecordProposerInconsistentL1Origin(from eth.BlockID, to e
 func (n *noopMetricer) RecordProposerReset() {
 }
 
+func (n *noopMetricer) RecordProposerLateBuildCancel() {
+}
+
+func (n *noopMetricer) RecordProposerL1Outage(policy string, active bool) {
+}
+
+func (n *noopMetricer) RecordEngineFailover(active string) {
+}
+
+func (n *noopMetricer) RecordThroughputController(gasTarget uint64, gasPerSecond float64) {
+}
+
+func (n *noopMetricer) RecordPendingDeposit(age time.Duration, blocksToDeadline int64) {
+}
+
+func (n *noopMetricer) RecordEngineCrossValidationFailure() {
+}
+
+func (n *noopMetricer) RecordUnsafeDivergence() {
+}
+
+func (n *noopMetricer) RecordDryRunBlock(result string) {
+}
+
+func (n *noopMetricer) RecordDryRunDivergence(field string) {
+}
+
 func (n *noopMetricer) RecordGossipEvent(evType int32) {
 }
 
+func (n *noopMetricer) RecordGossipValidationDropped(topic string, reason string) {
+}
+
+func (n *noopMetricer) RecordGossipMessage(topic string, direction string, result string) {
+}
+
 func (n *noopMetricer) SetPeerScores(scores map[string]float64) {
 }
 
+func (n *noopMetricer) ObservePeerScores(scores []float64) {
+}
+
 func (n *noopMetricer) IncPeerCount() {
 }
 
@@ -729,6 +1094,9 @@ func (n *noopMetricer) DecStreamCount() {
 func (n *noopMetricer) RecordBandwidth(ctx context.Context, bwc *libp2pmetrics.BandwidthCounter) {
 }
 
+func (n *noopMetricer) RecordDiscoveryTable(ctx context.Context, tableSize func() int) {
+}
+
 func (n *noopMetricer) RecordProposerBuildingDiffTime(duration time.Duration) {
 }
 
@@ -745,8 +1113,29 @@ func (n *noopMetricer) ClientPayloadByNumberEvent(num uint64, resultCode byte, d
 func (n *noopMetricer) ServerPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration) {
 }
 
+func (n *noopMetricer) ClientPayloadsByRangeEvent(num uint64, resultCode byte, duration time.Duration) {
+}
+
+func (n *noopMetricer) ServerPayloadsByRangeEvent(num uint64, resultCode byte, duration time.Duration) {
+}
+
+func (n *noopMetricer) ServerRequestRejected(reason string) {
+}
+
+func (n *noopMetricer) ServerPeerBanned() {
+}
+
 func (n *noopMetricer) PayloadsQuarantineSize(int) {
 }
 
+func (n *noopMetricer) RecordP2PSignerRequest(time.Duration, error) {
+}
+
+func (n *noopMetricer) RecordP2PSignerHealth(bool) {
+}
+
 func (n *noopMetricer) RecordChannelInputBytes(int) {
 }
+
+func (n *noopMetricer) RecordDroppedBatch(reason string) {
+}
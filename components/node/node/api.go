@@ -9,12 +9,16 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/kroma-network/kroma/bindings/bindings"
 	"github.com/kroma-network/kroma/bindings/predeploys"
 	"github.com/kroma-network/kroma/components/node/eth"
 	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+	"github.com/kroma-network/kroma/components/node/rollup/driver"
 	"github.com/kroma-network/kroma/components/node/version"
+	krpc "github.com/kroma-network/kroma/utils/service/rpc"
 )
 
 type l2EthClient interface {
@@ -31,6 +35,9 @@ type driverClient interface {
 	ResetDerivationPipeline(context.Context) error
 	StartProposer(ctx context.Context, blockHash common.Hash) error
 	StopProposer(context.Context) (common.Hash, error)
+	DroppedBatches(ctx context.Context) ([]derive.DroppedBatch, error)
+	SendConditionalTransaction(ctx context.Context, tx *types.Transaction, cond driver.TransactionConditional) error
+	ProposerHealth(ctx context.Context) (*eth.ProposerHealth, error)
 }
 
 type rpcMetrics interface {
@@ -39,17 +46,24 @@ type rpcMetrics interface {
 }
 
 type adminAPI struct {
-	dr driverClient
-	m  rpcMetrics
+	dr  driverClient
+	m   rpcMetrics
+	log log.Logger
 }
 
-func NewAdminAPI(dr driverClient, m rpcMetrics) *adminAPI {
+func NewAdminAPI(dr driverClient, m rpcMetrics, log log.Logger) *adminAPI {
 	return &adminAPI{
-		dr: dr,
-		m:  m,
+		dr:  dr,
+		m:   m,
+		log: log,
 	}
 }
 
+// remoteAddr returns the remote address of the caller of an RPC method.
+func remoteAddr(ctx context.Context) string {
+	return rpc.PeerInfoFromContext(ctx).RemoteAddr
+}
+
 func (n *adminAPI) ResetDerivationPipeline(ctx context.Context) error {
 	recordDur := n.m.RecordRPCServerRequest("admin_resetDerivationPipeline")
 	defer recordDur()
@@ -59,13 +73,24 @@ func (n *adminAPI) ResetDerivationPipeline(ctx context.Context) error {
 func (n *adminAPI) StartProposer(ctx context.Context, blockHash common.Hash) error {
 	recordDur := n.m.RecordRPCServerRequest("admin_startProposer")
 	defer recordDur()
-	return n.dr.StartProposer(ctx, blockHash)
+	err := n.dr.StartProposer(ctx, blockHash)
+	n.log.Info("Admin request to start proposer", "identity", krpc.AdminIdentity(ctx), "remote", remoteAddr(ctx), "block_hash", blockHash, "err", err)
+	return err
 }
 
 func (n *adminAPI) StopProposer(ctx context.Context) (common.Hash, error) {
 	recordDur := n.m.RecordRPCServerRequest("admin_stopProposer")
 	defer recordDur()
-	return n.dr.StopProposer(ctx)
+	hash, err := n.dr.StopProposer(ctx)
+	n.log.Info("Admin request to stop proposer", "identity", krpc.AdminIdentity(ctx), "remote", remoteAddr(ctx), "block_hash", hash, "err", err)
+	return hash, err
+}
+
+// DroppedBatches returns the batches most recently dropped by the derivation pipeline, with the reason of the drop.
+func (n *adminAPI) DroppedBatches(ctx context.Context) ([]derive.DroppedBatch, error) {
+	recordDur := n.m.RecordRPCServerRequest("admin_droppedBatches")
+	defer recordDur()
+	return n.dr.DroppedBatches(ctx)
 }
 
 type nodeAPI struct {
@@ -184,12 +209,34 @@ func (n *nodeAPI) fetchOutputAtBlock(ctx context.Context, number hexutil.Uint64)
 	}, nil
 }
 
+// SendRawTransactionConditional submits a signed transaction to the proposer,
+// to be included in the first block that meets the given preconditions.
+func (n *nodeAPI) SendRawTransactionConditional(ctx context.Context, input hexutil.Bytes, cond driver.TransactionConditional) (common.Hash, error) {
+	recordDur := n.m.RecordRPCServerRequest("kroma_sendRawTransactionConditional")
+	defer recordDur()
+
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	if err := n.dr.SendConditionalTransaction(ctx, tx, cond); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
 func (n *nodeAPI) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
 	recordDur := n.m.RecordRPCServerRequest("kroma_syncStatus")
 	defer recordDur()
 	return n.dr.SyncStatus(ctx)
 }
 
+func (n *nodeAPI) SequencerHealth(ctx context.Context) (*eth.ProposerHealth, error) {
+	recordDur := n.m.RecordRPCServerRequest("kroma_sequencerHealth")
+	defer recordDur()
+	return n.dr.ProposerHealth(ctx)
+}
+
 func (n *nodeAPI) RollupConfig(_ context.Context) (*rollup.Config, error) {
 	recordDur := n.m.RecordRPCServerRequest("kroma_rollupConfig")
 	defer recordDur()
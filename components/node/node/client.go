@@ -21,6 +21,13 @@ type L2EndpointSetup interface {
 	Check() error
 }
 
+type L2SecondaryEndpointSetup interface {
+	// Setup a RPC client to a secondary L2 execution engine, to cross-validate blocks and to fail over to.
+	// It may return a nil client with nil error if no secondary engine is configured.
+	Setup(ctx context.Context, log log.Logger, rollupCfg *rollup.Config) (cl client.RPC, rpcCfg *sources.EngineClientConfig, err error)
+	Check() error
+}
+
 type L2SyncEndpointSetup interface {
 	// Setup a RPC client to another L2 node to sync L2 blocks from.
 	// It may return a nil client with nil error if RPC based sync is not enabled.
@@ -28,6 +35,20 @@ type L2SyncEndpointSetup interface {
 	Check() error
 }
 
+type L2BuilderEndpointSetup interface {
+	// Setup a RPC client to an external block builder to request payloads from when proposing.
+	// It may return a nil client with nil error if no external builder is configured.
+	Setup(ctx context.Context, log log.Logger) (cl client.RPC, err error)
+	Check() error
+}
+
+type DAEndpointSetup interface {
+	// Setup a RPC client to an external DA provider to resolve DA commitments submitted by the batcher.
+	// It may return a nil client with nil error if no DA provider is configured.
+	Setup(ctx context.Context, log log.Logger) (cl client.RPC, err error)
+	Check() error
+}
+
 type L1EndpointSetup interface {
 	// Setup a RPC client to a L1 node to pull rollup input-data from.
 	// The results of the RPC client may be trusted for faster processing, or strictly validated.
@@ -67,6 +88,35 @@ func (cfg *L2EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 	return l2Node, sources.EngineClientDefaultConfig(rollupCfg), nil
 }
 
+type L2SecondaryEndpointConfig struct {
+	// Address of the secondary L2 Engine JSON-RPC endpoint, may be empty if there is no secondary engine.
+	L2EngineAddr string
+
+	// JWT secrets for the secondary L2 Engine API authentication.
+	L2EngineJWTSecret [32]byte
+}
+
+var _ L2SecondaryEndpointSetup = (*L2SecondaryEndpointConfig)(nil)
+
+// Setup creates an RPC client to the secondary engine.
+// It will return nil without error if no secondary engine is configured.
+func (cfg *L2SecondaryEndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCfg *rollup.Config) (client.RPC, *sources.EngineClientConfig, error) {
+	if cfg.L2EngineAddr == "" {
+		return nil, nil, nil
+	}
+	auth := rpc.WithHTTPAuth(gn.NewJWTAuth(cfg.L2EngineJWTSecret))
+	l2Node, err := client.NewRPC(ctx, log, cfg.L2EngineAddr, client.WithGethRPCOptions(auth))
+	if err != nil {
+		return nil, nil, err
+	}
+	return l2Node, sources.EngineClientDefaultConfig(rollupCfg), nil
+}
+
+func (cfg *L2SecondaryEndpointConfig) Check() error {
+	// empty addr is valid, as it is optional.
+	return nil
+}
+
 // PreparedL2Endpoints enables testing with in-process pre-setup RPC connections to L2 engines
 type PreparedL2Endpoints struct {
 	Client client.RPC
@@ -204,3 +254,45 @@ func (cfg *PreparedL1Endpoint) Check() error {
 
 	return nil
 }
+
+type L2BuilderEndpointConfig struct {
+	// Address of the external block builder RPC, may be empty if blocks are only built by the local engine.
+	BuilderAddr string
+}
+
+var _ L2BuilderEndpointSetup = (*L2BuilderEndpointConfig)(nil)
+
+// Setup creates an RPC client to request payloads from.
+// It will return nil without error if no external builder is configured.
+func (cfg *L2BuilderEndpointConfig) Setup(ctx context.Context, log log.Logger) (client.RPC, error) {
+	if cfg.BuilderAddr == "" {
+		return nil, nil
+	}
+	return client.NewRPC(ctx, log, cfg.BuilderAddr)
+}
+
+func (cfg *L2BuilderEndpointConfig) Check() error {
+	// empty addr is valid, as it is optional.
+	return nil
+}
+
+type DAEndpointConfig struct {
+	// Address of the external DA provider RPC, may be empty if all batches are submitted to L1.
+	DAServerAddr string
+}
+
+var _ DAEndpointSetup = (*DAEndpointConfig)(nil)
+
+// Setup creates an RPC client to resolve DA commitments with.
+// It will return nil without error if no DA provider is configured.
+func (cfg *DAEndpointConfig) Setup(ctx context.Context, log log.Logger) (client.RPC, error) {
+	if cfg.DAServerAddr == "" {
+		return nil, nil
+	}
+	return client.NewRPC(ctx, log, cfg.DAServerAddr)
+}
+
+func (cfg *DAEndpointConfig) Check() error {
+	// empty addr is valid, as it is optional.
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"math"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/kroma-network/kroma/components/node/p2p"
 	"github.com/kroma-network/kroma/components/node/rollup"
 	"github.com/kroma-network/kroma/components/node/rollup/driver"
@@ -17,6 +19,15 @@ type Config struct {
 	L2     L2EndpointSetup
 	L2Sync L2SyncEndpointSetup
 
+	// L2Secondary is the optional secondary execution engine, to cross-validate blocks and to fail over to
+	L2Secondary L2SecondaryEndpointSetup
+
+	// L2Builder is the optional external block builder the proposer requests payloads from
+	L2Builder L2BuilderEndpointSetup
+
+	// DA is the optional external DA provider that batches submitted as DA commitments are retrieved from
+	DA DAEndpointSetup
+
 	Driver driver.Config
 
 	Rollup rollup.Config
@@ -25,6 +36,9 @@ type Config struct {
 	// if the node is proposing and if the p2p stack is enabled
 	P2PSigner p2p.SignerSetup
 
+	// P2PSignerRotation configures the previous p2p proposer addresses accepted during a key rotation
+	P2PSignerRotation P2PSignerRotationConfig
+
 	RPC RPCConfig
 
 	P2P p2p.SetupP2P
@@ -45,6 +59,8 @@ type RPCConfig struct {
 	ListenAddr  string
 	ListenPort  int
 	EnableAdmin bool
+	// AdminJWTSecret, if set, authenticates the admin methods that control the proposer.
+	AdminJWTSecret []byte
 }
 
 func (cfg *RPCConfig) HttpEndpoint() string {
@@ -69,6 +85,18 @@ func (m MetricsConfig) Check() error {
 	return nil
 }
 
+// P2PSignerRotationConfig configures the p2p proposer addresses accepted alongside the current one,
+// so that the proposer key can be rotated without a coordinated restart of every node.
+type P2PSignerRotationConfig struct {
+	// Window is the time the previous address stays accepted after the SystemConfig updates the address.
+	// The previous address is dropped immediately if zero.
+	Window time.Duration
+	// PreviousAddress is accepted until the unix timestamp of PreviousUntil,
+	// for rotations that are not observed as a SystemConfig update by this node, e.g. when it starts up after one.
+	PreviousAddress common.Address
+	PreviousUntil   uint64
+}
+
 type HeartbeatConfig struct {
 	Enabled bool
 	Moniker string
@@ -83,9 +111,27 @@ func (cfg *Config) Check() error {
 	if err := cfg.L2Sync.Check(); err != nil {
 		return fmt.Errorf("sync config error: %w", err)
 	}
+	if cfg.L2Secondary != nil {
+		if err := cfg.L2Secondary.Check(); err != nil {
+			return fmt.Errorf("secondary l2 endpoint config error: %w", err)
+		}
+	}
+	if cfg.L2Builder != nil {
+		if err := cfg.L2Builder.Check(); err != nil {
+			return fmt.Errorf("builder config error: %w", err)
+		}
+	}
+	if cfg.DA != nil {
+		if err := cfg.DA.Check(); err != nil {
+			return fmt.Errorf("da config error: %w", err)
+		}
+	}
 	if err := cfg.Rollup.Check(); err != nil {
 		return fmt.Errorf("rollup config error: %w", err)
 	}
+	if err := cfg.Driver.Check(); err != nil {
+		return fmt.Errorf("driver config error: %w", err)
+	}
 	if err := cfg.Metrics.Check(); err != nil {
 		return fmt.Errorf("metrics config error: %w", err)
 	}
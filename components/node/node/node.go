@@ -29,15 +29,16 @@ type KromaNode struct {
 	l1SafeSub      ethereum.Subscription // Subscription to get L1 safe blocks, a.k.a. justified data (polling)
 	l1FinalizedSub ethereum.Subscription // Subscription to get L1 safe blocks, a.k.a. justified data (polling)
 
-	l1Source  *sources.L1Client     // L1 Client to fetch data from
-	l2Driver  *driver.Driver        // L2 Engine to Sync
-	l2Source  *sources.EngineClient // L2 Execution Engine RPC bindings
-	rpcSync   *sources.SyncClient   // Alt-sync RPC client, optional (may be nil)
-	server    *rpcServer            // RPC server hosting the rollup-node API
-	p2pNode   *p2p.NodeP2P          // P2P node functionality
-	p2pSigner p2p.Signer            // p2p gossip application messages will be signed with this signer
-	tracer    Tracer                // tracer to get events for testing/debugging
-	runCfg    *RuntimeConfig        // runtime configurables
+	l1Source    *sources.L1Client     // L1 Client to fetch data from
+	l2Driver    *driver.Driver        // L2 Engine to Sync
+	l2Source    *sources.EngineClient // L2 Execution Engine RPC bindings
+	l2Secondary *sources.EngineClient // Secondary L2 Execution Engine RPC bindings, optional (may be nil)
+	rpcSync     *sources.SyncClient   // Alt-sync RPC client, optional (may be nil)
+	server      *rpcServer            // RPC server hosting the rollup-node API
+	p2pNode     *p2p.NodeP2P          // P2P node functionality
+	p2pSigner   p2p.Signer            // p2p gossip application messages will be signed with this signer
+	tracer      Tracer                // tracer to get events for testing/debugging
+	runCfg      *RuntimeConfig        // runtime configurables
 
 	// some resources cannot be stopped directly, like the p2p gossipsub router (not our design),
 	// and depend on this ctx to be closed.
@@ -45,6 +46,13 @@ type KromaNode struct {
 	resourcesClose context.CancelFunc
 }
 
+// l1WithDA is the L1 source of the derivation pipeline when an external DA provider is configured,
+// so that batches submitted as DA commitments can be resolved.
+type l1WithDA struct {
+	*sources.L1Client
+	*sources.DAClient
+}
+
 // The KromaNode handles incoming gossip
 var _ p2p.GossipIn = (*KromaNode)(nil)
 
@@ -156,7 +164,7 @@ func (n *KromaNode) initL1(ctx context.Context, cfg *Config) error {
 
 func (n *KromaNode) initRuntimeConfig(ctx context.Context, cfg *Config) error {
 	// attempt to load runtime config, repeat N times
-	n.runCfg = NewRuntimeConfig(n.log, n.l1Source, &cfg.Rollup)
+	n.runCfg = NewRuntimeConfig(n.log, n.l1Source, &cfg.Rollup, cfg.P2PSignerRotation)
 
 	for i := 0; i < 5; i++ {
 		fetchCtx, fetchCancel := context.WithTimeout(ctx, time.Second*10)
@@ -198,7 +206,56 @@ func (n *KromaNode) initL2(ctx context.Context, cfg *Config, snapshotLog log.Log
 		return err
 	}
 
-	n.l2Driver = driver.NewDriver(&cfg.Driver, &cfg.Rollup, n.l2Source, n.l1Source, n, n, n.log, snapshotLog, n.metrics)
+	var secondary driver.L2Chain
+	if cfg.L2Secondary != nil {
+		secondaryRPC, secondaryCfg, err := cfg.L2Secondary.Setup(ctx, n.log, &cfg.Rollup)
+		if err != nil {
+			return fmt.Errorf("failed to setup secondary L2 execution-engine RPC client: %w", err)
+		}
+		if secondaryRPC != nil {
+			n.l2Secondary, err = sources.NewEngineClient(
+				client.NewInstrumentedRPC(secondaryRPC, n.metrics), n.log, n.metrics.L2SourceCache, secondaryCfg,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to create secondary Engine client: %w", err)
+			}
+			if err := cfg.Rollup.ValidateL2Config(ctx, n.l2Secondary); err != nil {
+				return fmt.Errorf("invalid secondary engine: %w", err)
+			}
+			secondary = n.l2Secondary
+		}
+	}
+
+	var builder driver.ExternalBuilderClient
+	if cfg.L2Builder != nil {
+		builderRPC, err := cfg.L2Builder.Setup(ctx, n.log)
+		if err != nil {
+			return fmt.Errorf("failed to setup external block builder RPC client: %w", err)
+		}
+		if builderRPC != nil {
+			builder = sources.NewBuilderClient(builderRPC)
+		}
+	}
+
+	var l1 driver.L1Chain = n.l1Source
+	if cfg.DA != nil {
+		daRPC, err := cfg.DA.Setup(ctx, n.log)
+		if err != nil {
+			return fmt.Errorf("failed to setup DA provider RPC client: %w", err)
+		}
+		if daRPC != nil {
+			// The derivation pipeline resolves DA commitments if its L1 source is able to.
+			l1 = &l1WithDA{L1Client: n.l1Source, DAClient: sources.NewDAClient(daRPC)}
+		}
+	}
+	if l1 == driver.L1Chain(n.l1Source) && cfg.Rollup.DACommitmentTime != nil {
+		return errors.New("rollup config activates DA commitments, but no DA provider is configured to resolve them")
+	}
+
+	n.l2Driver, err = driver.NewDriver(&cfg.Driver, &cfg.Rollup, n.l2Source, secondary, l1, builder, n, n, n.log, snapshotLog, n.metrics)
+	if err != nil {
+		return fmt.Errorf("failed to create driver: %w", err)
+	}
 
 	return nil
 }
@@ -228,7 +285,7 @@ func (n *KromaNode) initRPCServer(ctx context.Context, cfg *Config) error {
 		server.EnableP2P(p2p.NewP2PAPIBackend(n.p2pNode, n.log, n.metrics))
 	}
 	if cfg.RPC.EnableAdmin {
-		server.EnableAdminAPI(NewAdminAPI(n.l2Driver, n.metrics))
+		server.EnableAdminAPI(NewAdminAPI(n.l2Driver, n.metrics, n.log.New("rpc", "admin")))
 		n.log.Info("Admin RPC enabled")
 	}
 	n.log.Info("Starting JSON-RPC server")
@@ -274,7 +331,7 @@ func (n *KromaNode) initP2PSigner(ctx context.Context, cfg *Config) error {
 	}
 	// p2pSigner may still be nil, the signer setup may not create any signer, the signer is optional
 	var err error
-	n.p2pSigner, err = cfg.P2PSigner.SetupSigner(ctx)
+	n.p2pSigner, err = cfg.P2PSigner.SetupSigner(ctx, n.log, n.metrics)
 	return err
 }
 
@@ -352,6 +409,29 @@ func (n *KromaNode) PublishL2Payload(ctx context.Context, payload *eth.Execution
 	return nil
 }
 
+func (n *KromaNode) PublishPreconfirmation(ctx context.Context, preconf eth.Preconfirmation) error {
+	// publish to p2p, if we are running p2p at all
+	if n.p2pNode != nil {
+		if n.p2pSigner == nil {
+			return fmt.Errorf("node has no p2p signer, preconfirmation of %s cannot be published", preconf.ID())
+		}
+		n.log.Debug("Publishing signed preconfirmation on p2p", "id", preconf.ID())
+		return n.p2pNode.GossipOut().PublishPreconfirmation(ctx, &preconf, n.p2pSigner)
+	}
+	// if p2p is not enabled then we just don't publish the preconfirmation
+	return nil
+}
+
+func (n *KromaNode) OnPreconfirmation(ctx context.Context, from peer.ID, preconf *eth.Preconfirmation) error {
+	// ignore if it's from ourselves
+	if n.p2pNode != nil && from == n.p2pNode.Host().ID() {
+		return nil
+	}
+	// Preconfirmations are only relayed: the block is processed once its payload is received.
+	n.log.Debug("Received signed preconfirmation from p2p", "id", preconf.ID(), "time", preconf.Timestamp, "peer", from)
+	return nil
+}
+
 func (n *KromaNode) OnUnsafeL2Payload(ctx context.Context, from peer.ID, payload *eth.ExecutionPayload) error {
 	// ignore if it's from ourselves
 	if n.p2pNode != nil && from == n.p2pNode.Host().ID() {
@@ -429,6 +509,9 @@ func (n *KromaNode) Close() error {
 	}
 
 	// close L2 engine RPC client
+	if n.l2Secondary != nil {
+		n.l2Secondary.Close()
+	}
 	if n.l2Source != nil {
 		n.l2Source.Close()
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -34,6 +35,7 @@ type RuntimeConfig struct {
 
 	l1Client  RuntimeCfgL1Source
 	rollupCfg *rollup.Config
+	rotation  P2PSignerRotationConfig
 
 	// l1Ref is the current source of the data,
 	// if this is invalidated with a reorg the data will have to be reloaded.
@@ -45,15 +47,21 @@ type RuntimeConfig struct {
 // runtimeConfigData is a flat bundle of configurable data, easy and light to copy around.
 type runtimeConfigData struct {
 	p2pBlockSignerAddr common.Address
+
+	// prevP2PBlockSignerAddr is the address replaced by p2pBlockSignerAddr,
+	// still accepted until the unix timestamp of prevP2PBlockSignerUntil.
+	prevP2PBlockSignerAddr  common.Address
+	prevP2PBlockSignerUntil uint64
 }
 
 var _ p2p.GossipRuntimeConfig = (*RuntimeConfig)(nil)
 
-func NewRuntimeConfig(log log.Logger, l1Client RuntimeCfgL1Source, rollupCfg *rollup.Config) *RuntimeConfig {
+func NewRuntimeConfig(log log.Logger, l1Client RuntimeCfgL1Source, rollupCfg *rollup.Config, rotation P2PSignerRotationConfig) *RuntimeConfig {
 	return &RuntimeConfig{
 		log:       log,
 		l1Client:  l1Client,
 		rollupCfg: rollupCfg,
+		rotation:  rotation,
 	}
 }
 
@@ -63,6 +71,31 @@ func (r *RuntimeConfig) P2PProposerAddress() common.Address {
 	return r.p2pBlockSignerAddr
 }
 
+// P2PProposerAddresses returns the current p2p proposer address, if loaded,
+// followed by the previous addresses that are still accepted at the given time.
+func (r *RuntimeConfig) P2PProposerAddresses(now uint64) []common.Address {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.p2pBlockSignerAddr == (common.Address{}) {
+		return nil
+	}
+	addrs := []common.Address{r.p2pBlockSignerAddr}
+	add := func(addr common.Address, until uint64) {
+		if addr == (common.Address{}) || now >= until {
+			return
+		}
+		for _, a := range addrs {
+			if a == addr {
+				return
+			}
+		}
+		addrs = append(addrs, addr)
+	}
+	add(r.prevP2PBlockSignerAddr, r.prevP2PBlockSignerUntil)
+	add(r.rotation.PreviousAddress, r.rotation.PreviousUntil)
+	return addrs
+}
+
 // Load resets the runtime configuration by fetching the latest config data from L1 at the given L1 block.
 // Load is safe to call concurrently, but will lock the runtime configuration modifications only,
 // and will thus not block other Load calls with possibly alternative L1 block views.
@@ -74,7 +107,15 @@ func (r *RuntimeConfig) Load(ctx context.Context, l1Ref eth.L1BlockRef) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.l1Ref = l1Ref
-	r.p2pBlockSignerAddr = common.BytesToAddress(val[:])
+	addr := common.BytesToAddress(val[:])
+	// Keep accepting the replaced address for a while, counting from the L1 block that includes the update,
+	// so that payloads signed before the proposer switches its key are not rejected by the nodes that updated first.
+	if prev := r.p2pBlockSignerAddr; prev != (common.Address{}) && prev != addr && r.rotation.Window > 0 {
+		r.prevP2PBlockSignerAddr = prev
+		r.prevP2PBlockSignerUntil = l1Ref.Time + uint64(r.rotation.Window/time.Second)
+		r.log.Warn("p2p proposer address rotated", "previous", prev, "new", addr, "previous_until", r.prevP2PBlockSignerUntil)
+	}
+	r.p2pBlockSignerAddr = addr
 	r.log.Info("loaded new runtime config values!", "p2p_proposer_address", r.p2pBlockSignerAddr)
 	return nil
 }
@@ -0,0 +1,55 @@
+package node
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+type mockRuntimeCfgL1Source map[common.Hash]common.Address
+
+func (m mockRuntimeCfgL1Source) ReadStorageAt(ctx context.Context, address common.Address, storageSlot common.Hash, blockHash common.Hash) (common.Hash, error) {
+	return common.BytesToHash(m[blockHash].Bytes()), nil
+}
+
+func TestRuntimeConfigSignerRotation(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlError)
+	oldAddr := common.HexToAddress("0x1111")
+	newAddr := common.HexToAddress("0x2222")
+	cfgAddr := common.HexToAddress("0x3333")
+
+	blockA := eth.L1BlockRef{Hash: common.HexToHash("0xa"), Number: 1, Time: 1000}
+	blockB := eth.L1BlockRef{Hash: common.HexToHash("0xb"), Number: 2, Time: 2000}
+	l1 := mockRuntimeCfgL1Source{blockA.Hash: oldAddr, blockB.Hash: newAddr}
+
+	rotation := P2PSignerRotationConfig{Window: 10 * time.Minute, PreviousAddress: cfgAddr, PreviousUntil: 1500}
+	r := NewRuntimeConfig(logger, l1, &rollup.Config{}, rotation)
+	require.Empty(t, r.P2PProposerAddresses(0), "nothing is accepted before loading the current address")
+
+	require.NoError(t, r.Load(context.Background(), blockA))
+	require.Equal(t, []common.Address{oldAddr, cfgAddr}, r.P2PProposerAddresses(1499))
+	require.Equal(t, []common.Address{oldAddr}, r.P2PProposerAddresses(1500), "configured address expired")
+
+	require.NoError(t, r.Load(context.Background(), blockB))
+	require.Equal(t, newAddr, r.P2PProposerAddress())
+	require.Equal(t, []common.Address{newAddr, oldAddr}, r.P2PProposerAddresses(2000+600-1))
+	require.Equal(t, []common.Address{newAddr}, r.P2PProposerAddresses(2000+600), "rotation window ended")
+
+	// reloading the same address does not extend the window
+	require.NoError(t, r.Load(context.Background(), eth.L1BlockRef{Hash: blockB.Hash, Number: 3, Time: 3000}))
+	require.Equal(t, []common.Address{newAddr}, r.P2PProposerAddresses(3000))
+
+	// without a window the previous address is dropped immediately
+	r = NewRuntimeConfig(logger, l1, &rollup.Config{}, P2PSignerRotationConfig{})
+	require.NoError(t, r.Load(context.Background(), blockA))
+	require.NoError(t, r.Load(context.Background(), blockB))
+	require.Equal(t, []common.Address{newAddr}, r.P2PProposerAddresses(2000))
+}
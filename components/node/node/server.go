@@ -16,6 +16,7 @@ import (
 	"github.com/kroma-network/kroma/components/node/p2p"
 	"github.com/kroma-network/kroma/components/node/rollup"
 	"github.com/kroma-network/kroma/components/node/sources"
+	krpc "github.com/kroma-network/kroma/utils/service/rpc"
 )
 
 type rpcServer struct {
@@ -25,6 +26,8 @@ type rpcServer struct {
 	appVersion string
 	listenAddr net.Addr
 	log        log.Logger
+	// adminSecret is the JWT secret to authenticate admin requests with, disabled if nil.
+	adminSecret []byte
 	sources.L2Client
 }
 
@@ -40,8 +43,9 @@ func newRPCServer(ctx context.Context, rpcCfg *RPCConfig, rollupCfg *rollup.Conf
 			Public:        true,
 			Authenticated: false,
 		}},
-		appVersion: appVersion,
-		log:        log,
+		appVersion:  appVersion,
+		log:         log,
+		adminSecret: rpcCfg.AdminJWTSecret,
 	}
 	return r, nil
 }
@@ -77,6 +81,9 @@ func (s *rpcServer) Start() error {
 	// defaults to localhost, which will prevent containers from
 	// calling into the kroma-node without an "invalid host" error.
 	nodeHandler := node.NewHTTPHandlerStack(srv, []string{"*"}, []string{"*"}, nil)
+	if s.adminSecret != nil {
+		nodeHandler = krpc.NewAdminAuthHandler(s.log, s.adminSecret, nodeHandler)
+	}
 
 	mux := http.NewServeMux()
 	mux.Handle("/", nodeHandler)
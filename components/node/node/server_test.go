@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -18,6 +19,8 @@ import (
 	"github.com/kroma-network/kroma/components/node/eth"
 	"github.com/kroma-network/kroma/components/node/metrics"
 	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+	"github.com/kroma-network/kroma/components/node/rollup/driver"
 	"github.com/kroma-network/kroma/components/node/testlog"
 	"github.com/kroma-network/kroma/components/node/testutils"
 	"github.com/kroma-network/kroma/components/node/version"
@@ -219,6 +222,45 @@ func TestSyncStatus(t *testing.T) {
 	assert.Equal(t, status, out)
 }
 
+func TestSequencerHealth(t *testing.T) {
+	log := testlog.Logger(t, log.LvlError)
+	l2Client := &testutils.MockL2Client{}
+	drClient := &mockDriverClient{}
+	health := &eth.ProposerHealth{
+		Enabled:         true,
+		Active:          true,
+		BuiltBlocks:     100,
+		LatencyP50:      time.Second,
+		LatencyP90:      1500 * time.Millisecond,
+		LatencyP99:      1900 * time.Millisecond,
+		MissedSlots:     2,
+		PublishFailures: 1,
+		LastBlockTime:   1000,
+		SinceLastBlock:  500 * time.Millisecond,
+	}
+	drClient.On("ProposerHealth").Return(health)
+
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	rollupCfg := &rollup.Config{
+		// ignore other rollup config info in this test
+	}
+	server, err := newRPCServer(context.Background(), rpcCfg, rollupCfg, l2Client, drClient, log, "0.0", metrics.NoopMetrics)
+	assert.NoError(t, err)
+	assert.NoError(t, server.Start())
+	defer server.Stop()
+
+	client, err := rpcclient.NewRPC(context.Background(), log, "http://"+server.Addr().String(), rpcclient.WithDialBackoff(3))
+	assert.NoError(t, err)
+
+	var out *eth.ProposerHealth
+	err = client.CallContext(context.Background(), &out, "kroma_sequencerHealth")
+	assert.NoError(t, err)
+	assert.Equal(t, health, out)
+}
+
 type mockDriverClient struct {
 	mock.Mock
 }
@@ -247,3 +289,15 @@ func (c *mockDriverClient) StartProposer(ctx context.Context, blockHash common.H
 func (c *mockDriverClient) StopProposer(ctx context.Context) (common.Hash, error) {
 	return c.Mock.MethodCalled("StopProposer").Get(0).(common.Hash), nil
 }
+
+func (c *mockDriverClient) DroppedBatches(ctx context.Context) ([]derive.DroppedBatch, error) {
+	return c.Mock.MethodCalled("DroppedBatches").Get(0).([]derive.DroppedBatch), nil
+}
+
+func (c *mockDriverClient) ProposerHealth(ctx context.Context) (*eth.ProposerHealth, error) {
+	return c.Mock.MethodCalled("ProposerHealth").Get(0).(*eth.ProposerHealth), nil
+}
+
+func (c *mockDriverClient) SendConditionalTransaction(ctx context.Context, tx *types.Transaction, cond driver.TransactionConditional) error {
+	return c.Mock.MethodCalled("SendConditionalTransaction", tx, cond).Get(0).(error)
+}
@@ -0,0 +1,183 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/hashicorp/go-multierror"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/kroma-network/kroma/components/node/rollup"
+)
+
+const (
+	// blocksTopicTransition is the time before and after the activation of the v2 blocks topic in which both
+	// the v1 and the v2 blocks topics are subscribed to, so the gossip network does not split while it is rolled out.
+	blocksTopicTransition = 10 * time.Minute
+	// blocksTopicsCheckInterval is the interval of updating the subscribed blocks topics during a transition.
+	blocksTopicsCheckInterval = 15 * time.Second
+)
+
+func blocksTopicV2(cfg *rollup.Config) string {
+	return fmt.Sprintf("/kroma/%s/1/blocks", cfg.L2ChainID.String())
+}
+
+// blocksTopicName returns the name of the blocks topic of the given version.
+func blocksTopicName(cfg *rollup.Config, version int) string {
+	if version == 2 {
+		return blocksTopicV2(cfg)
+	}
+	return blocksTopicV1(cfg)
+}
+
+// blocksTopicVersion returns the version of the blocks topic the block with the given timestamp is published on.
+func blocksTopicVersion(cfg *rollup.Config, timestamp uint64) int {
+	if cfg.IsBlocksV2(timestamp) {
+		return 2
+	}
+	return 1
+}
+
+// activeBlocksTopicVersions returns the versions of the blocks topic to be subscribed to at the given time.
+// Both versions are subscribed to within the transition window around the activation of the v2 topic.
+func activeBlocksTopicVersions(cfg *rollup.Config, now uint64) []int {
+	if cfg.BlocksV2Time == nil {
+		return []int{1}
+	}
+	window := uint64(blocksTopicTransition / time.Second)
+	var versions []int
+	if now < *cfg.BlocksV2Time+window {
+		versions = append(versions, 1)
+	}
+	if now+window >= *cfg.BlocksV2Time {
+		versions = append(versions, 2)
+	}
+	return versions
+}
+
+// blocksTopicsTransitioning returns true if the subscribed blocks topics may still change after the given time.
+func blocksTopicsTransitioning(cfg *rollup.Config, now uint64) bool {
+	return cfg.BlocksV2Time != nil && now < *cfg.BlocksV2Time+uint64(blocksTopicTransition/time.Second)
+}
+
+// blocksTopic is a joined and subscribed blocks topic of a single version.
+type blocksTopic struct {
+	ps     *pubsub.PubSub
+	topic  *pubsub.Topic
+	sub    *pubsub.Subscription
+	cancel context.CancelFunc
+}
+
+func joinBlocksTopic(p2pCtx context.Context, self peer.ID, topicScoreParams *pubsub.TopicScoreParams, concurrency int, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, gossipIn GossipIn, version int) (*blocksTopic, error) {
+	val := guardGossipValidator(log, logValidationResult(self, "validated block", log, buildBlocksValidator(log, cfg, runCfg, version)))
+	name := blocksTopicName(cfg, version)
+	err := ps.RegisterTopicValidator(name,
+		val,
+		pubsub.WithValidatorTimeout(3*time.Second),
+		pubsub.WithValidatorConcurrency(concurrency))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register blocks gossip topic: %w", err)
+	}
+	topic, err := ps.Join(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join blocks gossip topic: %w", err)
+	}
+	events, err := topic.EventHandler()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blocks gossip topic handler: %w", err)
+	}
+	ctx, cancel := context.WithCancel(p2pCtx)
+	go LogTopicEvents(ctx, log.New("topic", "blocks", "version", version), events)
+
+	// A [TimeInMeshQuantum] value of 0 means the topic score is disabled.
+	// If we passed a topicScoreParams with [TimeInMeshQuantum] set to 0,
+	// libp2p errors since the params will be rejected.
+	if topicScoreParams != nil && topicScoreParams.TimeInMeshQuantum != 0 {
+		if err = topic.SetScoreParams(topicScoreParams); err != nil {
+			cancel()
+			return nil, fmt.Errorf("failed to set topic score params: %w", err)
+		}
+	}
+
+	sub, err := topic.Subscribe()
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to subscribe to blocks gossip topic: %w", err)
+	}
+
+	subscriber := MakeSubscriber(log, BlocksHandler(gossipIn.OnUnsafeL2Payload))
+	go subscriber(ctx, sub)
+
+	return &blocksTopic{ps: ps, topic: topic, sub: sub, cancel: cancel}, nil
+}
+
+// Close unsubscribes from and leaves the topic.
+func (t *blocksTopic) Close() error {
+	t.cancel()
+	t.sub.Cancel()
+	var result *multierror.Error
+	if err := t.ps.UnregisterTopicValidator(t.topic.String()); err != nil {
+		result = multierror.Append(result, fmt.Errorf("failed to unregister blocks topic validator: %w", err))
+	}
+	if err := t.topic.Close(); err != nil {
+		result = multierror.Append(result, fmt.Errorf("failed to close blocks topic: %w", err))
+	}
+	return result.ErrorOrNil()
+}
+
+// updateBlocksTopics joins the blocks topics to be subscribed to at the given time, and leaves the others.
+func (p *publisher) updateBlocksTopics(now uint64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var result *multierror.Error
+	active := make(map[int]bool)
+	for _, version := range activeBlocksTopicVersions(p.cfg, now) {
+		active[version] = true
+		if _, ok := p.blocksTopics[version]; ok {
+			continue
+		}
+		topic, err := p.joinBlocks(version)
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to join v%d blocks topic: %w", version, err))
+			continue
+		}
+		p.blocksTopics[version] = topic
+		p.log.Info("joined blocks gossip topic", "topic", topic.topic.String())
+	}
+	for version, topic := range p.blocksTopics {
+		if active[version] {
+			continue
+		}
+		if err := topic.Close(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to leave v%d blocks topic: %w", version, err))
+		}
+		delete(p.blocksTopics, version)
+		p.log.Info("left blocks gossip topic", "topic", topic.topic.String())
+	}
+	return result.ErrorOrNil()
+}
+
+// transitionBlocksTopics updates the subscribed blocks topics until the transition to the v2 topic is complete.
+func (p *publisher) transitionBlocksTopics(ctx context.Context) {
+	ticker := time.NewTicker(blocksTopicsCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			now := uint64(time.Now().Unix())
+			if err := p.updateBlocksTopics(now); err != nil {
+				p.log.Error("failed to update blocks gossip topics", "err", err)
+				continue
+			}
+			if !blocksTopicsTransitioning(p.cfg, now) {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package p2p
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/rollup"
+)
+
+func TestActiveBlocksTopicVersions(t *testing.T) {
+	cfg := &rollup.Config{L2ChainID: big.NewInt(100)}
+	require.Equal(t, []int{1}, activeBlocksTopicVersions(cfg, 0))
+	require.False(t, blocksTopicsTransitioning(cfg, 0))
+	require.Equal(t, 1, blocksTopicVersion(cfg, 1_000_000))
+
+	v2Time := uint64(10_000)
+	cfg.BlocksV2Time = &v2Time
+	window := uint64(blocksTopicTransition.Seconds())
+
+	tests := []struct {
+		name     string
+		now      uint64
+		versions []int
+	}{
+		{"before transition", v2Time - window - 1, []int{1}},
+		{"transition start", v2Time - window, []int{1, 2}},
+		{"activation", v2Time, []int{1, 2}},
+		{"transition end", v2Time + window - 1, []int{1, 2}},
+		{"after transition", v2Time + window, []int{2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.versions, activeBlocksTopicVersions(cfg, tt.now))
+			require.Equal(t, len(tt.versions) == 1 && tt.versions[0] == 2, !blocksTopicsTransitioning(cfg, tt.now))
+		})
+	}
+
+	require.Equal(t, 1, blocksTopicVersion(cfg, v2Time-1))
+	require.Equal(t, 2, blocksTopicVersion(cfg, v2Time))
+	require.Equal(t, "/kroma/100/0/blocks", blocksTopicName(cfg, 1))
+	require.Equal(t, "/kroma/100/1/blocks", blocksTopicName(cfg, 2))
+}
+
+func TestBuildSubscriptionFilter_BlocksV2(t *testing.T) {
+	cfg := &rollup.Config{L2ChainID: big.NewInt(100)}
+	require.False(t, BuildSubscriptionFilter(cfg).CanSubscribe(blocksTopicV2(cfg)))
+
+	v2Time := uint64(10_000)
+	cfg.BlocksV2Time = &v2Time
+	filter := BuildSubscriptionFilter(cfg)
+	require.True(t, filter.CanSubscribe(blocksTopicV1(cfg)))
+	require.True(t, filter.CanSubscribe(blocksTopicV2(cfg)))
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/nat"
 	ds "github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/sync"
 	leveldb "github.com/ipfs/go-ds-leveldb"
@@ -72,6 +73,14 @@ func NewConfig(ctx *cli.Context, blockTime uint64) (*p2p.Config, error) {
 	conf.ConnMngr = p2p.DefaultConnManager
 
 	conf.EnableReqRespSync = ctx.GlobalBool(flags.SyncReqRespFlag.Name)
+	conf.SyncServerLimits = p2p.ReqRespServerLimits{
+		PeerRateLimit:   ctx.GlobalFloat64(flags.SyncServerPeerRateLimitFlag.Name),
+		PeerBurst:       int(ctx.GlobalUint(flags.SyncServerPeerBurstFlag.Name)),
+		PeerConcurrency: int(ctx.GlobalUint(flags.SyncServerPeerConcurrencyFlag.Name)),
+		GlobalBandwidth: ctx.GlobalUint64(flags.SyncServerBandwidthFlag.Name),
+		BanThreshold:    int(ctx.GlobalUint(flags.SyncServerBanThresholdFlag.Name)),
+		BanDuration:     ctx.GlobalDuration(flags.SyncServerBanDurationFlag.Name),
+	}
 
 	return conf, nil
 }
@@ -119,9 +128,28 @@ func loadPeerScoringParams(conf *p2p.Config, ctx *cli.Context, blockTime uint64)
 		if err != nil {
 			return err
 		}
+		// The parameters of the scoring strategy can be overridden individually.
+		if ctx.GlobalIsSet(flags.PeerScoringIPColocationWeight.Name) {
+			peerScoreParams.IPColocationFactorWeight = ctx.GlobalFloat64(flags.PeerScoringIPColocationWeight.Name)
+		}
+		if ctx.GlobalIsSet(flags.PeerScoringIPColocationThreshold.Name) {
+			peerScoreParams.IPColocationFactorThreshold = ctx.GlobalInt(flags.PeerScoringIPColocationThreshold.Name)
+		}
+		if ctx.GlobalIsSet(flags.PeerScoringBehaviourPenaltyWeight.Name) {
+			peerScoreParams.BehaviourPenaltyWeight = ctx.GlobalFloat64(flags.PeerScoringBehaviourPenaltyWeight.Name)
+		}
+		if ctx.GlobalIsSet(flags.PeerScoringBehaviourPenaltyThreshold.Name) {
+			peerScoreParams.BehaviourPenaltyThreshold = ctx.GlobalFloat64(flags.PeerScoringBehaviourPenaltyThreshold.Name)
+		}
 		conf.PeerScoring = peerScoreParams
 	}
 
+	thresholds := p2p.NewPeerScoreThresholds()
+	thresholds.GossipThreshold = ctx.GlobalFloat64(flags.PeerScoringGossipThreshold.Name)
+	thresholds.PublishThreshold = ctx.GlobalFloat64(flags.PeerScoringPublishThreshold.Name)
+	thresholds.GraylistThreshold = ctx.GlobalFloat64(flags.PeerScoringGraylistThreshold.Name)
+	conf.ScoreThresholds = thresholds
+
 	return nil
 }
 
@@ -140,6 +168,7 @@ func loadPeerScoreBands(conf *p2p.Config, ctx *cli.Context) error {
 func loadBanningOption(conf *p2p.Config, ctx *cli.Context) error {
 	ban := ctx.GlobalBool(flags.Banning.Name)
 	conf.BanningEnabled = ban
+	conf.BanThreshold = ctx.GlobalFloat64(flags.BanThreshold.Name)
 	return nil
 }
 
@@ -221,6 +250,14 @@ func loadDiscoveryOpts(conf *p2p.Config, ctx *cli.Context) error {
 		conf.Bootnodes = append(conf.Bootnodes, nodeRecord)
 	}
 
+	for _, url := range strings.Split(ctx.GlobalString(flags.DNSDiscovery.Name), ",") {
+		url = strings.TrimSpace(url)
+		if url == "" { // ignore empty URLs
+			continue
+		}
+		conf.DNSDiscoveryURLs = append(conf.DNSDiscoveryURLs, url)
+	}
+
 	return nil
 }
 
@@ -272,6 +309,13 @@ func loadLibp2pOpts(conf *p2p.Config, ctx *cli.Context) error {
 	conf.PeersHi = ctx.GlobalUint(flags.PeersHi.Name)
 	conf.PeersGrace = ctx.GlobalDuration(flags.PeersGrace.Name)
 	conf.NAT = ctx.GlobalBool(flags.NAT.Name)
+	if conf.NAT {
+		m, err := nat.Parse(ctx.GlobalString(flags.NATMethod.Name))
+		if err != nil {
+			return fmt.Errorf("invalid NAT method: %w", err)
+		}
+		conf.NATMethod = m
+	}
 	conf.UserAgent = ctx.GlobalString(flags.UserAgent.Name)
 	conf.TimeoutNegotiation = ctx.GlobalDuration(flags.TimeoutNegotiation.Name)
 	conf.TimeoutAccept = ctx.GlobalDuration(flags.TimeoutAccept.Name)
@@ -357,5 +401,10 @@ func loadGossipOptions(conf *p2p.Config, ctx *cli.Context) error {
 	conf.MeshDHi = ctx.GlobalInt(flags.GossipMeshDhiFlag.Name)
 	conf.MeshDLazy = ctx.GlobalInt(flags.GossipMeshDlazyFlag.Name)
 	conf.FloodPublish = ctx.GlobalBool(flags.GossipFloodPublishFlag.Name)
+	conf.Validation = p2p.GossipValidationConfig{
+		Workers:     int(ctx.GlobalUint(flags.GossipValidationWorkersFlag.Name)),
+		QueueSize:   int(ctx.GlobalUint(flags.GossipValidationQueueFlag.Name)),
+		Concurrency: int(ctx.GlobalUint(flags.GossipValidationConcurrencyFlag.Name)),
+	}
 	return nil
 }
@@ -1,21 +1,25 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/urfave/cli"
 
 	"github.com/kroma-network/kroma/components/node/flags"
 	"github.com/kroma-network/kroma/components/node/p2p"
+	ktls "github.com/kroma-network/kroma/utils/service/tls"
 )
 
-// TODO: implement remote signer setup (config to authenticated endpoint)
-// and remote signer itself (e.g. a open http client to make signing requests)
-
 // LoadSignerSetup loads a configuration for a Signer to be set up later
 func LoadSignerSetup(ctx *cli.Context) (p2p.SignerSetup, error) {
 	key := ctx.GlobalString(flags.ProposerP2PKeyFlag.Name)
+	endpoint := ctx.GlobalString(flags.ProposerP2PSignerEndpointFlag.Name)
+	if key != "" && endpoint != "" {
+		return nil, errors.New("p2p proposer key and remote signer endpoint cannot be both set")
+	}
 	if key != "" {
 		// Mnemonics are bad because they leak *all* keys when they leak.
 		// Unencrypted keys from file are bad because they are easy to leak (and we are not checking file permissions).
@@ -27,7 +31,26 @@ func LoadSignerSetup(ctx *cli.Context) (p2p.SignerSetup, error) {
 		return &p2p.PreparedSigner{Signer: p2p.NewLocalSigner(priv)}, nil
 	}
 
-	// TODO: create remote signer
+	if endpoint != "" {
+		addr := ctx.GlobalString(flags.ProposerP2PSignerAddressFlag.Name)
+		if !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid remote signer address: %q", addr)
+		}
+		tlsConfig := ktls.CLIConfig{
+			TLSCaCert: ctx.GlobalString(flags.ProposerP2PSignerTLSCaFlag.Name),
+			TLSCert:   ctx.GlobalString(flags.ProposerP2PSignerTLSCertFlag.Name),
+			TLSKey:    ctx.GlobalString(flags.ProposerP2PSignerTLSKeyFlag.Name),
+		}
+		if err := tlsConfig.Check(); err != nil {
+			return nil, fmt.Errorf("invalid remote signer tls config: %w", err)
+		}
+		return &p2p.RemoteSignerSetup{
+			Endpoint:            endpoint,
+			Address:             common.HexToAddress(addr),
+			TLSConfig:           tlsConfig,
+			HealthCheckInterval: ctx.GlobalDuration(flags.ProposerP2PSignerHealthCheckIntervalFlag.Name),
+		}, nil
+	}
 
 	return nil, nil
 }
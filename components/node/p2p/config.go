@@ -1,6 +1,7 @@
 package p2p
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -8,7 +9,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/dnsdisc"
 	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/nat"
 	ds "github.com/ipfs/go-datastore"
 	"github.com/libp2p/go-libp2p"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -27,6 +30,10 @@ import (
 // TODO(chokobole): fill the default bootnodes.
 var DefaultBootnodes = []*enode.Node{}
 
+// DefaultDNSDiscoveryURLs are the EIP-1459 ENR tree URLs to bootstrap discovery from, by L2 chain ID.
+// They are used if no ENR tree URLs are configured.
+var DefaultDNSDiscoveryURLs = map[uint64][]string{}
+
 // SetupP2P provides a host and discovery service for usage in the rollup node.
 type SetupP2P interface {
 	Check() error
@@ -35,9 +42,16 @@ type SetupP2P interface {
 	Host(log log.Logger, reporter metrics.Reporter) (host.Host, error)
 	// Discovery creates a disc-v5 service. Returns nil, nil, nil if discovery is disabled.
 	Discovery(log log.Logger, rollupCfg *rollup.Config, tcpPort uint16) (*enode.LocalNode, *discover.UDPv5, error)
+	// DNSDiscovery creates an iterator over the nodes of the EIP-1459 DNS discovery lists.
+	// Returns nil, nil if there are no lists or discovery is disabled.
+	DNSDiscovery(log log.Logger, rollupCfg *rollup.Config) (enode.Iterator, error)
+	// NAT maps ports on the NAT device and advertises its external address in the discovery record,
+	// until the context is done. It returns immediately if NAT traversal is disabled.
+	NAT(ctx context.Context, log log.Logger, h host.Host, localNode *enode.LocalNode)
 	TargetPeers() uint
 	GossipSetupConfigurables
 	ReqRespSyncEnabled() bool
+	ReqRespServerLimits() ReqRespServerLimits
 }
 
 // Config sets up a p2p host and discv5 service from configuration.
@@ -60,6 +74,13 @@ type Config struct {
 
 	// Whether to ban peers based on their [PeerScoring] score.
 	BanningEnabled bool
+	// Score below which peers are banned, if banning is enabled. 0 uses the default [PeerScoreThreshold].
+	BanThreshold float64
+	// Gossip score thresholds. The zero value uses the defaults of [NewPeerScoreThresholds].
+	ScoreThresholds pubsub.PeerScoreThresholds
+
+	// Validation of incoming gossip messages. The zero value uses the defaults.
+	Validation GossipValidationConfig
 
 	ListenIP      net.IP
 	ListenTCPPort uint16
@@ -72,6 +93,9 @@ type Config struct {
 	AdvertiseUDPPort uint16
 	Bootnodes        []*enode.Node
 	DiscoveryDB      *enode.DB
+	// EIP-1459 ENR tree URLs (enrtree://<key>@<domain>) to discover nodes from, alongside the bootnodes.
+	// The DefaultDNSDiscoveryURLs of the L2 chain are used if there are none.
+	DNSDiscoveryURLs []string
 
 	StaticPeers []core.Multiaddr
 
@@ -93,6 +117,9 @@ type Config struct {
 
 	// If true a NAT manager will host a NAT port mapping that is updated with PMP and UPNP by libp2p/go-nat
 	NAT bool
+	// NATMethod is the NAT device the discovery port is mapped on and the external IP is learned from if NAT is true.
+	// Any UPnP or NAT-PMP device is discovered if nil.
+	NATMethod nat.Interface
 
 	UserAgent string
 
@@ -107,6 +134,8 @@ type Config struct {
 	ConnMngr  func(conf *Config) (connmgr.ConnManager, error)
 
 	EnableReqRespSync bool
+	// Limits of the req-resp sync server applied to the requests of each peer
+	SyncServerLimits ReqRespServerLimits
 }
 
 //go:generate mockery --name ConnectionGater
@@ -165,6 +194,21 @@ func (conf *Config) BanPeers() bool {
 	return conf.BanningEnabled
 }
 
+func (conf *Config) PeerBanThreshold() float64 {
+	if conf.BanThreshold == 0 {
+		return PeerScoreThreshold
+	}
+	return conf.BanThreshold
+}
+
+func (conf *Config) PeerScoreThresholds() *pubsub.PeerScoreThresholds {
+	if conf.ScoreThresholds == (pubsub.PeerScoreThresholds{}) {
+		thresholds := NewPeerScoreThresholds()
+		return &thresholds
+	}
+	return &conf.ScoreThresholds
+}
+
 func (conf *Config) TopicScoringParams() *pubsub.TopicScoreParams {
 	return &conf.TopicScoring
 }
@@ -173,6 +217,27 @@ func (conf *Config) ReqRespSyncEnabled() bool {
 	return conf.EnableReqRespSync
 }
 
+func (conf *Config) ReqRespServerLimits() ReqRespServerLimits {
+	return conf.SyncServerLimits
+}
+
+func (conf *Config) GossipValidation() GossipValidationConfig {
+	return conf.Validation.withDefaults()
+}
+
+// staticPeerAddrs returns the address info of the static peers.
+func (conf *Config) staticPeerAddrs() ([]*peer.AddrInfo, error) {
+	staticPeers := make([]*peer.AddrInfo, len(conf.StaticPeers))
+	for i, peerAddr := range conf.StaticPeers {
+		addr, err := peer.AddrInfoFromP2pAddr(peerAddr)
+		if err != nil {
+			return nil, fmt.Errorf("bad peer address: %w", err)
+		}
+		staticPeers[i] = addr
+	}
+	return staticPeers, nil
+}
+
 const maxMeshParam = 1000
 
 func (conf *Config) Check() error {
@@ -208,5 +273,26 @@ func (conf *Config) Check() error {
 	if conf.MeshDLazy <= 0 || conf.MeshDLazy > maxMeshParam {
 		return fmt.Errorf("mesh Dlazy param must not be 0 or exceed %d, but got %d", maxMeshParam, conf.MeshDLazy)
 	}
+	if t := conf.PeerScoreThresholds(); t.GossipThreshold > 0 || t.PublishThreshold > t.GossipThreshold || t.GraylistThreshold > t.PublishThreshold {
+		return fmt.Errorf("peer score thresholds must satisfy graylist <= publish <= gossip <= 0, but got %v, %v, %v",
+			t.GraylistThreshold, t.PublishThreshold, t.GossipThreshold)
+	}
+	if conf.PeerScoring.IPColocationFactorWeight > 0 || conf.PeerScoring.BehaviourPenaltyWeight > 0 {
+		return errors.New("peer score penalty weights must not be positive")
+	}
+	if err := conf.SyncServerLimits.Check(); err != nil {
+		return err
+	}
+	if err := conf.Validation.Check(); err != nil {
+		return err
+	}
+	if _, err := conf.staticPeerAddrs(); err != nil {
+		return fmt.Errorf("invalid static peers: %w", err)
+	}
+	for _, url := range conf.DNSDiscoveryURLs {
+		if _, _, err := dnsdisc.ParseURL(url); err != nil {
+			return fmt.Errorf("invalid DNS discovery URL %q: %w", url, err)
+		}
+	}
 	return nil
 }
@@ -17,6 +17,7 @@ import (
 	gcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/dnsdisc"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -46,6 +47,8 @@ const (
 	tableKickoffDelay      = time.Second * 3
 	discoveredAddrTTL      = time.Hour * 24
 	collectiveDialTimeout  = time.Second * 30
+	// dnsDiscoveryMixTimeout is the time discovery waits for a node of a source before moving on to the next source
+	dnsDiscoveryMixTimeout = time.Second * 5
 )
 
 func (conf *Config) Discovery(log log.Logger, rollupCfg *rollup.Config, tcpPort uint16) (*enode.LocalNode, *discover.UDPv5, error) {
@@ -108,12 +111,37 @@ func (conf *Config) Discovery(log log.Logger, rollupCfg *rollup.Config, tcpPort
 
 	log.Info("started discovery service", "enr", localNode.Node(), "id", localNode.ID())
 
-	// TODO: periodically we can pull the external IP and TCP port from libp2p NAT service,
-	// and add it as a statement to keep the localNode accurate (if we trust the NAT device more than the discv5 statements)
-
 	return localNode, udpV5, nil
 }
 
+// dnsDiscoveryURLs returns the configured ENR tree URLs, or the default ones of the L2 chain if there are none.
+func (conf *Config) dnsDiscoveryURLs(rollupCfg *rollup.Config) []string {
+	if len(conf.DNSDiscoveryURLs) > 0 {
+		return conf.DNSDiscoveryURLs
+	}
+	return DefaultDNSDiscoveryURLs[rollupCfg.L2ChainID.Uint64()]
+}
+
+func (conf *Config) DNSDiscovery(log log.Logger, rollupCfg *rollup.Config) (enode.Iterator, error) {
+	if conf.NoDiscovery {
+		return nil, nil
+	}
+	urls := conf.dnsDiscoveryURLs(rollupCfg)
+	if len(urls) == 0 {
+		return nil, nil
+	}
+	client := dnsdisc.NewClient(dnsdisc.Config{
+		Logger:       log,
+		ValidSchemes: enode.ValidSchemes,
+	})
+	iter, err := client.NewIterator(urls...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up DNS discovery: %w", err)
+	}
+	log.Info("started DNS discovery", "urls", urls)
+	return iter, nil
+}
+
 // Secp256k1 is like the geth Secp256k1 enr entry type, but using the libp2p pubkey representation instead
 type Secp256k1 crypto.Secp256k1PublicKey
 
@@ -244,6 +272,13 @@ func (n *NodeP2P) DiscoveryProcess(ctx context.Context, log log.Logger, cfg *rol
 	// We pull nodes from discv5 DHT in random order to find new peers.
 	// Eventually we'll find a peer record that matches our filter.
 	randomNodeIter := n.dv5Udp.RandomNodes()
+	// The nodes of the DNS discovery lists, if any, are mixed in as another source of peers.
+	if n.dnsDisc != nil {
+		mix := enode.NewFairMix(dnsDiscoveryMixTimeout)
+		mix.AddSource(randomNodeIter)
+		mix.AddSource(n.dnsDisc)
+		randomNodeIter = mix
+	}
 
 	randomNodeIter = enode.Filter(randomNodeIter, filter)
 	defer randomNodeIter.Close()
@@ -0,0 +1,39 @@
+package p2p
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+func TestDNSDiscovery(t *testing.T) {
+	const url = "enrtree://AKA3AM6LPBYEUDMVNU3BSVQJ5AD45Y7YPOHJLEF6W26QOE4VTUDPE@nodes.example.org"
+	rollupCfg := &rollup.Config{L2ChainID: big.NewInt(901)}
+	logger := testlog.Logger(t, log.LvlError)
+
+	conf := TestingConfig(t)
+	conf.NoDiscovery = false
+	iter, err := conf.DNSDiscovery(logger, rollupCfg)
+	require.NoError(t, err)
+	require.Nil(t, iter, "no lists of the network")
+
+	DefaultDNSDiscoveryURLs[901] = []string{url}
+	defer delete(DefaultDNSDiscoveryURLs, 901)
+	require.Equal(t, []string{url}, conf.dnsDiscoveryURLs(rollupCfg))
+	conf.DNSDiscoveryURLs = []string{"enrtree://AM5FCQLWIZX2QFPNJAP7VUERCCRNGRHWZG3YYHIUV7BVDQ5FDPRT2@other.example.org"}
+	require.Equal(t, conf.DNSDiscoveryURLs, conf.dnsDiscoveryURLs(rollupCfg), "configured lists override the defaults")
+
+	iter, err = conf.DNSDiscovery(logger, rollupCfg)
+	require.NoError(t, err)
+	require.NotNil(t, iter)
+	iter.Close()
+
+	conf.DNSDiscoveryURLs = []string{"enrtree://invalid@nodes.example.org"}
+	_, err = conf.DNSDiscovery(logger, rollupCfg)
+	require.Error(t, err)
+}
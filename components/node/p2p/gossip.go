@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/golang/snappy"
+	"github.com/hashicorp/go-multierror"
 	lru "github.com/hashicorp/golang-lru"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pb "github.com/libp2p/go-libp2p-pubsub/pb"
@@ -41,6 +42,9 @@ const (
 	DefaultMeshDlazy = 6  // gossip target
 	// peerScoreInspectFrequency is the frequency at which peer scores are inspected
 	peerScoreInspectFrequency = 15 * time.Second
+
+	// topicValidatorConcurrency is the number of messages of a topic validated at the same time
+	topicValidatorConcurrency = 4
 )
 
 // Message domains, the msg id function uncompresses to keep data monomorphic,
@@ -51,23 +55,68 @@ var (
 	MessageDomainValidSnappy   = [4]byte{1, 0, 0, 0}
 )
 
+// GossipValidationConfig configures the validation of incoming gossip messages.
+// Messages that cannot be queued for validation are dropped.
+type GossipValidationConfig struct {
+	// Workers is the number of goroutines validating the messages of all topics. 0 uses the number of CPUs.
+	Workers int
+	// QueueSize is the number of messages queued for validation, before newer messages are dropped.
+	QueueSize int
+	// Concurrency is the number of messages of a topic validated at the same time, before newer messages are dropped.
+	Concurrency int
+}
+
+// withDefaults returns the config with the unset queue size and concurrency replaced by their defaults.
+func (c GossipValidationConfig) withDefaults() GossipValidationConfig {
+	if c.QueueSize == 0 {
+		c.QueueSize = maxValidateQueue
+	}
+	if c.Concurrency == 0 {
+		c.Concurrency = topicValidatorConcurrency
+	}
+	return c
+}
+
+func (c GossipValidationConfig) Check() error {
+	if c.Workers < 0 || c.QueueSize < 0 || c.Concurrency < 0 {
+		return fmt.Errorf("gossip validation config must not be negative: %+v", c)
+	}
+	if c.Concurrency > globalValidateThrottle {
+		return fmt.Errorf("gossip validation concurrency must not exceed %d, but got %d", globalValidateThrottle, c.Concurrency)
+	}
+	return nil
+}
+
 type GossipSetupConfigurables interface {
 	PeerScoringParams() *pubsub.PeerScoreParams
 	TopicScoringParams() *pubsub.TopicScoreParams
 	BanPeers() bool
+	// PeerBanThreshold is the score below which peers are banned, if banning is enabled.
+	PeerBanThreshold() float64
+	PeerScoreThresholds() *pubsub.PeerScoreThresholds
 	ConfigureGossip(params *pubsub.GossipSubParams) []pubsub.Option
 	PeerBandScorer() *BandScoreThresholds
+	// GossipValidation configures the validation of incoming gossip messages.
+	GossipValidation() GossipValidationConfig
 }
 
 type GossipRuntimeConfig interface {
 	P2PProposerAddress() common.Address
+	// P2PProposerAddresses returns the addresses of the p2p proposer accepted at the given time: the current one,
+	// followed by the previous ones that are still accepted during a key rotation.
+	P2PProposerAddresses(now uint64) []common.Address
 }
 
 //go:generate mockery --name GossipMetricer
 type GossipMetricer interface {
 	RecordGossipEvent(evType int32)
+	// RecordGossipValidationDropped records a message dropped because the validation was saturated.
+	RecordGossipValidationDropped(topic string, reason string)
+	// RecordGossipMessage records a gossip message of the topic by direction and validation result.
+	RecordGossipMessage(topic string, direction string, result string)
 	// Peer Scoring Metric Funcs
 	SetPeerScores(map[string]float64)
+	ObservePeerScores(scores []float64)
 }
 
 func blocksTopicV1(cfg *rollup.Config) string {
@@ -77,7 +126,11 @@ func blocksTopicV1(cfg *rollup.Config) string {
 // BuildSubscriptionFilter builds a simple subscription filter,
 // to help protect against peers spamming useless subscriptions.
 func BuildSubscriptionFilter(cfg *rollup.Config) pubsub.SubscriptionFilter {
-	return pubsub.NewAllowlistSubscriptionFilter(blocksTopicV1(cfg)) // add more topics here in the future, if any.
+	topics := []string{blocksTopicV1(cfg), preconfsTopicV1(cfg)}
+	if cfg.BlocksV2Time != nil {
+		topics = append(topics, blocksTopicV2(cfg))
+	}
+	return pubsub.NewAllowlistSubscriptionFilter(topics...) // add more topics here in the future, if any.
 }
 
 var msgBufPool = sync.Pool{New: func() any {
@@ -131,10 +184,20 @@ func (p *Config) ConfigureGossip(params *pubsub.GossipSubParams) []pubsub.Option
 	params.Dhi = p.MeshDHi
 	params.Dlazy = p.MeshDLazy
 
-	// in the future we may add more advanced options like scoring and PX / direct-mesh / episub
-	return []pubsub.Option{
+	opts := []pubsub.Option{
 		pubsub.WithFloodPublish(p.FloodPublish),
 	}
+	// Static peers are direct peers: messages are always exchanged with them, regardless of the mesh and their score.
+	// Bad addresses are already rejected when the host is set up.
+	if staticPeers, err := p.staticPeerAddrs(); err == nil && len(staticPeers) > 0 {
+		directPeers := make([]peer.AddrInfo, len(staticPeers))
+		for i, addr := range staticPeers {
+			directPeers[i] = *addr
+		}
+		opts = append(opts, pubsub.WithDirectPeers(directPeers))
+	}
+	// in the future we may add more advanced options like PX / episub
+	return opts
 }
 
 func BuildGlobalGossipParams(cfg *rollup.Config) pubsub.GossipSubParams {
@@ -153,26 +216,30 @@ func BuildGlobalGossipParams(cfg *rollup.Config) pubsub.GossipSubParams {
 
 // NewGossipSub configures a new pubsub instance with the specified parameters.
 // PubSub uses a GossipSubRouter as it's router under the hood.
-func NewGossipSub(p2pCtx context.Context, h host.Host, g ConnectionGater, cfg *rollup.Config, gossipConf GossipSetupConfigurables, m GossipMetricer, log log.Logger) (*pubsub.PubSub, error) {
+func NewGossipSub(p2pCtx context.Context, h host.Host, g PeerGater, cfg *rollup.Config, gossipConf GossipSetupConfigurables, m GossipMetricer, log log.Logger) (*pubsub.PubSub, error) {
 	denyList, err := pubsub.NewTimeCachedBlacklist(30 * time.Second)
 	if err != nil {
 		return nil, err
 	}
 	params := BuildGlobalGossipParams(cfg)
+	validation := gossipConf.GossipValidation()
 	gossipOpts := []pubsub.Option{
 		pubsub.WithMaxMessageSize(maxGossipSize),
 		pubsub.WithMessageIdFn(BuildMsgIdFn(cfg)),
 		pubsub.WithNoAuthor(),
 		pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign),
 		pubsub.WithSubscriptionFilter(BuildSubscriptionFilter(cfg)),
-		pubsub.WithValidateQueueSize(maxValidateQueue),
+		pubsub.WithValidateQueueSize(validation.QueueSize),
 		pubsub.WithPeerOutboundQueueSize(maxOutboundQueue),
 		pubsub.WithValidateThrottle(globalValidateThrottle),
 		pubsub.WithSeenMessagesTTL(seenMessagesTTL),
 		pubsub.WithPeerExchange(false),
 		pubsub.WithBlacklist(denyList),
 		pubsub.WithGossipSubParams(params),
-		pubsub.WithEventTracer(&gossipTracer{m: m}),
+		pubsub.WithEventTracer(&gossipTracer{self: h.ID(), m: m}),
+	}
+	if validation.Workers > 0 {
+		gossipOpts = append(gossipOpts, pubsub.WithValidateWorkers(validation.Workers))
 	}
 	gossipOpts = append(gossipOpts, ConfigurePeerScoring(h, g, gossipConf, m, log)...)
 	gossipOpts = append(gossipOpts, gossipConf.ConfigureGossip(&params)...)
@@ -242,6 +309,11 @@ func (sb *seenBlocks) markSeen(h common.Hash) {
 }
 
 func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig) pubsub.ValidatorEx {
+	return buildBlocksValidator(log, cfg, runCfg, 1)
+}
+
+// buildBlocksValidator builds the validator of the blocks topic of the given version.
+func buildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, version int) pubsub.ValidatorEx {
 	// Seen block hashes per block height
 	// uint64 -> *seenBlocks
 	blockHeightLRU, err := lru.New(1000)
@@ -290,6 +362,13 @@ func BuildBlocksValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRunti
 			return pubsub.ValidationReject
 		}
 
+		// [REJECT] if the block is published on a topic version that is not active yet at its timestamp.
+		// Older topic versions are still accepted, they are only left after the transition to the new version.
+		if blocksTopicVersion(cfg, uint64(payload.Timestamp)) < version {
+			log.Warn("payload is published on inactive topic version", "timestamp", uint64(payload.Timestamp), "version", version)
+			return pubsub.ValidationReject
+		}
+
 		// rounding down to seconds is fine here.
 		now := uint64(time.Now().Unix())
 
@@ -343,7 +422,11 @@ func verifyBlockSignature(log log.Logger, cfg *rollup.Config, runCfg GossipRunti
 		log.Warn("failed to compute block signing hash", "err", err, "peer", id)
 		return pubsub.ValidationReject
 	}
+	return verifyProposerSignature(log, runCfg, id, signingHash, signatureBytes)
+}
 
+// verifyProposerSignature checks that the signing hash is signed by the p2p proposer.
+func verifyProposerSignature(log log.Logger, runCfg GossipRuntimeConfig, id peer.ID, signingHash common.Hash, signatureBytes []byte) pubsub.ValidationResult {
 	pub, err := crypto.SigToPub(signingHash[:], signatureBytes)
 	if err != nil {
 		log.Warn("invalid block signature", "err", err, "peer", id)
@@ -351,23 +434,50 @@ func verifyBlockSignature(log log.Logger, cfg *rollup.Config, runCfg GossipRunti
 	}
 	addr := crypto.PubkeyToAddress(*pub)
 
-	// In the future we may load & validate block metadata before checking the signature.
-	// And then check the signer based on the metadata, to support e.g. multiple p2p signers at the same time.
-	// For now we only have one signer at a time and thus check the address directly.
-	// This means we may drop old payloads upon key rotation,
-	// but this can be recovered from like any other missed unsafe payload.
-	if expected := runCfg.P2PProposerAddress(); expected == (common.Address{}) {
+	// The previous signer stays accepted for a while after a key rotation,
+	// so that the proposer can switch keys without every node restarting at the same time.
+	expected := runCfg.P2PProposerAddresses(uint64(time.Now().Unix()))
+	if len(expected) == 0 {
 		log.Warn("no configured p2p proposer address, ignoring gossiped block", "peer", id, "addr", addr)
 		return pubsub.ValidationIgnore
-	} else if addr != expected {
-		log.Warn("unexpected block author", "err", err, "peer", id, "addr", addr, "expected", expected)
-		return pubsub.ValidationReject
 	}
-	return pubsub.ValidationAccept
+	for _, a := range expected {
+		if addr == a {
+			return pubsub.ValidationAccept
+		}
+	}
+	log.Warn("unexpected block author", "peer", id, "addr", addr, "expected", expected)
+	return pubsub.ValidationReject
+}
+
+// checkSigner returns an error if the signature is not by a p2p proposer accepted by the gossip validators,
+// so that the publisher is not penalized by its peers for publishing messages they reject.
+// Messages are not checked while no p2p proposer address is known, they are ignored rather than rejected then.
+func checkSigner(cfg *rollup.Config, runCfg GossipRuntimeConfig, domain [32]byte, msg []byte, sig *[65]byte) error {
+	expected := runCfg.P2PProposerAddresses(uint64(time.Now().Unix()))
+	if len(expected) == 0 {
+		return nil
+	}
+	signingHash, err := SigningHash(domain, cfg.L2ChainID, msg)
+	if err != nil {
+		return err
+	}
+	pub, err := crypto.SigToPub(signingHash[:], sig[:])
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	addr := crypto.PubkeyToAddress(*pub)
+	for _, a := range expected {
+		if addr == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("signer %s is not an accepted p2p proposer %v", addr, expected)
 }
 
 type GossipIn interface {
 	OnUnsafeL2Payload(ctx context.Context, from peer.ID, msg *eth.ExecutionPayload) error
+	OnPreconfirmation(ctx context.Context, from peer.ID, msg *eth.Preconfirmation) error
 }
 
 type GossipTopicInfo interface {
@@ -377,23 +487,51 @@ type GossipTopicInfo interface {
 type GossipOut interface {
 	GossipTopicInfo
 	PublishL2Payload(ctx context.Context, msg *eth.ExecutionPayload, signer Signer) error
+	PublishPreconfirmation(ctx context.Context, msg *eth.Preconfirmation, signer Signer) error
 	Close() error
 }
 
 type publisher struct {
-	log         log.Logger
-	cfg         *rollup.Config
-	blocksTopic *pubsub.Topic
-	runCfg      GossipRuntimeConfig
+	log           log.Logger
+	cfg           *rollup.Config
+	preconfsTopic *pubsub.Topic
+	runCfg        GossipRuntimeConfig
+
+	// blocksTopics are the subscribed blocks topics by version, updated around the activation of a new version.
+	mu           sync.Mutex
+	blocksTopics map[int]*blocksTopic
+	joinBlocks   func(version int) (*blocksTopic, error)
+	cancel       context.CancelFunc
 }
 
 var _ GossipOut = (*publisher)(nil)
 
 func (p *publisher) BlocksTopicPeers() []peer.ID {
-	return p.blocksTopic.ListPeers()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// peers of both topics during a transition are counted once
+	seen := make(map[peer.ID]struct{})
+	var peers []peer.ID
+	for _, t := range p.blocksTopics {
+		for _, id := range t.topic.ListPeers() {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				peers = append(peers, id)
+			}
+		}
+	}
+	return peers
 }
 
 func (p *publisher) PublishL2Payload(ctx context.Context, payload *eth.ExecutionPayload, signer Signer) error {
+	version := blocksTopicVersion(p.cfg, uint64(payload.Timestamp))
+	p.mu.Lock()
+	topic := p.blocksTopics[version]
+	p.mu.Unlock()
+	if topic == nil {
+		return fmt.Errorf("cannot publish execution payload %s, v%d blocks topic is not joined", payload.ID(), version)
+	}
+
 	res := msgBufPool.Get().(*[]byte)
 	buf := bytes.NewBuffer((*res)[:0])
 	defer func() {
@@ -411,57 +549,82 @@ func (p *publisher) PublishL2Payload(ctx context.Context, payload *eth.Execution
 	if err != nil {
 		return fmt.Errorf("failed to sign execution payload with signer: %w", err)
 	}
+	if err := checkSigner(p.cfg, p.runCfg, SigningDomainBlocksV1, payloadData, sig); err != nil {
+		return fmt.Errorf("cannot publish execution payload %s: %w", payload.ID(), err)
+	}
 	copy(data[:65], sig[:])
 
 	// compress the full message
 	// This also copies the data, freeing up the original buffer to go back into the pool
 	out := snappy.Encode(nil, data)
 
-	return p.blocksTopic.Publish(ctx, out)
+	return topic.topic.Publish(ctx, out)
 }
 
-func (p *publisher) Close() error {
-	return p.blocksTopic.Close()
-}
-
-func JoinGossip(p2pCtx context.Context, self peer.ID, topicScoreParams *pubsub.TopicScoreParams, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, gossipIn GossipIn) (GossipOut, error) {
-	val := guardGossipValidator(log, logValidationResult(self, "validated block", log, BuildBlocksValidator(log, cfg, runCfg)))
-	blocksTopicName := blocksTopicV1(cfg)
-	err := ps.RegisterTopicValidator(blocksTopicName,
-		val,
-		pubsub.WithValidatorTimeout(3*time.Second),
-		pubsub.WithValidatorConcurrency(4))
+func (p *publisher) PublishPreconfirmation(ctx context.Context, preconf *eth.Preconfirmation, signer Signer) error {
+	preconfData, err := preconf.MarshalBinary()
 	if err != nil {
-		return nil, fmt.Errorf("failed to register blocks gossip topic: %w", err)
+		return fmt.Errorf("failed to encode preconfirmation to publish: %w", err)
 	}
-	blocksTopic, err := ps.Join(blocksTopicName)
+	sig, err := signer.Sign(ctx, SigningDomainPreconfsV1, p.cfg.L2ChainID, preconfData)
 	if err != nil {
-		return nil, fmt.Errorf("failed to join blocks gossip topic: %w", err)
+		return fmt.Errorf("failed to sign preconfirmation with signer: %w", err)
 	}
-	blocksTopicEvents, err := blocksTopic.EventHandler()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create blocks gossip topic handler: %w", err)
+	if err := checkSigner(p.cfg, p.runCfg, SigningDomainPreconfsV1, preconfData, sig); err != nil {
+		return fmt.Errorf("cannot publish preconfirmation: %w", err)
 	}
-	go LogTopicEvents(p2pCtx, log.New("topic", "blocks"), blocksTopicEvents)
+	// preconfirmations are small and fixed-size, they are not compressed
+	data := make([]byte, 0, 65+len(preconfData))
+	data = append(data, sig[:]...)
+	data = append(data, preconfData...)
+	return p.preconfsTopic.Publish(ctx, data)
+}
 
-	// A [TimeInMeshQuantum] value of 0 means the topic score is disabled.
-	// If we passed a topicScoreParams with [TimeInMeshQuantum] set to 0,
-	// libp2p errors since the params will be rejected.
-	if topicScoreParams != nil && topicScoreParams.TimeInMeshQuantum != 0 {
-		if err = blocksTopic.SetScoreParams(topicScoreParams); err != nil {
-			return nil, fmt.Errorf("failed to set topic score params: %w", err)
+func (p *publisher) Close() error {
+	p.cancel()
+	var result *multierror.Error
+	p.mu.Lock()
+	for version, topic := range p.blocksTopics {
+		if err := topic.Close(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to close v%d blocks topic: %w", version, err))
 		}
 	}
+	p.mu.Unlock()
+	if err := p.preconfsTopic.Close(); err != nil {
+		result = multierror.Append(result, fmt.Errorf("failed to close preconfirmations topic: %w", err))
+	}
+	return result.ErrorOrNil()
+}
 
-	subscription, err := blocksTopic.Subscribe()
-	if err != nil {
-		return nil, fmt.Errorf("failed to subscribe to blocks gossip topic: %w", err)
+func JoinGossip(p2pCtx context.Context, self peer.ID, topicScoreParams *pubsub.TopicScoreParams, validation GossipValidationConfig, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, gossipIn GossipIn) (GossipOut, error) {
+	ctx, cancel := context.WithCancel(p2pCtx)
+	p := &publisher{
+		log:          log,
+		cfg:          cfg,
+		runCfg:       runCfg,
+		blocksTopics: make(map[int]*blocksTopic),
+		joinBlocks: func(version int) (*blocksTopic, error) {
+			return joinBlocksTopic(ctx, self, topicScoreParams, validation.Concurrency, ps, log, cfg, runCfg, gossipIn, version)
+		},
+		cancel: cancel,
+	}
+	now := uint64(time.Now().Unix())
+	if err := p.updateBlocksTopics(now); err != nil {
+		cancel()
+		return nil, err
+	}
+	if blocksTopicsTransitioning(cfg, now) {
+		go p.transitionBlocksTopics(ctx)
 	}
 
-	subscriber := MakeSubscriber(log, BlocksHandler(gossipIn.OnUnsafeL2Payload))
-	go subscriber(p2pCtx, subscription)
+	preconfsTopic, err := joinPreconfsTopic(p2pCtx, self, validation.Concurrency, ps, log, cfg, runCfg, gossipIn)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	p.preconfsTopic = preconfsTopic
 
-	return &publisher{log: log, cfg: cfg, blocksTopic: blocksTopic, runCfg: runCfg}, nil
+	return p, nil
 }
 
 type (
@@ -518,11 +681,50 @@ func LogTopicEvents(ctx context.Context, log log.Logger, evHandler *pubsub.Topic
 }
 
 type gossipTracer struct {
-	m GossipMetricer
+	self peer.ID
+	m    GossipMetricer
 }
 
 func (g *gossipTracer) Trace(evt *pb.TraceEvent) {
-	if g.m != nil {
-		g.m.RecordGossipEvent(int32(*evt.Type))
+	if g.m == nil {
+		return
+	}
+	g.m.RecordGossipEvent(int32(*evt.Type))
+	// Messages published by this node are validated and delivered locally too,
+	// so they are counted as outgoing by their validation result like the incoming ones.
+	direction := func(from []byte) string {
+		if peer.ID(from) == g.self {
+			return "out"
+		}
+		return "in"
+	}
+	switch evt.GetType() {
+	case pb.TraceEvent_DELIVER_MESSAGE:
+		deliver := evt.GetDeliverMessage()
+		g.m.RecordGossipMessage(deliver.GetTopic(), direction(deliver.GetReceivedFrom()), "accept")
+	case pb.TraceEvent_DUPLICATE_MESSAGE:
+		duplicate := evt.GetDuplicateMessage()
+		g.m.RecordGossipMessage(duplicate.GetTopic(), direction(duplicate.GetReceivedFrom()), "duplicate")
+	case pb.TraceEvent_REJECT_MESSAGE:
+		reject := evt.GetRejectMessage()
+		reason := reject.GetReason()
+		if reason == pubsub.RejectValidationQueueFull || reason == pubsub.RejectValidationThrottled {
+			g.m.RecordGossipValidationDropped(reject.GetTopic(), reason)
+		}
+		g.m.RecordGossipMessage(reject.GetTopic(), direction(reject.GetReceivedFrom()), gossipRejectResult(reason))
+	}
+}
+
+// gossipRejectResult summarizes the reason of a rejected gossip message, to keep the number of metric labels low.
+func gossipRejectResult(reason string) string {
+	switch reason {
+	case pubsub.RejectValidationFailed:
+		return "reject"
+	case pubsub.RejectValidationIgnored:
+		return "ignore"
+	case pubsub.RejectValidationQueueFull, pubsub.RejectValidationThrottled:
+		return "dropped"
+	default:
+		return "invalid"
 	}
 }
@@ -4,14 +4,19 @@ import (
 	"context"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/kroma-network/kroma/components/node/eth"
+	p2pMocks "github.com/kroma-network/kroma/components/node/p2p/mocks"
 	"github.com/kroma-network/kroma/components/node/rollup"
 	"github.com/kroma-network/kroma/components/node/testlog"
 	"github.com/kroma-network/kroma/components/node/testutils"
@@ -67,6 +72,22 @@ func TestVerifyBlockSignature(t *testing.T) {
 		require.Equal(t, pubsub.ValidationReject, result)
 	})
 
+	t.Run("PreviousSigner", func(t *testing.T) {
+		runCfg := &testutils.MockRuntimeConfig{
+			P2PPropAddress:     common.HexToAddress("0x1234"),
+			PrevP2PPropAddress: crypto.PubkeyToAddress(secrets.ProposerP2P.PublicKey),
+		}
+		signer := &PreparedSigner{Signer: NewLocalSigner(secrets.ProposerP2P)}
+		sig, err := signer.Sign(context.Background(), SigningDomainBlocksV1, cfg.L2ChainID, msg)
+		require.NoError(t, err)
+		result := verifyBlockSignature(logger, cfg, runCfg, peerId, sig[:65], msg)
+		require.Equal(t, pubsub.ValidationAccept, result)
+		require.NoError(t, checkSigner(cfg, runCfg, SigningDomainBlocksV1, msg, sig))
+
+		runCfg.PrevP2PPropAddress = common.Address{}
+		require.Error(t, checkSigner(cfg, runCfg, SigningDomainBlocksV1, msg, sig), "publisher must not publish rejected messages")
+	})
+
 	t.Run("InvalidSignature", func(t *testing.T) {
 		runCfg := &testutils.MockRuntimeConfig{P2PPropAddress: crypto.PubkeyToAddress(secrets.ProposerP2P.PublicKey)}
 		sig := make([]byte, 65)
@@ -83,3 +104,106 @@ func TestVerifyBlockSignature(t *testing.T) {
 		require.Equal(t, pubsub.ValidationIgnore, result)
 	})
 }
+
+func TestPreconfsValidator(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	cfg := &rollup.Config{
+		L2ChainID: big.NewInt(100),
+	}
+	peerId := peer.ID("foo")
+	secrets, err := e2eutils.DefaultMnemonicConfig.Secrets()
+	require.NoError(t, err)
+	runCfg := &testutils.MockRuntimeConfig{P2PPropAddress: crypto.PubkeyToAddress(secrets.ProposerP2P.PublicKey)}
+	signer := &PreparedSigner{Signer: NewLocalSigner(secrets.ProposerP2P)}
+
+	makeMsg := func(preconf eth.Preconfirmation, domain [32]byte) *pubsub.Message {
+		data, err := preconf.MarshalBinary()
+		require.NoError(t, err)
+		sig, err := signer.Sign(context.Background(), domain, cfg.L2ChainID, data)
+		require.NoError(t, err)
+		return &pubsub.Message{Message: &pb.Message{Data: append(sig[:], data...)}}
+	}
+	preconf := eth.Preconfirmation{
+		BlockHash:   common.Hash{0x01},
+		BlockNumber: 10,
+		Timestamp:   uint64(time.Now().Unix()),
+	}
+
+	val := BuildPreconfsValidator(logger, cfg, runCfg)
+
+	msg := makeMsg(preconf, SigningDomainPreconfsV1)
+	require.Equal(t, pubsub.ValidationAccept, val(context.Background(), peerId, msg))
+	require.Equal(t, &preconf, msg.ValidatorData)
+
+	// the same preconfirmation is ignored
+	require.Equal(t, pubsub.ValidationIgnore, val(context.Background(), peerId, makeMsg(preconf, SigningDomainPreconfsV1)))
+
+	// a block signature cannot be replayed as preconfirmation
+	other := preconf
+	other.BlockHash = common.Hash{0x02}
+	require.Equal(t, pubsub.ValidationReject, val(context.Background(), peerId, makeMsg(other, SigningDomainBlocksV1)))
+
+	// too old
+	old := preconf
+	old.Timestamp -= 100
+	require.Equal(t, pubsub.ValidationReject, val(context.Background(), peerId, makeMsg(old, SigningDomainPreconfsV1)))
+
+	// invalid size
+	invalid := makeMsg(other, SigningDomainPreconfsV1)
+	invalid.Data = invalid.Data[:len(invalid.Data)-1]
+	require.Equal(t, pubsub.ValidationReject, val(context.Background(), peerId, invalid))
+}
+
+func TestGossipValidationConfig(t *testing.T) {
+	require.Equal(t, GossipValidationConfig{QueueSize: maxValidateQueue, Concurrency: topicValidatorConcurrency}, GossipValidationConfig{}.withDefaults())
+	require.Equal(t, GossipValidationConfig{Workers: 2, QueueSize: 10, Concurrency: 8}, GossipValidationConfig{Workers: 2, QueueSize: 10, Concurrency: 8}.withDefaults())
+	require.NoError(t, GossipValidationConfig{}.Check())
+	require.Error(t, GossipValidationConfig{Workers: -1}.Check())
+	require.Error(t, GossipValidationConfig{Concurrency: globalValidateThrottle + 1}.Check())
+}
+
+func TestGossipTracer_ValidationDropped(t *testing.T) {
+	m := p2pMocks.NewGossipMetricer(t)
+	tracer := &gossipTracer{m: m}
+	topic := "/kroma/100/0/blocks"
+	reject := func(reason string) *pb.TraceEvent {
+		evType := pb.TraceEvent_REJECT_MESSAGE
+		return &pb.TraceEvent{Type: &evType, RejectMessage: &pb.TraceEvent_RejectMessage{Topic: &topic, Reason: &reason}}
+	}
+
+	m.On("RecordGossipEvent", int32(pb.TraceEvent_REJECT_MESSAGE)).Times(3)
+	m.On("RecordGossipValidationDropped", topic, pubsub.RejectValidationQueueFull).Once()
+	m.On("RecordGossipValidationDropped", topic, pubsub.RejectValidationThrottled).Once()
+	m.On("RecordGossipMessage", topic, "in", "dropped").Twice()
+	m.On("RecordGossipMessage", topic, "in", "reject").Once()
+	tracer.Trace(reject(pubsub.RejectValidationQueueFull))
+	tracer.Trace(reject(pubsub.RejectValidationThrottled))
+	// messages rejected by the validator are not dropped
+	tracer.Trace(reject(pubsub.RejectValidationFailed))
+}
+
+func TestGossipTracer_Messages(t *testing.T) {
+	m := p2pMocks.NewGossipMetricer(t)
+	self := peer.ID("self")
+	tracer := &gossipTracer{self: self, m: m}
+	topic := "/kroma/100/0/blocks"
+	deliver := func(from peer.ID) *pb.TraceEvent {
+		evType := pb.TraceEvent_DELIVER_MESSAGE
+		return &pb.TraceEvent{Type: &evType, DeliverMessage: &pb.TraceEvent_DeliverMessage{Topic: &topic, ReceivedFrom: []byte(from)}}
+	}
+	evType := pb.TraceEvent_DUPLICATE_MESSAGE
+	duplicate := &pb.TraceEvent{Type: &evType, DuplicateMessage: &pb.TraceEvent_DuplicateMessage{Topic: &topic, ReceivedFrom: []byte("alice")}}
+	reason := pubsub.RejectValidationIgnored
+	evType = pb.TraceEvent_REJECT_MESSAGE
+	ignored := &pb.TraceEvent{Type: &evType, RejectMessage: &pb.TraceEvent_RejectMessage{Topic: &topic, Reason: &reason, ReceivedFrom: []byte("alice")}}
+
+	m.On("RecordGossipEvent", mock.Anything).Times(4)
+	m.On("RecordGossipMessage", topic, "in", "accept").Once()
+	m.On("RecordGossipMessage", topic, "out", "accept").Once()
+	m.On("RecordGossipMessage", topic, "in", "duplicate").Once()
+	m.On("RecordGossipMessage", topic, "in", "ignore").Once()
+	tracer.Trace(deliver("alice"))
+	tracer.Trace(deliver(self))
+	tracer.Trace(duplicate)
+	tracer.Trace(ignored)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -27,10 +26,22 @@ import (
 	madns "github.com/multiformats/go-multiaddr-dns"
 )
 
+const (
+	// staticPeerMinBackoff is the delay before reconnecting to a static peer that was disconnected
+	staticPeerMinBackoff = time.Second
+	// staticPeerMaxBackoff caps the delay between the attempts to reconnect to a static peer
+	staticPeerMaxBackoff = time.Minute * 2
+	// staticPeerPollInterval is the interval of checking the connection to a static peer that is connected
+	staticPeerPollInterval = time.Minute
+	staticPeerDialTimeout  = time.Second * 30
+)
+
 type ExtraHostFeatures interface {
 	host.Host
 	ConnectionGater() ConnectionGater
 	ConnectionManager() connmgr.ConnManager
+	// StaticPeers returns the peers the host always maintains connections to
+	StaticPeers() []peer.ID
 }
 
 type extraHost struct {
@@ -57,20 +68,39 @@ func (e *extraHost) Close() error {
 	return e.Host.Close()
 }
 
+func (e *extraHost) StaticPeers() []peer.ID {
+	ids := make([]peer.ID, len(e.staticPeers))
+	for i, addr := range e.staticPeers {
+		ids[i] = addr.ID
+	}
+	return ids
+}
+
 func (e *extraHost) initStaticPeers() {
+	if len(e.staticPeers) == 0 {
+		return
+	}
+	disconnected := make(map[peer.ID]chan struct{}, len(e.staticPeers))
 	for _, addr := range e.staticPeers {
 		e.Peerstore().AddAddrs(addr.ID, addr.Addrs, time.Hour*24*7)
 		// We protect the peer, so the connection manager doesn't decide to prune it.
 		// We tag it with "static" so other protects/unprotects with different tags don't affect this protection.
 		e.connMgr.Protect(addr.ID, "static")
-		// Try to dial the node in the background
-		go func(addr *peer.AddrInfo) {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
-			defer cancel()
-			if err := e.dialStaticPeer(ctx, addr); err != nil {
-				e.log.Warn("error dialing static peer", "peer", addr.ID, "err", err)
+		disconnected[addr.ID] = make(chan struct{}, 1)
+	}
+	// Wake up the routine of a static peer as soon as it is disconnected, instead of waiting for the next poll.
+	e.Network().Notify(&network.NotifyBundle{
+		DisconnectedF: func(nw network.Network, conn network.Conn) {
+			if ch, ok := disconnected[conn.RemotePeer()]; ok {
+				select {
+				case ch <- struct{}{}:
+				default:
+				}
 			}
-		}(addr)
+		},
+	})
+	for _, addr := range e.staticPeers {
+		go e.maintainStaticPeer(addr, disconnected[addr.ID])
 	}
 }
 
@@ -82,43 +112,55 @@ func (e *extraHost) dialStaticPeer(ctx context.Context, addr *peer.AddrInfo) err
 	return nil
 }
 
-func (e *extraHost) monitorStaticPeers() {
-	tick := time.NewTicker(time.Minute)
-	defer tick.Stop()
-
+// maintainStaticPeer keeps the connection to the static peer. The peer is dialed right away, and redialed
+// after a backoff once it is disconnected, doubling the backoff on every failed attempt.
+func (e *extraHost) maintainStaticPeer(addr *peer.AddrInfo, disconnected <-chan struct{}) {
+	backoff := staticPeerMinBackoff
+	var delay time.Duration
 	for {
-		select {
-		case <-tick.C:
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
-			var wg sync.WaitGroup
-
-			e.log.Debug("polling static peers", "peers", len(e.staticPeers))
-			for _, addr := range e.staticPeers {
-				connectedness := e.Network().Connectedness(addr.ID)
-				e.log.Trace("static peer connectedness", "peer", addr.ID, "connectedness", connectedness)
-
-				if connectedness == network.Connected {
-					continue
-				}
-
-				wg.Add(1)
-				go func(addr *peer.AddrInfo) {
-					e.log.Warn("static peer disconnected, reconnecting", "peer", addr.ID)
-					if err := e.dialStaticPeer(ctx, addr); err != nil {
-						e.log.Warn("error reconnecting to static peer", "peer", addr.ID, "err", err)
-					}
-					wg.Done()
-				}(addr)
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-e.quitC:
+				return
 			}
+		}
 
-			wg.Wait()
+		if connectedness := e.Network().Connectedness(addr.ID); connectedness != network.Connected {
+			ctx, cancel := context.WithTimeout(context.Background(), staticPeerDialTimeout)
+			err := e.dialStaticPeer(ctx, addr)
 			cancel()
+			if err != nil {
+				e.log.Warn("error dialing static peer", "peer", addr.ID, "retry", backoff, "err", err)
+				delay = backoff
+				backoff = nextStaticPeerBackoff(backoff)
+				continue
+			}
+		}
+		backoff = staticPeerMinBackoff
+
+		select {
+		case <-disconnected:
+			e.log.Warn("static peer disconnected, reconnecting", "peer", addr.ID, "delay", backoff)
+			delay = backoff
+		case <-time.After(staticPeerPollInterval):
+			// poll in case a disconnect was missed
+			delay = 0
 		case <-e.quitC:
 			return
 		}
 	}
 }
 
+// nextStaticPeerBackoff doubles the backoff of reconnecting to a static peer, up to the max backoff.
+func nextStaticPeerBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > staticPeerMaxBackoff {
+		return staticPeerMaxBackoff
+	}
+	return backoff
+}
+
 var _ ExtraHostFeatures = (*extraHost)(nil)
 
 func (conf *Config) Host(log log.Logger, reporter metrics.Reporter) (host.Host, error) {
@@ -201,13 +243,9 @@ func (conf *Config) Host(log log.Logger, reporter metrics.Reporter) (host.Host,
 		return nil, err
 	}
 
-	staticPeers := make([]*peer.AddrInfo, len(conf.StaticPeers))
-	for i, peerAddr := range conf.StaticPeers {
-		addr, err := peer.AddrInfoFromP2pAddr(peerAddr)
-		if err != nil {
-			return nil, fmt.Errorf("bad peer address: %w", err)
-		}
-		staticPeers[i] = addr
+	staticPeers, err := conf.staticPeerAddrs()
+	if err != nil {
+		return nil, err
 	}
 
 	out := &extraHost{
@@ -218,9 +256,6 @@ func (conf *Config) Host(log log.Logger, reporter metrics.Reporter) (host.Host,
 		quitC:       make(chan struct{}),
 	}
 	out.initStaticPeers()
-	if len(conf.StaticPeers) > 0 {
-		go out.monitorStaticPeers()
-	}
 
 	// Only add the connection gater if it offers the full interface we're looking for.
 	if g, ok := connGtr.(ConnectionGater); ok {
@@ -75,8 +75,44 @@ func TestP2PSimple(t *testing.T) {
 	require.Equal(t, hostB.Network().Connectedness(hostA.ID()), network.Connected)
 }
 
+func TestStaticPeerReconnect(t *testing.T) {
+	confA := TestingConfig(t)
+	confB := TestingConfig(t)
+	hostA, err := confA.Host(testlog.Logger(t, log.LvlError).New("host", "A"), nil)
+	require.NoError(t, err, "failed to launch host A")
+	defer hostA.Close()
+
+	confB.StaticPeers, err = peer.AddrInfoToP2pAddrs(&peer.AddrInfo{ID: hostA.ID(), Addrs: hostA.Addrs()})
+	require.NoError(t, err)
+	hostB, err := confB.Host(testlog.Logger(t, log.LvlError).New("host", "B"), nil)
+	require.NoError(t, err, "failed to launch host B")
+	defer hostB.Close()
+	require.Equal(t, []peer.ID{hostA.ID()}, hostB.(ExtraHostFeatures).StaticPeers())
+	require.True(t, hostB.ConnManager().IsProtected(hostA.ID(), "static"))
+
+	connected := func() bool { return hostB.Network().Connectedness(hostA.ID()) == network.Connected }
+	require.Eventually(t, connected, time.Second*5, time.Millisecond*10, "B dials its static peer")
+
+	// A drops the connection, B reconnects after the backoff
+	require.NoError(t, hostA.Network().ClosePeer(hostB.ID()))
+	require.Eventually(t, connected, staticPeerMinBackoff*5, time.Millisecond*10, "B reconnects to its static peer")
+}
+
+func TestNextStaticPeerBackoff(t *testing.T) {
+	backoff := staticPeerMinBackoff
+	for i := 0; i < 3; i++ {
+		backoff = nextStaticPeerBackoff(backoff)
+	}
+	require.Equal(t, staticPeerMinBackoff*8, backoff)
+	for i := 0; i < 10; i++ {
+		backoff = nextStaticPeerBackoff(backoff)
+	}
+	require.Equal(t, staticPeerMaxBackoff, backoff)
+}
+
 type mockGossipIn struct {
 	OnUnsafeL2PayloadFn func(ctx context.Context, from peer.ID, msg *eth.ExecutionPayload) error
+	OnPreconfirmationFn func(ctx context.Context, from peer.ID, msg *eth.Preconfirmation) error
 }
 
 func (m *mockGossipIn) OnUnsafeL2Payload(ctx context.Context, from peer.ID, msg *eth.ExecutionPayload) error {
@@ -86,6 +122,13 @@ func (m *mockGossipIn) OnUnsafeL2Payload(ctx context.Context, from peer.ID, msg
 	return nil
 }
 
+func (m *mockGossipIn) OnPreconfirmation(ctx context.Context, from peer.ID, msg *eth.Preconfirmation) error {
+	if m.OnPreconfirmationFn != nil {
+		return m.OnPreconfirmationFn(ctx, from, msg)
+	}
+	return nil
+}
+
 // Full setup, using negotiated transport security and muxes
 func TestP2PFull(t *testing.T) {
 	pA, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
@@ -133,7 +176,11 @@ func TestP2PFull(t *testing.T) {
 	hostA := nodeA.Host()
 	hostA.Network().Notify(&network.NotifyBundle{
 		ConnectedF: func(n network.Network, conn network.Conn) {
-			conns <- conn
+			// B may reconnect to its static peer A, do not block the notifications
+			select {
+			case conns <- conn:
+			default:
+			}
 		}})
 
 	backend := NewP2PAPIBackend(nodeA, logA, nil)
@@ -216,6 +263,20 @@ func TestP2PFull(t *testing.T) {
 
 	require.NoError(t, p2pClientA.ProtectPeer(ctx, hostB.ID()))
 	require.NoError(t, p2pClientA.UnprotectPeer(ctx, hostB.ID()))
+	scores, err := p2pClientA.PeerScores(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, scores)
+
+	// ban and disconnect
+	require.NoError(t, p2pClientA.BanPeer(ctx, hostB.ID(), time.Hour))
+	blockedPeers, err = p2pClientA.ListBlockedPeers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []peer.ID{hostB.ID()}, blockedPeers)
+	require.NotEqual(t, network.Connected, hostA.Network().Connectedness(hostB.ID()))
+	require.NoError(t, p2pClientA.UnbanPeer(ctx, hostB.ID()))
+	blockedPeers, err = p2pClientA.ListBlockedPeers(ctx)
+	require.NoError(t, err)
+	require.Empty(t, blockedPeers)
 }
 
 func TestDiscovery(t *testing.T) {
@@ -9,11 +9,26 @@ type GossipMetricer struct {
 	mock.Mock
 }
 
+// ObservePeerScores provides a mock function with given fields: scores
+func (_m *GossipMetricer) ObservePeerScores(scores []float64) {
+	_m.Called(scores)
+}
+
 // RecordGossipEvent provides a mock function with given fields: evType
 func (_m *GossipMetricer) RecordGossipEvent(evType int32) {
 	_m.Called(evType)
 }
 
+// RecordGossipMessage provides a mock function with given fields: topic, direction, result
+func (_m *GossipMetricer) RecordGossipMessage(topic string, direction string, result string) {
+	_m.Called(topic, direction, result)
+}
+
+// RecordGossipValidationDropped provides a mock function with given fields: topic, reason
+func (_m *GossipMetricer) RecordGossipValidationDropped(topic string, reason string) {
+	_m.Called(topic, reason)
+}
+
 // SetPeerScores provides a mock function with given fields: _a0
 func (_m *GossipMetricer) SetPeerScores(_a0 map[string]float64) {
 	_m.Called(_a0)
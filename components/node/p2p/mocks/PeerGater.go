@@ -6,6 +6,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	peer "github.com/libp2p/go-libp2p/core/peer"
+
+	time "time"
 )
 
 // PeerGater is an autogenerated mock type for the PeerGater type
@@ -13,6 +15,20 @@ type PeerGater struct {
 	mock.Mock
 }
 
+// Ban provides a mock function with given fields: _a0, _a1
+func (_m *PeerGater) Ban(_a0 peer.ID, _a1 time.Duration) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(peer.ID, time.Duration) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // IsBlocked provides a mock function with given fields: _a0
 func (_m *PeerGater) IsBlocked(_a0 peer.ID) bool {
 	ret := _m.Called(_a0)
@@ -27,6 +43,41 @@ func (_m *PeerGater) IsBlocked(_a0 peer.ID) bool {
 	return r0
 }
 
+// Scores provides a mock function with given fields:
+func (_m *PeerGater) Scores() map[peer.ID]float64 {
+	ret := _m.Called()
+
+	var r0 map[peer.ID]float64
+	if rf, ok := ret.Get(0).(func() map[peer.ID]float64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[peer.ID]float64)
+		}
+	}
+
+	return r0
+}
+
+// SetProtected provides a mock function with given fields: _a0, _a1
+func (_m *PeerGater) SetProtected(_a0 peer.ID, _a1 bool) {
+	_m.Called(_a0, _a1)
+}
+
+// Unban provides a mock function with given fields: _a0
+func (_m *PeerGater) Unban(_a0 peer.ID) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(peer.ID) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: _a0, _a1
 func (_m *PeerGater) Update(_a0 peer.ID, _a1 float64) {
 	_m.Called(_a0, _a1)
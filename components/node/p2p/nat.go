@@ -0,0 +1,93 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/p2p/nat"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+const (
+	// natRefreshInterval is the interval of refreshing the external address advertised in the discovery record.
+	natRefreshInterval = time.Minute
+	// natDiscoveryMappingName is the description of the discovery port mapping on the NAT device.
+	natDiscoveryMappingName = "kroma-node discovery"
+)
+
+// natDevice returns the NAT device to map ports on and to learn the external IP from, nil if NAT traversal is disabled.
+func (conf *Config) natDevice() nat.Interface {
+	if !conf.NAT {
+		return nil
+	}
+	if conf.NATMethod != nil {
+		return conf.NATMethod
+	}
+	return nat.Any()
+}
+
+// NAT maps the discovery UDP port on the NAT device, and keeps the discovery record up to date with the external IP
+// of the NAT device, until the context is done. The TCP port of libp2p is mapped by the NAT manager of the host.
+// Explicitly advertised IP and ports take priority over the ones learned from the NAT device.
+func (conf *Config) NAT(ctx context.Context, log log.Logger, h host.Host, localNode *enode.LocalNode) {
+	m := conf.natDevice()
+	if m == nil || localNode == nil {
+		return
+	}
+	// a dynamically picked port cannot be known here, and an explicitly advertised port is forwarded by the operator
+	if conf.AdvertiseUDPPort == 0 && conf.ListenUDPPort != 0 {
+		go nat.Map(m, ctx.Done(), "udp", int(conf.ListenUDPPort), int(conf.ListenUDPPort), natDiscoveryMappingName)
+	}
+
+	ticker := time.NewTicker(natRefreshInterval)
+	defer ticker.Stop()
+	for {
+		conf.advertiseNATAddr(log, m, h, localNode)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// advertiseNATAddr sets the external IP of the NAT device, and the TCP port mapped to it for the host,
+// in the discovery record.
+func (conf *Config) advertiseNATAddr(log log.Logger, m nat.Interface, h host.Host, localNode *enode.LocalNode) {
+	ip, err := m.ExternalIP()
+	if err != nil {
+		log.Debug("failed to get external IP from NAT device", "nat", m, "err", err)
+		return
+	}
+	if conf.AdvertiseIP == nil {
+		localNode.SetStaticIP(ip)
+	}
+	if conf.AdvertiseTCPPort != 0 {
+		return
+	}
+	if port, ok := externalTCPPort(h.Addrs(), ip); ok {
+		localNode.Set(enr.TCP(port))
+	}
+	log.Debug("advertising NAT address", "nat", m, "ip", ip, "tcp", localNode.Node().TCP(), "udp", localNode.Node().UDP())
+}
+
+// externalTCPPort returns the TCP port of the host address with the given external IP, if any.
+// The NAT manager of the host adds the addresses it mapped on the NAT device to the host addresses.
+func externalTCPPort(addrs []multiaddr.Multiaddr, ip net.IP) (uint16, bool) {
+	for _, addr := range addrs {
+		netAddr, err := manet.ToNetAddr(addr)
+		if err != nil {
+			continue
+		}
+		if tcpAddr, ok := netAddr.(*net.TCPAddr); ok && tcpAddr.IP.Equal(ip) {
+			return uint16(tcpAddr.Port), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,68 @@
+package p2p
+
+import (
+	"net"
+	"testing"
+
+	gcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/nat"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+func TestExternalTCPPort(t *testing.T) {
+	addrs := []multiaddr.Multiaddr{
+		multiaddr.StringCast("/ip4/192.168.0.2/tcp/9222"),
+		multiaddr.StringCast("/ip4/1.2.3.4/udp/9222"),
+		multiaddr.StringCast("/ip4/1.2.3.4/tcp/19222"),
+	}
+	port, ok := externalTCPPort(addrs, net.ParseIP("1.2.3.4"))
+	require.True(t, ok)
+	require.Equal(t, uint16(19222), port)
+
+	_, ok = externalTCPPort(addrs, net.ParseIP("5.6.7.8"))
+	require.False(t, ok, "no address was mapped for the IP")
+}
+
+func TestAdvertiseNATAddr(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlError)
+	extIP := net.ParseIP("1.2.3.4")
+
+	mnet, err := mocknet.FullMeshLinked(1)
+	require.NoError(t, err)
+	defer mnet.Close()
+	h := mnet.Hosts()[0]
+
+	newLocalNode := func() *enode.LocalNode {
+		db, err := enode.OpenDB("")
+		require.NoError(t, err)
+		t.Cleanup(db.Close)
+		priv, err := gcrypto.GenerateKey()
+		require.NoError(t, err)
+		return enode.NewLocalNode(db, priv)
+	}
+
+	conf := TestingConfig(t)
+	conf.NAT = true
+	conf.NATMethod = nat.ExtIP(extIP)
+	require.Equal(t, conf.NATMethod, conf.natDevice())
+
+	localNode := newLocalNode()
+	conf.advertiseNATAddr(logger, conf.natDevice(), h, localNode)
+	require.True(t, extIP.Equal(localNode.Node().IP()))
+
+	// an explicitly advertised IP is not overridden
+	conf.AdvertiseIP = net.ParseIP("5.6.7.8")
+	localNode = newLocalNode()
+	localNode.SetStaticIP(conf.AdvertiseIP)
+	conf.advertiseNATAddr(logger, conf.natDevice(), h, localNode)
+	require.True(t, conf.AdvertiseIP.Equal(localNode.Node().IP()))
+
+	conf.NAT = false
+	require.Nil(t, conf.natDevice())
+}
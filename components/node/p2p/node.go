@@ -28,12 +28,14 @@ type NodeP2P struct {
 	gater   ConnectionGater     // p2p gater, to ban/unban peers with, may be nil even with p2p enabled
 	connMgr connmgr.ConnManager // p2p conn manager, to keep a reliable number of peers, may be nil even with p2p enabled
 	// the below components are all optional, and may be nil. They require the host to not be nil.
-	dv5Local *enode.LocalNode // p2p discovery identity
-	dv5Udp   *discover.UDPv5  // p2p discovery service
-	gs       *pubsub.PubSub   // p2p gossip router
-	gsOut    GossipOut        // p2p gossip application interface for publishing
-	syncCl   *SyncClient
-	syncSrv  *ReqRespServer
+	dv5Local  *enode.LocalNode // p2p discovery identity
+	dv5Udp    *discover.UDPv5  // p2p discovery service
+	dnsDisc   enode.Iterator   // p2p DNS discovery of the nodes of ENR trees, used alongside discv5
+	peerGater PeerGater        // p2p peer gater, to ban peers by score and keep track of their scores
+	gs        *pubsub.PubSub   // p2p gossip router
+	gsOut     GossipOut        // p2p gossip application interface for publishing
+	syncCl    *SyncClient
+	syncSrv   *ReqRespServer
 }
 
 // NewNodeP2P creates a new p2p node, and returns a reference to it. If the p2p is disabled, it returns nil.
@@ -92,20 +94,29 @@ func (n *NodeP2P) init(resourcesCtx context.Context, rollupCfg *rollup.Config, l
 				n.syncCl.AddPeer(peerID)
 			}
 			if l2Chain != nil { // Only enable serving side of req-resp sync if we have a data-source, to make minimal P2P testing easy
-				n.syncSrv = NewReqRespServer(rollupCfg, l2Chain, metrics)
+				n.syncSrv = NewReqRespServer(rollupCfg, l2Chain, setup.ReqRespServerLimits(), metrics)
 				// register the sync protocol with libp2p host
 				payloadByNumber := MakeStreamHandler(resourcesCtx, log.New("serve", "payloads_by_number"), n.syncSrv.HandleSyncRequest)
 				n.host.SetStreamHandler(PayloadByNumberProtocolID(rollupCfg.L2ChainID), payloadByNumber)
+				payloadsByRange := MakeStreamHandler(resourcesCtx, log.New("serve", "payloads_by_range"), n.syncSrv.HandleRangeSyncRequest)
+				n.host.SetStreamHandler(PayloadsByRangeProtocolID(rollupCfg.L2ChainID), payloadsByRange)
 			}
 		}
 		// notify of any new connections/streams/etc.
 		n.host.Network().Notify(NewNetworkNotifier(log, metrics))
 		// note: the IDDelta functionality was removed from libP2P, and no longer needs to be explicitly disabled.
-		n.gs, err = NewGossipSub(resourcesCtx, n.host, n.gater, rollupCfg, setup, metrics, log)
+		n.peerGater = NewPeerGater(n.gater, log, setup.BanPeers(), setup.PeerBanThreshold())
+		// Static peers are trusted, they are never blocked for their score.
+		if extra, ok := n.host.(ExtraHostFeatures); ok {
+			for _, id := range extra.StaticPeers() {
+				n.peerGater.SetProtected(id, true)
+			}
+		}
+		n.gs, err = NewGossipSub(resourcesCtx, n.host, n.peerGater, rollupCfg, setup, metrics, log)
 		if err != nil {
 			return fmt.Errorf("failed to start gossipsub router: %w", err)
 		}
-		n.gsOut, err = JoinGossip(resourcesCtx, n.host.ID(), setup.TopicScoringParams(), n.gs, log, rollupCfg, runCfg, gossipIn)
+		n.gsOut, err = JoinGossip(resourcesCtx, n.host.ID(), setup.TopicScoringParams(), setup.GossipValidation(), n.gs, log, rollupCfg, runCfg, gossipIn)
 		if err != nil {
 			return fmt.Errorf("failed to join blocks gossip topic: %w", err)
 		}
@@ -121,9 +132,19 @@ func (n *NodeP2P) init(resourcesCtx context.Context, rollupCfg *rollup.Config, l
 		if err != nil {
 			return fmt.Errorf("failed to start discv5: %w", err)
 		}
+		n.dnsDisc, err = setup.DNSDiscovery(log.New("p2p", "dnsdisc"), rollupCfg)
+		if err != nil {
+			return fmt.Errorf("failed to start DNS discovery: %w", err)
+		}
+		if n.dv5Local != nil {
+			go setup.NAT(resourcesCtx, log.New("p2p", "nat"), n.host, n.dv5Local)
+		}
 
 		if metrics != nil {
 			go metrics.RecordBandwidth(resourcesCtx, bwc)
+			if n.dv5Udp != nil {
+				go metrics.RecordDiscoveryTable(resourcesCtx, func() int { return len(n.dv5Udp.AllNodes()) })
+			}
 		}
 	}
 	return nil
@@ -164,6 +185,10 @@ func (n *NodeP2P) ConnectionGater() ConnectionGater {
 	return n.gater
 }
 
+func (n *NodeP2P) PeerGater() PeerGater {
+	return n.peerGater
+}
+
 func (n *NodeP2P) ConnectionManager() connmgr.ConnManager {
 	return n.connMgr
 }
@@ -173,6 +198,9 @@ func (n *NodeP2P) Close() error {
 	if n.dv5Udp != nil {
 		n.dv5Udp.Close()
 	}
+	if n.dnsDisc != nil {
+		n.dnsDisc.Close()
+	}
 	if n.gsOut != nil {
 		if err := n.gsOut.Close(); err != nil {
 			result = multierror.Append(result, fmt.Errorf("failed to close gossip cleanly: %w", err))
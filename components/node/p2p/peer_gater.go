@@ -1,6 +1,9 @@
 package p2p
 
 import (
+	"sync"
+	"time"
+
 	log "github.com/ethereum/go-ethereum/log"
 	peer "github.com/libp2p/go-libp2p/core/peer"
 )
@@ -8,15 +11,22 @@ import (
 // ConnectionFactor is the factor by which we multiply the connection score.
 const ConnectionFactor = -10
 
-// PeerScoreThreshold is the threshold at which we block a peer.
+// PeerScoreThreshold is the default threshold at which we block a peer.
 const PeerScoreThreshold = -100
 
 // gater is an internal implementation of the [PeerGater] interface.
 type gater struct {
-	connGater  ConnectionGater
+	connGater    ConnectionGater
+	log          log.Logger
+	banEnabled   bool
+	banThreshold float64
+
+	mu         sync.Mutex
 	blockedMap map[peer.ID]bool
-	log        log.Logger
-	banEnabled bool
+	scores     map[peer.ID]float64
+	protected  map[peer.ID]bool
+	// bans are the peers banned through the API, with the timer lifting the ban, if any.
+	bans map[peer.ID]*time.Timer
 }
 
 // PeerGater manages the connection gating of peers.
@@ -27,20 +37,34 @@ type PeerGater interface {
 	Update(peer.ID, float64)
 	// IsBlocked returns true if the given [peer.ID] is blocked.
 	IsBlocked(peer.ID) bool
+	// Scores returns the latest peer scores reported by the gossip router.
+	Scores() map[peer.ID]float64
+	// SetProtected exempts the given [peer.ID] from being blocked for its score, or lifts the exemption.
+	SetProtected(peer.ID, bool)
+	// Ban blocks the given [peer.ID] regardless of its score, for the given duration or until unbanned if 0.
+	Ban(peer.ID, time.Duration) error
+	// Unban lifts the ban of the given [peer.ID].
+	Unban(peer.ID) error
 }
 
-// NewPeerGater returns a new peer gater.
-func NewPeerGater(connGater ConnectionGater, log log.Logger, banEnabled bool) PeerGater {
+// NewPeerGater returns a new peer gater, blocking peers with a score below the given threshold if banning is enabled.
+func NewPeerGater(connGater ConnectionGater, log log.Logger, banEnabled bool, banThreshold float64) PeerGater {
 	return &gater{
-		connGater:  connGater,
-		blockedMap: make(map[peer.ID]bool),
-		log:        log,
-		banEnabled: banEnabled,
+		connGater:    connGater,
+		log:          log,
+		banEnabled:   banEnabled,
+		banThreshold: banThreshold,
+		blockedMap:   make(map[peer.ID]bool),
+		scores:       make(map[peer.ID]float64),
+		protected:    make(map[peer.ID]bool),
+		bans:         make(map[peer.ID]*time.Timer),
 	}
 }
 
 // IsBlocked returns true if the given [peer.ID] is blocked.
 func (g *gater) IsBlocked(peerID peer.ID) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
 	return g.blockedMap[peerID]
 }
 
@@ -51,10 +75,19 @@ func (g *gater) setBlocked(peerID peer.ID, blocked bool) {
 
 // Update handles a peer score update and blocks/unblocks the peer if necessary.
 func (g *gater) Update(id peer.ID, score float64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.scores[id] = score
+
+	// Peers banned through the API are not gated by their score, and protected peers are never blocked.
+	if _, ok := g.bans[id]; ok || g.protected[id] {
+		return
+	}
+
 	// Check if the peer score is below the threshold
 	// If so, we need to block the peer
-	isAlreadyBlocked := g.IsBlocked(id)
-	if score < PeerScoreThreshold && g.banEnabled && !isAlreadyBlocked {
+	isAlreadyBlocked := g.blockedMap[id]
+	if score < g.banThreshold && g.banEnabled && !isAlreadyBlocked {
 		g.log.Warn("peer blocking enabled, blocking peer", "id", id.String(), "score", score)
 		err := g.connGater.BlockPeer(id)
 		if err != nil {
@@ -64,7 +97,7 @@ func (g *gater) Update(id peer.ID, score float64) {
 		g.setBlocked(id, true)
 	}
 	// Unblock peers whose score has recovered to an acceptable level
-	if (score > PeerScoreThreshold) && isAlreadyBlocked {
+	if (score > g.banThreshold) && isAlreadyBlocked {
 		err := g.connGater.UnblockPeer(id)
 		if err != nil {
 			g.log.Warn("connection gater failed to unblock peer", id.String(), "err", err)
@@ -73,3 +106,72 @@ func (g *gater) Update(id peer.ID, score float64) {
 		g.setBlocked(id, false)
 	}
 }
+
+// Scores returns the latest peer scores reported by the gossip router.
+func (g *gater) Scores() map[peer.ID]float64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	scores := make(map[peer.ID]float64, len(g.scores))
+	for id, score := range g.scores {
+		scores[id] = score
+	}
+	return scores
+}
+
+// SetProtected exempts the given [peer.ID] from being blocked for its score, or lifts the exemption.
+// A protected peer that was blocked for its score is unblocked right away.
+func (g *gater) SetProtected(id peer.ID, protected bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !protected {
+		delete(g.protected, id)
+		return
+	}
+	g.protected[id] = true
+	if _, banned := g.bans[id]; g.blockedMap[id] && !banned {
+		if err := g.connGater.UnblockPeer(id); err != nil {
+			g.log.Warn("connection gater failed to unblock protected peer", "id", id.String(), "err", err)
+		}
+		g.setBlocked(id, false)
+	}
+}
+
+// Ban blocks the given [peer.ID] regardless of its score, for the given duration or until unbanned if 0.
+func (g *gater) Ban(id peer.ID, duration time.Duration) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if err := g.connGater.BlockPeer(id); err != nil {
+		return err
+	}
+	if timer := g.bans[id]; timer != nil {
+		timer.Stop()
+	}
+	var timer *time.Timer
+	if duration > 0 {
+		timer = time.AfterFunc(duration, func() {
+			if err := g.Unban(id); err != nil {
+				g.log.Warn("failed to lift expired peer ban", "id", id.String(), "err", err)
+			}
+		})
+	}
+	g.bans[id] = timer
+	g.setBlocked(id, true)
+	g.log.Info("banned peer", "id", id.String(), "duration", duration)
+	return nil
+}
+
+// Unban lifts the ban of the given [peer.ID].
+func (g *gater) Unban(id peer.ID) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if timer := g.bans[id]; timer != nil {
+		timer.Stop()
+	}
+	delete(g.bans, id)
+	if err := g.connGater.UnblockPeer(id); err != nil {
+		return err
+	}
+	g.setBlocked(id, false)
+	g.log.Info("unbanned peer", "id", id.String())
+	return nil
+}
@@ -2,6 +2,7 @@ package p2p_test
 
 import (
 	"testing"
+	"time"
 
 	log "github.com/ethereum/go-ethereum/log"
 	peer "github.com/libp2p/go-libp2p/core/peer"
@@ -43,6 +44,7 @@ func (testSuite *PeerGaterTestSuite) TestPeerGater_UpdateBansPeers() {
 		testSuite.mockGater,
 		testSuite.logger,
 		true,
+		p2p.PeerScoreThreshold,
 	)
 
 	// Return an empty list of already blocked peers
@@ -76,6 +78,7 @@ func (testSuite *PeerGaterTestSuite) TestPeerGater_UpdateNoBanning() {
 		testSuite.mockGater,
 		testSuite.logger,
 		false,
+		p2p.PeerScoreThreshold,
 	)
 
 	// Return an empty list of already blocked peers
@@ -94,3 +97,60 @@ func (testSuite *PeerGaterTestSuite) TestPeerGater_UpdateNoBanning() {
 	// The peer should still be unblocked
 	testSuite.False(gater.IsBlocked(peer.ID("peer1")))
 }
+
+// TestPeerGater_ProtectedPeers tests that protected peers are not banned for their score.
+func (testSuite *PeerGaterTestSuite) TestPeerGater_ProtectedPeers() {
+	gater := p2p.NewPeerGater(
+		testSuite.mockGater,
+		testSuite.logger,
+		true,
+		-50,
+	)
+
+	// The peer is blocked below the configured threshold
+	testSuite.mockGater.On("BlockPeer", peer.ID("peer1")).Return(nil).Once()
+	gater.Update(peer.ID("peer1"), float64(-51))
+	testSuite.True(gater.IsBlocked(peer.ID("peer1")))
+	testSuite.Equal(map[peer.ID]float64{"peer1": -51}, gater.Scores())
+
+	// Protecting the peer unblocks it right away, and it is not blocked again
+	testSuite.mockGater.On("UnblockPeer", peer.ID("peer1")).Return(nil).Once()
+	gater.SetProtected(peer.ID("peer1"), true)
+	testSuite.False(gater.IsBlocked(peer.ID("peer1")))
+	gater.Update(peer.ID("peer1"), float64(-1000))
+	testSuite.False(gater.IsBlocked(peer.ID("peer1")))
+
+	testSuite.mockGater.AssertExpectations(testSuite.T())
+}
+
+// TestPeerGater_Ban tests that banned peers are not unblocked by their score.
+func (testSuite *PeerGaterTestSuite) TestPeerGater_Ban() {
+	gater := p2p.NewPeerGater(
+		testSuite.mockGater,
+		testSuite.logger,
+		true,
+		p2p.PeerScoreThreshold,
+	)
+
+	testSuite.mockGater.On("BlockPeer", peer.ID("peer1")).Return(nil).Once()
+	testSuite.NoError(gater.Ban(peer.ID("peer1"), 0))
+	testSuite.True(gater.IsBlocked(peer.ID("peer1")))
+
+	// A good score does not lift the ban
+	gater.Update(peer.ID("peer1"), float64(10))
+	testSuite.True(gater.IsBlocked(peer.ID("peer1")))
+
+	testSuite.mockGater.On("UnblockPeer", peer.ID("peer1")).Return(nil).Once()
+	testSuite.NoError(gater.Unban(peer.ID("peer1")))
+	testSuite.False(gater.IsBlocked(peer.ID("peer1")))
+
+	// A ban with a duration expires by itself
+	testSuite.mockGater.On("BlockPeer", peer.ID("peer2")).Return(nil).Once()
+	testSuite.mockGater.On("UnblockPeer", peer.ID("peer2")).Return(nil).Once()
+	testSuite.NoError(gater.Ban(peer.ID("peer2"), time.Millisecond))
+	testSuite.Eventually(func() bool {
+		return !gater.IsBlocked(peer.ID("peer2"))
+	}, time.Second, time.Millisecond*10)
+
+	testSuite.mockGater.AssertExpectations(testSuite.T())
+}
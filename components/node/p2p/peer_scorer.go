@@ -117,6 +117,7 @@ func NewScorer(peerGater PeerGater, peerStore Peerstore, metricer GossipMetricer
 func (s *scorer) SnapshotHook() pubsub.ExtendedPeerScoreInspectFn {
 	return func(m map[peer.ID]*pubsub.PeerScoreSnapshot) {
 		scoreMap := make(map[string]float64)
+		scores := make([]float64, 0, len(m))
 		// Zero out all bands.
 		for _, b := range s.bandScoreThresholds.bands {
 			scoreMap[b.band] = 0
@@ -125,9 +126,11 @@ func (s *scorer) SnapshotHook() pubsub.ExtendedPeerScoreInspectFn {
 		for id, snap := range m {
 			band := s.bandScoreThresholds.Bucket(snap.Score)
 			scoreMap[band] += 1
+			scores = append(scores, snap.Score)
 			s.gater.Update(id, snap.Score)
 		}
 		s.metricer.SetPeerScores(scoreMap)
+		s.metricer.ObservePeerScores(scores)
 	}
 }
 
@@ -84,6 +84,7 @@ func (testSuite *PeerScorerTestSuite) TestScorer_SnapshotHook() {
 		"friend":   0,
 		"graylist": 1,
 	}).Return(nil).Once()
+	testSuite.mockMetricer.On("ObservePeerScores", []float64{-100}).Once()
 
 	// Apply the snapshot
 	snapshotMap := map[peer.ID]*pubsub.PeerScoreSnapshot{
@@ -101,6 +102,7 @@ func (testSuite *PeerScorerTestSuite) TestScorer_SnapshotHook() {
 		"friend":   1,
 		"graylist": 0,
 	}).Return(nil).Once()
+	testSuite.mockMetricer.On("ObservePeerScores", []float64{0}).Once()
 
 	// Apply the snapshot
 	snapshotMap = map[peer.ID]*pubsub.PeerScoreSnapshot{
@@ -131,6 +133,7 @@ func (testSuite *PeerScorerTestSuite) TestScorer_SnapshotHookBlocksPeer() {
 		"friend":   0,
 		"graylist": 1,
 	}).Return(nil)
+	testSuite.mockMetricer.On("ObservePeerScores", []float64{-101})
 
 	// Apply the snapshot
 	snapshotMap := map[peer.ID]*pubsub.PeerScoreSnapshot{
@@ -7,19 +7,17 @@ import (
 )
 
 // ConfigurePeerScoring configures the peer scoring parameters for the pubsub
-func ConfigurePeerScoring(h host.Host, g ConnectionGater, gossipConf GossipSetupConfigurables, m GossipMetricer, log log.Logger) []pubsub.Option {
+func ConfigurePeerScoring(h host.Host, peerGater PeerGater, gossipConf GossipSetupConfigurables, m GossipMetricer, log log.Logger) []pubsub.Option {
 	// If we want to completely disable scoring config here, we can use the [peerScoringParams]
 	// to return early without returning any [pubsub.Option].
 	peerScoreParams := gossipConf.PeerScoringParams()
-	peerScoreThresholds := NewPeerScoreThresholds()
-	banEnabled := gossipConf.BanPeers()
-	peerGater := NewPeerGater(g, log, banEnabled)
+	peerScoreThresholds := gossipConf.PeerScoreThresholds()
 	scorer := NewScorer(peerGater, h.Peerstore(), m, gossipConf.PeerBandScorer(), log)
 	opts := []pubsub.Option{}
 	// Check the app specific score since libp2p doesn't export it's [validate] function :/
 	if peerScoreParams != nil && peerScoreParams.AppSpecificScore != nil {
 		opts = []pubsub.Option{
-			pubsub.WithPeerScore(peerScoreParams, &peerScoreThresholds),
+			pubsub.WithPeerScore(peerScoreParams, peerScoreThresholds),
 			pubsub.WithPeerScoreInspect(scorer.SnapshotHook(), peerScoreInspectFrequency),
 		}
 	} else {
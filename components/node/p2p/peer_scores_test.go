@@ -69,7 +69,7 @@ func newGossipSubs(testSuite *PeerScoresTestSuite, ctx context.Context, hosts []
 	for _, h := range hosts {
 		rt := pubsub.DefaultGossipSubRouter(h)
 		opts := []pubsub.Option{}
-		opts = append(opts, p2p.ConfigurePeerScoring(h, testSuite.mockGater, &p2p.Config{
+		opts = append(opts, p2p.ConfigurePeerScoring(h, p2p.NewPeerGater(testSuite.mockGater, logger, false, p2p.PeerScoreThreshold), &p2p.Config{
 			BandScoreThresholds: testSuite.bandScorer,
 			PeerScoring: pubsub.PeerScoreParams{
 				AppSpecificScore: func(p peer.ID) float64 {
@@ -0,0 +1,131 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	lru "github.com/hashicorp/golang-lru"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup"
+)
+
+func preconfsTopicV1(cfg *rollup.Config) string {
+	return fmt.Sprintf("/kroma/%s/0/preconfs", cfg.L2ChainID.String())
+}
+
+// BuildPreconfsValidator validates the preconfirmations signed by the proposer.
+// A preconfirmation message is the secp256k1 signature, followed by the encoded preconfirmation.
+func BuildPreconfsValidator(log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig) pubsub.ValidatorEx {
+	// Seen block hashes per block height
+	// uint64 -> *seenBlocks
+	blockHeightLRU, err := lru.New(1000)
+	if err != nil {
+		panic(fmt.Errorf("failed to set up block height LRU cache: %w", err))
+	}
+
+	return func(ctx context.Context, id peer.ID, message *pubsub.Message) pubsub.ValidationResult {
+		// [REJECT] if the size is not valid
+		if len(message.Data) != 65+eth.PreconfirmationSize {
+			log.Warn("invalid preconfirmation size", "size", len(message.Data), "peer", id)
+			return pubsub.ValidationReject
+		}
+		signatureBytes, preconfBytes := message.Data[:65], message.Data[65:]
+
+		// [REJECT] if the signature by the proposer is not valid
+		signingHash, err := PreconfSigningHash(cfg, preconfBytes)
+		if err != nil {
+			log.Warn("failed to compute preconfirmation signing hash", "err", err, "peer", id)
+			return pubsub.ValidationReject
+		}
+		if result := verifyProposerSignature(log, runCfg, id, signingHash, signatureBytes); result != pubsub.ValidationAccept {
+			return result
+		}
+
+		var preconf eth.Preconfirmation
+		if err := preconf.UnmarshalBinary(preconfBytes); err != nil {
+			log.Warn("invalid preconfirmation", "err", err, "peer", id)
+			return pubsub.ValidationReject
+		}
+
+		// rounding down to seconds is fine here.
+		now := uint64(time.Now().Unix())
+
+		// [REJECT] if the timestamp is older than 60 seconds in the past
+		if preconf.Timestamp < now-60 {
+			log.Warn("preconfirmation is too old", "timestamp", preconf.Timestamp)
+			return pubsub.ValidationReject
+		}
+
+		// [REJECT] if the timestamp is more than 5 seconds into the future
+		if preconf.Timestamp > now+5 {
+			log.Warn("preconfirmation is too new", "timestamp", preconf.Timestamp)
+			return pubsub.ValidationReject
+		}
+
+		seen, ok := blockHeightLRU.Get(preconf.BlockNumber)
+		if !ok {
+			seen = new(seenBlocks)
+			blockHeightLRU.Add(preconf.BlockNumber, seen)
+		}
+
+		if count, hasSeen := seen.(*seenBlocks).hasSeen(preconf.BlockHash); count > 5 {
+			// [REJECT] if more than 5 blocks have been preconfirmed at the same block height
+			log.Warn("seen too many different preconfirmations at same height", "height", preconf.BlockNumber)
+			return pubsub.ValidationReject
+		} else if hasSeen {
+			// [IGNORE] if the preconfirmation has already been seen
+			return pubsub.ValidationIgnore
+		}
+		seen.(*seenBlocks).markSeen(preconf.BlockHash)
+
+		// remember the decoded preconfirmation for later usage in topic subscriber.
+		message.ValidatorData = &preconf
+		return pubsub.ValidationAccept
+	}
+}
+
+func PreconfsHandler(onPreconf func(ctx context.Context, from peer.ID, msg *eth.Preconfirmation) error) MessageHandler {
+	return func(ctx context.Context, from peer.ID, msg any) error {
+		preconf, ok := msg.(*eth.Preconfirmation)
+		if !ok {
+			return fmt.Errorf("expected topic validator to parse and validate data into preconfirmation, but got %T", msg)
+		}
+		return onPreconf(ctx, from, preconf)
+	}
+}
+
+func joinPreconfsTopic(p2pCtx context.Context, self peer.ID, concurrency int, ps *pubsub.PubSub, log log.Logger, cfg *rollup.Config, runCfg GossipRuntimeConfig, gossipIn GossipIn) (*pubsub.Topic, error) {
+	val := guardGossipValidator(log, logValidationResult(self, "validated preconfirmation", log, BuildPreconfsValidator(log, cfg, runCfg)))
+	preconfsTopicName := preconfsTopicV1(cfg)
+	err := ps.RegisterTopicValidator(preconfsTopicName,
+		val,
+		pubsub.WithValidatorTimeout(3*time.Second),
+		pubsub.WithValidatorConcurrency(concurrency))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register preconfirmations gossip topic: %w", err)
+	}
+	preconfsTopic, err := ps.Join(preconfsTopicName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join preconfirmations gossip topic: %w", err)
+	}
+	preconfsTopicEvents, err := preconfsTopic.EventHandler()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create preconfirmations gossip topic handler: %w", err)
+	}
+	go LogTopicEvents(p2pCtx, log.New("topic", "preconfs"), preconfsTopicEvents)
+
+	subscription, err := preconfsTopic.Subscribe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to preconfirmations gossip topic: %w", err)
+	}
+
+	subscriber := MakeSubscriber(log, PreconfsHandler(gossipIn.OnPreconfirmation))
+	go subscriber(p2pCtx, subscription)
+
+	return preconfsTopic, nil
+}
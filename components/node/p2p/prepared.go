@@ -1,6 +1,7 @@
 package p2p
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -61,6 +62,15 @@ func (p *Prepared) Discovery(log log.Logger, rollupCfg *rollup.Config, tcpPort u
 	return p.LocalNode, p.UDPv5, nil
 }
 
+// DNSDiscovery is not used by prepared setups. Returns nil, nil.
+func (p *Prepared) DNSDiscovery(log log.Logger, rollupCfg *rollup.Config) (enode.Iterator, error) {
+	return nil, nil
+}
+
+// NAT is not used by prepared setups.
+func (p *Prepared) NAT(ctx context.Context, log log.Logger, h host.Host, localNode *enode.LocalNode) {
+}
+
 func (p *Prepared) ConfigureGossip(params *pubsub.GossipSubParams) []pubsub.Option {
 	return nil
 }
@@ -77,6 +87,15 @@ func (p *Prepared) BanPeers() bool {
 	return false
 }
 
+func (p *Prepared) PeerBanThreshold() float64 {
+	return PeerScoreThreshold
+}
+
+func (p *Prepared) PeerScoreThresholds() *pubsub.PeerScoreThresholds {
+	thresholds := NewPeerScoreThresholds()
+	return &thresholds
+}
+
 func (p *Prepared) TopicScoringParams() *pubsub.TopicScoreParams {
 	return nil
 }
@@ -88,3 +107,11 @@ func (p *Prepared) Disabled() bool {
 func (p *Prepared) ReqRespSyncEnabled() bool {
 	return p.EnableReqRespSync
 }
+
+func (p *Prepared) ReqRespServerLimits() ReqRespServerLimits {
+	return DefaultReqRespServerLimits()
+}
+
+func (p *Prepared) GossipValidation() GossipValidationConfig {
+	return GossipValidationConfig{}.withDefaults()
+}
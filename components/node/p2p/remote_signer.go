@@ -0,0 +1,159 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	ktls "github.com/kroma-network/kroma/utils/service/tls"
+	ksigner "github.com/kroma-network/kroma/utils/signer/client"
+)
+
+// DefaultSignerHealthCheckInterval is the interval between health checks of the remote signer.
+const DefaultSignerHealthCheckInterval = 10 * time.Second
+
+type SignerMetrics interface {
+	RecordP2PSignerRequest(duration time.Duration, err error)
+	RecordP2PSignerHealth(healthy bool)
+}
+
+// RemoteSignerClient is the client of a remote signing service, e.g. backed by an HSM.
+type RemoteSignerClient interface {
+	SignBlockPayload(ctx context.Context, args *ksigner.BlockPayloadArgs) (*[65]byte, error)
+	HealthCheck(ctx context.Context) error
+	Close()
+}
+
+// RemoteSigner signs p2p application messages with a remote signing service,
+// so the proposer does not have to hold the p2p key.
+type RemoteSigner struct {
+	log     log.Logger
+	client  RemoteSignerClient
+	address common.Address
+	metrics SignerMetrics
+
+	healthy atomic.Bool
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// NewRemoteSigner creates a RemoteSigner signing on behalf of the given address,
+// and starts checking the health of the remote signer at the given interval.
+func NewRemoteSigner(log log.Logger, client RemoteSignerClient, address common.Address, healthCheckInterval time.Duration, metrics SignerMetrics) *RemoteSigner {
+	if healthCheckInterval <= 0 {
+		healthCheckInterval = DefaultSignerHealthCheckInterval
+	}
+	s := &RemoteSigner{
+		log:     log,
+		client:  client,
+		address: address,
+		metrics: metrics,
+		done:    make(chan struct{}),
+	}
+	s.healthy.Store(true)
+	go s.healthCheckLoop(healthCheckInterval)
+	return s
+}
+
+func (s *RemoteSigner) Sign(ctx context.Context, domain [32]byte, chainID *big.Int, encodedMsg []byte) (sig *[65]byte, err error) {
+	select {
+	case <-s.done:
+		return nil, errors.New("signer is closed")
+	default:
+	}
+	signingHash, err := SigningHash(domain, chainID, encodedMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	args := ksigner.NewBlockPayloadArgs(domain, chainID, crypto.Keccak256(encodedMsg), s.address)
+	sig, err = s.client.SignBlockPayload(ctx, args)
+	if err == nil {
+		err = s.verify(signingHash, sig)
+	}
+	s.metrics.RecordP2PSignerRequest(time.Since(start), err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with remote signer: %w", err)
+	}
+	return sig, nil
+}
+
+// verify checks that the signature is made by the expected address, to not publish messages rejected by peers.
+func (s *RemoteSigner) verify(signingHash common.Hash, sig *[65]byte) error {
+	pub, err := crypto.SigToPub(signingHash[:], sig[:])
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	if addr := crypto.PubkeyToAddress(*pub); addr != s.address {
+		return fmt.Errorf("signature by %s does not match expected signer %s", addr, s.address)
+	}
+	return nil
+}
+
+// Healthy returns true if the latest health check of the remote signer succeeded.
+func (s *RemoteSigner) Healthy() bool {
+	return s.healthy.Load()
+}
+
+func (s *RemoteSigner) healthCheckLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.checkHealth()
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *RemoteSigner) checkHealth() {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := s.client.HealthCheck(ctx)
+	healthy := err == nil
+	if prev := s.healthy.Swap(healthy); prev != healthy {
+		if healthy {
+			s.log.Info("remote signer is healthy again")
+		} else {
+			s.log.Error("remote signer health check failed", "err", err)
+		}
+	}
+	s.metrics.RecordP2PSignerHealth(healthy)
+}
+
+func (s *RemoteSigner) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.client.Close()
+	})
+	return nil
+}
+
+// RemoteSignerSetup sets up a RemoteSigner connected to the configured endpoint.
+type RemoteSignerSetup struct {
+	Endpoint            string
+	Address             common.Address
+	TLSConfig           ktls.CLIConfig
+	HealthCheckInterval time.Duration
+}
+
+func (r *RemoteSignerSetup) SetupSigner(ctx context.Context, log log.Logger, metrics SignerMetrics) (Signer, error) {
+	// the client checks that the remote signer is reachable on creation
+	client, err := ksigner.NewSignerClient(log, r.Endpoint, r.TLSConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to remote signer: %w", err)
+	}
+	return NewRemoteSigner(log, client, r.Address, r.HealthCheckInterval, metrics), nil
+}
@@ -0,0 +1,79 @@
+package p2p
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/metrics"
+	"github.com/kroma-network/kroma/components/node/testlog"
+	ksigner "github.com/kroma-network/kroma/utils/signer/client"
+)
+
+type testRemoteSignerClient struct {
+	priv *ecdsa.PrivateKey
+}
+
+func (c *testRemoteSignerClient) SignBlockPayload(ctx context.Context, args *ksigner.BlockPayloadArgs) (*[65]byte, error) {
+	var domain [32]byte
+	copy(domain[:], args.Domain)
+	var msgInput [96]byte
+	copy(msgInput[:32], domain[:])
+	args.ChainID.ToInt().FillBytes(msgInput[32:64])
+	copy(msgInput[64:], args.PayloadHash)
+	sig, err := crypto.Sign(crypto.Keccak256(msgInput[:]), c.priv)
+	if err != nil {
+		return nil, err
+	}
+	return (*[65]byte)(sig), nil
+}
+
+func (c *testRemoteSignerClient) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+func (c *testRemoteSignerClient) Close() {}
+
+func TestRemoteSigner(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	addr := crypto.PubkeyToAddress(priv.PublicKey)
+	chainID := big.NewInt(100)
+	msg := []byte("arbitraryData")
+	logger := testlog.Logger(t, log.LvlError)
+
+	t.Run("valid signature", func(t *testing.T) {
+		signer := NewRemoteSigner(logger, &testRemoteSignerClient{priv: priv}, addr, time.Hour, metrics.NoopMetrics)
+		defer signer.Close()
+
+		sig, err := signer.Sign(context.Background(), SigningDomainBlocksV1, chainID, msg)
+		require.NoError(t, err)
+		expected, err := NewLocalSigner(priv).Sign(context.Background(), SigningDomainBlocksV1, chainID, msg)
+		require.NoError(t, err)
+		require.Equal(t, expected, sig)
+		require.True(t, signer.Healthy())
+	})
+
+	t.Run("signature by other key", func(t *testing.T) {
+		signer := NewRemoteSigner(logger, &testRemoteSignerClient{priv: priv}, common.Address{0x01}, time.Hour, metrics.NoopMetrics)
+		defer signer.Close()
+
+		_, err := signer.Sign(context.Background(), SigningDomainBlocksV1, chainID, msg)
+		require.ErrorContains(t, err, "does not match expected signer")
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		signer := NewRemoteSigner(logger, &testRemoteSignerClient{priv: priv}, addr, time.Hour, metrics.NoopMetrics)
+		require.NoError(t, signer.Close())
+
+		_, err := signer.Sign(context.Background(), SigningDomainBlocksV1, chainID, msg)
+		require.ErrorContains(t, err, "closed")
+	})
+}
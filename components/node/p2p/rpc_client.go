@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 	"net"
+	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -91,6 +92,20 @@ func (c *Client) ListBlockedSubnets(ctx context.Context) ([]*net.IPNet, error) {
 	return out, err
 }
 
+func (c *Client) PeerScores(ctx context.Context) (map[string]float64, error) {
+	var out map[string]float64
+	err := c.c.CallContext(ctx, &out, prefixRPC("peerScores"))
+	return out, err
+}
+
+func (c *Client) BanPeer(ctx context.Context, p peer.ID, duration time.Duration) error {
+	return c.c.CallContext(ctx, nil, prefixRPC("banPeer"), p, duration)
+}
+
+func (c *Client) UnbanPeer(ctx context.Context, p peer.ID) error {
+	return c.c.CallContext(ctx, nil, prefixRPC("unbanPeer"), p)
+}
+
 func (c *Client) ProtectPeer(ctx context.Context, p peer.ID) error {
 	return c.c.CallContext(ctx, nil, prefixRPC("protectPeer"), p)
 }
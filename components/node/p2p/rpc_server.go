@@ -32,6 +32,7 @@ var (
 	ErrDisabledDiscovery   = errors.New("discovery disabled")
 	ErrNoConnectionManager = errors.New("no connection manager")
 	ErrNoConnectionGater   = errors.New("no connection gater")
+	ErrNoPeerGater         = errors.New("no peer gater")
 )
 
 type Node interface {
@@ -49,6 +50,8 @@ type Node interface {
 	ConnectionGater() ConnectionGater
 	// ConnectionManager returns the connection manager, to protect peers with, may be nil
 	ConnectionManager() connmgr.ConnManager
+	// PeerGater returns the peer gater, to ban peers and inspect their scores with, may be nil
+	PeerGater() PeerGater
 }
 
 type APIBackend struct {
@@ -171,6 +174,11 @@ func (s *APIBackend) Peers(ctx context.Context, connected bool) (*PeerDump, erro
 		peers = pstore.Peers()
 	}
 
+	var scores map[peer.ID]float64
+	if peerGater := s.node.PeerGater(); peerGater != nil {
+		scores = peerGater.Scores()
+	}
+
 	dump := &PeerDump{Peers: make(map[string]*PeerInfo)}
 	for _, id := range peers {
 		peerInfo, err := dumpPeer(id, nw, pstore, s.node.ConnectionManager())
@@ -178,6 +186,7 @@ func (s *APIBackend) Peers(ctx context.Context, connected bool) (*PeerDump, erro
 			s.log.Debug("failed to dump peer info in RPC request", "peer", id, "err", err)
 			continue
 		}
+		peerInfo.PeerScore = scores[id]
 		// We don't use the peer.ID type as key,
 		// since JSON decoding can't use the provided json unmarshaler (on *string type).
 		dump.Peers[id.String()] = peerInfo
@@ -333,6 +342,52 @@ func (s *APIBackend) ListBlockedSubnets(_ context.Context) ([]*net.IPNet, error)
 	}
 }
 
+// PeerScores returns the latest gossip scores of the peers, by peer ID.
+func (s *APIBackend) PeerScores(_ context.Context) (map[string]float64, error) {
+	recordDur := s.m.RecordRPCServerRequest("opp2p_peerScores")
+	defer recordDur()
+	peerGater := s.node.PeerGater()
+	if peerGater == nil {
+		return nil, ErrNoPeerGater
+	}
+	scores := make(map[string]float64)
+	for id, score := range peerGater.Scores() {
+		scores[id.String()] = score
+	}
+	return scores, nil
+}
+
+// BanPeer blocks the peer regardless of its score and disconnects it, for the given duration or until unbanned if 0.
+func (s *APIBackend) BanPeer(_ context.Context, p peer.ID, duration time.Duration) error {
+	recordDur := s.m.RecordRPCServerRequest("opp2p_banPeer")
+	defer recordDur()
+	if s.node.ConnectionGater() == nil {
+		return ErrNoConnectionGater
+	}
+	peerGater := s.node.PeerGater()
+	if peerGater == nil {
+		return ErrNoPeerGater
+	}
+	if err := peerGater.Ban(p, duration); err != nil {
+		return err
+	}
+	return s.node.Host().Network().ClosePeer(p)
+}
+
+func (s *APIBackend) UnbanPeer(_ context.Context, p peer.ID) error {
+	recordDur := s.m.RecordRPCServerRequest("opp2p_unbanPeer")
+	defer recordDur()
+	if s.node.ConnectionGater() == nil {
+		return ErrNoConnectionGater
+	}
+	peerGater := s.node.PeerGater()
+	if peerGater == nil {
+		return ErrNoPeerGater
+	}
+	return peerGater.Unban(p)
+}
+
+// ProtectPeer protects the peer from being pruned by the connection manager and from being banned for its score.
 func (s *APIBackend) ProtectPeer(_ context.Context, p peer.ID) error {
 	recordDur := s.m.RecordRPCServerRequest("opp2p_protectPeer")
 	defer recordDur()
@@ -340,8 +395,11 @@ func (s *APIBackend) ProtectPeer(_ context.Context, p peer.ID) error {
 		return ErrNoConnectionManager
 	} else {
 		manager.Protect(p, "api-protected")
-		return nil
 	}
+	if peerGater := s.node.PeerGater(); peerGater != nil {
+		peerGater.SetProtected(p, true)
+	}
+	return nil
 }
 
 func (s *APIBackend) UnprotectPeer(_ context.Context, p peer.ID) error {
@@ -351,8 +409,11 @@ func (s *APIBackend) UnprotectPeer(_ context.Context, p peer.ID) error {
 		return ErrNoConnectionManager
 	} else {
 		manager.Unprotect(p, "api-protected")
-		return nil
 	}
+	if peerGater := s.node.PeerGater(); peerGater != nil {
+		peerGater.SetProtected(p, false)
+	}
+	return nil
 }
 
 // ConnectPeer connects to a given peer address, and wait for protocol negotiation & identification of the peer
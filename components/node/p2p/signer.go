@@ -9,11 +9,15 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/kroma-network/kroma/components/node/rollup"
 )
 
-var SigningDomainBlocksV1 = [32]byte{}
+var (
+	SigningDomainBlocksV1   = [32]byte{}
+	SigningDomainPreconfsV1 = [32]byte{31: 1}
+)
 
 type Signer interface {
 	Sign(ctx context.Context, domain [32]byte, chainID *big.Int, encodedMsg []byte) (sig *[65]byte, err error)
@@ -39,6 +43,10 @@ func BlockSigningHash(cfg *rollup.Config, payloadBytes []byte) (common.Hash, err
 	return SigningHash(SigningDomainBlocksV1, cfg.L2ChainID, payloadBytes)
 }
 
+func PreconfSigningHash(cfg *rollup.Config, preconfBytes []byte) (common.Hash, error) {
+	return SigningHash(SigningDomainPreconfsV1, cfg.L2ChainID, preconfBytes)
+}
+
 // LocalSigner is suitable for testing
 type LocalSigner struct {
 	priv   *ecdsa.PrivateKey
@@ -73,10 +81,10 @@ type PreparedSigner struct {
 	Signer
 }
 
-func (p *PreparedSigner) SetupSigner(ctx context.Context) (Signer, error) {
+func (p *PreparedSigner) SetupSigner(ctx context.Context, log log.Logger, metrics SignerMetrics) (Signer, error) {
 	return p.Signer, nil
 }
 
 type SignerSetup interface {
-	SetupSigner(ctx context.Context) (Signer, error)
+	SetupSigner(ctx context.Context, log log.Logger, metrics SignerMetrics) (Signer, error)
 }
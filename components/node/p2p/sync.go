@@ -57,6 +57,8 @@ const (
 	// we rather sync from other servers. We'll try again later,
 	// and eventually kick the peer based on degraded scoring if it's really not serving us well.
 	clientErrRateCost = 100
+	// Contiguous blocks are requested from the same peer in ranges of up to 8 blocks, newest-first
+	clientRangeRequestSize = 8
 )
 
 func PayloadByNumberProtocolID(l2ChainID *big.Int) protocol.ID {
@@ -92,14 +94,17 @@ type syncResult struct {
 	peer    peer.ID
 }
 
+// peerRequest is a range of blocks to fetch from a peer, newest-first: from num down to num-count+1.
 type peerRequest struct {
-	num uint64
+	num   uint64
+	count uint64
 
 	complete *atomic.Bool
 }
 
 type SyncClientMetrics interface {
 	ClientPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration)
+	ClientPayloadsByRangeEvent(num uint64, resultCode byte, duration time.Duration)
 	PayloadsQuarantineSize(n int)
 }
 
@@ -115,16 +120,18 @@ type SyncClientMetrics interface {
 //
 // The sync mechanism is implemented as following:
 // - User sends range request: blocks on sync main loop (with ctx timeout)
-// - Main loop processes range request (from high to low), dividing block requests by number between parallel peers.
+// - Main loop processes range request (from high to low), dividing contiguous block numbers into ranges between parallel peers.
 //   - The high part of the range has a known block-hash, and is marked as trusted.
 //   - Once there are no more peers available for buffering requests, we stop the range request processing.
-//   - Every request buffered for a peer is tracked as in-flight, by block number.
+//   - Every block of a range buffered for a peer is tracked as in-flight, by block number.
 //   - In-flight requests are not repeated
 //   - Requests for data that's already in the quarantine are not repeated
 //   - Data already in the quarantine that is trusted is attempted to be promoted.
 //
 // - Peers each have their own routine for processing requests.
-//   - They fetch the requested block by number, parse and validate it, and then send it back to the main loop
+//   - They fetch the blocks of the requested range by number newest-first, parse and validate them,
+//     and then send them back to the main loop. Every block must be the parent of the block fetched before it,
+//     so the range is verified to chain backwards; the peer stops at the first block that does not.
 //   - If peers fail to fetch or process it, or fail to send it back to the main loop within timeout,
 //     then the doRequest returns an error. It then marks the in-flight request as completed.
 //
@@ -176,6 +183,7 @@ type SyncClient struct {
 
 	newStreamFn     newStreamFn
 	payloadByNumber protocol.ID
+	payloadsByRange protocol.ID
 
 	peersLock sync.Mutex
 	// syncing worker per peer
@@ -224,6 +232,7 @@ func NewSyncClient(log log.Logger, cfg *rollup.Config, newStream newStreamFn, rc
 		metrics:         metrics,
 		newStreamFn:     newStream,
 		payloadByNumber: PayloadByNumberProtocolID(cfg.L2ChainID),
+		payloadsByRange: PayloadsByRangeProtocolID(cfg.L2ChainID),
 		peers:           make(map[peer.ID]context.CancelFunc),
 		quarantineByNum: make(map[uint64]common.Hash),
 		inFlight:        make(map[uint64]*atomic.Bool),
@@ -345,10 +354,34 @@ func (s *SyncClient) onRangeRequest(ctx context.Context, req rangeRequest) {
 		}
 	}
 
+	// Contiguous numbers are grouped into ranges, which are fetched newest-first by a single peer.
+	pr := peerRequest{complete: new(atomic.Bool)}
+	schedule := func() bool {
+		if pr.count == 0 {
+			return true
+		}
+		log.Debug("Scheduling P2P block range request", "num", pr.num, "count", pr.count)
+		select {
+		case s.peerRequests <- pr:
+			for i := uint64(0); i < pr.count; i++ {
+				s.inFlight[pr.num-i] = pr.complete
+			}
+			pr = peerRequest{complete: new(atomic.Bool)}
+			return true
+		case <-ctx.Done():
+			log.Info("did not schedule full P2P sync range", "current", pr.num, "err", ctx.Err())
+			return false
+		default: // peers may all be busy processing requests already
+			log.Info("no peers ready to handle block requests for more P2P requests for L2 block history", "current", pr.num)
+			return false
+		}
+	}
+
 	// Now try to fetch lower numbers than current end, to traverse back towards the updated start.
 	for i := uint64(0); ; i++ {
 		num := req.end.Number - 1 - i
 		if num <= req.start {
+			schedule()
 			return
 		}
 		// check if we have something in quarantine already
@@ -358,24 +391,25 @@ func (s *SyncClient) onRangeRequest(ctx context.Context, req rangeRequest) {
 			}
 			// Don't fetch things that we have a candidate for already.
 			// We'll evict it from quarantine by finding a conflict, or if we sync enough other blocks
+			if !schedule() {
+				return
+			}
 			continue
 		}
 
 		if _, ok := s.inFlight[num]; ok {
-			continue // request still in flight
+			// request still in flight
+			if !schedule() {
+				return
+			}
+			continue
 		}
-		pr := peerRequest{num: num, complete: new(atomic.Bool)}
 
-		log.Debug("Scheduling P2P block request", "num", num)
-		// schedule number
-		select {
-		case s.peerRequests <- pr:
-			s.inFlight[num] = pr.complete
-		case <-ctx.Done():
-			log.Info("did not schedule full P2P sync range", "current", num, "err", ctx.Err())
-			return
-		default: // peers may all be busy processing requests already
-			log.Info("no peers ready to handle block requests for more P2P requests for L2 block history", "current", num)
+		if pr.count == 0 {
+			pr.num = num
+		}
+		pr.count++
+		if pr.count == clientRangeRequestSize && !schedule() {
 			return
 		}
 	}
@@ -462,12 +496,12 @@ func (s *SyncClient) peerLoop(ctx context.Context, id peer.ID) {
 	log := s.log.New("peer", id)
 	log.Info("Starting P2P sync client event loop")
 
-	var rl rate.Limiter
-
 	// Implement the same rate limits as the server does per-peer,
 	// so we don't be too aggressive to the server.
-	rl.SetLimit(peerServerBlocksRateLimit)
-	rl.SetBurst(peerServerBlocksBurst)
+	rl := rate.NewLimiter(peerServerBlocksRateLimit, peerServerBlocksBurst)
+
+	// Ranges are fetched in a single request, unless the peer turns out to only serve payloads by number.
+	byRange := true
 
 	for {
 		// wait for peer to be available for more work
@@ -480,40 +514,64 @@ func (s *SyncClient) peerLoop(ctx context.Context, id peer.ID) {
 		case pr := <-s.peerRequests:
 			// We already established the peer is available w.r.t. rate-limiting,
 			// and this is the only loop over this peer, so we can request now.
-			start := time.Now()
-			err := s.doRequest(ctx, id, pr.num)
+			var err error
+			if byRange {
+				err = s.doPayloadsByRange(ctx, rl, id, pr)
+				if errors.Is(err, errRangeUnsupported) {
+					log.Debug("peer does not serve payloads by range, falling back to payloads by number")
+					byRange = false
+				}
+			}
+			if !byRange {
+				err = s.doRangeRequest(ctx, rl, id, pr)
+			}
 			if err != nil {
-				// mark as complete if there's an error: we are not sending any result and can complete immediately.
+				// mark as complete if there's an error: we are not sending any more results and can complete immediately.
 				pr.complete.Store(true)
-				log.Warn("failed p2p sync request", "num", pr.num, "err", err)
+				log.Warn("failed p2p sync range request", "num", pr.num, "count", pr.count, "err", err)
 				// If we hit an error, then count it as many requests.
 				// We'd like to avoid making more requests for a while, to back off.
 				if err := rl.WaitN(ctx, clientErrRateCost); err != nil {
 					return
 				}
 			} else {
-				log.Debug("completed p2p sync request", "num", pr.num)
-			}
-			took := time.Since(start)
-			// TODO(CLI-3732): update scores: depending on the speed of the result,
-			//  increase the p2p-sync part of the peer score
-			//  (don't allow the score to grow indefinitely only based on this factor though)
-
-			resultCode := byte(0)
-			if err != nil {
-				if re, ok := err.(requestResultErr); ok {
-					resultCode = re.ResultCode()
-				} else {
-					resultCode = 1
-				}
+				log.Debug("completed p2p sync range request", "num", pr.num, "count", pr.count)
 			}
-			s.metrics.ClientPayloadByNumberEvent(pr.num, resultCode, took)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// doRangeRequest fetches the blocks of the range newest-first, verifying that every block is the parent
+// of the block fetched before it. It stops at the first block that fails to be fetched or verified.
+func (s *SyncClient) doRangeRequest(ctx context.Context, rl *rate.Limiter, id peer.ID, pr peerRequest) error {
+	// the newest block of the range is verified later, once its child is promoted or it is found trusted
+	var expectedHash common.Hash
+	for i := uint64(0); i < pr.count; i++ {
+		num := pr.num - i
+		// the first request already took a token from the rate-limiter
+		if i > 0 {
+			if err := rl.WaitN(ctx, 1); err != nil {
+				return err
+			}
+		}
+		start := time.Now()
+		parentHash, err := s.doRequest(ctx, id, num, expectedHash)
+		took := time.Since(start)
+		// TODO(CLI-3732): update scores: depending on the speed of the result,
+		//  increase the p2p-sync part of the peer score
+		//  (don't allow the score to grow indefinitely only based on this factor though)
+
+		s.metrics.ClientPayloadByNumberEvent(num, clientResultCode(err), took)
+		if err != nil {
+			return fmt.Errorf("failed to fetch block %d: %w", num, err)
+		}
+		expectedHash = parentHash
+	}
+	return nil
+}
+
 type requestResultErr byte
 
 func (r requestResultErr) Error() string {
@@ -524,22 +582,36 @@ func (r requestResultErr) ResultCode() byte {
 	return byte(r)
 }
 
-func (s *SyncClient) doRequest(ctx context.Context, id peer.ID, n uint64) error {
+// clientResultCode returns the result code of a request to record in the metrics.
+func clientResultCode(err error) byte {
+	if err == nil {
+		return 0
+	}
+	var re requestResultErr
+	if errors.As(err, &re) {
+		return re.ResultCode()
+	}
+	return 1
+}
+
+// doRequest fetches the block of the given number, and verifies it has the expected hash, unless it is empty.
+// The parent hash of the block is returned, so that the next lower block can be verified against it.
+func (s *SyncClient) doRequest(ctx context.Context, id peer.ID, n uint64, expectedHash common.Hash) (common.Hash, error) {
 	// open stream to peer
 	reqCtx, reqCancel := context.WithTimeout(ctx, streamTimeout)
 	str, err := s.newStreamFn(reqCtx, id, s.payloadByNumber)
 	reqCancel()
 	if err != nil {
-		return fmt.Errorf("failed to open stream: %w", err)
+		return common.Hash{}, fmt.Errorf("failed to open stream: %w", err)
 	}
 	defer str.Close()
 	// set write timeout (if available)
 	_ = str.SetWriteDeadline(time.Now().Add(clientWriteRequestTimeout))
 	if err := binary.Write(str, binary.LittleEndian, n); err != nil {
-		return fmt.Errorf("failed to write request (%d): %w", n, err)
+		return common.Hash{}, fmt.Errorf("failed to write request (%d): %w", n, err)
 	}
 	if err := str.CloseWrite(); err != nil {
-		return fmt.Errorf("failed to close writer side while making request: %w", err)
+		return common.Hash{}, fmt.Errorf("failed to close writer side while making request: %w", err)
 	}
 
 	// set read timeout (if available)
@@ -551,18 +623,18 @@ func (s *SyncClient) doRequest(ctx context.Context, id peer.ID, n uint64) error
 	r := io.LimitReader(str, maxGossipSize)
 	var result [1]byte
 	if _, err := io.ReadFull(r, result[:]); err != nil {
-		return fmt.Errorf("failed to read result part of response: %w", err)
+		return common.Hash{}, fmt.Errorf("failed to read result part of response: %w", err)
 	}
 	if res := result[0]; res != 0 {
-		return requestResultErr(res)
+		return common.Hash{}, requestResultErr(res)
 	}
 	var versionData [4]byte
 	if _, err := io.ReadFull(r, versionData[:]); err != nil {
-		return fmt.Errorf("failed to read version part of response: %w", err)
+		return common.Hash{}, fmt.Errorf("failed to read version part of response: %w", err)
 	}
 	version := binary.LittleEndian.Uint32(versionData[:])
 	if version != 0 {
-		return fmt.Errorf("unrecognized ExecutionPayload version: %d", version)
+		return common.Hash{}, fmt.Errorf("unrecognized ExecutionPayload version: %d", version)
 	}
 	// payload is SSZ encoded with Snappy framed compression
 	r = snappy.NewReader(r)
@@ -571,24 +643,33 @@ func (s *SyncClient) doRequest(ctx context.Context, id peer.ID, n uint64) error
 	// The server does not prepend it, nor would we trust a claimed length anyway, so we buffer the data we get.
 	data, err := io.ReadAll(r)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		return common.Hash{}, fmt.Errorf("failed to read response: %w", err)
 	}
 	var res eth.ExecutionPayload
 	if err := res.UnmarshalSSZ(uint32(len(data)), bytes.NewReader(data)); err != nil {
-		return fmt.Errorf("failed to decode response: %w", err)
+		return common.Hash{}, fmt.Errorf("failed to decode response: %w", err)
 	}
 	if err := str.CloseRead(); err != nil {
-		return fmt.Errorf("failed to close reading side")
+		return common.Hash{}, fmt.Errorf("failed to close reading side")
 	}
-	if err := verifyBlock(&res, n); err != nil {
-		return fmt.Errorf("received execution payload is invalid: %w", err)
+	return s.receiveBlock(ctx, id, &res, n, expectedHash)
+}
+
+// receiveBlock verifies the fetched block has the expected number, and the expected hash unless it is empty,
+// and passes it on to be promoted once trusted. The parent hash of the block is returned.
+func (s *SyncClient) receiveBlock(ctx context.Context, id peer.ID, res *eth.ExecutionPayload, n uint64, expectedHash common.Hash) (common.Hash, error) {
+	if err := verifyBlock(res, n); err != nil {
+		return common.Hash{}, fmt.Errorf("received execution payload is invalid: %w", err)
+	}
+	if expectedHash != (common.Hash{}) && res.BlockHash != expectedHash {
+		return common.Hash{}, fmt.Errorf("received execution payload %s is not the parent %s of the previous block in the range", res.ID(), expectedHash)
 	}
 	select {
-	case s.results <- syncResult{payload: &res, peer: id}:
+	case s.results <- syncResult{payload: res, peer: id}:
 	case <-ctx.Done():
-		return fmt.Errorf("failed to process response, sync client is too busy: %w", err)
+		return common.Hash{}, fmt.Errorf("failed to process response, sync client is too busy: %w", ctx.Err())
 	}
-	return nil
+	return res.ParentHash, nil
 }
 
 func verifyBlock(payload *eth.ExecutionPayload, expectedNum uint64) error {
@@ -607,6 +688,14 @@ func verifyBlock(payload *eth.ExecutionPayload, expectedNum uint64) error {
 type peerStat struct {
 	// Requests tokenizes each request to sync
 	Requests *rate.Limiter
+
+	// inFlight is the number of requests of the peer being served
+	inFlight int
+	// violations is the number of requests of the peer rejected by the limits since violationsSince
+	violations      int
+	violationsSince time.Time
+	// bannedUntil is the time until which the requests of the peer are refused
+	bannedUntil time.Time
 }
 
 type L2Chain interface {
@@ -615,6 +704,9 @@ type L2Chain interface {
 
 type ReqRespServerMetrics interface {
 	ServerPayloadByNumberEvent(num uint64, resultCode byte, duration time.Duration)
+	ServerPayloadsByRangeEvent(num uint64, resultCode byte, duration time.Duration)
+	ServerRequestRejected(reason string)
+	ServerPeerBanned()
 }
 
 type ReqRespServer struct {
@@ -624,13 +716,17 @@ type ReqRespServer struct {
 
 	metrics ReqRespServerMetrics
 
+	limits ReqRespServerLimits
+
 	peerRateLimits *simplelru.LRU[peer.ID, *peerStat]
 	peerStatsLock  sync.Mutex
 
 	globalRequestsRL *rate.Limiter
+	// globalBandwidthRL is nil if the bandwidth is not limited
+	globalBandwidthRL *rate.Limiter
 }
 
-func NewReqRespServer(cfg *rollup.Config, l2 L2Chain, metrics ReqRespServerMetrics) *ReqRespServer {
+func NewReqRespServer(cfg *rollup.Config, l2 L2Chain, limits ReqRespServerLimits, metrics ReqRespServerMetrics) *ReqRespServer {
 	// We should never allow over 1000 different peers to churn through quickly,
 	// so it's fine to prune rate-limit details past this.
 
@@ -638,12 +734,15 @@ func NewReqRespServer(cfg *rollup.Config, l2 L2Chain, metrics ReqRespServerMetri
 	// 3 sync requests per second, with 2 burst
 	globalRequestsRL := rate.NewLimiter(globalServerBlocksRateLimit, globalServerBlocksBurst)
 
+	limits = limits.withDefaults()
 	return &ReqRespServer{
-		cfg:              cfg,
-		l2:               l2,
-		metrics:          metrics,
-		peerRateLimits:   peerRateLimits,
-		globalRequestsRL: globalRequestsRL,
+		cfg:               cfg,
+		l2:                l2,
+		metrics:           metrics,
+		limits:            limits,
+		peerRateLimits:    peerRateLimits,
+		globalRequestsRL:  globalRequestsRL,
+		globalBandwidthRL: limits.bandwidthLimiter(),
 	}
 }
 
@@ -663,55 +762,74 @@ func (srv *ReqRespServer) HandleSyncRequest(ctx context.Context, log log.Logger,
 	req, err := srv.handleSyncRequest(ctx, stream)
 	cancel()
 
-	resultCode := byte(0)
+	resultCode := serverResultCode(err)
 	if err != nil {
 		log.Warn("failed to serve p2p sync request", "req", req, "err", err)
-		if errors.Is(err, ethereum.NotFound) {
-			resultCode = 1
-		} else if errors.Is(err, invalidRequestErr) {
-			resultCode = 2
-		} else {
-			resultCode = 3
-		}
 		// try to write error code, so the other peer can understand the reason for failure.
 		_, _ = stream.Write([]byte{resultCode})
 	} else {
 		log.Debug("successfully served sync response", "req", req)
 	}
-	srv.metrics.ServerPayloadByNumberEvent(req, 0, time.Since(start))
+	srv.metrics.ServerPayloadByNumberEvent(req, resultCode, time.Since(start))
 }
 
 var invalidRequestErr = errors.New("invalid request")
 
+// serverResultCode returns the result code of a request served with the given error, sent to the requesting peer.
+func serverResultCode(err error) byte {
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, ethereum.NotFound):
+		return 1
+	case errors.Is(err, invalidRequestErr):
+		return 2
+	case errors.Is(err, errPeerBanned) || errors.Is(err, errPeerConcurrency) || errors.Is(err, errRateLimited):
+		return 4
+	default:
+		return 3
+	}
+}
+
+// checkRequestNumber checks the requested block number is within the expected range of blocks.
+func (srv *ReqRespServer) checkRequestNumber(req uint64) error {
+	if req < srv.cfg.Genesis.L2.Number {
+		return fmt.Errorf("cannot serve request for L2 block %d before genesis %d: %w", req, srv.cfg.Genesis.L2.Number, invalidRequestErr)
+	}
+	max, err := srv.cfg.TargetBlockNumber(uint64(time.Now().Unix()))
+	if err != nil {
+		return fmt.Errorf("cannot determine max target block number to verify request: %w", invalidRequestErr)
+	}
+	if req > max {
+		return fmt.Errorf("cannot serve request for L2 block %d after max expected block (%v): %w", req, max, invalidRequestErr)
+	}
+	return nil
+}
+
 func (srv *ReqRespServer) handleSyncRequest(ctx context.Context, stream network.Stream) (uint64, error) {
 	peerId := stream.Conn().RemotePeer()
 
+	// refuse banned peers and peers with too many requests in flight before doing any work for them
+	ps, err := srv.admit(peerId)
+	if err != nil {
+		return 0, err
+	}
+	defer srv.release(ps)
+
 	// take a token from the global rate-limiter,
 	// to make sure there's not too much concurrent server work between different peers.
 	if err := srv.globalRequestsRL.Wait(ctx); err != nil {
 		return 0, fmt.Errorf("timed out waiting for global sync rate limit: %w", err)
 	}
 
-	// find rate limiting data of peer, or add otherwise
-	srv.peerStatsLock.Lock()
-	ps, _ := srv.peerRateLimits.Get(peerId)
-	if ps == nil {
-		ps = &peerStat{
-			Requests: rate.NewLimiter(peerServerBlocksRateLimit, peerServerBlocksBurst),
-		}
-		srv.peerRateLimits.Add(peerId, ps)
-		ps.Requests.Reserve() // count the hit, but make it delay the next request rather than immediately waiting
-	} else {
-		// Only wait if it's an existing peer, otherwise the instant rate-limit Wait call always errors.
-
-		// If the requester thinks we're taking too long, then it's their problem and they can disconnect.
-		// We'll disconnect ourselves only when failing to read/write,
-		// if the work is invalid (range validation), or when individual sub tasks timeout.
-		if err := ps.Requests.Wait(ctx); err != nil {
-			return 0, fmt.Errorf("timed out waiting for global sync rate limit: %w", err)
-		}
+	// If the requester thinks we're taking too long, then it's their problem and they can disconnect.
+	// We'll disconnect ourselves only when failing to read/write,
+	// if the work is invalid (range validation), or when individual sub tasks timeout.
+	// The wait fails right away if the delay would exceed the max throttle delay, which counts against the peer.
+	if err := ps.Requests.Wait(ctx); err != nil {
+		srv.violate(ps, ServerRejectRateLimit)
+		return 0, fmt.Errorf("%w: timed out waiting for peer sync rate limit: %v", errRateLimited, err)
 	}
-	srv.peerStatsLock.Unlock()
 
 	// Set read deadline, if available
 	_ = stream.SetReadDeadline(time.Now().Add(serverReadRequestTimeout))
@@ -726,15 +844,8 @@ func (srv *ReqRespServer) handleSyncRequest(ctx context.Context, stream network.
 	}
 
 	// Check the request is within the expected range of blocks
-	if req < srv.cfg.Genesis.L2.Number {
-		return req, fmt.Errorf("cannot serve request for L2 block %d before genesis %d: %w", req, srv.cfg.Genesis.L2.Number, invalidRequestErr)
-	}
-	max, err := srv.cfg.TargetBlockNumber(uint64(time.Now().Unix()))
-	if err != nil {
-		return req, fmt.Errorf("cannot determine max target block number to verify request: %w", invalidRequestErr)
-	}
-	if req > max {
-		return req, fmt.Errorf("cannot serve request for L2 block %d after max expected block (%v): %w", req, max, invalidRequestErr)
+	if err := srv.checkRequestNumber(req); err != nil {
+		return req, err
 	}
 
 	payload, err := srv.l2.PayloadByNumber(ctx, req)
@@ -746,6 +857,14 @@ func (srv *ReqRespServer) handleSyncRequest(ctx context.Context, stream network.
 		}
 	}
 
+	// take the size of the payload from the global bandwidth budget
+	if srv.globalBandwidthRL != nil {
+		if err := srv.globalBandwidthRL.WaitN(ctx, int(payload.SizeSSZ())); err != nil {
+			srv.metrics.ServerRequestRejected(ServerRejectBandwidth)
+			return req, fmt.Errorf("%w: timed out waiting for global sync bandwidth: %v", errRateLimited, err)
+		}
+	}
+
 	// We set write deadline, if available, to safely write without blocking on a throttling peer connection
 	_ = stream.SetWriteDeadline(time.Now().Add(serverWriteChunkTimeout))
 
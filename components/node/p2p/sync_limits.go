@@ -0,0 +1,158 @@
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// Do not serve more than 2 requests of the same peer at the same time
+	peerServerBlocksConcurrency = 2
+	// Ban a peer temporarily once 20 of its requests were rejected by the limits within a minute
+	peerServerBanThreshold = 20
+	peerServerBanWindow    = time.Minute
+	peerServerBanDuration  = time.Minute * 10
+	// Do not serve more than 10 MiB of payloads per second to all peers together
+	globalServerBandwidth = 10 * (1 << 20)
+)
+
+const (
+	ServerRejectBanned      = "banned"
+	ServerRejectConcurrency = "concurrency"
+	ServerRejectRateLimit   = "rate_limit"
+	ServerRejectBandwidth   = "bandwidth"
+)
+
+var (
+	errPeerBanned      = errors.New("peer is temporarily banned from the sync server")
+	errPeerConcurrency = errors.New("too many concurrent requests of peer")
+	errRateLimited     = errors.New("rate limited")
+)
+
+// ReqRespServerLimits configures the limits applied by the req-resp sync server to the requests of its peers,
+// so that serving the payload history cannot degrade the node.
+type ReqRespServerLimits struct {
+	// PeerRateLimit is the number of requests per second served to a single peer.
+	PeerRateLimit float64
+	// PeerBurst is the number of requests a single peer may burst.
+	PeerBurst int
+	// PeerConcurrency is the number of requests of a single peer that are served at the same time.
+	PeerConcurrency int
+	// GlobalBandwidth is the number of payload bytes per second served to all peers together. 0 disables the budget.
+	GlobalBandwidth uint64
+	// BanThreshold is the number of requests of a peer rejected by the limits within a minute after which
+	// the peer is banned from the sync server for BanDuration. 0 disables the bans.
+	BanThreshold int
+	BanDuration  time.Duration
+}
+
+// DefaultReqRespServerLimits returns the limits applied when nothing else is configured.
+func DefaultReqRespServerLimits() ReqRespServerLimits {
+	return ReqRespServerLimits{
+		PeerRateLimit:   float64(peerServerBlocksRateLimit),
+		PeerBurst:       peerServerBlocksBurst,
+		PeerConcurrency: peerServerBlocksConcurrency,
+		GlobalBandwidth: globalServerBandwidth,
+		BanThreshold:    peerServerBanThreshold,
+		BanDuration:     peerServerBanDuration,
+	}
+}
+
+// withDefaults returns the limits with the unset per-peer limits replaced by their defaults.
+func (l ReqRespServerLimits) withDefaults() ReqRespServerLimits {
+	if l.PeerRateLimit == 0 {
+		l.PeerRateLimit = float64(peerServerBlocksRateLimit)
+	}
+	if l.PeerBurst == 0 {
+		l.PeerBurst = peerServerBlocksBurst
+	}
+	if l.PeerConcurrency == 0 {
+		l.PeerConcurrency = peerServerBlocksConcurrency
+	}
+	if l.BanDuration == 0 {
+		l.BanDuration = peerServerBanDuration
+	}
+	return l
+}
+
+func (l ReqRespServerLimits) Check() error {
+	if l.PeerRateLimit < 0 || l.PeerBurst < 0 || l.PeerConcurrency < 0 || l.BanThreshold < 0 || l.BanDuration < 0 {
+		return fmt.Errorf("sync server limits must not be negative: %+v", l)
+	}
+	return nil
+}
+
+// bandwidthLimiter returns the limiter of the global bandwidth budget, or nil if there is none.
+// The burst allows a payload of the max size to be served at once.
+func (l ReqRespServerLimits) bandwidthLimiter() *rate.Limiter {
+	if l.GlobalBandwidth == 0 {
+		return nil
+	}
+	burst := int(l.GlobalBandwidth)
+	if burst < maxGossipSize {
+		burst = maxGossipSize
+	}
+	return rate.NewLimiter(rate.Limit(l.GlobalBandwidth), burst)
+}
+
+// admit checks the ban and the concurrency limit of the peer before serving one of its requests,
+// and returns the stats of the peer. The caller must release the request once it is served.
+func (srv *ReqRespServer) admit(id peer.ID) (*peerStat, error) {
+	srv.peerStatsLock.Lock()
+	defer srv.peerStatsLock.Unlock()
+
+	ps, _ := srv.peerRateLimits.Get(id)
+	if ps == nil {
+		ps = &peerStat{
+			Requests: rate.NewLimiter(rate.Limit(srv.limits.PeerRateLimit), srv.limits.PeerBurst),
+		}
+		srv.peerRateLimits.Add(id, ps)
+	}
+
+	now := time.Now()
+	if now.Before(ps.bannedUntil) {
+		srv.metrics.ServerRequestRejected(ServerRejectBanned)
+		return nil, fmt.Errorf("%w until %s", errPeerBanned, ps.bannedUntil.Format(time.RFC3339))
+	}
+	if ps.inFlight >= srv.limits.PeerConcurrency {
+		srv.violateLocked(ps, now, ServerRejectConcurrency)
+		return nil, fmt.Errorf("%w: %d in flight", errPeerConcurrency, ps.inFlight)
+	}
+	ps.inFlight++
+	return ps, nil
+}
+
+// release marks a request of the peer admitted before as served.
+func (srv *ReqRespServer) release(ps *peerStat) {
+	srv.peerStatsLock.Lock()
+	defer srv.peerStatsLock.Unlock()
+	ps.inFlight--
+}
+
+// violate records a request of the peer rejected by the limits, banning the peer if it hits the threshold.
+func (srv *ReqRespServer) violate(ps *peerStat, reason string) {
+	srv.peerStatsLock.Lock()
+	defer srv.peerStatsLock.Unlock()
+	srv.violateLocked(ps, time.Now(), reason)
+}
+
+func (srv *ReqRespServer) violateLocked(ps *peerStat, now time.Time, reason string) {
+	srv.metrics.ServerRequestRejected(reason)
+	if srv.limits.BanThreshold == 0 {
+		return
+	}
+	if now.Sub(ps.violationsSince) > peerServerBanWindow {
+		ps.violations = 0
+		ps.violationsSince = now
+	}
+	ps.violations++
+	if ps.violations >= srv.limits.BanThreshold {
+		ps.bannedUntil = now.Add(srv.limits.BanDuration)
+		ps.violations = 0
+		srv.metrics.ServerPeerBanned()
+	}
+}
@@ -0,0 +1,64 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/metrics"
+)
+
+func TestReqRespServerLimits(t *testing.T) {
+	cfg, _, _ := setupSyncTestData(1)
+	srv := NewReqRespServer(cfg, mockPayloadFn(nil), ReqRespServerLimits{
+		PeerConcurrency: 1,
+		BanThreshold:    2,
+		BanDuration:     time.Hour,
+	}, metrics.NoopMetrics)
+	a, b := peer.ID("a"), peer.ID("b")
+
+	ps, err := srv.admit(a)
+	require.NoError(t, err)
+
+	// the peer hits the concurrency cap, while other peers are still served.
+	_, err = srv.admit(a)
+	require.ErrorIs(t, err, errPeerConcurrency)
+	psB, err := srv.admit(b)
+	require.NoError(t, err)
+	srv.release(psB)
+
+	// the second violation bans the peer, even once its request in flight is served.
+	_, err = srv.admit(a)
+	require.ErrorIs(t, err, errPeerConcurrency)
+	srv.release(ps)
+	_, err = srv.admit(a)
+	require.ErrorIs(t, err, errPeerBanned)
+
+	_, err = srv.admit(b)
+	require.NoError(t, err)
+}
+
+func TestReqRespServerLimits_NoBans(t *testing.T) {
+	cfg, _, _ := setupSyncTestData(1)
+	srv := NewReqRespServer(cfg, mockPayloadFn(nil), ReqRespServerLimits{PeerConcurrency: 1}, metrics.NoopMetrics)
+	a := peer.ID("a")
+
+	ps, err := srv.admit(a)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		_, err = srv.admit(a)
+		require.ErrorIs(t, err, errPeerConcurrency)
+	}
+	srv.release(ps)
+	_, err = srv.admit(a)
+	require.NoError(t, err, "peers are not banned if the threshold is 0")
+}
+
+func TestReqRespServerLimits_Check(t *testing.T) {
+	require.NoError(t, DefaultReqRespServerLimits().Check())
+	require.Error(t, ReqRespServerLimits{PeerBurst: -1}.Check())
+	require.Nil(t, ReqRespServerLimits{}.bandwidthLimiter())
+	require.Equal(t, maxGossipSize, ReqRespServerLimits{GlobalBandwidth: 1}.bandwidthLimiter().Burst())
+}
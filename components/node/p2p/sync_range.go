@@ -0,0 +1,251 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/golang/snappy"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"golang.org/x/time/rate"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// maxRangeRequestCount is the max number of payloads served in response to a single range request.
+const maxRangeRequestCount = 64
+
+var errRangeUnsupported = errors.New("peer does not serve payloads by range")
+
+func PayloadsByRangeProtocolID(l2ChainID *big.Int) protocol.ID {
+	return protocol.ID(fmt.Sprintf("/kroma-stack/req/payloads_by_range/%d/0", l2ChainID))
+}
+
+// rangeSyncRequest requests the payloads from Num down to Num-Count+1, newest-first,
+// so that every payload can be verified as the parent of the payload received before it.
+type rangeSyncRequest struct {
+	Num   uint64
+	Count uint64
+}
+
+// HandleRangeSyncRequest is a stream handler function to register the L2 unsafe payloads by range alt-sync protocol.
+// See MakeStreamHandler to transform this into a LibP2P handler function.
+//
+// The response is streamed in chunks, one per payload, each formatted like the response of a payload by number,
+// except that the snappy block-compressed payload is prefixed with its length. A chunk with a non-zero result code
+// ends the response.
+//
+// The caller must Close the stream.
+func (srv *ReqRespServer) HandleRangeSyncRequest(ctx context.Context, log log.Logger, stream network.Stream) {
+	start := time.Now()
+	req, err := srv.handleRangeSyncRequest(ctx, stream)
+
+	resultCode := serverResultCode(err)
+	if err != nil {
+		log.Warn("failed to serve p2p range sync request", "num", req.Num, "count", req.Count, "err", err)
+		// try to write error code, so the other peer can understand the reason for failure.
+		_, _ = stream.Write([]byte{resultCode})
+	} else {
+		log.Debug("successfully served range sync response", "num", req.Num, "count", req.Count)
+	}
+	srv.metrics.ServerPayloadsByRangeEvent(req.Num, resultCode, time.Since(start))
+}
+
+func (srv *ReqRespServer) handleRangeSyncRequest(ctx context.Context, stream network.Stream) (rangeSyncRequest, error) {
+	var req rangeSyncRequest
+	peerId := stream.Conn().RemotePeer()
+
+	// refuse banned peers and peers with too many requests in flight before doing any work for them
+	ps, err := srv.admit(peerId)
+	if err != nil {
+		return req, err
+	}
+	defer srv.release(ps)
+
+	// Set read deadline, if available
+	_ = stream.SetReadDeadline(time.Now().Add(serverReadRequestTimeout))
+
+	if err := binary.Read(stream, binary.LittleEndian, &req); err != nil {
+		return req, fmt.Errorf("failed to read requested block range: %w", err)
+	}
+	if err := stream.CloseRead(); err != nil {
+		return req, fmt.Errorf("failed to close reading-side of a P2P range sync request call: %w", err)
+	}
+
+	if req.Count == 0 || req.Count > maxRangeRequestCount || req.Count-1 > req.Num {
+		return req, fmt.Errorf("cannot serve request for %d L2 blocks down from %d: %w", req.Count, req.Num, invalidRequestErr)
+	}
+	if err := srv.checkRequestNumber(req.Num); err != nil {
+		return req, err
+	}
+	if err := srv.checkRequestNumber(req.Num - req.Count + 1); err != nil {
+		return req, err
+	}
+
+	for i := uint64(0); i < req.Count; i++ {
+		if err := srv.serveRangeChunk(ctx, stream, ps, req.Num-i); err != nil {
+			return req, err
+		}
+	}
+	return req, nil
+}
+
+// serveRangeChunk writes the chunk of the payload of the given number, counting it as a request against the limits.
+func (srv *ReqRespServer) serveRangeChunk(ctx context.Context, stream network.Stream, ps *peerStat, num uint64) error {
+	// Every payload is throttled like a single request,
+	// giving up once the delay reaches a threshold that is unreasonable to wait for.
+	ctx, cancel := context.WithTimeout(ctx, maxThrottleDelay)
+	defer cancel()
+
+	if err := srv.globalRequestsRL.Wait(ctx); err != nil {
+		return fmt.Errorf("timed out waiting for global sync rate limit: %w", err)
+	}
+	if err := ps.Requests.Wait(ctx); err != nil {
+		srv.violate(ps, ServerRejectRateLimit)
+		return fmt.Errorf("%w: timed out waiting for peer sync rate limit: %v", errRateLimited, err)
+	}
+
+	payload, err := srv.l2.PayloadByNumber(ctx, num)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve payload %d to serve to peer: %w", num, err)
+	}
+
+	// take the size of the payload from the global bandwidth budget
+	if srv.globalBandwidthRL != nil {
+		if err := srv.globalBandwidthRL.WaitN(ctx, int(payload.SizeSSZ())); err != nil {
+			srv.metrics.ServerRequestRejected(ServerRejectBandwidth)
+			return fmt.Errorf("%w: timed out waiting for global sync bandwidth: %v", errRateLimited, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if _, err := payload.MarshalSSZ(&buf); err != nil {
+		return fmt.Errorf("failed to encode payload %d: %w", num, err)
+	}
+	data := snappy.Encode(nil, buf.Bytes())
+
+	// We set write deadline, if available, to safely write without blocking on a throttling peer connection
+	_ = stream.SetWriteDeadline(time.Now().Add(serverWriteChunkTimeout))
+
+	// 0 - resultCode: success = 0
+	// 1:5 - version: 0
+	// 5:9 - length of the compressed payload
+	var header [9]byte
+	binary.LittleEndian.PutUint32(header[5:], uint32(len(data)))
+	if _, err := stream.Write(header[:]); err != nil {
+		return fmt.Errorf("failed to write response chunk header: %w", err)
+	}
+	if _, err := stream.Write(data); err != nil {
+		return fmt.Errorf("failed to write payload %d to range sync response: %w", num, err)
+	}
+	return nil
+}
+
+// doPayloadsByRange fetches the blocks of the range in a single request, verifying that every block is the parent
+// of the block fetched before it. It stops at the first block that fails to be fetched or verified.
+// errRangeUnsupported is returned if the peer only serves payloads by number.
+func (s *SyncClient) doPayloadsByRange(ctx context.Context, rl *rate.Limiter, id peer.ID, pr peerRequest) (err error) {
+	start := time.Now()
+	defer func() {
+		if !errors.Is(err, errRangeUnsupported) {
+			s.metrics.ClientPayloadsByRangeEvent(pr.num, clientResultCode(err), time.Since(start))
+		}
+	}()
+
+	// open stream to peer, falling back to the payloads by number protocol reveals whether the peer supports ranges
+	reqCtx, reqCancel := context.WithTimeout(ctx, streamTimeout)
+	str, err := s.newStreamFn(reqCtx, id, s.payloadsByRange, s.payloadByNumber)
+	reqCancel()
+	if err != nil {
+		return fmt.Errorf("failed to open stream: %w", err)
+	}
+	if str.Protocol() != s.payloadsByRange {
+		_ = str.Reset()
+		return errRangeUnsupported
+	}
+	defer str.Close()
+
+	// set write timeout (if available)
+	_ = str.SetWriteDeadline(time.Now().Add(clientWriteRequestTimeout))
+	if err := binary.Write(str, binary.LittleEndian, rangeSyncRequest{Num: pr.num, Count: pr.count}); err != nil {
+		return fmt.Errorf("failed to write range request (%d, %d): %w", pr.num, pr.count, err)
+	}
+	if err := str.CloseWrite(); err != nil {
+		return fmt.Errorf("failed to close writer side while making request: %w", err)
+	}
+
+	// the newest block of the range is verified later, once its child is promoted or it is found trusted
+	var expectedHash common.Hash
+	for i := uint64(0); i < pr.count; i++ {
+		num := pr.num - i
+		// the first block already took a token from the rate-limiter
+		if i > 0 {
+			if err := rl.WaitN(ctx, 1); err != nil {
+				return err
+			}
+		}
+		// set read timeout per chunk (if available)
+		_ = str.SetReadDeadline(time.Now().Add(clientReadResponsetimeout))
+		payload, err := readRangeChunk(str)
+		if err == nil {
+			expectedHash, err = s.receiveBlock(ctx, id, payload, num, expectedHash)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch block %d: %w", num, err)
+		}
+	}
+	if err := str.CloseRead(); err != nil {
+		return fmt.Errorf("failed to close reading side")
+	}
+	return nil
+}
+
+// readRangeChunk reads a single payload of a range sync response.
+func readRangeChunk(r io.Reader) (*eth.ExecutionPayload, error) {
+	var result [1]byte
+	if _, err := io.ReadFull(r, result[:]); err != nil {
+		return nil, fmt.Errorf("failed to read result part of response: %w", err)
+	}
+	if res := result[0]; res != 0 {
+		return nil, requestResultErr(res)
+	}
+	var header [8]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, fmt.Errorf("failed to read header part of response: %w", err)
+	}
+	if version := binary.LittleEndian.Uint32(header[:4]); version != 0 {
+		return nil, fmt.Errorf("unrecognized ExecutionPayload version: %d", version)
+	}
+	// Limit input, as well as output, to not be zip-bombed
+	size := binary.LittleEndian.Uint32(header[4:])
+	if size > uint32(snappy.MaxEncodedLen(maxGossipSize)) {
+		return nil, fmt.Errorf("compressed payload of %d bytes is too large", size)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if n, err := snappy.DecodedLen(data); err != nil {
+		return nil, fmt.Errorf("invalid snappy compression of response: %w", err)
+	} else if n > maxGossipSize {
+		return nil, fmt.Errorf("decoded payload of %d bytes is too large", n)
+	}
+	data, err := snappy.Decode(nil, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress response: %w", err)
+	}
+	var payload eth.ExecutionPayload
+	if err := payload.UnmarshalSSZ(uint32(len(data)), bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &payload, nil
+}
@@ -0,0 +1,64 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/metrics"
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+func TestPayloadsByRange(t *testing.T) {
+	log := testlog.Logger(t, log.LvlError)
+	cfg, payloads, _ := setupSyncTestData(10)
+
+	servePayload := mockPayloadFn(func(n uint64) (*eth.ExecutionPayload, error) {
+		p, ok := payloads[n]
+		if !ok || n == 3 {
+			return nil, ethereum.NotFound
+		}
+		return p, nil
+	})
+
+	mnet, err := mocknet.FullMeshConnected(3)
+	require.NoError(t, err, "failed to setup mocknet")
+	defer mnet.Close()
+	hostA, hostB, hostC := mnet.Hosts()[0], mnet.Hosts()[1], mnet.Hosts()[2]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := NewReqRespServer(cfg, servePayload, DefaultReqRespServerLimits(), metrics.NoopMetrics)
+	hostA.SetStreamHandler(PayloadByNumberProtocolID(cfg.L2ChainID), MakeStreamHandler(ctx, log, srv.HandleSyncRequest))
+	hostA.SetStreamHandler(PayloadsByRangeProtocolID(cfg.L2ChainID), MakeStreamHandler(ctx, log, srv.HandleRangeSyncRequest))
+	cl := NewSyncClient(log, cfg, hostB.NewStream, nil, metrics.NoopMetrics)
+	rl := rate.NewLimiter(rate.Inf, 1)
+
+	// the whole range is served in a single request, newest-first
+	require.NoError(t, cl.doPayloadsByRange(ctx, rl, hostA.ID(), peerRequest{num: 8, count: 4}))
+	for i := uint64(8); i > 4; i-- {
+		require.Equal(t, payloads[i].BlockHash, (<-cl.results).payload.BlockHash)
+	}
+
+	// the response ends at the first payload that cannot be served
+	err = cl.doPayloadsByRange(ctx, rl, hostA.ID(), peerRequest{num: 4, count: 3})
+	require.ErrorIs(t, err, requestResultErr(1))
+	require.Equal(t, payloads[4].BlockHash, (<-cl.results).payload.BlockHash)
+	require.Zero(t, len(cl.results))
+
+	// ranges below genesis are invalid
+	err = cl.doPayloadsByRange(ctx, rl, hostA.ID(), peerRequest{num: 1, count: 3})
+	require.ErrorIs(t, err, requestResultErr(2))
+
+	// peers that only serve payloads by number are recognized
+	hostC.SetStreamHandler(PayloadByNumberProtocolID(cfg.L2ChainID), MakeStreamHandler(ctx, log, srv.HandleSyncRequest))
+	err = cl.doPayloadsByRange(ctx, rl, hostC.ID(), peerRequest{num: 8, count: 4})
+	require.ErrorIs(t, err, errRangeUnsupported)
+}
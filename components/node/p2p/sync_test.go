@@ -102,7 +102,7 @@ func TestSinglePeerSync(t *testing.T) {
 	defer cancel()
 
 	// Setup host A as the server
-	srv := NewReqRespServer(cfg, servePayload, metrics.NoopMetrics)
+	srv := NewReqRespServer(cfg, servePayload, DefaultReqRespServerLimits(), metrics.NoopMetrics)
 	payloadByNumber := MakeStreamHandler(ctx, log.New("role", "server"), srv.HandleSyncRequest)
 	hostA.SetStreamHandler(PayloadByNumberProtocolID(cfg.L2ChainID), payloadByNumber)
 
@@ -152,7 +152,7 @@ func TestMultiPeerSync(t *testing.T) {
 		})
 
 		// Setup as server
-		srv := NewReqRespServer(cfg, servePayload, metrics.NoopMetrics)
+		srv := NewReqRespServer(cfg, servePayload, DefaultReqRespServerLimits(), metrics.NoopMetrics)
 		payloadByNumber := MakeStreamHandler(ctx, log.New("serve", "payloads_by_number"), srv.HandleSyncRequest)
 		h.SetStreamHandler(PayloadByNumberProtocolID(cfg.L2ChainID), payloadByNumber)
 
@@ -225,3 +225,61 @@ func TestMultiPeerSync(t *testing.T) {
 		require.Equal(t, exp.BlockHash, p.BlockHash, "expecting the correct payload")
 	}
 }
+
+func TestRangeRequestScheduling(t *testing.T) {
+	log := testlog.Logger(t, log.LvlError)
+	cfg, payloads, l2Ref := setupSyncTestData(30)
+
+	cl := NewSyncClient(log, cfg, nil, nil, metrics.NoopMetrics)
+	// a block waiting in quarantine splits the ranges
+	cl.quarantineByNum[15] = payloads[15].BlockHash
+
+	cl.onRangeRequest(context.Background(), rangeRequest{start: 2, end: l2Ref(30)})
+
+	var ranges [][2]uint64
+	for len(cl.peerRequests) > 0 {
+		pr := <-cl.peerRequests
+		ranges = append(ranges, [2]uint64{pr.num, pr.count})
+	}
+	require.Equal(t, [][2]uint64{{29, 8}, {21, 6}, {14, 8}, {6, 4}}, ranges)
+	require.Len(t, cl.inFlight, 26)
+
+	// in-flight blocks are not requested again
+	cl.onRangeRequest(context.Background(), rangeRequest{start: 2, end: l2Ref(30)})
+	require.Zero(t, len(cl.peerRequests))
+}
+
+func TestRangeRequestChainVerification(t *testing.T) {
+	log := testlog.Logger(t, log.LvlError)
+	cfg, payloads, _ := setupSyncTestData(10)
+
+	servePayload := mockPayloadFn(func(n uint64) (*eth.ExecutionPayload, error) {
+		p, ok := payloads[n]
+		if !ok {
+			return nil, ethereum.NotFound
+		}
+		return p, nil
+	})
+
+	mnet, err := mocknet.FullMeshConnected(2)
+	require.NoError(t, err, "failed to setup mocknet")
+	defer mnet.Close()
+	hostA, hostB := mnet.Hosts()[0], mnet.Hosts()[1]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := NewReqRespServer(cfg, servePayload, DefaultReqRespServerLimits(), metrics.NoopMetrics)
+	hostA.SetStreamHandler(PayloadByNumberProtocolID(cfg.L2ChainID), MakeStreamHandler(ctx, log, srv.HandleSyncRequest))
+	cl := NewSyncClient(log, cfg, hostB.NewStream, nil, metrics.NoopMetrics)
+
+	parentHash, err := cl.doRequest(ctx, hostA.ID(), 8, payloads[8].BlockHash)
+	require.NoError(t, err)
+	require.Equal(t, payloads[7].BlockHash, parentHash)
+	require.Equal(t, payloads[8].BlockHash, (<-cl.results).payload.BlockHash)
+
+	// a block that does not chain to the previous block of the range is rejected
+	_, err = cl.doRequest(ctx, hostA.ID(), 6, parentHash)
+	require.ErrorContains(t, err, "is not the parent")
+	require.Zero(t, len(cl.results))
+}
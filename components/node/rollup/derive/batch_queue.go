@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/kroma-network/kroma/components/node/eth"
@@ -27,6 +28,21 @@ import (
 // It is internally responsible for making sure that batches with L1 inclusions block outside it's
 // working range are not considered or pruned.
 
+// maxDroppedBatches is the number of most recently dropped batches that are retained by the batch queue,
+// for operators to audit missed proposer windows.
+const maxDroppedBatches = 128
+
+// DroppedBatch describes a batch that was dropped by the batch queue, and why it was dropped.
+type DroppedBatch struct {
+	Reason           BatchDropReason `json:"reason"`
+	L1InclusionBlock eth.BlockID     `json:"l1_inclusion_block"`
+	Epoch            eth.BlockID     `json:"epoch"`
+	Timestamp        uint64          `json:"timestamp"`
+	ParentHash       common.Hash     `json:"parent_hash"`
+	Transactions     int             `json:"transactions"`
+	L2SafeHead       eth.BlockID     `json:"l2_safe_head"`
+}
+
 type NextBatchProvider interface {
 	Origin() eth.L1BlockRef
 	NextBatch(ctx context.Context) (*BatchData, error)
@@ -44,14 +60,21 @@ type BatchQueue struct {
 
 	// batches in order of when we've first seen them, grouped by L2 timestamp
 	batches map[uint64][]*BatchWithL1InclusionBlock
+
+	// droppedBatches tracks the most recently dropped batches, at most maxDroppedBatches large.
+	// It is not cleared on resets, to retain the history of drops across reorgs.
+	droppedBatches []DroppedBatch
+
+	metrics Metrics
 }
 
 // NewBatchQueue creates a BatchQueue, which should be Reset(origin) before use.
-func NewBatchQueue(log log.Logger, cfg *rollup.Config, prev NextBatchProvider) *BatchQueue {
+func NewBatchQueue(log log.Logger, cfg *rollup.Config, prev NextBatchProvider, metrics Metrics) *BatchQueue {
 	return &BatchQueue{
-		log:    log,
-		config: cfg,
-		prev:   prev,
+		log:     log,
+		config:  cfg,
+		prev:    prev,
+		metrics: metrics,
 	}
 }
 
@@ -135,14 +158,40 @@ func (bq *BatchQueue) AddBatch(batch *BatchData, l2SafeHead eth.L2BlockRef) {
 		L1InclusionBlock: bq.origin,
 		Batch:            batch,
 	}
-	validity := CheckBatch(bq.config, bq.log, bq.l1Blocks, l2SafeHead, &data)
+	validity, reason := checkBatch(bq.config, bq.log, bq.l1Blocks, l2SafeHead, &data)
 	if validity == BatchDrop {
-		return // if we do drop the batch, CheckBatch will log the drop reason with WARN level.
+		// if we do drop the batch, CheckBatch will log the drop reason with WARN level.
+		bq.recordDroppedBatch(&data, reason, l2SafeHead)
+		return
 	}
 	bq.log.Debug("Adding batch", "batch_timestamp", batch.Timestamp, "parent_hash", batch.ParentHash, "batch_epoch", batch.Epoch(), "txs", len(batch.Transactions))
 	bq.batches[batch.Timestamp] = append(bq.batches[batch.Timestamp], &data)
 }
 
+// recordDroppedBatch keeps track of the dropped batch, and meters the drop by reason.
+func (bq *BatchQueue) recordDroppedBatch(batch *BatchWithL1InclusionBlock, reason BatchDropReason, l2SafeHead eth.L2BlockRef) {
+	bq.metrics.RecordDroppedBatch(string(reason))
+	if len(bq.droppedBatches) >= maxDroppedBatches {
+		bq.droppedBatches = append(bq.droppedBatches[:0], bq.droppedBatches[1:maxDroppedBatches]...)
+	}
+	bq.droppedBatches = append(bq.droppedBatches, DroppedBatch{
+		Reason:           reason,
+		L1InclusionBlock: batch.L1InclusionBlock.ID(),
+		Epoch:            batch.Batch.Epoch(),
+		Timestamp:        batch.Batch.Timestamp,
+		ParentHash:       batch.Batch.ParentHash,
+		Transactions:     len(batch.Batch.Transactions),
+		L2SafeHead:       l2SafeHead.ID(),
+	})
+}
+
+// DroppedBatches returns a copy of the most recently dropped batches, ordered from oldest to newest.
+func (bq *BatchQueue) DroppedBatches() []DroppedBatch {
+	out := make([]DroppedBatch, len(bq.droppedBatches))
+	copy(out, bq.droppedBatches)
+	return out
+}
+
 // deriveNextBatch derives the next batch to apply on top of the current L2 safe head,
 // following the validity rules imposed on consecutive batches,
 // based on currently available buffered batch and L1 origin information.
@@ -173,7 +222,7 @@ func (bq *BatchQueue) deriveNextBatch(ctx context.Context, outOfData bool, l2Saf
 	candidates := bq.batches[nextTimestamp]
 batchLoop:
 	for i, batch := range candidates {
-		validity := CheckBatch(bq.config, bq.log.New("batch_index", i), bq.l1Blocks, l2SafeHead, batch)
+		validity, reason := checkBatch(bq.config, bq.log.New("batch_index", i), bq.l1Blocks, l2SafeHead, batch)
 		switch validity {
 		case BatchFuture:
 			return nil, NewCriticalError(fmt.Errorf("found batch with timestamp %d marked as future batch, but expected timestamp %d", batch.Batch.Timestamp, nextTimestamp))
@@ -185,7 +234,9 @@ batchLoop:
 				"txs", len(batch.Batch.Transactions),
 				"l2_safe_head", l2SafeHead.ID(),
 				"l2_safe_head_time", l2SafeHead.Time,
+				"reason", reason,
 			)
+			bq.recordDroppedBatch(batch, reason, l2SafeHead)
 			continue
 		case BatchAccept:
 			nextBatch = batch
@@ -102,7 +102,7 @@ func TestBatchQueueNewOrigin(t *testing.T) {
 		origin:  l1[0],
 	}
 
-	bq := NewBatchQueue(log, cfg, input)
+	bq := NewBatchQueue(log, cfg, input, &testutils.TestDerivationMetrics{})
 	_ = bq.Reset(context.Background(), l1[0], eth.SystemConfig{})
 	require.Equal(t, []eth.L1BlockRef{l1[0]}, bq.l1Blocks)
 
@@ -164,7 +164,7 @@ func TestBatchQueueEager(t *testing.T) {
 		origin:  l1[0],
 	}
 
-	bq := NewBatchQueue(log, cfg, input)
+	bq := NewBatchQueue(log, cfg, input, &testutils.TestDerivationMetrics{})
 	_ = bq.Reset(context.Background(), l1[0], eth.SystemConfig{})
 	// Advance the origin
 	input.origin = l1[1]
@@ -214,7 +214,7 @@ func TestBatchQueueInvalidInternalAdvance(t *testing.T) {
 		origin:  l1[0],
 	}
 
-	bq := NewBatchQueue(log, cfg, input)
+	bq := NewBatchQueue(log, cfg, input, &testutils.TestDerivationMetrics{})
 	_ = bq.Reset(context.Background(), l1[0], eth.SystemConfig{})
 
 	// Load continuous batches for epoch 0
@@ -307,7 +307,7 @@ func TestBatchQueueMissing(t *testing.T) {
 		origin:  l1[0],
 	}
 
-	bq := NewBatchQueue(log, cfg, input)
+	bq := NewBatchQueue(log, cfg, input, &testutils.TestDerivationMetrics{})
 	_ = bq.Reset(context.Background(), l1[0], eth.SystemConfig{})
 
 	for i := 0; i < len(batches); i++ {
@@ -369,3 +369,61 @@ func TestBatchQueueMissing(t *testing.T) {
 	require.Empty(t, b.BatchV1.Transactions)
 	require.Equal(t, rollup.Epoch(1), b.EpochNum)
 }
+
+// TestBatchQueueDroppedBatches asserts that dropped batches are recorded with their drop reason.
+func TestBatchQueueDroppedBatches(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	l1 := L1Chain([]uint64{10, 20, 30})
+	safeHead := eth.L2BlockRef{
+		Hash:           mockHash(10, 2),
+		Number:         0,
+		ParentHash:     common.Hash{},
+		Time:           10,
+		L1Origin:       l1[0].ID(),
+		SequenceNumber: 0,
+	}
+	cfg := &rollup.Config{
+		Genesis: rollup.Genesis{
+			L2Time: 10,
+		},
+		BlockTime:          2,
+		MaxProposerDrift:   600,
+		ProposerWindowSize: 30,
+	}
+
+	oldBatch := b(10, l1[0])
+	wrongParent := b(12, l1[0])
+	wrongParent.ParentHash = common.Hash{0xaa}
+	batches := []*BatchData{oldBatch, wrongParent, nil}
+	errors := []error{nil, nil, io.EOF}
+
+	input := &fakeBatchQueueInput{
+		batches: batches,
+		errors:  errors,
+		origin:  l1[0],
+	}
+
+	var reasons []string
+	metrics := &testutils.TestDerivationMetrics{
+		FnRecordDroppedBatch: func(reason string) {
+			reasons = append(reasons, reason)
+		},
+	}
+	bq := NewBatchQueue(log, cfg, input, metrics)
+	_ = bq.Reset(context.Background(), l1[0], eth.SystemConfig{})
+	input.origin = l1[1]
+
+	for i := 0; i < len(batches); i++ {
+		b, _ := bq.NextBatch(context.Background(), safeHead)
+		require.Nil(t, b)
+	}
+
+	dropped := bq.DroppedBatches()
+	require.Len(t, dropped, 2)
+	require.Equal(t, BatchDropOldTimestamp, dropped[0].Reason)
+	require.Equal(t, uint64(10), dropped[0].Timestamp)
+	require.Equal(t, l1[1].ID(), dropped[0].L1InclusionBlock)
+	require.Equal(t, BatchDropParentMismatch, dropped[1].Reason)
+	require.Equal(t, l1[0].ID(), dropped[1].Epoch)
+	require.Equal(t, []string{string(BatchDropOldTimestamp), string(BatchDropParentMismatch)}, reasons)
+}
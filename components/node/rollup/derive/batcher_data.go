@@ -0,0 +1,70 @@
+package derive
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Data posted to the batch inbox is versioned by its first byte, the derivation
+// version, which determines how the rest of the data is parsed:
+//
+//	data = derivation_version ++ payload
+//
+// All formats posted to the batch inbox share this version namespace, so a new
+// format, e.g. a new frame encoding or DA commitment scheme, must get a new
+// derivation version instead of changing the payload of an existing one. Data
+// of unknown versions is skipped by the derivation, so that data posted before
+// a new version got introduced keeps its meaning. Changes to the data within a
+// channel, like a new compression algorithm, are versioned by the channel data
+// instead, see ChannelVersionZstd.
+const (
+	// DerivationVersion0 is the version byte of batcher data that carries one or more frames.
+	DerivationVersion0 = 0
+
+	// DerivationVersionDACommitment is the version byte of batcher data that only carries a
+	// DACommitment to the actual batcher data, which is stored on an external DA provider.
+	DerivationVersionDACommitment = 1
+)
+
+var (
+	ErrEmptyBatcherData         = errors.New("batcher data must not be empty")
+	ErrUnknownDerivationVersion = errors.New("unknown derivation version")
+	knownDerivationVersions     = map[byte]string{DerivationVersion0: "frames", DerivationVersionDACommitment: "da_commitment"}
+)
+
+// DerivationVersionName returns the name of the format of the derivation version,
+// or "unknown".
+func DerivationVersionName(version byte) string {
+	if name, ok := knownDerivationVersions[version]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// SplitBatcherData splits data posted to the batch inbox into its derivation
+// version and payload. It returns an error if the data is empty or its version
+// is unknown.
+func SplitBatcherData(data []byte) (byte, []byte, error) {
+	if len(data) == 0 {
+		return 0, nil, ErrEmptyBatcherData
+	}
+	if _, ok := knownDerivationVersions[data[0]]; !ok {
+		return data[0], nil, fmt.Errorf("%w: %d", ErrUnknownDerivationVersion, data[0])
+	}
+	return data[0], data[1:], nil
+}
+
+// EncodeBatcherData returns the data to post to the batch inbox for the
+// concatenated payloads of the given derivation version.
+func EncodeBatcherData(version byte, payloads ...[]byte) []byte {
+	size := 1
+	for _, p := range payloads {
+		size += len(p)
+	}
+	data := make([]byte, 0, size)
+	data = append(data, version)
+	for _, p := range payloads {
+		data = append(data, p...)
+	}
+	return data
+}
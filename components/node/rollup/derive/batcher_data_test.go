@@ -0,0 +1,31 @@
+package derive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitBatcherData(t *testing.T) {
+	data := EncodeBatcherData(DerivationVersion0, []byte{1, 2}, []byte{3})
+	require.Equal(t, []byte{DerivationVersion0, 1, 2, 3}, data)
+
+	version, payload, err := SplitBatcherData(data)
+	require.NoError(t, err)
+	require.Equal(t, byte(DerivationVersion0), version)
+	require.Equal(t, []byte{1, 2, 3}, payload)
+
+	_, _, err = SplitBatcherData(nil)
+	require.ErrorIs(t, err, ErrEmptyBatcherData)
+
+	version, _, err = SplitBatcherData([]byte{42, 1})
+	require.ErrorIs(t, err, ErrUnknownDerivationVersion)
+	require.Equal(t, byte(42), version)
+	require.Equal(t, "unknown", DerivationVersionName(version))
+}
+
+func TestParseFramesOtherVersion(t *testing.T) {
+	comm := NewKeccak256DACommitment([]byte{1})
+	_, err := ParseFrames(comm.TxData())
+	require.ErrorContains(t, err, "da_commitment")
+}
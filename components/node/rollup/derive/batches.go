@@ -26,10 +26,37 @@ const (
 	BatchFuture
 )
 
+// BatchDropReason describes why a batch was dropped by CheckBatch, or why the data source ignored a batcher tx.
+type BatchDropReason string
+
+const (
+	BatchDropNone               BatchDropReason = ""
+	BatchDropOldTimestamp       BatchDropReason = "old_timestamp"
+	BatchDropParentMismatch     BatchDropReason = "parent_mismatch"
+	BatchDropIncludedTooLate    BatchDropReason = "included_too_late"
+	BatchDropEpochTooOld        BatchDropReason = "epoch_too_old"
+	BatchDropEpochTooFarAhead   BatchDropReason = "epoch_too_far_ahead"
+	BatchDropEpochHashMismatch  BatchDropReason = "epoch_hash_mismatch"
+	BatchDropTimestampBeforeL1  BatchDropReason = "timestamp_before_l1_origin"
+	BatchDropProposerDrift      BatchDropReason = "proposer_drift_exceeded"
+	BatchDropEmptyTransaction   BatchDropReason = "empty_transaction"
+	BatchDropDepositTransaction BatchDropReason = "deposit_transaction"
+	// BatchDropInvalidSignature and BatchDropUnauthorizedSubmitter are the reasons of batcher txs
+	// ignored by the data source, before their batches are decoded.
+	BatchDropInvalidSignature      BatchDropReason = "invalid_signature"
+	BatchDropUnauthorizedSubmitter BatchDropReason = "unauthorized_submitter"
+)
+
 // CheckBatch checks if the given batch can be applied on top of the given l2SafeHead, given the contextual L1 blocks the batch was included in.
 // The first entry of the l1Blocks should match the origin of the l2SafeHead. One or more consecutive l1Blocks should be provided.
 // In case of only a single L1 block, the decision whether a batch is valid may have to stay undecided.
 func CheckBatch(cfg *rollup.Config, log log.Logger, l1Blocks []eth.L1BlockRef, l2SafeHead eth.L2BlockRef, batch *BatchWithL1InclusionBlock) BatchValidity {
+	validity, _ := checkBatch(cfg, log, l1Blocks, l2SafeHead, batch)
+	return validity
+}
+
+// checkBatch implements CheckBatch, and additionally returns the reason if the batch is dropped.
+func checkBatch(cfg *rollup.Config, log log.Logger, l1Blocks []eth.L1BlockRef, l2SafeHead eth.L2BlockRef, batch *BatchWithL1InclusionBlock) (BatchValidity, BatchDropReason) {
 	// add details to the log
 	log = log.New(
 		"batch_timestamp", batch.Batch.Timestamp,
@@ -41,30 +68,30 @@ func CheckBatch(cfg *rollup.Config, log log.Logger, l1Blocks []eth.L1BlockRef, l
 	// sanity check we have consistent inputs
 	if len(l1Blocks) == 0 {
 		log.Warn("missing L1 block input, cannot proceed with batch checking")
-		return BatchUndecided
+		return BatchUndecided, BatchDropNone
 	}
 	epoch := l1Blocks[0]
 
 	nextTimestamp := l2SafeHead.Time + cfg.BlockTime
 	if batch.Batch.Timestamp > nextTimestamp {
 		log.Trace("received out-of-order batch for future processing after next batch", "next_timestamp", nextTimestamp)
-		return BatchFuture
+		return BatchFuture, BatchDropNone
 	}
 	if batch.Batch.Timestamp < nextTimestamp {
 		log.Warn("dropping batch with old timestamp", "min_timestamp", nextTimestamp)
-		return BatchDrop
+		return BatchDrop, BatchDropOldTimestamp
 	}
 
 	// dependent on above timestamp check. If the timestamp is correct, then it must build on top of the safe head.
 	if batch.Batch.ParentHash != l2SafeHead.Hash {
 		log.Warn("ignoring batch with mismatching parent hash", "current_safe_head", l2SafeHead.Hash)
-		return BatchDrop
+		return BatchDrop, BatchDropParentMismatch
 	}
 
 	// Filter out batches that were included too late.
 	if uint64(batch.Batch.EpochNum)+cfg.ProposerWindowSize < batch.L1InclusionBlock.Number {
 		log.Warn("batch was included too late, proposer window expired")
-		return BatchDrop
+		return BatchDrop, BatchDropIncludedTooLate
 	}
 
 	// Check the L1 origin of the batch
@@ -72,7 +99,7 @@ func CheckBatch(cfg *rollup.Config, log log.Logger, l1Blocks []eth.L1BlockRef, l
 	if uint64(batch.Batch.EpochNum) < epoch.Number {
 		log.Warn("dropped batch, epoch is too old", "minimum", epoch.ID())
 		// batch epoch too old
-		return BatchDrop
+		return BatchDrop, BatchDropEpochTooOld
 	} else if uint64(batch.Batch.EpochNum) == epoch.Number {
 		// Batch is sticking to the current epoch, continue.
 	} else if uint64(batch.Batch.EpochNum) == epoch.Number+1 {
@@ -83,22 +110,22 @@ func CheckBatch(cfg *rollup.Config, log log.Logger, l1Blocks []eth.L1BlockRef, l
 		// algorithm.
 		if len(l1Blocks) < 2 {
 			log.Info("eager batch wants to advance epoch, but could not without more L1 blocks", "current_epoch", epoch.ID())
-			return BatchUndecided
+			return BatchUndecided, BatchDropNone
 		}
 		batchOrigin = l1Blocks[1]
 	} else {
 		log.Warn("batch is for future epoch too far ahead, while it has the next timestamp, so it must be invalid", "current_epoch", epoch.ID())
-		return BatchDrop
+		return BatchDrop, BatchDropEpochTooFarAhead
 	}
 
 	if batch.Batch.EpochHash != batchOrigin.Hash {
 		log.Warn("batch is for different L1 chain, epoch hash does not match", "expected", batchOrigin.ID())
-		return BatchDrop
+		return BatchDrop, BatchDropEpochHashMismatch
 	}
 
 	if batch.Batch.Timestamp < batchOrigin.Time {
 		log.Warn("batch timestamp is less than L1 origin timestamp", "l2_timestamp", batch.Batch.Timestamp, "l1_timestamp", batchOrigin.Time, "origin", batchOrigin.ID())
-		return BatchDrop
+		return BatchDrop, BatchDropTimestampBeforeL1
 	}
 
 	// Check if we ran out of proposer time drift
@@ -110,12 +137,12 @@ func CheckBatch(cfg *rollup.Config, log log.Logger, l1Blocks []eth.L1BlockRef, l
 			if epoch.Number == batchOrigin.Number {
 				if len(l1Blocks) < 2 {
 					log.Info("without the next L1 origin we cannot determine yet if this empty batch that exceeds the time drift is still valid")
-					return BatchUndecided
+					return BatchUndecided, BatchDropNone
 				}
 				nextOrigin := l1Blocks[1]
 				if batch.Batch.Timestamp >= nextOrigin.Time { // check if the next L1 origin could have been adopted
 					log.Info("batch exceeded proposer time drift without adopting next origin, and next L1 origin would have been valid")
-					return BatchDrop
+					return BatchDrop, BatchDropProposerDrift
 				} else {
 					log.Info("continuing with empty batch before late L1 block to preserve L2 time invariant")
 				}
@@ -124,7 +151,7 @@ func CheckBatch(cfg *rollup.Config, log log.Logger, l1Blocks []eth.L1BlockRef, l
 			// If the proposer is ignoring the time drift rule, then drop the batch and force an empty batch instead,
 			// as the proposer is not allowed to include anything past this point without moving to the next epoch.
 			log.Warn("batch exceeded proposer time drift, proposer must adopt new L1 origin to include transactions again", "max_time", max)
-			return BatchDrop
+			return BatchDrop, BatchDropProposerDrift
 		}
 	}
 
@@ -132,13 +159,13 @@ func CheckBatch(cfg *rollup.Config, log log.Logger, l1Blocks []eth.L1BlockRef, l
 	for i, txBytes := range batch.Batch.Transactions {
 		if len(txBytes) == 0 {
 			log.Warn("transaction data must not be empty, but found empty tx", "tx_index", i)
-			return BatchDrop
+			return BatchDrop, BatchDropEmptyTransaction
 		}
 		if txBytes[0] == types.DepositTxType {
 			log.Warn("proposers may not embed any deposits into batch data, but found tx that has one", "tx_index", i)
-			return BatchDrop
+			return BatchDrop, BatchDropDepositTransaction
 		}
 	}
 
-	return BatchAccept
+	return BatchAccept, BatchDropNone
 }
@@ -0,0 +1,33 @@
+package derive
+
+import (
+	"fmt"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// BlockLimits are soft limits of the blocks built by the proposer, below the hard limits of the chain.
+// They are not part of the consensus rules: blocks derived from L1 or received from the network are not checked.
+// Zeroed fields are disabled.
+type BlockLimits struct {
+	// MaxGasUsed is the maximum gas used by a built block.
+	MaxGasUsed uint64 `json:"max_gas_used"`
+	// MaxTxs is the maximum number of transactions in a built block, including forced transactions.
+	MaxTxs uint64 `json:"max_txs"`
+}
+
+// Enabled returns true if any of the limits is set.
+func (l BlockLimits) Enabled() bool {
+	return l.MaxGasUsed != 0 || l.MaxTxs != 0
+}
+
+// Check returns an error if the payload exceeds any of the limits.
+func (l BlockLimits) Check(payload *eth.ExecutionPayload) error {
+	if l.MaxGasUsed != 0 && uint64(payload.GasUsed) > l.MaxGasUsed {
+		return fmt.Errorf("payload %s uses %d gas, exceeding the limit of %d", payload.ID(), uint64(payload.GasUsed), l.MaxGasUsed)
+	}
+	if l.MaxTxs != 0 && uint64(len(payload.Transactions)) > l.MaxTxs {
+		return fmt.Errorf("payload %s has %d txs, exceeding the limit of %d", payload.ID(), len(payload.Transactions), l.MaxTxs)
+	}
+	return nil
+}
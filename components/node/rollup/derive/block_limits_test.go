@@ -0,0 +1,31 @@
+package derive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+func TestBlockLimitsCheck(t *testing.T) {
+	payload := &eth.ExecutionPayload{
+		GasUsed:      100_000,
+		Transactions: []eth.Data{{0x01}, {0x02}, {0x03}},
+	}
+
+	require.False(t, BlockLimits{}.Enabled())
+	require.NoError(t, BlockLimits{}.Check(payload))
+
+	gasLimits := BlockLimits{MaxGasUsed: 100_000}
+	require.True(t, gasLimits.Enabled())
+	require.NoError(t, gasLimits.Check(payload))
+	gasLimits.MaxGasUsed -= 1
+	require.Error(t, gasLimits.Check(payload))
+
+	txLimits := BlockLimits{MaxTxs: 3}
+	require.True(t, txLimits.Enabled())
+	require.NoError(t, txLimits.Check(payload))
+	txLimits.MaxTxs -= 1
+	require.Error(t, txLimits.Check(payload))
+}
@@ -30,15 +30,19 @@ type DataSourceFactory struct {
 	log     log.Logger
 	cfg     *rollup.Config
 	fetcher L1TransactionFetcher
+	da      DAFetcher
+	metrics Metrics
 }
 
-func NewDataSourceFactory(log log.Logger, cfg *rollup.Config, fetcher L1TransactionFetcher) *DataSourceFactory {
-	return &DataSourceFactory{log: log, cfg: cfg, fetcher: fetcher}
+// NewDataSourceFactory creates a new DataSourceFactory.
+// If da is nil, batches submitted as commitments to an external DA provider are skipped.
+func NewDataSourceFactory(log log.Logger, cfg *rollup.Config, fetcher L1TransactionFetcher, da DAFetcher, metrics Metrics) *DataSourceFactory {
+	return &DataSourceFactory{log: log, cfg: cfg, fetcher: fetcher, da: da, metrics: metrics}
 }
 
 // OpenData returns a DataIter. This struct implements the `Next` function.
 func (ds *DataSourceFactory) OpenData(ctx context.Context, id eth.BlockID, batcherAddr common.Address) DataIter {
-	return NewDataSource(ctx, ds.log, ds.cfg, ds.fetcher, id, batcherAddr)
+	return NewDataSource(ctx, ds.log, ds.cfg, ds.fetcher, ds.da, ds.metrics, id, batcherAddr)
 }
 
 // DataSource is a fault tolerant approach to fetching data.
@@ -52,30 +56,32 @@ type DataSource struct {
 	id      eth.BlockID
 	cfg     *rollup.Config // TODO: `DataFromEVMTransactions` should probably not take the full config
 	fetcher L1TransactionFetcher
+	da      DAFetcher
 	log     log.Logger
+	metrics Metrics
 
 	batcherAddr common.Address
 }
 
 // NewDataSource creates a new calldata source. It suppresses errors in fetching the L1 block if they occur.
 // If there is an error, it will attempt to fetch the result on the next call to `Next`.
-func NewDataSource(ctx context.Context, log log.Logger, cfg *rollup.Config, fetcher L1TransactionFetcher, block eth.BlockID, batcherAddr common.Address) DataIter {
-	_, txs, err := fetcher.InfoAndTxsByHash(ctx, block.Hash)
-	if err != nil {
-		return &DataSource{
-			open:        false,
-			id:          block,
-			cfg:         cfg,
-			fetcher:     fetcher,
-			log:         log,
-			batcherAddr: batcherAddr,
-		}
-	} else {
-		return &DataSource{
-			open: true,
-			data: DataFromEVMTransactions(cfg, batcherAddr, txs, log.New("origin", block)),
-		}
+// Once DA commitments are active, the DA commitments submitted in the block are resolved with da
+// to the batches they commit to.
+func NewDataSource(ctx context.Context, log log.Logger, cfg *rollup.Config, fetcher L1TransactionFetcher, da DAFetcher, metrics Metrics, block eth.BlockID, batcherAddr common.Address) DataIter {
+	ds := &DataSource{
+		id:          block,
+		cfg:         cfg,
+		fetcher:     fetcher,
+		da:          da,
+		log:         log,
+		metrics:     metrics,
+		batcherAddr: batcherAddr,
+	}
+	if data, err := ds.fetchData(ctx); err == nil {
+		ds.open = true
+		ds.data = data
 	}
+	return ds
 }
 
 // Next returns the next piece of data if it has it. If the constructor failed, this
@@ -83,11 +89,13 @@ func NewDataSource(ctx context.Context, log log.Logger, cfg *rollup.Config, fetc
 // otherwise it returns a temporary error if fetching the block returns an error.
 func (ds *DataSource) Next(ctx context.Context) (eth.Data, error) {
 	if !ds.open {
-		if _, txs, err := ds.fetcher.InfoAndTxsByHash(ctx, ds.id.Hash); err == nil {
+		if data, err := ds.fetchData(ctx); err == nil {
 			ds.open = true
-			ds.data = DataFromEVMTransactions(ds.cfg, ds.batcherAddr, txs, log.New("origin", ds.id))
+			ds.data = data
 		} else if errors.Is(err, ethereum.NotFound) {
 			return nil, NewResetError(fmt.Errorf("failed to open calldata source: %w", err))
+		} else if errors.Is(err, ErrCritical) {
+			return nil, err
 		} else {
 			return nil, NewTemporaryError(fmt.Errorf("failed to open calldata source: %w", err))
 		}
@@ -101,22 +109,80 @@ func (ds *DataSource) Next(ctx context.Context) (eth.Data, error) {
 	}
 }
 
+// fetchData fetches the batcher calldata of the block, and resolves the DA commitments among it.
+func (ds *DataSource) fetchData(ctx context.Context) ([]eth.Data, error) {
+	info, txs, err := ds.fetcher.InfoAndTxsByHash(ctx, ds.id.Hash)
+	if err != nil {
+		return nil, err
+	}
+	logger := ds.log.New("origin", ds.id)
+	data := dataFromEVMTransactions(ds.cfg, ds.batcherAddr, txs, logger, func(reason BatchDropReason) {
+		ds.metrics.RecordDroppedBatch(string(reason))
+	})
+	if !ds.cfg.IsDACommitment(info.Time()) {
+		// DA commitments are rejected by the frame queue, the same way on every node.
+		return data, nil
+	}
+	return ds.resolveCommitments(ctx, data, logger)
+}
+
+// resolveCommitments replaces DA commitments with the batcher data they commit to, fetched
+// from the external DA provider. Invalid commitments are skipped. Data that can't be fetched
+// fails the whole block, so that it is retried.
+// If no DA provider is configured, the derivation cannot proceed and a critical error is returned.
+func (ds *DataSource) resolveCommitments(ctx context.Context, data []eth.Data, logger log.Logger) ([]eth.Data, error) {
+	if ds.da == nil {
+		return nil, NewCriticalError(errors.New("DA commitments are active, but no DA provider is configured"))
+	}
+	out := data[:0]
+	for i, d := range data {
+		if len(d) == 0 || d[0] != DerivationVersionDACommitment {
+			out = append(out, d)
+			continue
+		}
+		comm, err := DecodeDACommitment(d)
+		if err != nil {
+			logger.Warn("skipping invalid DA commitment", "index", i, "err", err)
+			continue
+		}
+		input, err := ds.da.GetInput(ctx, comm)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch data of DA commitment %s: %w", comm, err)
+		}
+		if err := comm.Verify(input); err != nil {
+			logger.Warn("skipping DA commitment with mismatching data", "index", i, "commitment", comm, "err", err)
+			continue
+		}
+		out = append(out, input)
+	}
+	return out, nil
+}
+
 // DataFromEVMTransactions filters all of the transactions and returns the calldata from transactions
 // that are sent to the batch inbox address from the batch sender address.
 // This will return an empty array if no valid transactions are found.
 func DataFromEVMTransactions(config *rollup.Config, batcherAddr common.Address, txs types.Transactions, log log.Logger) []eth.Data {
+	return dataFromEVMTransactions(config, batcherAddr, txs, log, func(BatchDropReason) {})
+}
+
+// dataFromEVMTransactions is DataFromEVMTransactions, calling onDrop with the reason of every
+// transaction to the batch inbox that is ignored.
+func dataFromEVMTransactions(config *rollup.Config, batcherAddr common.Address, txs types.Transactions, log log.Logger, onDrop func(BatchDropReason)) []eth.Data {
 	var out []eth.Data
 	l1Signer := config.L1Signer()
 	for j, tx := range txs {
 		if to := tx.To(); to != nil && *to == config.BatchInboxAddress {
 			seqDataSubmitter, err := l1Signer.Sender(tx) // optimization: only derive sender if To is correct
 			if err != nil {
-				log.Warn("tx in inbox with invalid signature", "index", j, "err", err)
+				log.Warn("tx in inbox with invalid signature", "index", j, "reason", BatchDropInvalidSignature, "err", err)
+				onDrop(BatchDropInvalidSignature)
 				continue // bad signature, ignore
 			}
 			// some random L1 user might have sent a transaction to our batch inbox, ignore them
 			if seqDataSubmitter != batcherAddr {
-				log.Warn("tx in inbox with unauthorized submitter", "index", j, "err", err)
+				log.Warn("tx in inbox with unauthorized submitter", "index", j, "reason", BatchDropUnauthorizedSubmitter,
+					"submitter", seqDataSubmitter, "batcher", batcherAddr)
+				onDrop(BatchDropUnauthorizedSubmitter)
 				continue // not an authorized batch submitter, ignore
 			}
 			out = append(out, tx.Data())
@@ -1,11 +1,14 @@
 package derive
 
 import (
+	"context"
 	"crypto/ecdsa"
+	"io"
 	"math/big"
 	"math/rand"
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -125,3 +128,157 @@ func TestDataFromEVMTransactions(t *testing.T) {
 	}
 
 }
+
+type testDAFetcher struct {
+	inputs map[string]eth.Data
+}
+
+func (f *testDAFetcher) GetInput(ctx context.Context, commitment DACommitment) (eth.Data, error) {
+	input, ok := f.inputs[commitment.String()]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return input, nil
+}
+
+// TestDataSourceResolvesDACommitments asserts that DA commitments are replaced by the data they commit to,
+// and that invalid commitments or commitments to mismatching data are skipped.
+func TestDataSourceResolvesDACommitments(t *testing.T) {
+	inboxPriv := testutils.RandomKey()
+	batcherPriv := testutils.RandomKey()
+	daTime := uint64(0)
+	cfg := &rollup.Config{
+		L1ChainID:         big.NewInt(100),
+		BatchInboxAddress: crypto.PubkeyToAddress(inboxPriv.PublicKey),
+		DACommitmentTime:  &daTime,
+	}
+	batcherAddr := crypto.PubkeyToAddress(batcherPriv.PublicKey)
+	rng := rand.New(rand.NewSource(1234))
+	signer := cfg.L1Signer()
+
+	calldata := append([]byte{DerivationVersion0}, testutils.RandomData(rng, 100)...)
+	input := append([]byte{DerivationVersion0}, testutils.RandomData(rng, 200)...)
+	comm := NewKeccak256DACommitment(input)
+	mismatching := NewKeccak256DACommitment(testutils.RandomData(rng, 200))
+	da := &testDAFetcher{inputs: map[string]eth.Data{
+		comm.String():        input,
+		mismatching.String(): testutils.RandomData(rng, 200),
+	}}
+
+	var txs types.Transactions
+	for _, data := range [][]byte{
+		calldata,
+		comm.TxData(),
+		mismatching.TxData(),
+		{DerivationVersionDACommitment, DACommitmentKeccak256, 0x01},
+	} {
+		tx, err := types.SignNewTx(batcherPriv, signer, &types.DynamicFeeTx{
+			ChainID:   signer.ChainID(),
+			GasTipCap: big.NewInt(2 * params.GWei),
+			GasFeeCap: big.NewInt(30 * params.GWei),
+			Gas:       100_000,
+			To:        &cfg.BatchInboxAddress,
+			Data:      data,
+		})
+		require.NoError(t, err)
+		txs = append(txs, tx)
+	}
+
+	block := testutils.RandomBlockRef(rng)
+	l1F := &testutils.MockL1Source{}
+	l1F.ExpectInfoAndTxsByHash(block.Hash, testutils.RandomBlockInfo(rng), txs, nil)
+
+	src := NewDataSource(context.Background(), testlog.Logger(t, log.LvlCrit), cfg, l1F, da, &testutils.TestDerivationMetrics{}, block.ID(), batcherAddr)
+	for _, exp := range []eth.Data{calldata, input} {
+		data, err := src.Next(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, exp, data)
+	}
+	_, err := src.Next(context.Background())
+	require.ErrorIs(t, err, io.EOF)
+}
+
+// TestDataSourceDACommitmentActivation asserts that DA commitments are only resolved once they are active,
+// and that the derivation cannot proceed without a DA provider then.
+func TestDataSourceDACommitmentActivation(t *testing.T) {
+	batcherPriv := testutils.RandomKey()
+	daTime := uint64(1000)
+	cfg := &rollup.Config{
+		L1ChainID:         big.NewInt(100),
+		BatchInboxAddress: common.Address{0x42},
+		DACommitmentTime:  &daTime,
+	}
+	batcherAddr := crypto.PubkeyToAddress(batcherPriv.PublicKey)
+	rng := rand.New(rand.NewSource(1234))
+	signer := cfg.L1Signer()
+
+	input := append([]byte{DerivationVersion0}, testutils.RandomData(rng, 200)...)
+	comm := NewKeccak256DACommitment(input)
+	da := &testDAFetcher{inputs: map[string]eth.Data{comm.String(): input}}
+	tx, err := types.SignNewTx(batcherPriv, signer, &types.DynamicFeeTx{
+		ChainID:   signer.ChainID(),
+		GasTipCap: big.NewInt(2 * params.GWei),
+		GasFeeCap: big.NewInt(30 * params.GWei),
+		Gas:       100_000,
+		To:        &cfg.BatchInboxAddress,
+		Data:      comm.TxData(),
+	})
+	require.NoError(t, err)
+
+	newSource := func(time uint64, da DAFetcher, fetches int) DataIter {
+		block := testutils.RandomBlockRef(rng)
+		info := testutils.RandomBlockInfo(rng)
+		info.InfoTime = time
+		l1F := &testutils.MockL1Source{}
+		for i := 0; i < fetches; i++ {
+			l1F.ExpectInfoAndTxsByHash(block.Hash, info, types.Transactions{tx}, nil)
+		}
+		return NewDataSource(context.Background(), testlog.Logger(t, log.LvlCrit), cfg, l1F, da, &testutils.TestDerivationMetrics{}, block.ID(), batcherAddr)
+	}
+
+	data, err := newSource(daTime-1, da, 1).Next(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, eth.Data(comm.TxData()), data, "commitment is not resolved before activation")
+
+	data, err = newSource(daTime, da, 1).Next(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, eth.Data(input), data)
+
+	// the source fails to open on creation, and again on the first read
+	_, err = newSource(daTime, nil, 2).Next(context.Background())
+	require.ErrorIs(t, err, ErrCritical, "commitments cannot be resolved without a DA provider")
+}
+
+// TestDataSourceRecordsIgnoredTxs asserts that batcher txs of unauthorized submitters are metered with their drop reason.
+func TestDataSourceRecordsIgnoredTxs(t *testing.T) {
+	inboxPriv := testutils.RandomKey()
+	batcherPriv := testutils.RandomKey()
+	cfg := &rollup.Config{
+		L1ChainID:         big.NewInt(100),
+		BatchInboxAddress: crypto.PubkeyToAddress(inboxPriv.PublicKey),
+	}
+	batcherAddr := crypto.PubkeyToAddress(batcherPriv.PublicKey)
+	rng := rand.New(rand.NewSource(1234))
+	signer := cfg.L1Signer()
+
+	authorized := (&testTx{to: &cfg.BatchInboxAddress, dataLen: 100, author: batcherPriv}).Create(t, signer, rng)
+	unauthorized := (&testTx{to: &cfg.BatchInboxAddress, dataLen: 100, author: testutils.RandomKey()}).Create(t, signer, rng)
+
+	block := testutils.RandomBlockRef(rng)
+	l1F := &testutils.MockL1Source{}
+	l1F.ExpectInfoAndTxsByHash(block.Hash, testutils.RandomBlockInfo(rng), types.Transactions{unauthorized, authorized}, nil)
+
+	var dropped []string
+	metrics := &testutils.TestDerivationMetrics{
+		FnRecordDroppedBatch: func(reason string) {
+			dropped = append(dropped, reason)
+		},
+	}
+	src := NewDataSource(context.Background(), testlog.Logger(t, log.LvlCrit), cfg, l1F, nil, metrics, block.ID(), batcherAddr)
+	data, err := src.Next(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, eth.Data(authorized.Data()), data)
+	_, err = src.Next(context.Background())
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, []string{string(BatchDropUnauthorizedSubmitter)}, dropped)
+}
@@ -2,7 +2,6 @@ package derive
 
 import (
 	"bytes"
-	"compress/zlib"
 	"fmt"
 	"io"
 
@@ -145,9 +144,11 @@ func (ch *Channel) Reader() io.Reader {
 
 // BatchReader provides a function that iteratively consumes batches from the reader.
 // The L1Inclusion block is also provided at creation time.
-func BatchReader(r io.Reader, l1InclusionBlock eth.L1BlockRef) (func() (BatchWithL1InclusionBlock, error), error) {
+// zstd compressed channels are only read if zstdEnabled is set, zlib compressed channels are always read.
+// zstd compressed channels may only refer to the given dictionaries.
+func BatchReader(r io.Reader, l1InclusionBlock eth.L1BlockRef, zstdEnabled bool, zstdDicts [][]byte) (func() (BatchWithL1InclusionBlock, error), error) {
 	// Setup decompressor stage + RLP reader
-	zr, err := zlib.NewReader(r)
+	zr, err := newDecompressor(r, zstdEnabled, zstdDicts)
 	if err != nil {
 		return nil, err
 	}
@@ -2,7 +2,6 @@ package derive
 
 import (
 	"bytes"
-	"compress/zlib"
 	"crypto/rand"
 	"errors"
 	"fmt"
@@ -36,7 +35,9 @@ type ChannelOut struct {
 	rlpLength int
 
 	// Compressor stage. Write input data to it
-	compress *zlib.Writer
+	compress compressor
+	// algorithm of the compressor stage, determines the channel version prefix
+	algo CompressionAlgo
 	// post compression buffer
 	buf bytes.Buffer
 
@@ -48,17 +49,30 @@ func (co *ChannelOut) ID() ChannelID {
 }
 
 func NewChannelOut() (*ChannelOut, error) {
+	return NewChannelOutWithCompression(DefaultCompression)
+}
+
+// NewChannelOutWithCompression creates a channel out that compresses with the given compression config.
+func NewChannelOutWithCompression(comp CompressionConfig) (*ChannelOut, error) {
+	var id ChannelID // TODO: use GUID here instead of fully random data
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+	return NewChannelOutWithID(id, comp)
+}
+
+// NewChannelOutWithID creates a channel out with the given id. Adding the same batches to it
+// reproduces the frames of a previous channel out with that id, e.g. to resume it after a restart.
+func NewChannelOutWithID(id ChannelID, comp CompressionConfig) (*ChannelOut, error) {
 	c := &ChannelOut{
-		id:        ChannelID{}, // TODO: use GUID here instead of fully random data
+		id:        id,
 		frame:     0,
 		rlpLength: 0,
-	}
-	_, err := rand.Read(c.id[:])
-	if err != nil {
-		return nil, err
+		algo:      comp.Algo,
 	}
 
-	compress, err := zlib.NewWriterLevel(&c.buf, zlib.BestCompression)
+	c.buf.Write(channelVersionPrefix(c.algo))
+	compress, err := newCompressor(comp, &c.buf)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +86,7 @@ func (co *ChannelOut) Reset() error {
 	co.frame = 0
 	co.rlpLength = 0
 	co.buf.Reset()
+	co.buf.Write(channelVersionPrefix(co.algo))
 	co.compress.Reset(&co.buf)
 	co.closed = false
 	_, err := rand.Read(co.id[:])
@@ -0,0 +1,160 @@
+package derive
+
+import (
+	"bufio"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// CompressionAlgo is the algorithm channels are compressed with.
+type CompressionAlgo string
+
+const (
+	// CompressionZlib is the original channel compression, it is always accepted.
+	CompressionZlib CompressionAlgo = "zlib"
+	// CompressionZstd is only accepted in channels read from L1 blocks after the zstd compression activation.
+	CompressionZstd CompressionAlgo = "zstd"
+)
+
+var CompressionAlgos = []CompressionAlgo{CompressionZlib, CompressionZstd}
+
+// ChannelVersionZstd prefixes the compressed data of zstd compressed channels.
+// zlib streams never start with this byte, as the compression method of a zlib header is 8 (deflate).
+const ChannelVersionZstd byte = 0x01
+
+var ErrUnknownCompressionAlgo = errors.New("unknown compression algorithm")
+
+// CompressionConfig configures the compression of a channel.
+type CompressionConfig struct {
+	Algo CompressionAlgo
+	// Level is the compression level of the algorithm: 1-9 for zlib, 1-22 for zstd.
+	// If 0, the best compression of the algorithm is used.
+	Level int
+	// Dictionary is the shared zstd dictionary to compress with, if any. Only allowed for zstd.
+	Dictionary []byte
+}
+
+// DefaultCompression is the compression of channels unless configured otherwise.
+var DefaultCompression = CompressionConfig{Algo: CompressionZlib, Level: zlib.BestCompression}
+
+func (c CompressionConfig) Check() error {
+	switch c.Algo {
+	case CompressionZlib:
+		if c.Level < 0 || c.Level > zlib.BestCompression {
+			return fmt.Errorf("invalid zlib compression level %d, must be within 1-%d", c.Level, zlib.BestCompression)
+		}
+		if len(c.Dictionary) > 0 {
+			return errors.New("compression dictionaries are only supported by zstd")
+		}
+	case CompressionZstd:
+		if c.Level < 0 || c.Level > 22 {
+			return fmt.Errorf("invalid zstd compression level %d, must be within 1-22", c.Level)
+		}
+	default:
+		names := make([]string, 0, len(CompressionAlgos))
+		for _, algo := range CompressionAlgos {
+			names = append(names, string(algo))
+		}
+		return fmt.Errorf("%w %q, supported algorithms: %s", ErrUnknownCompressionAlgo, c.Algo, strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// compressor is the compression stage of a channel out.
+type compressor interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+// newCompressor creates a compressor writing to w.
+// The channel version prefix of the algorithm must be written to w before any compressed data.
+func newCompressor(cfg CompressionConfig, w io.Writer) (compressor, error) {
+	switch cfg.Algo {
+	case CompressionZlib:
+		level := cfg.Level
+		if level == 0 {
+			level = zlib.BestCompression
+		}
+		return zlib.NewWriterLevel(w, level)
+	case CompressionZstd:
+		level := zstd.SpeedBestCompression
+		if cfg.Level != 0 {
+			level = zstd.EncoderLevelFromZstd(cfg.Level)
+		}
+		// A single goroutine keeps the output deterministic, so that channels can be rebuilt from the same input.
+		opts := []zstd.EOption{zstd.WithEncoderLevel(level), zstd.WithEncoderConcurrency(1)}
+		if len(cfg.Dictionary) > 0 {
+			opts = append(opts, zstd.WithEncoderDict(cfg.Dictionary))
+		}
+		return zstd.NewWriter(w, opts...)
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownCompressionAlgo, cfg.Algo)
+	}
+}
+
+// channelVersionPrefix returns the prefix of channels compressed with the algorithm.
+func channelVersionPrefix(algo CompressionAlgo) []byte {
+	if algo == CompressionZstd {
+		return []byte{ChannelVersionZstd}
+	}
+	return nil
+}
+
+// newDecompressor detects the compression of the channel data and returns a reader of the decompressed data.
+// zstd compressed channels are only read if zstdEnabled is set, and may only refer to the given dictionaries.
+func newDecompressor(r io.Reader, zstdEnabled bool, zstdDicts [][]byte) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	version, err := br.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+	if version[0] != ChannelVersionZstd {
+		return zlib.NewReader(br)
+	}
+	if !zstdEnabled {
+		return nil, errors.New("zstd compressed channel before zstd compression activation")
+	}
+	if _, err := br.Discard(1); err != nil {
+		return nil, err
+	}
+	// No more than MaxRLPBytesPerChannel bytes are read from a channel,
+	// so the decoder does not need a larger window, and channels that require one are rejected.
+	opts := []zstd.DOption{
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxMemory(MaxRLPBytesPerChannel),
+		zstd.WithDecoderMaxWindow(MaxRLPBytesPerChannel),
+	}
+	if len(zstdDicts) > 0 {
+		opts = append(opts, zstd.WithDecoderDicts(zstdDicts...))
+	}
+	dec, err := zstd.NewReader(br, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &zstdReader{dec: dec}, nil
+}
+
+// zstdReader reads from a zstd decoder, and closes the decoder to release its resources
+// as soon as the decompressed data is read to the end or the decoding fails.
+type zstdReader struct {
+	dec *zstd.Decoder
+	err error
+}
+
+func (r *zstdReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	n, err := r.dec.Read(p)
+	if err != nil {
+		r.err = err
+		r.dec.Close()
+	}
+	return n, err
+}
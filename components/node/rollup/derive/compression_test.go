@@ -0,0 +1,140 @@
+package derive
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/testutils"
+)
+
+// channelFromBatches compresses the batches into a channel out and reads its frames back into a channel.
+func channelFromBatches(t *testing.T, comp CompressionConfig, batches []*BatchData) *Channel {
+	co, err := NewChannelOutWithCompression(comp)
+	require.NoError(t, err)
+	for _, batch := range batches {
+		_, err := co.AddBatch(batch)
+		require.NoError(t, err)
+	}
+	require.NoError(t, co.Close())
+
+	ch := NewChannel(co.ID(), eth.L1BlockRef{})
+	for {
+		var buf bytes.Buffer
+		_, err := co.OutputFrame(&buf, 1000)
+		if err != nil && !errors.Is(err, io.EOF) {
+			require.NoError(t, err)
+		}
+		var f Frame
+		require.NoError(t, f.UnmarshalBinary(bytes.NewReader(buf.Bytes())))
+		require.NoError(t, ch.AddFrame(f, eth.L1BlockRef{}))
+		if errors.Is(err, io.EOF) {
+			break
+		}
+	}
+	require.True(t, ch.IsReady())
+	return ch
+}
+
+func randomBatches(rng *rand.Rand) []*BatchData {
+	var batches []*BatchData
+	for i := 0; i < 10; i++ {
+		batches = append(batches, &BatchData{BatchV1{
+			ParentHash:   testutils.RandomHash(rng),
+			EpochHash:    testutils.RandomHash(rng),
+			Timestamp:    rng.Uint64(),
+			Transactions: []hexutil.Bytes{testutils.RandomData(rng, 200)},
+		}})
+	}
+	return batches
+}
+
+func TestCompressionRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	batches := randomBatches(rng)
+
+	for _, comp := range []CompressionConfig{
+		DefaultCompression,
+		{Algo: CompressionZlib, Level: 1},
+		{Algo: CompressionZstd},
+		{Algo: CompressionZstd, Level: 3},
+	} {
+		comp := comp
+		t.Run(string(comp.Algo), func(t *testing.T) {
+			require.NoError(t, comp.Check())
+			ch := channelFromBatches(t, comp, batches)
+
+			next, err := BatchReader(ch.Reader(), eth.L1BlockRef{}, true, nil)
+			require.NoError(t, err)
+			for _, batch := range batches {
+				out, err := next()
+				require.NoError(t, err)
+				require.Equal(t, batch, out.Batch)
+			}
+		})
+	}
+}
+
+func TestZstdCompressionRequiresActivation(t *testing.T) {
+	ch := channelFromBatches(t, CompressionConfig{Algo: CompressionZstd}, []*BatchData{{}})
+	_, err := BatchReader(ch.Reader(), eth.L1BlockRef{}, false, nil)
+	require.Error(t, err)
+}
+
+func TestZstdDictionaryRoundTrip(t *testing.T) {
+	// testdata/zstd.dict was trained with `zstd --train --maxdict=2048`.
+	dict, err := os.ReadFile("testdata/zstd.dict")
+	require.NoError(t, err)
+	comp := CompressionConfig{Algo: CompressionZstd, Dictionary: dict}
+	require.NoError(t, comp.Check())
+
+	batches := randomBatches(rand.New(rand.NewSource(1234)))
+	ch := channelFromBatches(t, comp, batches)
+	next, err := BatchReader(ch.Reader(), eth.L1BlockRef{}, true, [][]byte{dict})
+	require.NoError(t, err)
+	for _, batch := range batches {
+		out, err := next()
+		require.NoError(t, err)
+		require.Equal(t, batch, out.Batch)
+	}
+
+	// Channels referring to a dictionary that is not active can't be read.
+	ch = channelFromBatches(t, comp, batches)
+	next, err = BatchReader(ch.Reader(), eth.L1BlockRef{}, true, nil)
+	if err == nil {
+		_, err = next()
+	}
+	require.Error(t, err)
+}
+
+func TestZstdWindowLimit(t *testing.T) {
+	// The window is larger than the data that is read from a channel.
+	var buf bytes.Buffer
+	buf.WriteByte(ChannelVersionZstd)
+	w, err := zstd.NewWriter(&buf, zstd.WithWindowSize(32<<20))
+	require.NoError(t, err)
+	_, err = w.Write(testutils.RandomData(rand.New(rand.NewSource(1234)), 1<<20))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	next, err := BatchReader(&buf, eth.L1BlockRef{}, true, nil)
+	if err == nil {
+		_, err = next()
+	}
+	require.ErrorIs(t, err, zstd.ErrWindowSizeExceeded)
+}
+
+func TestCompressionConfigCheck(t *testing.T) {
+	require.ErrorIs(t, CompressionConfig{Algo: "brotli"}.Check(), ErrUnknownCompressionAlgo)
+	require.Error(t, CompressionConfig{Algo: CompressionZlib, Level: 10}.Check())
+	require.Error(t, CompressionConfig{Algo: CompressionZstd, Level: 23}.Check())
+	require.Error(t, CompressionConfig{Algo: CompressionZlib, Dictionary: []byte{0x01}}.Check())
+}
@@ -0,0 +1,70 @@
+package derive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// DACommitmentKeccak256 is the type of commitments to the keccak256 hash of the batcher data.
+const DACommitmentKeccak256 byte = 0
+
+var (
+	ErrInvalidDACommitment  = errors.New("invalid DA commitment")
+	ErrDACommitmentMismatch = errors.New("data does not match DA commitment")
+)
+
+// DACommitment is a commitment to batcher data that is stored on an external DA provider.
+// It is encoded as the commitment type followed by the type-specific commitment, and is
+// submitted to L1 prefixed by DerivationVersionDACommitment:
+//
+//	data = DerivationVersionDACommitment ++ commitment_type ++ commitment
+type DACommitment []byte
+
+// NewKeccak256DACommitment creates a commitment to the keccak256 hash of the given data.
+func NewKeccak256DACommitment(data []byte) DACommitment {
+	return append(DACommitment{DACommitmentKeccak256}, crypto.Keccak256(data)...)
+}
+
+// DecodeDACommitment decodes the commitment of batcher data submitted to L1.
+func DecodeDACommitment(data []byte) (DACommitment, error) {
+	version, payload, err := SplitBatcherData(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDACommitment, err)
+	}
+	if version != DerivationVersionDACommitment {
+		return nil, fmt.Errorf("%w: unexpected derivation version", ErrInvalidDACommitment)
+	}
+	c := DACommitment(payload)
+	if len(c) != 1+common.HashLength || c[0] != DACommitmentKeccak256 {
+		return nil, fmt.Errorf("%w: unknown commitment type or length", ErrInvalidDACommitment)
+	}
+	return c, nil
+}
+
+// TxData returns the data to submit to L1 for the commitment.
+func (c DACommitment) TxData() []byte {
+	return EncodeBatcherData(DerivationVersionDACommitment, c)
+}
+
+// Verify checks that the data matches the commitment.
+func (c DACommitment) Verify(data []byte) error {
+	if common.BytesToHash(crypto.Keccak256(data)) != common.BytesToHash(c[1:]) {
+		return ErrDACommitmentMismatch
+	}
+	return nil
+}
+
+func (c DACommitment) String() string {
+	return common.Bytes2Hex(c)
+}
+
+// DAFetcher retrieves batcher data from an external DA provider by its commitment.
+type DAFetcher interface {
+	GetInput(ctx context.Context, commitment DACommitment) (eth.Data, error)
+}
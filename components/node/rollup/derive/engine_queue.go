@@ -51,6 +51,13 @@ type EngineControl interface {
 	StartPayload(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes, updateSafe bool) (errType BlockInsertionErrType, err error)
 	// ConfirmPayload requests the engine to complete the current block. If no block is being built, or if it fails, an error is returned.
 	ConfirmPayload(ctx context.Context) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error)
+	// ConfirmPayloadAndStart completes the current block like ConfirmPayload, and starts building the next block
+	// on top of it with the same forkchoice update, using the attributes returned by nextAttrs.
+	// Whether the next block is being built can be checked with BuildingPayload.
+	ConfirmPayloadAndStart(ctx context.Context, nextAttrs func(parent eth.L2BlockRef) (*eth.PayloadAttributes, error)) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error)
+	// InsertPayload completes the current block with the given payload instead, which must be built onto the same parent.
+	// If the payload is rejected, the building job of the engine is left untouched, and may still be confirmed.
+	InsertPayload(ctx context.Context, payload *eth.ExecutionPayload) (errTyp BlockInsertionErrType, err error)
 	// CancelPayload requests the engine to stop building the current block without making it canonical.
 	// This is optional, as the engine expires building jobs that are left uncompleted, but can still save resources.
 	CancelPayload(ctx context.Context, force bool) error
@@ -93,9 +100,15 @@ type EngineQueue struct {
 	safeHead   eth.L2BlockRef
 	unsafeHead eth.L2BlockRef
 
-	buildingOnto eth.L2BlockRef
-	buildingID   eth.PayloadID
-	buildingSafe bool
+	buildingOnto  eth.L2BlockRef
+	buildingID    eth.PayloadID
+	buildingSafe  bool
+	buildingAttrs *eth.PayloadAttributes
+	// buildingResumed is true if the building job was resumed from an interrupted build
+	buildingResumed bool
+
+	// interrupted is the latest unsafe building job that was dropped before sealing, nil if none.
+	interrupted *interruptedBuild
 
 	// Track when the rollup node changes the forkchoice without engine action,
 	// e.g. on a reset after a reorg, or after consolidating a block.
@@ -120,6 +133,21 @@ type EngineQueue struct {
 
 	metrics   Metrics
 	l1Fetcher L1Fetcher
+
+	// prefetcher fetches the data of the next origin in the background, nil if disabled.
+	prefetcher *originPrefetcher
+
+	// haltTarget is the point beyond which no L2 blocks are derived or inserted.
+	haltTarget HaltTarget
+
+	// blockLimits are the soft limits of the unsafe blocks built on top of the unsafe head.
+	blockLimits BlockLimits
+
+	// onUnsafeDivergence is called when an unsafe block does not match the derived block, nil if not set.
+	onUnsafeDivergence func(UnsafeDivergence)
+
+	// onPayloadSealed is called with every built unsafe payload before it is inserted, nil if not set.
+	onPayloadSealed func(*eth.ExecutionPayload)
 }
 
 var _ EngineControl = (*EngineQueue)(nil)
@@ -138,6 +166,16 @@ func NewEngineQueue(log log.Logger, cfg *rollup.Config, engine Engine, metrics M
 	}
 }
 
+// SetOriginPrefetch makes the engine queue prefetch the data of the next L1 origins
+// while the data of the current origin is being consumed, until ctx is done.
+func (eq *EngineQueue) SetOriginPrefetch(ctx context.Context, cfg PrefetchConfig) {
+	if !cfg.Enabled() {
+		eq.prefetcher = nil
+		return
+	}
+	eq.prefetcher = newOriginPrefetcher(ctx, eq.log, eq.l1Fetcher, cfg)
+}
+
 // Origin identifies the L1 chain (incl.) that included and/or produced all the safe L2 blocks.
 func (eq *EngineQueue) Origin() eth.L1BlockRef {
 	return eq.origin
@@ -147,6 +185,29 @@ func (eq *EngineQueue) SystemConfig() eth.SystemConfig {
 	return eq.sysCfg
 }
 
+// SetHaltTarget configures the engine queue to stop processing L2 blocks beyond the given target.
+func (eq *EngineQueue) SetHaltTarget(target HaltTarget) {
+	eq.haltTarget = target
+}
+
+// SetBlockLimits configures the soft limits of the unsafe blocks built by the engine queue.
+// The transactions of a built payload that exceeds the limits are capped to the limits.
+func (eq *EngineQueue) SetBlockLimits(limits BlockLimits) {
+	eq.blockLimits = limits
+}
+
+// SetUnsafeDivergenceHandler configures the function that is called whenever an unsafe block
+// does not match the block derived from L1 at the same height.
+func (eq *EngineQueue) SetUnsafeDivergenceHandler(fn func(UnsafeDivergence)) {
+	eq.onUnsafeDivergence = fn
+}
+
+// SetPayloadSealedHandler configures the function that is called with every unsafe payload
+// built on top of the unsafe head, after it is sealed and before it is inserted into the engine.
+func (eq *EngineQueue) SetPayloadSealedHandler(fn func(*eth.ExecutionPayload)) {
+	eq.onPayloadSealed = fn
+}
+
 func (eq *EngineQueue) SetUnsafeHead(head eth.L2BlockRef) {
 	eq.unsafeHead = head
 	eq.metrics.RecordL2Ref("l2_unsafe", head)
@@ -157,6 +218,17 @@ func (eq *EngineQueue) AddUnsafePayload(payload *eth.ExecutionPayload) {
 		eq.log.Warn("cannot add nil unsafe payload")
 		return
 	}
+	if eq.haltTarget.Enabled() {
+		ref, err := PayloadToBlockRef(payload, &eq.cfg.Genesis)
+		if err != nil {
+			eq.log.Warn("Could not decode unsafe payload", "id", payload.ID(), "err", err)
+			return
+		}
+		if eq.haltTarget.Exceeds(ref.Number, ref.L1Origin.Number) {
+			eq.log.Warn("Refusing unsafe payload beyond halt target", "id", payload.ID(), "l1_origin", ref.L1Origin, "target", eq.haltTarget)
+			return
+		}
+	}
 	if err := eq.unsafePayloads.Push(payload); err != nil {
 		eq.log.Warn("Could not add unsafe payload", "id", payload.ID(), "timestamp", uint64(payload.Timestamp), "err", err)
 		return
@@ -217,6 +289,9 @@ func (eq *EngineQueue) Step(ctx context.Context) error {
 	}
 	eq.origin = newOrigin
 	eq.postProcessSafeL2() // make sure we track the last L2 safe head for every new L1 block
+	if eq.prefetcher != nil {
+		eq.prefetcher.PrefetchNext(eq.origin)
+	}
 	if next, err := eq.prev.NextAttributes(ctx, eq.safeHead); err == io.EOF {
 		outOfData = true
 	} else if err != nil {
@@ -248,13 +323,13 @@ func (eq *EngineQueue) verifyNewL1Origin(ctx context.Context, newOrigin eth.L1Bl
 	}
 	unsafeOrigin := eq.unsafeHead.L1Origin
 	if newOrigin.Number == unsafeOrigin.Number && newOrigin.ID() != unsafeOrigin {
-		return NewResetError(fmt.Errorf("l1 origin was inconsistent with l2 unsafe head origin, need reset to resolve: l1 origin: %v; unsafe origin: %v",
-			newOrigin.ID(), unsafeOrigin))
+		return NewResetErrorWithReason(fmt.Errorf("l1 origin was inconsistent with l2 unsafe head origin, need reset to resolve: l1 origin: %v; unsafe origin: %v",
+			newOrigin.ID(), unsafeOrigin), ResetReasonL1Reorg)
 	}
 	// Avoid requesting an older block by checking against the parent hash
 	if newOrigin.Number == unsafeOrigin.Number+1 && newOrigin.ParentHash != unsafeOrigin.Hash {
-		return NewResetError(fmt.Errorf("l2 unsafe head origin is no longer canonical, need reset to resolve: canonical hash: %v; unsafe origin hash: %v",
-			newOrigin.ParentHash, unsafeOrigin.Hash))
+		return NewResetErrorWithReason(fmt.Errorf("l2 unsafe head origin is no longer canonical, need reset to resolve: canonical hash: %v; unsafe origin hash: %v",
+			newOrigin.ParentHash, unsafeOrigin.Hash), ResetReasonL1Reorg)
 	}
 	if newOrigin.Number > unsafeOrigin.Number+1 {
 		// If unsafe origin is further behind new origin, check it's still on the canonical chain.
@@ -264,8 +339,8 @@ func (eq *EngineQueue) verifyNewL1Origin(ctx context.Context, newOrigin eth.L1Bl
 		}
 		if canonical.ID() != unsafeOrigin {
 			eq.log.Error("Resetting due to origin mismatch")
-			return NewResetError(fmt.Errorf("l2 unsafe head origin is no longer canonical, need reset to resolve: canonical: %v; unsafe origin: %v",
-				canonical, unsafeOrigin))
+			return NewResetErrorWithReason(fmt.Errorf("l2 unsafe head origin is no longer canonical, need reset to resolve: canonical: %v; unsafe origin: %v",
+				canonical, unsafeOrigin), ResetReasonL1Reorg)
 		}
 	}
 	return nil
@@ -342,7 +417,7 @@ func (eq *EngineQueue) tryUpdateEngine(ctx context.Context) error {
 		if errors.As(err, &inputErr) {
 			switch inputErr.Code {
 			case eth.InvalidForkchoiceState:
-				return NewResetError(fmt.Errorf("forkchoice update was inconsistent with engine, need reset to resolve: %w", inputErr.Unwrap()))
+				return NewResetErrorWithReason(fmt.Errorf("forkchoice update was inconsistent with engine, need reset to resolve: %w", inputErr.Unwrap()), ResetReasonEngineMismatch)
 			default:
 				return NewTemporaryError(fmt.Errorf("unexpected error code in forkchoice-updated response: %w", err))
 			}
@@ -402,7 +477,7 @@ func (eq *EngineQueue) tryNextUnsafePayload(ctx context.Context) error {
 		if errors.As(err, &inputErr) {
 			switch inputErr.Code {
 			case eth.InvalidForkchoiceState:
-				return NewResetError(fmt.Errorf("pre-unsafe-block forkchoice update was inconsistent with engine, need reset to resolve: %w", inputErr.Unwrap()))
+				return NewResetErrorWithReason(fmt.Errorf("pre-unsafe-block forkchoice update was inconsistent with engine, need reset to resolve: %w", inputErr.Unwrap()), ResetReasonEngineMismatch)
 			default:
 				return NewTemporaryError(fmt.Errorf("unexpected error code in forkchoice-updated response: %w", err))
 			}
@@ -429,6 +504,15 @@ func (eq *EngineQueue) tryNextSafeAttributes(ctx context.Context) error {
 	if eq.safeAttributes == nil { // sanity check the attributes are there
 		return nil
 	}
+	if eq.haltTarget.Enabled() {
+		l1Origin, err := attributesL1Origin(eq.safeAttributes)
+		if err != nil {
+			return NewCriticalError(fmt.Errorf("failed to read L1 origin of safe attributes: %w", err))
+		}
+		if eq.haltTarget.Exceeds(eq.safeAttributesParent.Number+1, l1Origin.Number) {
+			return ErrHalted
+		}
+	}
 	// validate the safe attributes before processing them. The engine may have completed processing them through other means.
 	if eq.safeHead != eq.safeAttributesParent {
 		if eq.safeHead.ParentHash != eq.safeAttributesParent.Hash {
@@ -464,12 +548,20 @@ func (eq *EngineQueue) consolidateNextSafeAttributes(ctx context.Context) error
 	if err != nil {
 		if errors.Is(err, ethereum.NotFound) {
 			// engine may have restarted, or inconsistent safe head. We need to reset
-			return NewResetError(fmt.Errorf("expected engine was synced and had unsafe block to reconcile, but cannot find the block: %w", err))
+			return NewResetErrorWithReason(fmt.Errorf("expected engine was synced and had unsafe block to reconcile, but cannot find the block: %w", err), ResetReasonEngineMismatch)
 		}
 		return NewTemporaryError(fmt.Errorf("failed to get existing unsafe payload to compare against derived attributes from L1: %w", err))
 	}
 	if err := AttributesMatchBlock(eq.safeAttributes, eq.safeHead.Hash, payload, eq.log); err != nil {
 		eq.log.Warn("L2 reorg: existing unsafe block does not match derived attributes from L1", "err", err, "unsafe", eq.unsafeHead, "safe", eq.safeHead)
+		if eq.onUnsafeDivergence != nil {
+			eq.onUnsafeDivergence(UnsafeDivergence{
+				Unsafe: payload.ID(),
+				Parent: eq.safeHead.ID(),
+				Origin: eq.origin.ID(),
+				Reason: err.Error(),
+			})
+		}
 		// geth cannot wind back a chain without reorging to a new, previously non-canonical, block
 		return eq.forceNextSafeAttributes(ctx)
 	}
@@ -505,7 +597,7 @@ func (eq *EngineQueue) forceNextSafeAttributes(ctx context.Context) error {
 			return NewTemporaryError(fmt.Errorf("temporarily cannot insert new safe block: %w", err))
 		case BlockInsertPrestateErr:
 			_ = eq.CancelPayload(ctx, true)
-			return NewResetError(fmt.Errorf("need reset to resolve pre-state problem: %w", err))
+			return NewResetErrorWithReason(fmt.Errorf("need reset to resolve pre-state problem: %w", err), ResetReasonEngineMismatch)
 		case BlockInsertPayloadErr:
 			_ = eq.CancelPayload(ctx, true)
 			eq.log.Warn("could not process payload derived from L1 data, dropping batch", "err", err)
@@ -540,10 +632,40 @@ func (eq *EngineQueue) forceNextSafeAttributes(ctx context.Context) error {
 	return nil
 }
 
+// checkHaltTarget returns an error if the block built on top of parent with the given attributes is beyond the halt target.
+func (eq *EngineQueue) checkHaltTarget(parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (BlockInsertionErrType, error) {
+	if !eq.haltTarget.Enabled() {
+		return BlockInsertOK, nil
+	}
+	l1Origin, err := attributesL1Origin(attrs)
+	if err != nil {
+		return BlockInsertPayloadErr, fmt.Errorf("failed to read L1 origin of payload attributes: %w", err)
+	}
+	if eq.haltTarget.Exceeds(parent.Number+1, l1Origin.Number) {
+		return BlockInsertTemporaryErr, fmt.Errorf("cannot build block %d with L1 origin %s: %w", parent.Number+1, l1Origin, ErrHalted)
+	}
+	return BlockInsertOK, nil
+}
+
 func (eq *EngineQueue) StartPayload(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes, updateSafe bool) (errType BlockInsertionErrType, err error) {
+	if errTyp, err := eq.checkHaltTarget(parent, attrs); err != nil {
+		return errTyp, err
+	}
 	if eq.buildingID != (eth.PayloadID{}) {
 		eq.log.Warn("did not finish previous block building, starting new building now", "prev_onto", eq.buildingOnto, "prev_payload_id", eq.buildingID, "new_onto", parent)
 		// TODO: maybe worth it to force-cancel the old payload ID here.
+		eq.interruptBuilding()
+	}
+	// Resume the interrupted job if it builds the same block, instead of restarting the build.
+	if !updateSafe && eq.interrupted.matches(parent, attrs) {
+		eq.log.Info("resuming interrupted block building", "onto", parent, "payload_id", eq.interrupted.id)
+		eq.buildingID = eq.interrupted.id
+		eq.buildingSafe = false
+		eq.buildingOnto = parent
+		eq.buildingAttrs = attrs
+		eq.buildingResumed = true
+		eq.interrupted = nil
+		return BlockInsertOK, nil
 	}
 	fc := eth.ForkchoiceState{
 		HeadBlockHash:      parent.Hash,
@@ -557,6 +679,7 @@ func (eq *EngineQueue) StartPayload(ctx context.Context, parent eth.L2BlockRef,
 	eq.buildingID = id
 	eq.buildingSafe = updateSafe
 	eq.buildingOnto = parent
+	eq.buildingAttrs = attrs
 	return BlockInsertOK, nil
 }
 
@@ -572,14 +695,205 @@ func (eq *EngineQueue) ConfirmPayload(ctx context.Context) (out *eth.ExecutionPa
 		SafeBlockHash:      eq.safeHead.Hash,
 		FinalizedBlockHash: eq.finalized.Hash,
 	}
-	payload, errTyp, err := ConfirmPayload(ctx, eq.log, eq.engine, fc, eq.buildingID, eq.buildingSafe)
+	var payload *eth.ExecutionPayload
+	if eq.buildingSafe {
+		payload, errTyp, err = ConfirmPayload(ctx, eq.log, eq.engine, fc, eq.buildingID, eq.buildingSafe)
+	} else {
+		payload, errTyp, err = eq.confirmUnsafePayload(ctx, fc)
+	}
 	if err != nil {
-		return nil, errTyp, fmt.Errorf("failed to complete building on top of L2 chain %s, id: %s, error (%d): %w", eq.buildingOnto, eq.buildingID, errTyp, err)
+		err = fmt.Errorf("failed to complete building on top of L2 chain %s, id: %s, error (%d): %w", eq.buildingOnto, eq.buildingID, errTyp, err)
+		eq.dropResumedBuilding()
+		return nil, errTyp, err
+	}
+	if err := eq.onPayloadInserted(payload); err != nil {
+		return nil, BlockInsertPayloadErr, err
 	}
+	return payload, BlockInsertOK, nil
+}
+
+func (eq *EngineQueue) ConfirmPayloadAndStart(ctx context.Context, nextAttrs func(parent eth.L2BlockRef) (*eth.PayloadAttributes, error)) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error) {
+	// Safe blocks are not followed by unsafe blocks.
+	if eq.buildingSafe {
+		return eq.ConfirmPayload(ctx)
+	}
+	if eq.buildingID == (eth.PayloadID{}) {
+		return nil, BlockInsertPrestateErr, fmt.Errorf("cannot complete payload building: not currently building a payload")
+	}
+	if eq.buildingOnto.Hash != eq.unsafeHead.Hash {
+		eq.log.Warn("engine is building block that reorgs previous unsafe head", "onto", eq.buildingOnto, "unsafe", eq.unsafeHead)
+	}
+	payload, err := eq.engine.GetPayload(ctx, eq.buildingID)
+	if err != nil {
+		eq.dropResumedBuilding()
+		return nil, BlockInsertTemporaryErr, fmt.Errorf("failed to get execution payload: %w", err)
+	}
+	payload, errTyp, err = eq.capPayload(ctx, payload)
+	if err != nil {
+		eq.dropResumedBuilding()
+		return nil, errTyp, fmt.Errorf("failed to cap execution payload to the block limits: %w", err)
+	}
+	eq.sealPayload(payload)
 	ref, err := PayloadToBlockRef(payload, &eq.cfg.Genesis)
 	if err != nil {
 		return nil, BlockInsertPayloadErr, NewResetError(fmt.Errorf("failed to decode L2 block ref from payload: %w", err))
 	}
+	fc := eth.ForkchoiceState{
+		HeadBlockHash:      common.Hash{}, // gets overridden
+		SafeBlockHash:      eq.safeHead.Hash,
+		FinalizedBlockHash: eq.finalized.Hash,
+	}
+	nextID, attrs, errTyp, err := InsertPayloadAndStart(ctx, eq.log, eq.engine, fc, payload, func() (*eth.PayloadAttributes, error) {
+		attrs, err := nextAttrs(ref)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := eq.checkHaltTarget(ref, attrs); err != nil {
+			return nil, err
+		}
+		return attrs, nil
+	})
+	if err != nil {
+		err = fmt.Errorf("failed to complete building on top of L2 chain %s, id: %s, error (%d): %w", eq.buildingOnto, eq.buildingID, errTyp, err)
+		eq.dropResumedBuilding()
+		return nil, errTyp, err
+	}
+	if err := eq.onPayloadInserted(payload); err != nil {
+		return nil, BlockInsertPayloadErr, err
+	}
+	if nextID != nil {
+		eq.buildingID = *nextID
+		eq.buildingOnto = ref
+		eq.buildingSafe = false
+		eq.buildingAttrs = attrs
+	}
+	return payload, BlockInsertOK, nil
+}
+
+func (eq *EngineQueue) InsertPayload(ctx context.Context, payload *eth.ExecutionPayload) (errTyp BlockInsertionErrType, err error) {
+	if eq.buildingID == (eth.PayloadID{}) {
+		return BlockInsertPrestateErr, fmt.Errorf("cannot insert payload: not currently building a payload")
+	}
+	if eq.buildingSafe {
+		return BlockInsertPrestateErr, fmt.Errorf("cannot replace safe block building on top of %s with an external payload", eq.buildingOnto)
+	}
+	if payload.ParentHash != eq.buildingOnto.Hash {
+		return BlockInsertPayloadErr, fmt.Errorf("payload %s has parent %s, but building on top of %s", payload.ID(), payload.ParentHash, eq.buildingOnto)
+	}
+	if err := eq.blockLimits.Check(payload); err != nil {
+		return BlockInsertPayloadErr, err
+	}
+	fc := eth.ForkchoiceState{
+		HeadBlockHash:      common.Hash{}, // gets overridden
+		SafeBlockHash:      eq.safeHead.Hash,
+		FinalizedBlockHash: eq.finalized.Hash,
+	}
+	eq.sealPayload(payload)
+	if errTyp, err := InsertPayload(ctx, eq.log, eq.engine, fc, payload, false); err != nil {
+		return errTyp, fmt.Errorf("failed to insert payload %s on top of L2 chain %s, error (%d): %w", payload.ID(), eq.buildingOnto, errTyp, err)
+	}
+	// the local building job gets wrapped up as soon as the payload is retrieved, the result is discarded
+	if _, err := eq.engine.GetPayload(ctx, eq.buildingID); err != nil {
+		eq.log.Warn("failed to wrap up replaced block building job", "payload", eq.buildingID, "err", err)
+	}
+	if err := eq.onPayloadInserted(payload); err != nil {
+		return BlockInsertPayloadErr, err
+	}
+	return BlockInsertOK, nil
+}
+
+// confirmUnsafePayload completes the unsafe block being built, like ConfirmPayload.
+// If the payload exceeds the block limits, its transactions are capped to the limits before insertion.
+func (eq *EngineQueue) confirmUnsafePayload(ctx context.Context, fc eth.ForkchoiceState) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error) {
+	payload, err := eq.engine.GetPayload(ctx, eq.buildingID)
+	if err != nil {
+		return nil, BlockInsertTemporaryErr, fmt.Errorf("failed to get execution payload: %w", err)
+	}
+	payload, errTyp, err = eq.capPayload(ctx, payload)
+	if err != nil {
+		return nil, errTyp, err
+	}
+	eq.sealPayload(payload)
+	if errTyp, err := InsertPayload(ctx, eq.log, eq.engine, fc, payload, false); err != nil {
+		return nil, errTyp, err
+	}
+	return payload, BlockInsertOK, nil
+}
+
+// sealPayload passes the sealed unsafe payload, that is about to be inserted, to the sealed payload handler if any.
+func (eq *EngineQueue) sealPayload(payload *eth.ExecutionPayload) {
+	if eq.onPayloadSealed != nil {
+		eq.onPayloadSealed(payload)
+	}
+}
+
+// capPayload caps the transactions of the built unsafe payload to the block limits.
+// The transactions of a block are executed in order, so any prefix of them is valid on top of the same parent:
+// the block is rebuilt with the longest prefix within the limits, keeping the tx pool transactions that fit.
+// The forced transactions are always included, even if they exceed the limits by themselves.
+func (eq *EngineQueue) capPayload(ctx context.Context, payload *eth.ExecutionPayload) (*eth.ExecutionPayload, BlockInsertionErrType, error) {
+	if !eq.blockLimits.Enabled() || eq.buildingSafe {
+		return payload, BlockInsertOK, nil
+	}
+	limitErr := eq.blockLimits.Check(payload)
+	if limitErr == nil {
+		return payload, BlockInsertOK, nil
+	}
+	forced := len(eq.buildingAttrs.Transactions)
+	lo, hi := forced, len(payload.Transactions)-1
+	if eq.blockLimits.MaxTxs != 0 && uint64(hi) > eq.blockLimits.MaxTxs {
+		hi = int(eq.blockLimits.MaxTxs)
+	}
+	if hi < lo {
+		hi = lo
+	}
+	// The gas used grows with the prefix length: search the longest prefix within the limits,
+	// starting with the longest candidate, which is enough if only the tx count is exceeded.
+	var capped *eth.ExecutionPayload
+	for n := hi; lo <= hi; n = lo + (hi-lo)/2 {
+		prefix, errTyp, err := eq.buildPrefix(ctx, payload.Transactions[:n])
+		if err != nil {
+			return nil, errTyp, err
+		}
+		if n == forced || eq.blockLimits.Check(prefix) == nil {
+			capped = prefix
+			lo = n + 1
+		} else {
+			hi = n - 1
+		}
+	}
+	eq.log.Warn("built payload exceeds block limits, capped its transactions",
+		"err", limitErr, "txs", len(payload.Transactions), "capped_txs", len(capped.Transactions), "capped_gas_used", uint64(capped.GasUsed))
+	return capped, BlockInsertOK, nil
+}
+
+// buildPrefix builds the unsafe block being built again, with exactly the given transactions.
+func (eq *EngineQueue) buildPrefix(ctx context.Context, txs []eth.Data) (*eth.ExecutionPayload, BlockInsertionErrType, error) {
+	attrs := *eq.buildingAttrs
+	attrs.Transactions = txs
+	attrs.NoTxPool = true
+	fc := eth.ForkchoiceState{
+		HeadBlockHash:      eq.buildingOnto.Hash,
+		SafeBlockHash:      eq.safeHead.Hash,
+		FinalizedBlockHash: eq.finalized.Hash,
+	}
+	id, errTyp, err := StartPayload(ctx, eq.engine, fc, &attrs)
+	if err != nil {
+		return nil, errTyp, err
+	}
+	payload, err := eq.engine.GetPayload(ctx, id)
+	if err != nil {
+		return nil, BlockInsertTemporaryErr, fmt.Errorf("failed to get capped execution payload: %w", err)
+	}
+	return payload, BlockInsertOK, nil
+}
+
+// onPayloadInserted updates the heads after the block that was being built got inserted as the given payload.
+func (eq *EngineQueue) onPayloadInserted(payload *eth.ExecutionPayload) error {
+	ref, err := PayloadToBlockRef(payload, &eq.cfg.Genesis)
+	if err != nil {
+		return NewResetError(fmt.Errorf("failed to decode L2 block ref from payload: %w", err))
+	}
 
 	eq.unsafeHead = ref
 	eq.metrics.RecordL2Ref("l2_unsafe", ref)
@@ -590,7 +904,8 @@ func (eq *EngineQueue) ConfirmPayload(ctx context.Context) (out *eth.ExecutionPa
 		eq.metrics.RecordL2Ref("l2_safe", ref)
 	}
 	eq.resetBuildingState()
-	return payload, BlockInsertOK, nil
+	eq.interrupted = nil
+	return nil
 }
 
 func (eq *EngineQueue) CancelPayload(ctx context.Context, force bool) error {
@@ -606,7 +921,7 @@ func (eq *EngineQueue) CancelPayload(ctx context.Context, force bool) error {
 			return err
 		}
 	}
-	eq.resetBuildingState()
+	eq.interruptBuilding()
 	return nil
 }
 
@@ -618,6 +933,26 @@ func (eq *EngineQueue) resetBuildingState() {
 	eq.buildingID = eth.PayloadID{}
 	eq.buildingOnto = eth.L2BlockRef{}
 	eq.buildingSafe = false
+	eq.buildingAttrs = nil
+	eq.buildingResumed = false
+}
+
+// interruptBuilding drops the current building job.
+// An unsafe job is remembered, to be resumed if the same block is built again.
+func (eq *EngineQueue) interruptBuilding() {
+	if eq.buildingID != (eth.PayloadID{}) && !eq.buildingSafe && eq.buildingAttrs != nil {
+		eq.interrupted = &interruptedBuild{onto: eq.buildingOnto, id: eq.buildingID, attrs: eq.buildingAttrs}
+	}
+	eq.resetBuildingState()
+}
+
+// dropResumedBuilding drops the current building job if it was resumed, since the engine may not hold
+// the payload anymore: the block is built from scratch on the next attempt.
+func (eq *EngineQueue) dropResumedBuilding() {
+	if eq.buildingResumed {
+		eq.log.Warn("failed to complete resumed block building, dropping it", "onto", eq.buildingOnto, "payload_id", eq.buildingID)
+		eq.resetBuildingState()
+	}
 }
 
 // ResetStep Walks the L2 chain backwards until it finds an L2 block whose L1 origin is canonical.
@@ -665,12 +1000,15 @@ func (eq *EngineQueue) Reset(ctx context.Context, _ eth.L1BlockRef, _ eth.System
 	eq.unsafeHead = unsafe
 	eq.safeHead = safe
 	eq.finalized = finalized
-	eq.resetBuildingState()
+	eq.interruptBuilding()
 	eq.needForkchoiceUpdate = true
 	eq.finalityData = eq.finalityData[:0]
 	// note: we do not clear the unsafe payloads queue; if the payloads are not applicable anymore the parent hash checks will clear out the old payloads.
 	eq.origin = pipelineOrigin
 	eq.sysCfg = l1Cfg
+	if eq.prefetcher != nil {
+		eq.prefetcher.Reset()
+	}
 	eq.metrics.RecordL2Ref("l2_finalized", finalized)
 	eq.metrics.RecordL2Ref("l2_safe", safe)
 	eq.metrics.RecordL2Ref("l2_unsafe", unsafe)
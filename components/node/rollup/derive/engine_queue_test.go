@@ -1013,3 +1013,83 @@ func TestBlockBuildingRace(t *testing.T) {
 	l1F.AssertExpectations(t)
 	eng.AssertExpectations(t)
 }
+
+func TestCapPayload(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	parent := testutils.RandomL2BlockRef(rng)
+	attrs := &eth.PayloadAttributes{
+		Timestamp:    eth.Uint64Quantity(parent.Time + 2),
+		Transactions: []eth.Data{{0x00}},
+	}
+	txs := []eth.Data{{0x00}, {0x01}, {0x02}, {0x03}, {0x04}}
+	// every tx uses 100 gas
+	payloadWith := func(n int) *eth.ExecutionPayload {
+		return &eth.ExecutionPayload{
+			ParentHash:   parent.Hash,
+			BlockNumber:  eth.Uint64Quantity(parent.Number + 1),
+			GasUsed:      eth.Uint64Quantity(100 * n),
+			Transactions: txs[:n],
+		}
+	}
+	fc := &eth.ForkchoiceState{HeadBlockHash: parent.Hash}
+	expectBuild := func(eng *testutils.MockEngine, n int) {
+		capped := *attrs
+		capped.Transactions = txs[:n]
+		capped.NoTxPool = true
+		id := eth.PayloadID{byte(n)}
+		eng.ExpectForkchoiceUpdate(fc, &capped, &eth.ForkchoiceUpdatedResult{
+			PayloadStatus: eth.PayloadStatusV1{Status: eth.ExecutionValid},
+			PayloadID:     &id,
+		}, nil)
+		eng.ExpectGetPayload(id, payloadWith(n), nil)
+	}
+	newEngineQueue := func(eng *testutils.MockEngine, limits BlockLimits) *EngineQueue {
+		return &EngineQueue{
+			log:           testlog.Logger(t, log.LvlError),
+			engine:        eng,
+			blockLimits:   limits,
+			buildingOnto:  parent,
+			buildingAttrs: attrs,
+		}
+	}
+
+	t.Run("within limits", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		eq := newEngineQueue(eng, BlockLimits{MaxTxs: 5, MaxGasUsed: 500})
+		out, _, err := eq.capPayload(context.Background(), payloadWith(5))
+		require.NoError(t, err)
+		require.Equal(t, payloadWith(5), out)
+		eng.AssertExpectations(t)
+	})
+	t.Run("tx count", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		eq := newEngineQueue(eng, BlockLimits{MaxTxs: 3})
+		expectBuild(eng, 3)
+		out, _, err := eq.capPayload(context.Background(), payloadWith(5))
+		require.NoError(t, err)
+		require.Equal(t, payloadWith(3), out, "tx pool txs within the limit are kept")
+		eng.AssertExpectations(t)
+	})
+	t.Run("gas used", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		eq := newEngineQueue(eng, BlockLimits{MaxGasUsed: 250})
+		expectBuild(eng, 4)
+		expectBuild(eng, 2)
+		expectBuild(eng, 3)
+		out, _, err := eq.capPayload(context.Background(), payloadWith(5))
+		require.NoError(t, err)
+		require.Equal(t, payloadWith(2), out, "longest prefix within the gas limit")
+		eng.AssertExpectations(t)
+	})
+	t.Run("forced txs", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		eq := newEngineQueue(eng, BlockLimits{MaxGasUsed: 50})
+		expectBuild(eng, 4)
+		expectBuild(eng, 2)
+		expectBuild(eng, 1)
+		out, _, err := eq.capPayload(context.Background(), payloadWith(5))
+		require.NoError(t, err)
+		require.Equal(t, payloadWith(1), out, "forced txs are always included")
+		eng.AssertExpectations(t)
+	})
+}
@@ -123,26 +123,89 @@ func ConfirmPayload(ctx context.Context, log log.Logger, eng Engine, fc eth.Fork
 		// even if it is an input-error (unknown payload ID), it is temporary, since we will re-attempt the full payload building, not just the retrieval of the payload.
 		return nil, BlockInsertTemporaryErr, fmt.Errorf("failed to get execution payload: %w", err)
 	}
+	if errTyp, err := InsertPayload(ctx, log, eng, fc, payload, updateSafe); err != nil {
+		return nil, errTyp, err
+	}
+	return payload, BlockInsertOK, nil
+}
+
+// InsertPayload inserts the given payload into the engine, and makes it the canonical head.
+// If updateSafe, the payload is marked as the safe block too.
+func InsertPayload(ctx context.Context, log log.Logger, eng Engine, fc eth.ForkchoiceState, payload *eth.ExecutionPayload, updateSafe bool) (errTyp BlockInsertionErrType, err error) {
+	if errTyp, err := executePayload(ctx, eng, payload); err != nil {
+		return errTyp, err
+	}
+	fc.HeadBlockHash = payload.BlockHash
+	if updateSafe {
+		fc.SafeBlockHash = payload.BlockHash
+	}
+	if _, errTyp, err := updateForkchoicePostPayload(ctx, eng, &fc, nil); err != nil {
+		return errTyp, err
+	}
+	logInsertedPayload(log, payload, updateSafe)
+	return BlockInsertOK, nil
+}
+
+// InsertPayloadAndStart inserts the given unsafe payload into the engine, and makes it the canonical head,
+// while starting to build the next block on top of it with the same forkchoice update.
+// The attributes of the next block are retrieved with nextAttrs once the payload is executed.
+// If the next block cannot be started, the payload is still inserted, and a nil payload ID is returned.
+func InsertPayloadAndStart(ctx context.Context, log log.Logger, eng Engine, fc eth.ForkchoiceState, payload *eth.ExecutionPayload,
+	nextAttrs func() (*eth.PayloadAttributes, error)) (nextID *eth.PayloadID, attrs *eth.PayloadAttributes, errTyp BlockInsertionErrType, err error) {
+	if errTyp, err := executePayload(ctx, eng, payload); err != nil {
+		return nil, nil, errTyp, err
+	}
+	fc.HeadBlockHash = payload.BlockHash
+	attrs, err = nextAttrs()
+	if err != nil {
+		log.Warn("failed to prepare attributes of next block, only inserting payload", "payload", payload.ID(), "err", err)
+		attrs = nil
+	}
+	id, errTyp, err := updateForkchoicePostPayload(ctx, eng, &fc, attrs)
+	if err != nil && attrs != nil {
+		var inputErr eth.InputError
+		if errors.As(err, &inputErr) && inputErr.Code == eth.InvalidPayloadAttributes {
+			log.Warn("attributes of next block are not valid, only inserting payload", "payload", payload.ID(), "err", err)
+			attrs = nil
+			id, errTyp, err = updateForkchoicePostPayload(ctx, eng, &fc, nil)
+		}
+	}
+	if err != nil {
+		return nil, nil, errTyp, err
+	}
+	logInsertedPayload(log, payload, false)
+	if attrs == nil {
+		return nil, nil, BlockInsertOK, nil
+	}
+	if id == nil {
+		log.Warn("nil id in forkchoice result when expecting a valid ID, only inserted payload", "payload", payload.ID())
+		return nil, nil, BlockInsertOK, nil
+	}
+	return id, attrs, BlockInsertOK, nil
+}
+
+// executePayload executes the payload in the engine, without changing the forkchoice.
+func executePayload(ctx context.Context, eng Engine, payload *eth.ExecutionPayload) (errTyp BlockInsertionErrType, err error) {
 	if err := sanityCheckPayload(payload); err != nil {
-		return nil, BlockInsertPayloadErr, err
+		return BlockInsertPayloadErr, err
 	}
 
 	status, err := eng.NewPayload(ctx, payload)
 	if err != nil {
-		return nil, BlockInsertTemporaryErr, fmt.Errorf("failed to insert execution payload: %w", err)
+		return BlockInsertTemporaryErr, fmt.Errorf("failed to insert execution payload: %w", err)
 	}
 	if status.Status == eth.ExecutionInvalid || status.Status == eth.ExecutionInvalidBlockHash {
-		return nil, BlockInsertPayloadErr, eth.NewPayloadErr(payload, status)
+		return BlockInsertPayloadErr, eth.NewPayloadErr(payload, status)
 	}
 	if status.Status != eth.ExecutionValid {
-		return nil, BlockInsertTemporaryErr, eth.NewPayloadErr(payload, status)
+		return BlockInsertTemporaryErr, eth.NewPayloadErr(payload, status)
 	}
+	return BlockInsertOK, nil
+}
 
-	fc.HeadBlockHash = payload.BlockHash
-	if updateSafe {
-		fc.SafeBlockHash = payload.BlockHash
-	}
-	fcRes, err := eng.ForkchoiceUpdate(ctx, &fc, nil)
+// updateForkchoicePostPayload makes the executed payload canonical, and optionally starts building on top of it.
+func updateForkchoicePostPayload(ctx context.Context, eng Engine, fc *eth.ForkchoiceState, attrs *eth.PayloadAttributes) (id *eth.PayloadID, errTyp BlockInsertionErrType, err error) {
+	fcRes, err := eng.ForkchoiceUpdate(ctx, fc, attrs)
 	if err != nil {
 		var inputErr eth.InputError
 		if errors.As(err, &inputErr) {
@@ -150,6 +213,8 @@ func ConfirmPayload(ctx context.Context, log log.Logger, eng Engine, fc eth.Fork
 			case eth.InvalidForkchoiceState:
 				// if we succeed to update the forkchoice pre-payload, but fail post-payload, then it is a payload error
 				return nil, BlockInsertPayloadErr, fmt.Errorf("post-block-creation forkchoice update was inconsistent with engine, need reset to resolve: %w", inputErr.Unwrap())
+			case eth.InvalidPayloadAttributes:
+				return nil, BlockInsertPayloadErr, err
 			default:
 				return nil, BlockInsertPrestateErr, fmt.Errorf("unexpected error code in forkchoice-updated response: %w", err)
 			}
@@ -160,9 +225,12 @@ func ConfirmPayload(ctx context.Context, log log.Logger, eng Engine, fc eth.Fork
 	if fcRes.PayloadStatus.Status != eth.ExecutionValid {
 		return nil, BlockInsertPayloadErr, eth.ForkchoiceUpdateErr(fcRes.PayloadStatus)
 	}
+	return fcRes.PayloadID, BlockInsertOK, nil
+}
+
+func logInsertedPayload(log log.Logger, payload *eth.ExecutionPayload, updateSafe bool) {
 	log.Info("inserted block", "hash", payload.BlockHash, "number", uint64(payload.BlockNumber),
 		"state_root", payload.StateRoot, "timestamp", uint64(payload.Timestamp), "parent", payload.ParentHash,
 		"prev_randao", payload.PrevRandao, "fee_recipient", payload.FeeRecipient,
 		"txs", len(payload.Transactions), "update_safe", updateSafe)
-	return payload, BlockInsertOK, nil
 }
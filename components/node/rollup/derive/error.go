@@ -33,10 +33,30 @@ const (
 	LevelCritical
 )
 
+// ResetReason describes the cause of a derivation pipeline reset.
+type ResetReason string
+
+const (
+	// ResetReasonStartup is used for the initial reset of the pipeline.
+	ResetReasonStartup ResetReason = "startup"
+	// ResetReasonL1Reorg is used when the L1 chain reorganized underneath the derived data.
+	ResetReasonL1Reorg ResetReason = "l1_reorg"
+	// ResetReasonEngineMismatch is used when the engine state is inconsistent with the derivation state.
+	ResetReasonEngineMismatch ResetReason = "engine_mismatch"
+	// ResetReasonEngineFailover is used when the standby execution engine took over from the active engine.
+	ResetReasonEngineFailover ResetReason = "engine_failover"
+	// ResetReasonManual is used when the reset is requested by an operator.
+	ResetReasonManual ResetReason = "manual"
+	// ResetReasonCorruption is used for any other inconsistency of the derived data.
+	ResetReasonCorruption ResetReason = "corruption"
+)
+
 // Error is a wrapper for error, description and a severity level.
 type Error struct {
 	err   error
 	level Level
+	// reason is the cause of the reset, only used for errors of LevelReset.
+	reason ResetReason
 }
 
 // Error satisfies the error interface.
@@ -77,9 +97,28 @@ func NewTemporaryError(err error) error {
 	return NewError(err, LevelTemporary)
 }
 
-// NewResetError returns a pipeline reset error.
+// NewResetError returns a pipeline reset error, caused by a corruption of the derived data.
 func NewResetError(err error) error {
-	return NewError(err, LevelReset)
+	return NewResetErrorWithReason(err, ResetReasonCorruption)
+}
+
+// NewResetErrorWithReason returns a pipeline reset error with the given reset cause.
+func NewResetErrorWithReason(err error, reason ResetReason) error {
+	return Error{
+		err:    err,
+		level:  LevelReset,
+		reason: reason,
+	}
+}
+
+// ResetReasonOf returns the reset cause of the given reset error.
+// If the error does not carry a reset cause, ResetReasonCorruption is returned.
+func ResetReasonOf(err error) ResetReason {
+	var derivErr Error
+	if errors.As(err, &derivErr) && derivErr.reason != "" {
+		return derivErr.reason
+	}
+	return ResetReasonCorruption
 }
 
 // NewCriticalError returns a critical error.
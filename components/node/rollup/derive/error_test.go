@@ -0,0 +1,22 @@
+package derive
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResetReasonOf(t *testing.T) {
+	reorgErr := NewResetErrorWithReason(errors.New("reorg"), ResetReasonL1Reorg)
+	require.ErrorIs(t, reorgErr, ErrReset)
+	require.Equal(t, ResetReasonL1Reorg, ResetReasonOf(reorgErr))
+
+	wrapped := fmt.Errorf("engine stage failed: %w", reorgErr)
+	require.ErrorIs(t, wrapped, ErrReset)
+	require.Equal(t, ResetReasonL1Reorg, ResetReasonOf(wrapped))
+
+	require.Equal(t, ResetReasonCorruption, ResetReasonOf(NewResetError(errors.New("bad data"))))
+	require.Equal(t, ResetReasonCorruption, ResetReasonOf(errors.New("unknown")))
+}
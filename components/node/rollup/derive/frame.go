@@ -127,13 +127,14 @@ func eofAsUnexpectedMissing(err error) error {
 // All frames must be parsed without error and there must not be
 // any left over data and there must be at least one frame.
 func ParseFrames(data []byte) ([]Frame, error) {
-	if len(data) == 0 {
-		return nil, errors.New("data array must not be empty")
+	version, payload, err := SplitBatcherData(data)
+	if err != nil {
+		return nil, err
 	}
-	if data[0] != DerivationVersion0 {
-		return nil, fmt.Errorf("invalid derivation format byte: got %d", data[0])
+	if version != DerivationVersion0 {
+		return nil, fmt.Errorf("invalid derivation format byte: got %d (%s)", version, DerivationVersionName(version))
 	}
-	buf := bytes.NewBuffer(data[1:])
+	buf := bytes.NewBuffer(payload)
 	var frames []Frame
 	for buf.Len() > 0 {
 		var f Frame
@@ -2,6 +2,7 @@ package derive
 
 import (
 	"context"
+	"errors"
 	"io"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -41,6 +42,8 @@ func (fq *FrameQueue) NextFrame(ctx context.Context) (Frame, error) {
 		} else {
 			if new, err := ParseFrames(data); err == nil {
 				fq.frames = append(fq.frames, new...)
+			} else if errors.Is(err, ErrUnknownDerivationVersion) {
+				fq.log.Warn("Skipping batcher data of unknown derivation version", "origin", fq.prev.Origin(), "err", err)
 			} else {
 				fq.log.Warn("Failed to parse frames", "origin", fq.prev.Origin(), "err", err)
 			}
@@ -0,0 +1,51 @@
+package derive
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// ErrHalted is returned by the pipeline when the derivation reached its halt target,
+// and no more L2 blocks are derived or inserted.
+var ErrHalted = errors.New("derivation halted at target")
+
+// HaltTarget describes where the derivation stops. Zeroed fields are disabled.
+type HaltTarget struct {
+	// L2Block is the number of the last L2 block to derive.
+	L2Block uint64 `json:"l2_block"`
+	// L1Origin is the number of the last L1 origin to derive L2 blocks for.
+	L1Origin uint64 `json:"l1_origin"`
+}
+
+// Enabled returns true if any of the targets is set.
+func (t HaltTarget) Enabled() bool {
+	return t.L2Block != 0 || t.L1Origin != 0
+}
+
+// Exceeds returns true if an L2 block with the given number and L1 origin is beyond the target.
+func (t HaltTarget) Exceeds(number uint64, l1Origin uint64) bool {
+	return (t.L2Block != 0 && number > t.L2Block) || (t.L1Origin != 0 && l1Origin > t.L1Origin)
+}
+
+// attributesL1Origin reads the L1 origin from the L1 info deposit of the payload attributes.
+func attributesL1Origin(attrs *eth.PayloadAttributes) (eth.BlockID, error) {
+	if len(attrs.Transactions) == 0 {
+		return eth.BlockID{}, errors.New("payload attributes are missing L1 info deposit tx")
+	}
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(attrs.Transactions[0]); err != nil {
+		return eth.BlockID{}, fmt.Errorf("failed to decode first tx to read l1 info from: %w", err)
+	}
+	if tx.Type() != types.DepositTxType {
+		return eth.BlockID{}, fmt.Errorf("first payload attributes tx has unexpected tx type: %d", tx.Type())
+	}
+	info, err := L1InfoDepositTxData(tx.Data())
+	if err != nil {
+		return eth.BlockID{}, fmt.Errorf("failed to parse L1 info deposit tx from payload attributes: %w", err)
+	}
+	return eth.BlockID{Hash: info.BlockHash, Number: info.Number}, nil
+}
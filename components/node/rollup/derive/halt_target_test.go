@@ -0,0 +1,44 @@
+package derive
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/testutils"
+)
+
+func TestHaltTargetExceeds(t *testing.T) {
+	require.False(t, HaltTarget{}.Enabled())
+	require.False(t, HaltTarget{}.Exceeds(100, 100))
+
+	l2Target := HaltTarget{L2Block: 10}
+	require.True(t, l2Target.Enabled())
+	require.False(t, l2Target.Exceeds(10, 100))
+	require.True(t, l2Target.Exceeds(11, 0))
+
+	l1Target := HaltTarget{L1Origin: 5}
+	require.True(t, l1Target.Enabled())
+	require.False(t, l1Target.Exceeds(100, 5))
+	require.True(t, l1Target.Exceeds(0, 6))
+}
+
+func TestAttributesL1Origin(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	info := testutils.RandomBlockInfo(rng)
+	depositTx, err := L1InfoDepositBytes(0, info, eth.SystemConfig{})
+	require.NoError(t, err)
+
+	origin, err := attributesL1Origin(&eth.PayloadAttributes{Transactions: []eth.Data{depositTx}})
+	require.NoError(t, err)
+	require.Equal(t, info.ID(), origin)
+
+	_, err = attributesL1Origin(&eth.PayloadAttributes{})
+	require.Error(t, err)
+
+	_, err = attributesL1Origin(&eth.PayloadAttributes{Transactions: []eth.Data{hexutil.Bytes{0x01}}})
+	require.Error(t, err)
+}
@@ -0,0 +1,41 @@
+package derive
+
+import (
+	"bytes"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// interruptedBuild is an unsafe block building job that was dropped before the block was sealed,
+// e.g. when the proposer failed to seal the block and cancelled the job.
+// The engine may still hold the payload, which is then resumed if the same block is built again.
+type interruptedBuild struct {
+	onto  eth.L2BlockRef
+	id    eth.PayloadID
+	attrs *eth.PayloadAttributes
+}
+
+// matches returns true if the interrupted job builds the block with the given parent and attributes.
+func (b *interruptedBuild) matches(parent eth.L2BlockRef, attrs *eth.PayloadAttributes) bool {
+	if b == nil || b.onto.Hash != parent.Hash {
+		return false
+	}
+	prev := b.attrs
+	if prev.Timestamp != attrs.Timestamp || prev.PrevRandao != attrs.PrevRandao ||
+		prev.SuggestedFeeRecipient != attrs.SuggestedFeeRecipient || prev.NoTxPool != attrs.NoTxPool ||
+		prev.TxOrdering != attrs.TxOrdering {
+		return false
+	}
+	if (prev.GasLimit == nil) != (attrs.GasLimit == nil) || (prev.GasLimit != nil && *prev.GasLimit != *attrs.GasLimit) {
+		return false
+	}
+	if len(prev.Transactions) != len(attrs.Transactions) {
+		return false
+	}
+	for i, tx := range prev.Transactions {
+		if !bytes.Equal(tx, attrs.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
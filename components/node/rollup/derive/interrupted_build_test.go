@@ -0,0 +1,49 @@
+package derive
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/testutils"
+)
+
+func TestInterruptedBuildMatches(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	parent := testutils.RandomL2BlockRef(rng)
+	gasLimit := eth.Uint64Quantity(30_000_000)
+	attrs := func() *eth.PayloadAttributes {
+		return &eth.PayloadAttributes{
+			Timestamp:    eth.Uint64Quantity(parent.Time + 2),
+			Transactions: []eth.Data{{0x01, 0x02}},
+			GasLimit:     &gasLimit,
+		}
+	}
+	b := &interruptedBuild{onto: parent, id: eth.PayloadID{1}, attrs: attrs()}
+
+	require.True(t, b.matches(parent, attrs()))
+	require.False(t, (*interruptedBuild)(nil).matches(parent, attrs()))
+	require.False(t, b.matches(testutils.RandomL2BlockRef(rng), attrs()))
+
+	other := attrs()
+	other.Timestamp++
+	require.False(t, b.matches(parent, other))
+
+	other = attrs()
+	other.NoTxPool = true
+	require.False(t, b.matches(parent, other))
+
+	other = attrs()
+	other.GasLimit = nil
+	require.False(t, b.matches(parent, other))
+
+	other = attrs()
+	other.Transactions = []eth.Data{{0x01, 0x03}}
+	require.False(t, b.matches(parent, other))
+
+	other = attrs()
+	other.Transactions = append(other.Transactions, eth.Data{0x04})
+	require.False(t, b.matches(parent, other))
+}
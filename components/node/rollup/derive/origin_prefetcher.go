@@ -0,0 +1,126 @@
+package derive
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// originPrefetchTimeout bounds the time spent on prefetching a single origin.
+const originPrefetchTimeout = 30 * time.Second
+
+// PrefetchConfig configures the prefetching of the L1 origins ahead of the current origin.
+type PrefetchConfig struct {
+	// Depth is the number of origins after the current origin to prefetch. Prefetching is disabled if zero.
+	Depth uint64 `json:"depth"`
+	// Concurrency is the maximum number of origins being prefetched concurrently.
+	Concurrency uint64 `json:"concurrency"`
+}
+
+// Enabled returns true if origins are prefetched.
+func (c PrefetchConfig) Enabled() bool {
+	return c.Depth != 0
+}
+
+// Check verifies that the prefetch configuration makes sense.
+func (c PrefetchConfig) Check() error {
+	if c.Enabled() && c.Concurrency == 0 {
+		return errors.New("origin prefetch concurrency must be at least 1")
+	}
+	return nil
+}
+
+// originPrefetcher fetches the L1 data of the origins after the current one in the background,
+// while the pipeline is still consuming the data of the current origin.
+// The fetched data is not returned: it only warms up the caches of the L1 source,
+// so that the next origins can be processed without waiting for L1 fetch latency.
+type originPrefetcher struct {
+	log     log.Logger
+	fetcher L1Fetcher
+
+	// ctx is the lifetime of the prefetcher: in-flight prefetches are canceled once it is done.
+	ctx   context.Context
+	depth uint64
+	// sem bounds the number of in-flight prefetches
+	sem chan struct{}
+
+	mu   sync.Mutex
+	last uint64 // number of the last origin that a prefetch was started for
+}
+
+func newOriginPrefetcher(ctx context.Context, log log.Logger, fetcher L1Fetcher, cfg PrefetchConfig) *originPrefetcher {
+	return &originPrefetcher{
+		log:     log,
+		fetcher: fetcher,
+		ctx:     ctx,
+		depth:   cfg.Depth,
+		sem:     make(chan struct{}, cfg.Concurrency),
+	}
+}
+
+// PrefetchNext starts fetching the origins after the given one in the background, up to the configured depth,
+// if not already done. It does not block: origins that cannot be prefetched because the maximum number of
+// prefetches are in-flight are prefetched on a later call.
+func (p *originPrefetcher) PrefetchNext(current eth.L1BlockRef) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.ctx.Err() != nil {
+		return
+	}
+	start := current.Number + 1
+	if p.last >= start {
+		start = p.last + 1
+	}
+	for num := start; num <= current.Number+p.depth; num++ {
+		select {
+		case p.sem <- struct{}{}:
+		default:
+			return
+		}
+		p.last = num
+		go func(num uint64) {
+			defer func() { <-p.sem }()
+			ctx, cancel := context.WithTimeout(p.ctx, originPrefetchTimeout)
+			defer cancel()
+			p.prefetch(ctx, current, num)
+		}(num)
+	}
+}
+
+// Reset allows origins that were prefetched before to be prefetched again, e.g. after a L1 reorg.
+func (p *originPrefetcher) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.last = 0
+}
+
+func (p *originPrefetcher) prefetch(ctx context.Context, current eth.L1BlockRef, num uint64) {
+	next, err := p.fetcher.L1BlockRefByNumber(ctx, num)
+	if err != nil {
+		if !errors.Is(err, ethereum.NotFound) && !errors.Is(err, context.Canceled) {
+			p.log.Debug("failed to prefetch origin", "current", current, "num", num, "err", err)
+		}
+		return
+	}
+	// Only prefetch data that the pipeline is going to use: if the next block does not build on the
+	// current origin, the traversal stage detects the reorg and the pipeline is reset anyway.
+	if num == current.Number+1 && next.ParentHash != current.Hash {
+		p.log.Debug("next origin does not build on current origin, skipping prefetch", "current", current, "next", next)
+		return
+	}
+	if _, _, err := p.fetcher.FetchReceipts(ctx, next.Hash); err != nil {
+		p.log.Debug("failed to prefetch receipts of origin", "origin", next, "err", err)
+		return
+	}
+	if _, _, err := p.fetcher.InfoAndTxsByHash(ctx, next.Hash); err != nil {
+		p.log.Debug("failed to prefetch transactions of origin", "origin", next, "err", err)
+		return
+	}
+	p.log.Trace("prefetched origin", "origin", next)
+}
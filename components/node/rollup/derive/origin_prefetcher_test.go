@@ -0,0 +1,100 @@
+package derive
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/testlog"
+	"github.com/kroma-network/kroma/components/node/testutils"
+)
+
+func TestOriginPrefetcher(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	current := testutils.RandomBlockRef(rng)
+	next := testutils.NextRandomRef(rng, current)
+	info := testutils.RandomBlockInfo(rng)
+
+	l1F := &testutils.MockL1Source{}
+	l1F.ExpectL1BlockRefByNumber(next.Number, next, nil)
+	l1F.ExpectFetchReceipts(next.Hash, info, types.Receipts{}, nil)
+	l1F.ExpectInfoAndTxsByHash(next.Hash, info, types.Transactions{}, nil)
+
+	p := newOriginPrefetcher(context.Background(), testlog.Logger(t, log.LvlError), l1F, PrefetchConfig{Depth: 1, Concurrency: 1})
+	p.PrefetchNext(current)
+	// prefetching the same origin again is a no-op
+	p.PrefetchNext(current)
+
+	require.Eventually(t, func() bool {
+		return len(p.sem) == 0
+	}, time.Second, 10*time.Millisecond)
+	l1F.AssertExpectations(t)
+}
+
+func TestOriginPrefetcherSkipsReorgedNext(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	current := testutils.RandomBlockRef(rng)
+	next := testutils.NextRandomRef(rng, testutils.RandomBlockRef(rng))
+	next.Number = current.Number + 1
+
+	l1F := &testutils.MockL1Source{}
+	l1F.ExpectL1BlockRefByNumber(next.Number, next, nil)
+
+	p := newOriginPrefetcher(context.Background(), testlog.Logger(t, log.LvlError), l1F, PrefetchConfig{Depth: 1, Concurrency: 1})
+	p.PrefetchNext(current)
+
+	require.Eventually(t, func() bool {
+		return len(p.sem) == 0
+	}, time.Second, 10*time.Millisecond)
+	l1F.AssertExpectations(t)
+}
+
+func TestOriginPrefetcherDepth(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	current := testutils.RandomBlockRef(rng)
+	next := testutils.NextRandomRef(rng, current)
+	nextNext := testutils.NextRandomRef(rng, next)
+	info := testutils.RandomBlockInfo(rng)
+
+	l1F := &testutils.MockL1Source{}
+	for _, ref := range []eth.L1BlockRef{next, nextNext} {
+		l1F.ExpectL1BlockRefByNumber(ref.Number, ref, nil)
+		l1F.ExpectFetchReceipts(ref.Hash, info, types.Receipts{}, nil)
+		l1F.ExpectInfoAndTxsByHash(ref.Hash, info, types.Transactions{}, nil)
+	}
+
+	p := newOriginPrefetcher(context.Background(), testlog.Logger(t, log.LvlError), l1F, PrefetchConfig{Depth: 2, Concurrency: 2})
+	p.PrefetchNext(current)
+	require.Eventually(t, func() bool {
+		return len(p.sem) == 0
+	}, time.Second, 10*time.Millisecond)
+	// the origins within the depth of the next current origin were prefetched already
+	p.PrefetchNext(next)
+	require.Equal(t, nextNext.Number, p.last)
+	l1F.AssertExpectations(t)
+}
+
+func TestOriginPrefetcherStopsWithContext(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	current := testutils.RandomBlockRef(rng)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	l1F := &testutils.MockL1Source{}
+	p := newOriginPrefetcher(ctx, testlog.Logger(t, log.LvlError), l1F, PrefetchConfig{Depth: 1, Concurrency: 1})
+	p.PrefetchNext(current)
+	require.Zero(t, p.last, "no prefetch is started once the context is done")
+	l1F.AssertExpectations(t)
+}
+
+func TestPrefetchConfigCheck(t *testing.T) {
+	require.NoError(t, PrefetchConfig{}.Check())
+	require.NoError(t, PrefetchConfig{Depth: 2, Concurrency: 1}.Check())
+	require.Error(t, PrefetchConfig{Depth: 1}.Check())
+}
@@ -17,8 +17,6 @@ func frameSize(frame Frame) uint64 {
 	return uint64(len(frame.Data)) + frameOverhead
 }
 
-const DerivationVersion0 = 0
-
 // MaxChannelBankSize is the amount of memory space, in number of bytes,
 // till the bank is pruned by removing channels,
 // starting with the oldest channel.
@@ -71,10 +71,11 @@ func PayloadToSystemConfig(payload *eth.ExecutionPayload, cfg *rollup.Config) (e
 			return eth.SystemConfig{}, fmt.Errorf("failed to parse L1 info deposit tx from L2 block: %w", err)
 		}
 		return eth.SystemConfig{
-			BatcherAddr: info.BatcherAddr,
-			Overhead:    info.L1FeeOverhead,
-			Scalar:      info.L1FeeScalar,
-			GasLimit:    uint64(payload.GasLimit),
+			BatcherAddr:  info.BatcherAddr,
+			Overhead:     info.L1FeeOverhead,
+			Scalar:       info.L1FeeScalar,
+			GasLimit:     uint64(payload.GasLimit),
+			FeeRecipient: payload.FeeRecipient,
 		}, err
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/ethereum/go-ethereum/log"
 
@@ -16,6 +17,8 @@ type Metrics interface {
 	RecordL2Ref(name string, ref eth.L2BlockRef)
 	RecordUnsafePayloadsBuffer(length uint64, memSize uint64, next eth.BlockID)
 	RecordChannelInputBytes(inputCompressedBytes int)
+	RecordDroppedBatch(reason string)
+	RecordPipelineReset(reason string)
 }
 
 type L1Fetcher interface {
@@ -31,7 +34,7 @@ type L1Fetcher interface {
 // by determining the last valid block references to continue from.
 type ResettableEngineControl interface {
 	EngineControl
-	Reset()
+	Reset(reason ResetReason)
 }
 
 type ResetableStage interface {
@@ -53,6 +56,10 @@ type EngineQueueStage interface {
 	Finalize(l1Origin eth.L1BlockRef)
 	AddUnsafePayload(payload *eth.ExecutionPayload)
 	UnsafeL2SyncTarget() eth.L2BlockRef
+	SetHaltTarget(target HaltTarget)
+	SetBlockLimits(limits BlockLimits)
+	SetUnsafeDivergenceHandler(fn func(UnsafeDivergence))
+	SetPayloadSealedHandler(fn func(*eth.ExecutionPayload))
 	Step(context.Context) error
 }
 
@@ -68,8 +75,12 @@ type DerivationPipeline struct {
 	stages    []ResetableStage
 
 	// Special stages to keep track of
-	traversal *L1Traversal
-	eng       EngineQueueStage
+	traversal  *L1Traversal
+	batchQueue *BatchQueue
+	eng        EngineQueueStage
+
+	// lastReset describes the latest reset of the pipeline
+	lastReset eth.ResetInfo
 
 	metrics Metrics
 }
@@ -79,12 +90,14 @@ func NewDerivationPipeline(log log.Logger, cfg *rollup.Config, l1Fetcher L1Fetch
 
 	// Pull stages
 	l1Traversal := NewL1Traversal(log, cfg, l1Fetcher)
-	dataSrc := NewDataSourceFactory(log, cfg, l1Fetcher) // auxiliary stage for L1Retrieval
+	// Batches submitted as DA commitments are only resolved if the L1 source is backed by a DA provider.
+	da, _ := l1Fetcher.(DAFetcher)
+	dataSrc := NewDataSourceFactory(log, cfg, l1Fetcher, da, metrics) // auxiliary stage for L1Retrieval
 	l1Src := NewL1Retrieval(log, dataSrc, l1Traversal)
 	frameQueue := NewFrameQueue(log, l1Src)
 	bank := NewChannelBank(log, cfg, frameQueue, l1Fetcher)
-	chInReader := NewChannelInReader(log, bank, metrics)
-	batchQueue := NewBatchQueue(log, cfg, chInReader)
+	chInReader := NewChannelInReader(log, cfg, bank, metrics)
+	batchQueue := NewBatchQueue(log, cfg, chInReader, metrics)
 	attrBuilder := NewFetchingAttributesBuilder(cfg, l1Fetcher, engine)
 	attributesQueue := NewAttributesQueue(log, cfg, attrBuilder, batchQueue)
 
@@ -97,14 +110,15 @@ func NewDerivationPipeline(log log.Logger, cfg *rollup.Config, l1Fetcher L1Fetch
 	stages := []ResetableStage{eng, l1Traversal, l1Src, frameQueue, bank, chInReader, batchQueue, attributesQueue}
 
 	return &DerivationPipeline{
-		log:       log,
-		cfg:       cfg,
-		l1Fetcher: l1Fetcher,
-		resetting: 0,
-		stages:    stages,
-		eng:       eng,
-		metrics:   metrics,
-		traversal: l1Traversal,
+		log:        log,
+		cfg:        cfg,
+		l1Fetcher:  l1Fetcher,
+		resetting:  0,
+		stages:     stages,
+		eng:        eng,
+		metrics:    metrics,
+		traversal:  l1Traversal,
+		batchQueue: batchQueue,
 	}
 }
 
@@ -114,8 +128,21 @@ func (dp *DerivationPipeline) EngineReady() bool {
 	return dp.resetting > 0
 }
 
-func (dp *DerivationPipeline) Reset() {
+// Reset resets the pipeline, and records the given cause of the reset.
+func (dp *DerivationPipeline) Reset(reason ResetReason) {
 	dp.resetting = 0
+	dp.lastReset = eth.ResetInfo{
+		Reason: string(reason),
+		Time:   uint64(time.Now().Unix()),
+		Origin: dp.eng.Origin().ID(),
+		Count:  dp.lastReset.Count + 1,
+	}
+	dp.metrics.RecordPipelineReset(string(reason))
+}
+
+// LastReset returns information about the latest reset of the pipeline.
+func (dp *DerivationPipeline) LastReset() eth.ResetInfo {
+	return dp.lastReset
 }
 
 // Origin is the L1 block of the inner-most stage of the derivation pipeline,
@@ -155,6 +182,14 @@ func (dp *DerivationPipeline) ConfirmPayload(ctx context.Context) (out *eth.Exec
 	return dp.eng.ConfirmPayload(ctx)
 }
 
+func (dp *DerivationPipeline) ConfirmPayloadAndStart(ctx context.Context, nextAttrs func(parent eth.L2BlockRef) (*eth.PayloadAttributes, error)) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error) {
+	return dp.eng.ConfirmPayloadAndStart(ctx, nextAttrs)
+}
+
+func (dp *DerivationPipeline) InsertPayload(ctx context.Context, payload *eth.ExecutionPayload) (errTyp BlockInsertionErrType, err error) {
+	return dp.eng.InsertPayload(ctx, payload)
+}
+
 func (dp *DerivationPipeline) CancelPayload(ctx context.Context, force bool) error {
 	return dp.eng.CancelPayload(ctx, force)
 }
@@ -163,11 +198,44 @@ func (dp *DerivationPipeline) BuildingPayload() (onto eth.L2BlockRef, id eth.Pay
 	return dp.eng.BuildingPayload()
 }
 
+// DroppedBatches returns the most recently dropped batches, ordered from oldest to newest.
+func (dp *DerivationPipeline) DroppedBatches() []DroppedBatch {
+	return dp.batchQueue.DroppedBatches()
+}
+
 // AddUnsafePayload schedules an execution payload to be processed, ahead of deriving it from L1
 func (dp *DerivationPipeline) AddUnsafePayload(payload *eth.ExecutionPayload) {
 	dp.eng.AddUnsafePayload(payload)
 }
 
+// SetHaltTarget configures the pipeline to stop deriving L2 blocks beyond the given target.
+// Once the target is reached, Step returns ErrHalted.
+func (dp *DerivationPipeline) SetHaltTarget(target HaltTarget) {
+	dp.eng.SetHaltTarget(target)
+}
+
+// SetOriginPrefetch configures the prefetching of the next L1 origins, which stops once ctx is done.
+func (dp *DerivationPipeline) SetOriginPrefetch(ctx context.Context, cfg PrefetchConfig) {
+	dp.eng.SetOriginPrefetch(ctx, cfg)
+}
+
+// SetBlockLimits configures the soft limits of the unsafe blocks built by the pipeline.
+func (dp *DerivationPipeline) SetBlockLimits(limits BlockLimits) {
+	dp.eng.SetBlockLimits(limits)
+}
+
+// SetUnsafeDivergenceHandler configures the function that is called whenever an unsafe block
+// does not match the block derived from L1 at the same height.
+func (dp *DerivationPipeline) SetUnsafeDivergenceHandler(fn func(UnsafeDivergence)) {
+	dp.eng.SetUnsafeDivergenceHandler(fn)
+}
+
+// SetPayloadSealedHandler configures the function that is called with every unsafe payload
+// built by the pipeline, after it is sealed and before it is inserted into the engine.
+func (dp *DerivationPipeline) SetPayloadSealedHandler(fn func(*eth.ExecutionPayload)) {
+	dp.eng.SetPayloadSealedHandler(fn)
+}
+
 // UnsafeL2SyncTarget retrieves the first queued-up L2 unsafe payload, or a zeroed reference if there is none.
 func (dp *DerivationPipeline) UnsafeL2SyncTarget() eth.L2BlockRef {
 	return dp.eng.UnsafeL2SyncTarget()
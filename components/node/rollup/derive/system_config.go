@@ -19,6 +19,7 @@ var (
 	SystemConfigUpdateGasConfig         = common.Hash{31: 1}
 	SystemConfigUpdateGasLimit          = common.Hash{31: 2}
 	SystemConfigUpdateUnsafeBlockSigner = common.Hash{31: 3}
+	SystemConfigUpdateFeeRecipient      = common.Hash{31: 4}
 )
 
 var (
@@ -38,8 +39,9 @@ var (
 	addressPadding = make([]byte, 12)
 )
 
-// UpdateSystemConfigWithL1Receipts filters all L1 receipts to find config updates and applies the config updates to the given sysCfg
-func UpdateSystemConfigWithL1Receipts(sysCfg *eth.SystemConfig, receipts []*types.Receipt, cfg *rollup.Config) error {
+// UpdateSystemConfigWithL1Receipts filters all L1 receipts to find config updates and applies the config updates to the given sysCfg.
+// The l1Time is the timestamp of the L1 block the receipts belong to, and determines which update types are active.
+func UpdateSystemConfigWithL1Receipts(sysCfg *eth.SystemConfig, receipts []*types.Receipt, cfg *rollup.Config, l1Time uint64) error {
 	var result error
 	for i, rec := range receipts {
 		if rec.Status != types.ReceiptStatusSuccessful {
@@ -47,7 +49,7 @@ func UpdateSystemConfigWithL1Receipts(sysCfg *eth.SystemConfig, receipts []*type
 		}
 		for j, log := range rec.Logs {
 			if log.Address == cfg.L1SystemConfigAddress && len(log.Topics) > 0 && log.Topics[0] == ConfigUpdateEventABIHash {
-				if err := ProcessSystemConfigUpdateLogEvent(sysCfg, log); err != nil {
+				if err := ProcessSystemConfigUpdateLogEvent(sysCfg, log, cfg, l1Time); err != nil {
 					result = multierror.Append(result, fmt.Errorf("malformatted L1 system sysCfg log in receipt %d, log %d: %w", i, j, err))
 				}
 			}
@@ -65,7 +67,7 @@ func UpdateSystemConfigWithL1Receipts(sysCfg *eth.SystemConfig, receipts []*type
 //	    UpdateType indexed updateType,
 //	    bytes data
 //	);
-func ProcessSystemConfigUpdateLogEvent(destSysCfg *eth.SystemConfig, ev *types.Log) error {
+func ProcessSystemConfigUpdateLogEvent(destSysCfg *eth.SystemConfig, ev *types.Log, cfg *rollup.Config, l1Time uint64) error {
 	if len(ev.Topics) != 3 {
 		return fmt.Errorf("expected 3 event topics (event identity, indexed version, indexed updateType), got %d", len(ev.Topics))
 	}
@@ -171,6 +173,35 @@ func ProcessSystemConfigUpdateLogEvent(destSysCfg *eth.SystemConfig, ev *types.L
 		return nil
 	case SystemConfigUpdateUnsafeBlockSigner:
 		// Ignored in derivation. This configurable applies to runtime configuration outside of the derivation.
+		return nil
+	case SystemConfigUpdateFeeRecipient:
+		// Ignored in derivation until the fee recipient updates are activated.
+		if !cfg.IsFeeRecipientUpdate(l1Time) {
+			return nil
+		}
+
+		// Read the pointer, it should always equal 32.
+		if word := readWord(); word != oneWordUint {
+			return fmt.Errorf("expected offset to point to length location, but got %s", word)
+		}
+
+		// Read the length, it should also always equal 32.
+		if word := readWord(); word != oneWordUint {
+			return fmt.Errorf("expected length to be 32 bytes, but got %s", word)
+		}
+
+		// Indexing `word` directly is always safe here, it is guaranteed to be 32 bytes in length.
+		// Check that the fee recipient address is correctly zero-padded.
+		word := readWord()
+		if !bytes.Equal(word[:12], addressPadding) {
+			return fmt.Errorf("expected version 0 fee recipient with zero padding, but got %x", word)
+		}
+		destSysCfg.FeeRecipient.SetBytes(word[12:])
+
+		if countReadBytes != 32*3 {
+			return NewCriticalError(fmt.Errorf("expected 32*3 bytes in fee recipient update, but got %d bytes", len(ev.Data)))
+		}
+
 		return nil
 	default:
 		return fmt.Errorf("unrecognized L1 sysCfg update type: %s", updateType)
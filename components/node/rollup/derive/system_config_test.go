@@ -9,7 +9,9 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/kroma-network/kroma/bindings/bindings"
 	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup"
 )
 
 var (
@@ -139,6 +141,29 @@ func TestProcessSystemConfigUpdateLogEvent(t *testing.T) {
 			},
 			err: false,
 		},
+		{
+			name: "SystemConfigUpdateFeeRecipient",
+			log: &types.Log{
+				Topics: []common.Hash{
+					ConfigUpdateEventABIHash,
+					ConfigUpdateEventVersion0,
+					SystemConfigUpdateFeeRecipient,
+				},
+			},
+			hook: func(t *testing.T, log *types.Log) *types.Log {
+				addr := common.Address{19: 0xcc}
+				addrData, err := addressArgs.Pack(&addr)
+				require.NoError(t, err)
+				data, err := bytesArgs.Pack(addrData)
+				require.NoError(t, err)
+				log.Data = data
+				return log
+			},
+			config: eth.SystemConfig{
+				FeeRecipient: common.Address{19: 0xcc},
+			},
+			err: false,
+		},
 		{
 			name: "SystemConfigOneTopic",
 			log: &types.Log{
@@ -154,12 +179,15 @@ func TestProcessSystemConfigUpdateLogEvent(t *testing.T) {
 		},
 	}
 
+	activation := uint64(10)
+	rollupCfg := &rollup.Config{FeeRecipientUpdateTime: &activation}
+
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
 			config := eth.SystemConfig{}
 
-			err := ProcessSystemConfigUpdateLogEvent(&config, test.hook(t, test.log))
+			err := ProcessSystemConfigUpdateLogEvent(&config, test.hook(t, test.log), rollupCfg, activation)
 			if test.err {
 				require.Error(t, err)
 			} else {
@@ -169,3 +197,68 @@ func TestProcessSystemConfigUpdateLogEvent(t *testing.T) {
 		})
 	}
 }
+
+// TestProcessSystemConfigUpdateFeeRecipientBeforeActivation tests that fee recipient updates
+// are ignored before they are activated.
+func TestProcessSystemConfigUpdateFeeRecipientBeforeActivation(t *testing.T) {
+	addr := common.Address{19: 0xcc}
+	addrData, err := addressArgs.Pack(&addr)
+	require.NoError(t, err)
+	data, err := bytesArgs.Pack(addrData)
+	require.NoError(t, err)
+	log := &types.Log{
+		Topics: []common.Hash{
+			ConfigUpdateEventABIHash,
+			ConfigUpdateEventVersion0,
+			SystemConfigUpdateFeeRecipient,
+		},
+		Data: data,
+	}
+
+	activation := uint64(10)
+	for _, rollupCfg := range []*rollup.Config{{}, {FeeRecipientUpdateTime: &activation}} {
+		config := eth.SystemConfig{}
+		require.NoError(t, ProcessSystemConfigUpdateLogEvent(&config, log, rollupCfg, activation-1))
+		require.Equal(t, eth.SystemConfig{}, config)
+	}
+}
+
+// TestSystemConfigFeeRecipientEvent tests that the fee recipient updates are applied from
+// receipts with the ConfigUpdate event encoded as emitted by SystemConfig.setFeeRecipient.
+func TestSystemConfigFeeRecipientEvent(t *testing.T) {
+	sysCfgABI, err := bindings.SystemConfigMetaData.GetAbi()
+	require.NoError(t, err)
+	event := sysCfgABI.Events["ConfigUpdate"]
+	require.Equal(t, ConfigUpdateEventABIHash, event.ID)
+
+	require.Contains(t, sysCfgABI.Methods, "setFeeRecipient")
+
+	feeRecipient := common.Address{19: 0xcc}
+	// The update data of setFeeRecipient is abi.encode(_feeRecipient).
+	updateData, err := addressArgs.Pack(feeRecipient)
+	require.NoError(t, err)
+	data, err := event.Inputs.NonIndexed().Pack(updateData)
+	require.NoError(t, err)
+	// FEE_RECIPIENT is the fifth member of the UpdateType enum of the SystemConfig.
+	topics, err := abi.MakeTopics([]any{event.ID}, []any{big.NewInt(0)}, []any{uint8(4)})
+	require.NoError(t, err)
+
+	activation := uint64(10)
+	rollupCfg := &rollup.Config{
+		L1SystemConfigAddress:  common.Address{19: 0x42},
+		FeeRecipientUpdateTime: &activation,
+	}
+	receipts := []*types.Receipt{{
+		Status: types.ReceiptStatusSuccessful,
+		Logs: []*types.Log{{
+			Address: rollupCfg.L1SystemConfigAddress,
+			Topics:  []common.Hash{topics[0][0], topics[1][0], topics[2][0]},
+			Data:    data,
+		}},
+	}}
+	require.Equal(t, SystemConfigUpdateFeeRecipient, topics[2][0])
+
+	sysCfg := eth.SystemConfig{}
+	require.NoError(t, UpdateSystemConfigWithL1Receipts(&sysCfg, receipts, rollupCfg, activation))
+	require.Equal(t, feeRecipient, sysCfg.FeeRecipient)
+}
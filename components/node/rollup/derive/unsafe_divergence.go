@@ -0,0 +1,16 @@
+package derive
+
+import "github.com/kroma-network/kroma/components/node/eth"
+
+// UnsafeDivergence describes an unsafe L2 block that does not match the block derived from L1 at the same height.
+// Unless the unsafe block was never submitted to L1, this means the proposer published conflicting blocks.
+type UnsafeDivergence struct {
+	// Unsafe is the unsafe block that is reorged out by the derived block.
+	Unsafe eth.BlockID `json:"unsafe"`
+	// Parent is the safe block that both blocks build on.
+	Parent eth.BlockID `json:"parent"`
+	// Origin is the L1 block the derived block was derived from.
+	Origin eth.BlockID `json:"origin"`
+	// Reason describes how the unsafe block differs from the derived attributes.
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,139 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+	"github.com/kroma-network/kroma/components/node/rollup/proposer"
+)
+
+// BlockBuilder is the backend the proposer builds blocks with.
+// The local engine is a BlockBuilder, other backends decorate it.
+type BlockBuilder interface {
+	derive.ResettableEngineControl
+}
+
+// ExternalBuilderClient requests payloads from an external block builder.
+type ExternalBuilderClient interface {
+	// GetPayload returns a payload built on top of parent with the given attributes.
+	GetPayload(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error)
+}
+
+// ExternalBlockBuilder is a BlockBuilder that completes blocks with payloads from an external builder.
+// The local engine builds every block in parallel, and its payload is used if the external builder fails
+// to return a valid payload in time. Safe blocks are always built by the local engine.
+type ExternalBlockBuilder struct {
+	BlockBuilder // local builder
+
+	log     log.Logger
+	client  ExternalBuilderClient
+	timeout time.Duration
+
+	// parent and attrs of the block being built, nil if no unsafe block is being built
+	parent eth.L2BlockRef
+	attrs  *eth.PayloadAttributes
+}
+
+var _ BlockBuilder = (*ExternalBlockBuilder)(nil)
+
+func NewExternalBlockBuilder(log log.Logger, local BlockBuilder, client ExternalBuilderClient, timeout time.Duration) *ExternalBlockBuilder {
+	if timeout <= 0 {
+		timeout = proposer.DefaultBuilderTimeout
+	}
+	return &ExternalBlockBuilder{
+		BlockBuilder: local,
+		log:          log,
+		client:       client,
+		timeout:      timeout,
+	}
+}
+
+func (b *ExternalBlockBuilder) StartPayload(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes, updateSafe bool) (errType derive.BlockInsertionErrType, err error) {
+	b.attrs = nil
+	errType, err = b.BlockBuilder.StartPayload(ctx, parent, attrs, updateSafe)
+	if err == nil && !updateSafe {
+		b.parent = parent
+		b.attrs = attrs
+	}
+	return errType, err
+}
+
+func (b *ExternalBlockBuilder) ConfirmPayload(ctx context.Context) (out *eth.ExecutionPayload, errTyp derive.BlockInsertionErrType, err error) {
+	parent, attrs := b.parent, b.attrs
+	b.attrs = nil
+	if attrs == nil {
+		return b.BlockBuilder.ConfirmPayload(ctx)
+	}
+
+	payload, err := b.fetchPayload(ctx, parent, attrs)
+	if err != nil {
+		b.log.Warn("failed to get payload from external builder, using local payload", "parent", parent, "err", err)
+		return b.BlockBuilder.ConfirmPayload(ctx)
+	}
+	errTyp, err = b.BlockBuilder.InsertPayload(ctx, payload)
+	if err != nil {
+		if errTyp == derive.BlockInsertPayloadErr {
+			b.log.Warn("external payload was rejected, using local payload", "payload", payload.ID(), "err", err)
+			return b.BlockBuilder.ConfirmPayload(ctx)
+		}
+		return nil, errTyp, err
+	}
+	b.log.Info("inserted payload of external builder", "payload", payload.ID(), "txs", len(payload.Transactions))
+	return payload, derive.BlockInsertOK, nil
+}
+
+// ConfirmPayloadAndStart completes the current block like ConfirmPayload, but does not start the next block:
+// the payload of the external builder is only available after the building, and cannot be pipelined.
+func (b *ExternalBlockBuilder) ConfirmPayloadAndStart(ctx context.Context, _ func(parent eth.L2BlockRef) (*eth.PayloadAttributes, error)) (out *eth.ExecutionPayload, errTyp derive.BlockInsertionErrType, err error) {
+	return b.ConfirmPayload(ctx)
+}
+
+func (b *ExternalBlockBuilder) CancelPayload(ctx context.Context, force bool) error {
+	b.attrs = nil
+	return b.BlockBuilder.CancelPayload(ctx, force)
+}
+
+func (b *ExternalBlockBuilder) Reset(reason derive.ResetReason) {
+	b.attrs = nil
+	b.BlockBuilder.Reset(reason)
+}
+
+// fetchPayload requests a payload from the external builder, and checks that it matches the attributes.
+func (b *ExternalBlockBuilder) fetchPayload(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.timeout)
+	defer cancel()
+	payload, err := b.client.GetPayload(ctx, parent, attrs)
+	if err != nil {
+		return nil, err
+	}
+	if payload == nil {
+		return nil, fmt.Errorf("no payload")
+	}
+	if payload.ParentHash != parent.Hash {
+		return nil, fmt.Errorf("payload parent %s does not match %s", payload.ParentHash, parent.Hash)
+	}
+	if payload.Timestamp != attrs.Timestamp {
+		return nil, fmt.Errorf("payload timestamp %d does not match %d", payload.Timestamp, attrs.Timestamp)
+	}
+	if attrs.GasLimit != nil && payload.GasLimit != *attrs.GasLimit {
+		return nil, fmt.Errorf("payload gas limit %d does not match %d", payload.GasLimit, *attrs.GasLimit)
+	}
+	// the forced transactions, i.e. deposits, must be included first and in order
+	if len(payload.Transactions) < len(attrs.Transactions) {
+		return nil, fmt.Errorf("payload has %d txs, expected at least %d forced txs", len(payload.Transactions), len(attrs.Transactions))
+	}
+	for i, tx := range attrs.Transactions {
+		if string(payload.Transactions[i]) != string(tx) {
+			return nil, fmt.Errorf("payload tx %d does not match forced tx", i)
+		}
+	}
+	if attrs.NoTxPool && len(payload.Transactions) != len(attrs.Transactions) {
+		return nil, fmt.Errorf("payload includes txs from the tx pool, but the tx pool was disabled")
+	}
+	return payload, nil
+}
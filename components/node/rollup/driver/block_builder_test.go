@@ -0,0 +1,162 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+type testLocalBuilder struct {
+	derive.ResettableEngineControl // unused methods are not implemented
+
+	confirmed int
+	inserted  []*eth.ExecutionPayload
+
+	insertErrTyp derive.BlockInsertionErrType
+	insertErr    error
+}
+
+func (b *testLocalBuilder) StartPayload(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes, updateSafe bool) (errType derive.BlockInsertionErrType, err error) {
+	return derive.BlockInsertOK, nil
+}
+
+func (b *testLocalBuilder) ConfirmPayload(ctx context.Context) (out *eth.ExecutionPayload, errTyp derive.BlockInsertionErrType, err error) {
+	b.confirmed += 1
+	return &eth.ExecutionPayload{BlockHash: common.Hash{0xaa}}, derive.BlockInsertOK, nil
+}
+
+func (b *testLocalBuilder) InsertPayload(ctx context.Context, payload *eth.ExecutionPayload) (errTyp derive.BlockInsertionErrType, err error) {
+	if b.insertErr != nil {
+		return b.insertErrTyp, b.insertErr
+	}
+	b.inserted = append(b.inserted, payload)
+	return derive.BlockInsertOK, nil
+}
+
+type testBuilderClientFn func(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error)
+
+func (fn testBuilderClientFn) GetPayload(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error) {
+	return fn(ctx, parent, attrs)
+}
+
+func TestExternalBlockBuilder(t *testing.T) {
+	parent := eth.L2BlockRef{Hash: common.Hash{0x01}, Number: 10, Time: 1000}
+	attrs := &eth.PayloadAttributes{
+		Timestamp:    1002,
+		Transactions: []eth.Data{{0x7e, 0x01}},
+	}
+	externalPayload := func() *eth.ExecutionPayload {
+		return &eth.ExecutionPayload{
+			ParentHash:   parent.Hash,
+			BlockHash:    common.Hash{0xbb},
+			Timestamp:    attrs.Timestamp,
+			Transactions: []eth.Data{attrs.Transactions[0], {0x02}},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		safe     bool
+		client   testBuilderClientFn
+		local    *testLocalBuilder
+		external bool
+		err      bool
+	}{
+		{
+			name: "external payload",
+			client: func(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error) {
+				return externalPayload(), nil
+			},
+			external: true,
+		},
+		{
+			name: "builder error",
+			client: func(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error) {
+				return nil, errors.New("builder down")
+			},
+		},
+		{
+			name: "builder timeout",
+			client: func(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+		},
+		{
+			name: "wrong parent",
+			client: func(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error) {
+				payload := externalPayload()
+				payload.ParentHash = common.Hash{0x02}
+				return payload, nil
+			},
+		},
+		{
+			name: "missing forced tx",
+			client: func(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error) {
+				payload := externalPayload()
+				payload.Transactions = payload.Transactions[1:]
+				return payload, nil
+			},
+		},
+		{
+			name: "rejected by engine",
+			client: func(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error) {
+				return externalPayload(), nil
+			},
+			local: &testLocalBuilder{insertErrTyp: derive.BlockInsertPayloadErr, insertErr: errors.New("invalid payload")},
+		},
+		{
+			name: "temporary insert error",
+			client: func(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error) {
+				return externalPayload(), nil
+			},
+			local: &testLocalBuilder{insertErrTyp: derive.BlockInsertTemporaryErr, insertErr: errors.New("engine busy")},
+			err:   true,
+		},
+		{
+			name: "safe block",
+			safe: true,
+			client: func(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error) {
+				panic("safe blocks must not be requested from the external builder")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			local := tc.local
+			if local == nil {
+				local = &testLocalBuilder{}
+			}
+			builder := NewExternalBlockBuilder(testlog.Logger(t, log.LvlError), local, tc.client, 10*time.Millisecond)
+
+			_, err := builder.StartPayload(context.Background(), parent, attrs, tc.safe)
+			require.NoError(t, err)
+			payload, _, err := builder.ConfirmPayload(context.Background())
+			if tc.err {
+				require.Error(t, err)
+				require.Zero(t, local.confirmed)
+				return
+			}
+			require.NoError(t, err)
+			if tc.external {
+				require.Equal(t, common.Hash{0xbb}, payload.BlockHash)
+				require.Len(t, local.inserted, 1)
+				require.Zero(t, local.confirmed)
+			} else {
+				require.Equal(t, common.Hash{0xaa}, payload.BlockHash)
+				require.Empty(t, local.inserted)
+				require.Equal(t, 1, local.confirmed)
+			}
+		})
+	}
+}
@@ -0,0 +1,382 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// maxConditionalTxs is the maximum number of conditional transactions waiting for inclusion.
+const maxConditionalTxs = 1024
+
+// maxConditionalKnownSlots is the maximum number of storage slots a single conditional transaction may depend on.
+const maxConditionalKnownSlots = 1000
+
+var (
+	ErrConditionalTxPoolFull   = errors.New("conditional transaction pool is full")
+	ErrConditionalTxKnown      = errors.New("conditional transaction already known")
+	ErrConditionalTxsDisabled  = errors.New("conditional transactions are not enabled")
+	ErrInvalidTxConditional    = errors.New("invalid transaction conditional")
+	ErrTxConditionalNotMet     = errors.New("transaction conditional not met")
+	ErrTooManyKnownStorageSlot = errors.New("too many known storage slots")
+)
+
+// TransactionConditional are the preconditions of a transaction, checked when building the block to include it in.
+// All fields are optional.
+type TransactionConditional struct {
+	// KnownAccounts maps accounts to the expected values of their storage slots.
+	KnownAccounts  map[common.Address]map[common.Hash]common.Hash `json:"knownAccounts,omitempty"`
+	BlockNumberMin *hexutil.Uint64                                `json:"blockNumberMin,omitempty"`
+	BlockNumberMax *hexutil.Uint64                                `json:"blockNumberMax,omitempty"`
+	TimestampMin   *hexutil.Uint64                                `json:"timestampMin,omitempty"`
+	TimestampMax   *hexutil.Uint64                                `json:"timestampMax,omitempty"`
+}
+
+// Check verifies that the conditional is well-formed.
+func (c *TransactionConditional) Check() error {
+	if c.BlockNumberMin != nil && c.BlockNumberMax != nil && *c.BlockNumberMin > *c.BlockNumberMax {
+		return fmt.Errorf("%w: block number min %d is greater than max %d", ErrInvalidTxConditional, *c.BlockNumberMin, *c.BlockNumberMax)
+	}
+	if c.TimestampMin != nil && c.TimestampMax != nil && *c.TimestampMin > *c.TimestampMax {
+		return fmt.Errorf("%w: timestamp min %d is greater than max %d", ErrInvalidTxConditional, *c.TimestampMin, *c.TimestampMax)
+	}
+	slots := 0
+	for _, storage := range c.KnownAccounts {
+		slots += len(storage)
+	}
+	if slots > maxConditionalKnownSlots {
+		return fmt.Errorf("%w: %d > %d", ErrTooManyKnownStorageSlot, slots, maxConditionalKnownSlots)
+	}
+	return nil
+}
+
+// expired returns true if the conditional can never be met at or after the given block number and timestamp.
+func (c *TransactionConditional) expired(number uint64, timestamp uint64) bool {
+	return (c.BlockNumberMax != nil && number > uint64(*c.BlockNumberMax)) ||
+		(c.TimestampMax != nil && timestamp > uint64(*c.TimestampMax))
+}
+
+// early returns true if the conditional is not met yet at the given block number and timestamp.
+func (c *TransactionConditional) early(number uint64, timestamp uint64) bool {
+	return (c.BlockNumberMin != nil && number < uint64(*c.BlockNumberMin)) ||
+		(c.TimestampMin != nil && timestamp < uint64(*c.TimestampMin))
+}
+
+// ConditionalStateReader reads the L2 state to check the conditionals against.
+type ConditionalStateReader interface {
+	// GetProof returns a proof of the account, it may return a nil result without error if the address was not found.
+	GetProof(ctx context.Context, address common.Address, storage []common.Hash, blockTag string) (*eth.AccountResult, error)
+	// NextBaseFee returns the base fee of the block built on top of the given block.
+	NextBaseFee(ctx context.Context, parent eth.BlockID) (*big.Int, error)
+}
+
+// conditionalRefreshTimeout bounds the time spent on checking the state of the conditional transactions.
+const conditionalRefreshTimeout = 10 * time.Second
+
+type conditionalTx struct {
+	tx   *types.Transaction
+	from common.Address
+	cond TransactionConditional
+	// verifiedAt is the hash of the block that the transaction can be included on top of, according to the state checks
+	verifiedAt common.Hash
+}
+
+// senderState is the state of a sender account, after the execution of its previously verified transactions.
+type senderState struct {
+	nonce   uint64
+	balance *big.Int
+}
+
+// ConditionalTxPool holds conditional transactions until a block is built that meets their preconditions.
+// The matching transactions are force-included in the block, right after the deposits, so they are
+// fully validated before: the state checks run in the background, against the latest unsafe head,
+// and the block building only selects the transactions that were verified against its parent.
+type ConditionalTxPool struct {
+	log    log.Logger
+	signer types.Signer
+	state  ConditionalStateReader
+
+	// refreshReq signals that the transactions should be checked against the latest head
+	refreshReq chan struct{}
+
+	mu  sync.Mutex
+	txs []*conditionalTx // ordered by arrival
+	// head is the latest unsafe head, that the transactions are checked against
+	head eth.L2BlockRef
+	// baseFee is the base fee of the block built on top of baseFeeAt
+	baseFee   *big.Int
+	baseFeeAt common.Hash
+}
+
+func NewConditionalTxPool(log log.Logger, l2ChainID *big.Int, state ConditionalStateReader) *ConditionalTxPool {
+	return &ConditionalTxPool{
+		log:        log,
+		signer:     types.LatestSignerForChainID(l2ChainID),
+		state:      state,
+		refreshReq: make(chan struct{}, 1),
+	}
+}
+
+// Add queues up the transaction to be included in the first block that meets the conditional.
+func (p *ConditionalTxPool) Add(tx *types.Transaction, cond TransactionConditional) error {
+	if err := cond.Check(); err != nil {
+		return err
+	}
+	if tx.Type() == types.DepositTxType {
+		return fmt.Errorf("%w: deposit transactions cannot be conditional", ErrInvalidTxConditional)
+	}
+	if tx.GasTipCapIntCmp(tx.GasFeeCap()) > 0 {
+		return core.ErrTipAboveFeeCap
+	}
+	// The stricter rules of the latest forks are applied, the transaction is valid under all of them.
+	intrinsicGas, err := core.IntrinsicGas(tx.Data(), tx.AccessList(), tx.To() == nil, true, true, true)
+	if err != nil {
+		return err
+	}
+	if tx.Gas() < intrinsicGas {
+		return fmt.Errorf("%w: have %d, want %d", core.ErrIntrinsicGas, tx.Gas(), intrinsicGas)
+	}
+	from, err := types.Sender(p.signer, tx)
+	if err != nil {
+		return fmt.Errorf("invalid transaction sender: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.txs) >= maxConditionalTxs {
+		return ErrConditionalTxPoolFull
+	}
+	for _, item := range p.txs {
+		if item.tx.Hash() == tx.Hash() {
+			return ErrConditionalTxKnown
+		}
+	}
+	p.txs = append(p.txs, &conditionalTx{tx: tx, from: from, cond: cond})
+	p.requestRefresh()
+	return nil
+}
+
+// Len returns the number of transactions waiting for inclusion.
+func (p *ConditionalTxPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.txs)
+}
+
+// OnUnsafeHead schedules the transactions to be checked against the state of the given unsafe head, if it changed.
+func (p *ConditionalTxPool) OnUnsafeHead(head eth.L2BlockRef) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.head == head {
+		return
+	}
+	p.head = head
+	p.requestRefresh()
+}
+
+// requestRefresh schedules a refresh without blocking. The caller must hold the lock.
+func (p *ConditionalTxPool) requestRefresh() {
+	if len(p.txs) == 0 || p.head == (eth.L2BlockRef{}) {
+		return
+	}
+	select {
+	case p.refreshReq <- struct{}{}:
+	default:
+	}
+}
+
+// Run checks the transactions against the latest unsafe head whenever it changes, until ctx is done.
+func (p *ConditionalTxPool) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.refreshReq:
+			p.refresh(ctx)
+		}
+	}
+}
+
+// refresh checks the sender accounts and the known storage slots of the transactions against the state
+// of the latest unsafe head, without holding the lock, and drops the transactions that can never be included.
+func (p *ConditionalTxPool) refresh(ctx context.Context) {
+	p.mu.Lock()
+	head := p.head
+	items := append([]*conditionalTx(nil), p.txs...)
+	p.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, conditionalRefreshTimeout)
+	defer cancel()
+
+	baseFee, err := p.state.NextBaseFee(ctx, head.ID())
+	if err != nil {
+		p.log.Warn("failed to fetch base fee to check conditional transactions against", "head", head, "err", err)
+		return
+	}
+	blockTag := head.Hash.String()
+	senders := make(map[common.Address]*senderState)
+	verified := make(map[*conditionalTx]struct{})
+	dropped := make(map[*conditionalTx]error)
+	for _, item := range items {
+		// The next block is at least one second later than the head.
+		if item.cond.expired(head.Number+1, head.Time+1) {
+			dropped[item] = errors.New("conditional expired")
+			continue
+		}
+		keep, err := p.checkState(ctx, item, blockTag, senders)
+		if err != nil {
+			if !keep {
+				dropped[item] = err
+			}
+			continue
+		}
+		verified[item] = struct{}{}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	remaining := p.txs[:0]
+	for _, item := range p.txs {
+		if err, ok := dropped[item]; ok {
+			p.log.Debug("dropping conditional transaction", "tx", item.tx.Hash(), "err", err)
+			continue
+		}
+		if _, ok := verified[item]; ok {
+			item.verifiedAt = head.Hash
+		}
+		remaining = append(remaining, item)
+	}
+	p.removeTail(len(remaining))
+	p.txs = remaining
+	p.baseFee = baseFee
+	p.baseFeeAt = head.Hash
+}
+
+// removeTail clears the references of the transactions that were removed by filtering the transactions in-place.
+// The caller must hold the lock.
+func (p *ConditionalTxPool) removeTail(remaining int) {
+	for i := remaining; i < len(p.txs); i++ {
+		p.txs[i] = nil
+	}
+}
+
+// Select removes and returns the transactions whose conditionals are met by a block built on top of parent,
+// with the given attributes. Only the transactions that were verified against the state of parent,
+// that pay the base fee of the block and that fit within its gas limit are selected, so that the block
+// does not fail because of them. Selection does not read the state: if the transactions were not
+// checked against parent yet, they are left for a later block.
+// Transactions that can never be included anymore are dropped.
+// Selection is best-effort: if building the block fails, the selected transactions are not re-queued.
+func (p *ConditionalTxPool) Select(parent eth.L2BlockRef, attrs *eth.PayloadAttributes) types.Transactions {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.baseFeeAt != parent.Hash || attrs.GasLimit == nil {
+		return nil
+	}
+	number := parent.Number + 1
+	timestamp := uint64(attrs.Timestamp)
+	gasLimit := uint64(*attrs.GasLimit)
+	gasLeft := gasLimit
+	for _, data := range attrs.Transactions {
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(data); err != nil {
+			p.log.Warn("failed to decode payload attributes tx to account for its gas", "err", err)
+			return nil
+		}
+		if tx.Gas() > gasLeft {
+			return nil
+		}
+		gasLeft -= tx.Gas()
+	}
+	// skipped tracks the senders with a transaction that is not selected, so that their later
+	// transactions are not selected either: they would not have the expected nonce.
+	skipped := make(map[common.Address]struct{})
+
+	var selected types.Transactions
+	remaining := p.txs[:0]
+	for _, item := range p.txs {
+		if item.cond.expired(number, timestamp) {
+			p.log.Debug("dropping expired conditional transaction", "tx", item.tx.Hash(), "number", number, "timestamp", timestamp)
+			continue
+		}
+		if item.tx.Gas() > gasLimit {
+			p.log.Debug("dropping conditional transaction above block gas limit", "tx", item.tx.Hash(), "gas", item.tx.Gas(), "gas_limit", gasLimit)
+			continue
+		}
+		if _, ok := skipped[item.from]; ok || item.verifiedAt != parent.Hash || item.cond.early(number, timestamp) ||
+			item.tx.GasFeeCapIntCmp(p.baseFee) < 0 || item.tx.Gas() > gasLeft {
+			skipped[item.from] = struct{}{}
+			remaining = append(remaining, item)
+			continue
+		}
+		gasLeft -= item.tx.Gas()
+		selected = append(selected, item.tx)
+	}
+	p.removeTail(len(remaining))
+	p.txs = remaining
+	return selected
+}
+
+// checkState checks the sender account and the known storage slots of the transaction against the state of blockTag,
+// updated with the execution of the transactions of the sender that were checked before.
+// If it returns an error, keep indicates whether the transaction may still be included in a later block.
+func (p *ConditionalTxPool) checkState(ctx context.Context, item *conditionalTx, blockTag string, senders map[common.Address]*senderState) (keep bool, err error) {
+	sender, ok := senders[item.from]
+	if !ok {
+		account, err := p.state.GetProof(ctx, item.from, nil, blockTag)
+		if err != nil {
+			return true, fmt.Errorf("failed to fetch sender account %s: %w", item.from, err)
+		}
+		if account == nil {
+			return true, fmt.Errorf("sender account %s not found", item.from)
+		}
+		sender = &senderState{nonce: uint64(account.Nonce), balance: account.Balance.ToInt()}
+		senders[item.from] = sender
+	}
+	if item.tx.Nonce() < sender.nonce {
+		return false, fmt.Errorf("nonce too low: %d < %d", item.tx.Nonce(), sender.nonce)
+	}
+	if item.tx.Nonce() > sender.nonce {
+		return true, fmt.Errorf("nonce too high: %d > %d", item.tx.Nonce(), sender.nonce)
+	}
+	if sender.balance.Cmp(item.tx.Cost()) < 0 {
+		return true, fmt.Errorf("insufficient funds of sender %s", item.from)
+	}
+
+	for addr, storage := range item.cond.KnownAccounts {
+		keys := make([]common.Hash, 0, len(storage))
+		for key := range storage {
+			keys = append(keys, key)
+		}
+		account, err := p.state.GetProof(ctx, addr, keys, blockTag)
+		if err != nil {
+			return true, fmt.Errorf("failed to fetch storage of %s: %w", addr, err)
+		}
+		values := make(map[common.Hash]common.Hash, len(keys))
+		if account != nil {
+			for _, entry := range account.StorageProof {
+				values[entry.Key] = common.BigToHash(entry.Value.ToInt())
+			}
+		}
+		for key, expected := range storage {
+			if values[key] != expected {
+				return false, fmt.Errorf("%w: storage slot %s of %s is %s, expected %s", ErrTxConditionalNotMet, key, addr, values[key], expected)
+			}
+		}
+	}
+	sender.nonce++
+	sender.balance = new(big.Int).Sub(sender.balance, item.tx.Cost())
+	return false, nil
+}
@@ -0,0 +1,164 @@
+package driver
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/testlog"
+	"github.com/kroma-network/kroma/components/node/testutils"
+)
+
+type fakeConditionalState struct {
+	nonces  map[common.Address]uint64
+	storage map[common.Address]map[common.Hash]common.Hash
+	baseFee *big.Int
+}
+
+func (f *fakeConditionalState) NextBaseFee(ctx context.Context, parent eth.BlockID) (*big.Int, error) {
+	return f.baseFee, nil
+}
+
+func (f *fakeConditionalState) GetProof(ctx context.Context, address common.Address, storage []common.Hash, blockTag string) (*eth.AccountResult, error) {
+	res := &eth.AccountResult{
+		Address: address,
+		Balance: (*hexutil.Big)(big.NewInt(1e18)),
+		Nonce:   hexutil.Uint64(f.nonces[address]),
+	}
+	for _, key := range storage {
+		res.StorageProof = append(res.StorageProof, eth.StorageProofEntry{
+			Key:   key,
+			Value: hexutil.Big(*f.storage[address][key].Big()),
+		})
+	}
+	return res, nil
+}
+
+func TestConditionalTxPool(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	chainID := big.NewInt(901)
+	signer := types.LatestSignerForChainID(chainID)
+	key := testutils.RandomKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	contract := testutils.RandomAddress(rng)
+	slot := testutils.RandomHash(rng)
+	value := testutils.RandomHash(rng)
+
+	state := &fakeConditionalState{
+		nonces:  map[common.Address]uint64{from: 1},
+		storage: map[common.Address]map[common.Hash]common.Hash{contract: {slot: value}},
+		baseFee: big.NewInt(1),
+	}
+	pool := NewConditionalTxPool(testlog.Logger(t, log.LvlError), chainID, state)
+
+	newTxWithFee := func(nonce uint64, gas uint64, feeCap int64) *types.Transaction {
+		tx, err := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     nonce,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(feeCap),
+			Gas:       gas,
+			To:        &contract,
+		})
+		require.NoError(t, err)
+		return tx
+	}
+	newTx := func(nonce uint64) *types.Transaction {
+		return newTxWithFee(nonce, 21000, 1)
+	}
+	u64 := func(v uint64) *hexutil.Uint64 {
+		return (*hexutil.Uint64)(&v)
+	}
+
+	included := newTx(1)
+	next := newTx(2)
+	early := newTx(5)
+	stale := newTx(0)
+	expired := newTx(4)
+	mismatch := newTx(3)
+
+	require.NoError(t, pool.Add(included, TransactionConditional{
+		KnownAccounts: map[common.Address]map[common.Hash]common.Hash{contract: {slot: value}},
+	}))
+	require.ErrorIs(t, pool.Add(included, TransactionConditional{}), ErrConditionalTxKnown)
+	require.NoError(t, pool.Add(next, TransactionConditional{BlockNumberMax: u64(11)}))
+	require.NoError(t, pool.Add(early, TransactionConditional{TimestampMin: u64(1000)}))
+	require.NoError(t, pool.Add(stale, TransactionConditional{}))
+	require.NoError(t, pool.Add(expired, TransactionConditional{BlockNumberMax: u64(5)}))
+	require.NoError(t, pool.Add(mismatch, TransactionConditional{
+		KnownAccounts: map[common.Address]map[common.Hash]common.Hash{contract: {slot: common.Hash{}}},
+	}))
+	require.ErrorIs(t, pool.Add(newTx(6), TransactionConditional{BlockNumberMin: u64(2), BlockNumberMax: u64(1)}), ErrInvalidTxConditional)
+
+	require.ErrorIs(t, pool.Add(newTxWithFee(6, 20000, 1), TransactionConditional{}), core.ErrIntrinsicGas)
+
+	parent := eth.L2BlockRef{Hash: testutils.RandomHash(rng), Number: 9, Time: 98}
+	gasLimit := eth.Uint64Quantity(100_000)
+	attrs := &eth.PayloadAttributes{Timestamp: 100, GasLimit: &gasLimit}
+	require.Empty(t, pool.Select(parent, attrs), "transactions are only selected once checked against the parent state")
+
+	pool.OnUnsafeHead(parent)
+	pool.refresh(context.Background())
+	selected := pool.Select(parent, attrs)
+	require.Equal(t, []common.Hash{included.Hash(), next.Hash()}, []common.Hash{selected[0].Hash(), selected[1].Hash()})
+	require.Len(t, selected, 2)
+	// the early transaction is kept, the others are included or dropped
+	require.Equal(t, 1, pool.Len())
+}
+
+func TestConditionalTxPoolBlockValidity(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	chainID := big.NewInt(901)
+	signer := types.LatestSignerForChainID(chainID)
+	key := testutils.RandomKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := testutils.RandomAddress(rng)
+
+	state := &fakeConditionalState{nonces: map[common.Address]uint64{from: 0}, baseFee: big.NewInt(10)}
+	pool := NewConditionalTxPool(testlog.Logger(t, log.LvlError), chainID, state)
+	newTx := func(nonce uint64, gas uint64, feeCap int64) *types.Transaction {
+		tx, err := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     nonce,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(feeCap),
+			Gas:       gas,
+			To:        &to,
+		})
+		require.NoError(t, err)
+		return tx
+	}
+
+	underpriced := newTx(0, 21000, 5)
+	following := newTx(1, 21000, 4)
+	tooLarge := newTx(2, 200_000, 10)
+	require.NoError(t, pool.Add(underpriced, TransactionConditional{}))
+	require.NoError(t, pool.Add(following, TransactionConditional{}))
+	require.NoError(t, pool.Add(tooLarge, TransactionConditional{}))
+
+	parent := eth.L2BlockRef{Hash: testutils.RandomHash(rng), Number: 9, Time: 98}
+	gasLimit := eth.Uint64Quantity(100_000)
+	attrs := &eth.PayloadAttributes{Timestamp: 100, GasLimit: &gasLimit}
+	pool.OnUnsafeHead(parent)
+	pool.refresh(context.Background())
+	// the underpriced transaction is kept for a block with a lower base fee, and the later transaction
+	// of the same sender is kept too, since it depends on its nonce.
+	require.Empty(t, pool.Select(parent, attrs))
+	require.Equal(t, 2, pool.Len(), "transaction above the block gas limit is dropped")
+
+	state.baseFee = big.NewInt(5)
+	pool.refresh(context.Background())
+	selected := pool.Select(parent, attrs)
+	require.Len(t, selected, 1, "later transaction pays less than the base fee")
+	require.Equal(t, underpriced.Hash(), selected[0].Hash())
+}
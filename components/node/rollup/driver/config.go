@@ -1,9 +1,23 @@
 package driver
 
+import (
+	"errors"
+	"time"
+
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+)
+
 type Config struct {
 	// SyncerConfDepth is the distance to keep from the L1 head when reading L1 data for L2 derivation.
 	SyncerConfDepth uint64 `json:"syncer_conf_depth"`
 
+	// SyncerPrefetch configures the prefetching of the L1 origins ahead of the origin being derived from.
+	SyncerPrefetch derive.PrefetchConfig `json:"syncer_prefetch"`
+
+	// SyncerDivergenceWebhook is the URL that alerts are posted to when an unsafe block does not match
+	// the block derived from L1 at the same height. Only used when the proposer is disabled.
+	SyncerDivergenceWebhook string `json:"syncer_divergence_webhook"`
+
 	// ProposerConfDepth is the distance to keep from the L1 head as origin when proposing new L2 blocks.
 	// If this distance is too large, the proposer may:
 	// - not adopt a L1 origin within the allowed time (rollup.Config.MaxProposerDrift)
@@ -14,10 +28,87 @@ type Config struct {
 	// ProposerEnabled is true when the driver should propose new blocks.
 	ProposerEnabled bool `json:"proposer_enabled"`
 
+	// ProposerDryRun enables building a local block for every block received from the canonical proposer,
+	// to compare the two blocks without inserting the local block. Requires the proposer to be disabled.
+	ProposerDryRun bool `json:"proposer_dry_run"`
+
 	// ProposerStopped is false when the driver should propose new blocks.
 	ProposerStopped bool `json:"proposer_stopped"`
 
 	// ProposerMaxSafeLag is the maximum number of L2 blocks for restricting the distance between L2 safe and unsafe.
 	// Disabled if 0.
 	ProposerMaxSafeLag uint64 `json:"proposer_max_safe_lag"`
+
+	// ProposerL1OriginPolicy is the name of the policy used to select the L1 origin of new L2 blocks.
+	// See proposer.L1OriginPolicies for the supported policies. Defaults to proposer.L1OriginConfirmations if empty.
+	ProposerL1OriginPolicy string `json:"proposer_l1_origin_policy"`
+
+	// ProposerGapFillPolicy is the name of the policy used to backfill the blocks missed by the proposer, e.g. after downtime.
+	// See proposer.GapFillPolicies for the supported policies. Defaults to proposer.GapFillImmediate if empty.
+	ProposerGapFillPolicy string `json:"proposer_gap_fill_policy"`
+
+	// ProposerLateBuildPolicy is the name of the policy applied to blocks that are still being built when the next slot passed.
+	// See proposer.LateBuildPolicies for the supported policies. Defaults to proposer.LateBuildSeal if empty.
+	ProposerLateBuildPolicy string `json:"proposer_late_build_policy"`
+
+	// ProposerL1OutageTimeout is the time without new L1 head after which the L1 is considered unavailable,
+	// and the L1 outage policy is applied. Disabled if 0.
+	ProposerL1OutageTimeout time.Duration `json:"proposer_l1_outage_timeout"`
+
+	// ProposerL1OutagePolicy is the name of the policy applied by the proposer while the L1 is unavailable.
+	// See proposer.L1OutagePolicies for the supported policies. Defaults to proposer.L1OutageContinue if empty.
+	ProposerL1OutagePolicy string `json:"proposer_l1_outage_policy"`
+
+	// ProposerTxOrdering is the name of the policy to order the tx pool transactions of proposed blocks with.
+	// See proposer.TxOrderingPolicies for the supported policies. The engine applies its default ordering if empty.
+	ProposerTxOrdering string `json:"proposer_tx_ordering"`
+
+	// ProposerPipelining is true when the proposer starts building the next block together with the insertion
+	// of the sealed block, if there is no time left to wait before building the next block.
+	ProposerPipelining bool `json:"proposer_pipelining"`
+
+	// ProposerScheduleSkew shifts the start of the block building within the slot. Disabled if zeroed.
+	ProposerScheduleSkew ScheduleSkew `json:"proposer_schedule_skew"`
+
+	// ProposerPayloadJournal is the path of the file persisting the latest proposed block until it is published,
+	// to replay it after a crash. Disabled if empty.
+	ProposerPayloadJournal string `json:"proposer_payload_journal"`
+
+	// ProposerConditionalTxs is true when the proposer accepts conditional transactions,
+	// to be included in the first block that meets their preconditions.
+	ProposerConditionalTxs bool `json:"proposer_conditional_txs"`
+
+	// ProposerBuilderTimeout is the time to wait for the external block builder to return a payload,
+	// before using the payload built by the local engine. Only used if an external builder is configured.
+	ProposerBuilderTimeout time.Duration `json:"proposer_builder_timeout"`
+
+	// ProposerBlockLimits are the soft limits of the blocks built by the proposer, below the hard gas limit.
+	// Disabled if zeroed.
+	ProposerBlockLimits derive.BlockLimits `json:"proposer_block_limits"`
+
+	// ProposerTargetGasPerSecond is the sustained gas per second targeted by the proposer, by adjusting
+	// the soft gas limit of its blocks within the gas limit of the system config. Disabled if 0.
+	ProposerTargetGasPerSecond uint64 `json:"proposer_target_gas_per_second"`
+
+	// ProposerEmptyBlockFastPath enables sealing blocks right away when they are known to be empty,
+	// because the tx pool is empty or the block is built without the tx pool.
+	ProposerEmptyBlockFastPath bool `json:"proposer_empty_block_fast_path"`
+
+	// HaltTarget is the point at which the derivation stops, and beyond which unsafe payloads are refused.
+	// Disabled if zeroed.
+	HaltTarget derive.HaltTarget `json:"halt_target"`
+}
+
+// Check verifies that the given configuration makes sense
+func (c *Config) Check() error {
+	if c.ProposerEnabled && c.ProposerDryRun {
+		return errors.New("proposer dry-run mode cannot be enabled together with the proposer")
+	}
+	if err := c.SyncerPrefetch.Check(); err != nil {
+		return err
+	}
+	if err := c.ProposerScheduleSkew.Check(); err != nil {
+		return err
+	}
+	return nil
 }
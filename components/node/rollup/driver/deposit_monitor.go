@@ -0,0 +1,109 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+)
+
+const (
+	// depositScanLimit is the maximum number of L1 blocks scanned for deposits per update,
+	// to bound the time spent in the driver event loop.
+	depositScanLimit = 16
+	// depositDeadlineWarnFraction is the fraction of the proposer window below which
+	// the remaining time to include a pending deposit is warned about.
+	depositDeadlineWarnFraction = 4
+)
+
+type DepositMetrics interface {
+	RecordPendingDeposit(age time.Duration, blocksToDeadline int64)
+}
+
+type DepositFetcher interface {
+	derive.L1BlockRefByNumberFetcher
+	derive.L1ReceiptsFetcher
+}
+
+// DepositMonitor tracks the oldest L1 deposit that is not yet included in the L2 chain.
+// Deposits are included when the L1 origin of the L2 chain passes the L1 block of the deposit,
+// and are forcibly included by the derivation once the proposer window of that L1 block has elapsed.
+type DepositMonitor struct {
+	log     log.Logger
+	cfg     *rollup.Config
+	l1      DepositFetcher
+	metrics DepositMetrics
+
+	// scanned is the latest L1 block that was scanned for deposits
+	scanned eth.L1BlockRef
+	// oldest is the oldest L1 block with deposits past the L1 origin, nil if there are none
+	oldest *eth.L1BlockRef
+}
+
+func NewDepositMonitor(log log.Logger, cfg *rollup.Config, l1 DepositFetcher, metrics DepositMetrics) *DepositMonitor {
+	return &DepositMonitor{
+		log:     log,
+		cfg:     cfg,
+		l1:      l1,
+		metrics: metrics,
+	}
+}
+
+// Pending returns true if there are L1 deposits that are not yet included in the L2 chain.
+func (m *DepositMonitor) Pending() bool {
+	return m.oldest != nil
+}
+
+// Update scans the L1 blocks past the given L1 origin of the L2 chain for deposits,
+// and reports the age of the oldest pending deposit and the time left before it is forcibly included.
+func (m *DepositMonitor) Update(ctx context.Context, origin eth.BlockID, l1Head eth.L1BlockRef, now time.Time) error {
+	if m.oldest != nil && m.oldest.Number <= origin.Number {
+		m.oldest = nil
+	}
+	// Restart scanning from the L1 origin if it passed the scanned blocks, or if the L1 head reorged below them.
+	if m.scanned == (eth.L1BlockRef{}) || m.scanned.Number < origin.Number || m.scanned.Number > l1Head.Number {
+		m.scanned = eth.L1BlockRef{Hash: origin.Hash, Number: origin.Number}
+		m.oldest = nil
+	}
+	for i := 0; m.oldest == nil && m.scanned.Number < l1Head.Number && i < depositScanLimit; i++ {
+		ref, err := m.l1.L1BlockRefByNumber(ctx, m.scanned.Number+1)
+		if err != nil {
+			return fmt.Errorf("failed to fetch L1 block %d: %w", m.scanned.Number+1, err)
+		}
+		if ref.ParentHash != m.scanned.Hash {
+			m.log.Debug("L1 reorg detected while scanning for deposits, restarting from L1 origin", "scanned", m.scanned, "next", ref)
+			m.scanned = eth.L1BlockRef{Hash: origin.Hash, Number: origin.Number}
+			break
+		}
+		_, receipts, err := m.l1.FetchReceipts(ctx, ref.Hash)
+		if err != nil {
+			return fmt.Errorf("failed to fetch receipts of L1 block %s: %w", ref, err)
+		}
+		deposits, err := derive.UserDeposits(receipts, m.cfg.DepositContractAddress)
+		if err != nil {
+			m.log.Warn("failed to parse some deposits", "l1_block", ref, "err", err)
+		}
+		m.scanned = ref
+		if len(deposits) > 0 {
+			m.oldest = &ref
+		}
+	}
+
+	if m.oldest == nil {
+		m.metrics.RecordPendingDeposit(0, int64(m.cfg.ProposerWindowSize))
+		return nil
+	}
+	age := now.Sub(time.Unix(int64(m.oldest.Time), 0))
+	blocksToDeadline := int64(m.oldest.Number+m.cfg.ProposerWindowSize) - int64(l1Head.Number)
+	m.metrics.RecordPendingDeposit(age, blocksToDeadline)
+	if blocksToDeadline <= int64(m.cfg.ProposerWindowSize/depositDeadlineWarnFraction) {
+		m.log.Warn("Pending L1 deposit approaches forced inclusion deadline",
+			"l1_block", m.oldest, "origin", origin, "age", age, "blocks_to_deadline", blocksToDeadline)
+	}
+	return nil
+}
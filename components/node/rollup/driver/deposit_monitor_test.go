@@ -0,0 +1,91 @@
+package driver
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+	"github.com/kroma-network/kroma/components/node/testlog"
+	"github.com/kroma-network/kroma/components/node/testutils"
+)
+
+type fakeDepositL1 struct {
+	refs     []eth.L1BlockRef
+	receipts map[common.Hash]types.Receipts
+}
+
+func (f *fakeDepositL1) L1BlockRefByNumber(ctx context.Context, num uint64) (eth.L1BlockRef, error) {
+	if num >= uint64(len(f.refs)) {
+		return eth.L1BlockRef{}, ethereum.NotFound
+	}
+	return f.refs[num], nil
+}
+
+func (f *fakeDepositL1) InfoByHash(ctx context.Context, hash common.Hash) (eth.BlockInfo, error) {
+	return nil, ethereum.NotFound
+}
+
+func (f *fakeDepositL1) FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	return nil, f.receipts[blockHash], nil
+}
+
+type fakeDepositMetrics struct {
+	age              time.Duration
+	blocksToDeadline int64
+}
+
+func (f *fakeDepositMetrics) RecordPendingDeposit(age time.Duration, blocksToDeadline int64) {
+	f.age = age
+	f.blocksToDeadline = blocksToDeadline
+}
+
+func TestDepositMonitor(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	cfg := &rollup.Config{
+		ProposerWindowSize:     10,
+		DepositContractAddress: testutils.RandomAddress(rng),
+	}
+
+	l1 := &fakeDepositL1{receipts: make(map[common.Hash]types.Receipts)}
+	ref := eth.L1BlockRef{Hash: testutils.RandomHash(rng), Time: 1000}
+	for i := 0; i < 8; i++ {
+		l1.refs = append(l1.refs, ref)
+		ref = eth.L1BlockRef{Hash: testutils.RandomHash(rng), Number: ref.Number + 1, ParentHash: ref.Hash, Time: ref.Time + 12}
+	}
+	depositLog, err := derive.MarshalDepositLogEvent(cfg.DepositContractAddress, testutils.GenerateDeposit(testutils.RandomHash(rng), rng))
+	require.NoError(t, err)
+	l1.receipts[l1.refs[3].Hash] = types.Receipts{{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{depositLog}}}
+
+	m := &fakeDepositMetrics{}
+	monitor := NewDepositMonitor(testlog.Logger(t, log.LvlError), cfg, l1, m)
+	ctx := context.Background()
+
+	// No deposits past the origin up to the L1 head.
+	require.NoError(t, monitor.Update(ctx, l1.refs[0].ID(), l1.refs[2], time.Unix(1100, 0)))
+	require.False(t, monitor.Pending())
+	require.Equal(t, int64(cfg.ProposerWindowSize), m.blocksToDeadline)
+
+	// The deposit in L1 block 3 is pending until the L1 origin reaches it.
+	require.NoError(t, monitor.Update(ctx, l1.refs[1].ID(), l1.refs[7], time.Unix(1100, 0)))
+	require.True(t, monitor.Pending())
+	require.Equal(t, 1100*time.Second-time.Duration(l1.refs[3].Time)*time.Second, m.age)
+	require.Equal(t, int64(3+10-7), m.blocksToDeadline)
+
+	require.NoError(t, monitor.Update(ctx, l1.refs[2].ID(), l1.refs[7], time.Unix(1100, 0)))
+	require.True(t, monitor.Pending())
+
+	// Once the L1 origin includes the deposit, it is no longer pending.
+	require.NoError(t, monitor.Update(ctx, l1.refs[3].ID(), l1.refs[7], time.Unix(1100, 0)))
+	require.False(t, monitor.Pending())
+	require.Equal(t, time.Duration(0), m.age)
+}
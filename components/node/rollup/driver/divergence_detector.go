@@ -0,0 +1,78 @@
+package driver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+)
+
+// divergenceWebhookTimeout is the maximum time to deliver a divergence alert to the webhook.
+const divergenceWebhookTimeout = 10 * time.Second
+
+type DivergenceMetrics interface {
+	RecordUnsafeDivergence()
+}
+
+// DivergenceDetector alerts when an unsafe block, received from the proposer, does not match the block
+// derived from L1 at the same height, to catch proposer equivocation early.
+// Alerts are logged and metered, and optionally posted as JSON to a webhook.
+type DivergenceDetector struct {
+	log     log.Logger
+	metrics DivergenceMetrics
+
+	// webhook is the URL that alerts are posted to, disabled if empty
+	webhook string
+	client  *http.Client
+}
+
+func NewDivergenceDetector(log log.Logger, webhook string, metrics DivergenceMetrics) *DivergenceDetector {
+	return &DivergenceDetector{
+		log:     log,
+		metrics: metrics,
+		webhook: webhook,
+		client:  &http.Client{Timeout: divergenceWebhookTimeout},
+	}
+}
+
+// OnDivergence alerts about the given divergence. The webhook is notified in the background.
+func (d *DivergenceDetector) OnDivergence(div derive.UnsafeDivergence) {
+	d.log.Error("Unsafe block does not match the block derived from L1, the proposer may have equivocated",
+		"unsafe", div.Unsafe, "parent", div.Parent, "origin", div.Origin, "reason", div.Reason)
+	d.metrics.RecordUnsafeDivergence()
+	if d.webhook != "" {
+		go func() {
+			if err := d.notify(context.Background(), div); err != nil {
+				d.log.Warn("failed to notify divergence webhook", "unsafe", div.Unsafe, "err", err)
+			}
+		}()
+	}
+}
+
+// notify posts the given divergence to the webhook.
+func (d *DivergenceDetector) notify(ctx context.Context, div derive.UnsafeDivergence) error {
+	body, err := json.Marshal(div)
+	if err != nil {
+		return fmt.Errorf("failed to encode divergence: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.webhook, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+type fakeDivergenceMetrics struct {
+	count int
+}
+
+func (f *fakeDivergenceMetrics) RecordUnsafeDivergence() {
+	f.count++
+}
+
+func TestDivergenceDetectorWebhook(t *testing.T) {
+	received := make(chan derive.UnsafeDivergence, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var div derive.UnsafeDivergence
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&div))
+		received <- div
+	}))
+	defer srv.Close()
+
+	m := &fakeDivergenceMetrics{}
+	detector := NewDivergenceDetector(testlog.Logger(t, log.LvlCrit), srv.URL, m)
+	div := derive.UnsafeDivergence{
+		Unsafe: eth.BlockID{Number: 11},
+		Parent: eth.BlockID{Number: 10},
+		Origin: eth.BlockID{Number: 5},
+		Reason: "transactions differ",
+	}
+	require.NoError(t, detector.notify(context.Background(), div))
+	require.Equal(t, div, <-received)
+
+	detector.OnDivergence(div)
+	require.Equal(t, 1, m.count)
+	require.Equal(t, div, <-received)
+}
+
+func TestDivergenceDetectorWebhookError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	detector := NewDivergenceDetector(testlog.Logger(t, log.LvlCrit), srv.URL, &fakeDivergenceMetrics{})
+	require.Error(t, detector.notify(context.Background(), derive.UnsafeDivergence{}))
+}
@@ -13,7 +13,7 @@ import (
 )
 
 type Metrics interface {
-	RecordPipelineReset()
+	RecordPipelineReset(reason string)
 	RecordPublishingError()
 	RecordDerivationError()
 
@@ -22,6 +22,7 @@ type Metrics interface {
 	RecordL1Ref(name string, ref eth.L1BlockRef)
 	RecordL2Ref(name string, ref eth.L2BlockRef)
 	RecordChannelInputBytes(inputCompressedBytes int)
+	RecordDroppedBatch(reason string)
 
 	RecordUnsafePayloadsBuffer(length uint64, memSize uint64, next eth.BlockID)
 
@@ -29,6 +30,14 @@ type Metrics interface {
 
 	RecordL1ReorgDepth(d uint64)
 
+	RecordProposerL1Outage(policy string, active bool)
+
+	DualEngineMetrics
+	ThroughputMetrics
+	DepositMetrics
+	DryRunMetrics
+	DivergenceMetrics
+
 	EngineMetrics
 	ProposerMetrics
 }
@@ -43,10 +52,11 @@ type L2Chain interface {
 	L2BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L2BlockRef, error)
 	L2BlockRefByHash(ctx context.Context, l2Hash common.Hash) (eth.L2BlockRef, error)
 	L2BlockRefByNumber(ctx context.Context, num uint64) (eth.L2BlockRef, error)
+	ConditionalStateReader
 }
 
 type DerivationPipeline interface {
-	Reset()
+	Reset(reason derive.ResetReason)
 	Step(ctx context.Context) error
 	AddUnsafePayload(payload *eth.ExecutionPayload)
 	UnsafeL2SyncTarget() eth.L2BlockRef
@@ -57,6 +67,8 @@ type DerivationPipeline interface {
 	UnsafeL2Head() eth.L2BlockRef
 	Origin() eth.L1BlockRef
 	EngineReady() bool
+	DroppedBatches() []derive.DroppedBatch
+	LastReset() eth.ResetInfo
 }
 
 type L1StateIface interface {
@@ -75,11 +87,17 @@ type ProposerIface interface {
 	PlanNextProposerAction() time.Duration
 	RunNextProposerAction(ctx context.Context) (*eth.ExecutionPayload, error)
 	BuildingOnto() eth.L2BlockRef
+	Health() eth.ProposerHealth
+	SetSkipTxPool(skip bool)
+	SetDepositsPending(pending bool)
+	Halted() bool
 }
 
 type Network interface {
 	// PublishL2Payload is called by the driver whenever there is a new payload to publish, synchronously with the driver main loop.
 	PublishL2Payload(ctx context.Context, payload *eth.ExecutionPayload) error
+	// PublishPreconfirmation is called by the driver whenever the proposer sealed a new block, ahead of publishing its payload.
+	PublishPreconfirmation(ctx context.Context, preconf eth.Preconfirmation) error
 }
 
 type AltSync interface {
@@ -102,16 +120,101 @@ type AltSync interface {
 }
 
 // NewDriver composes an events handler that tracks L1 state, triggers L2 derivation, and optionally proposes new L2 blocks.
-func NewDriver(driverCfg *Config, cfg *rollup.Config, l2 L2Chain, l1 L1Chain, altSync AltSync, network Network, log log.Logger, snapshotLog log.Logger, metrics Metrics) *Driver {
+// If secondary is not nil, blocks are cross-validated on the secondary engine, which takes over if l2 becomes unhealthy.
+// If builder is not nil, the proposer completes its blocks with payloads of the external builder when available.
+func NewDriver(driverCfg *Config, cfg *rollup.Config, l2 L2Chain, secondary L2Chain, l1 L1Chain, builder ExternalBuilderClient, altSync AltSync, network Network, log log.Logger, snapshotLog log.Logger, metrics Metrics) (*Driver, error) {
 	l1State := NewL1State(log, metrics)
-	proposerConfDepth := NewConfDepth(driverCfg.ProposerConfDepth, l1State.L1Head, l1)
-	findL1Origin := NewL1OriginSelector(log, cfg, proposerConfDepth)
+	var dualEngine *DualEngine
+	if secondary != nil {
+		dualEngine = NewDualEngine(log, l2, secondary, metrics)
+		l2 = dualEngine
+	}
+	l1OriginPolicy, err := NewL1OriginPolicy(driverCfg.ProposerL1OriginPolicy, driverCfg.ProposerConfDepth)
+	if err != nil {
+		return nil, err
+	}
+	proposerConfDepth := NewConfDepth(l1OriginPolicy.ConfDepth(), l1State.L1Head, l1)
+	findL1Origin := NewL1OriginSelectorWithPolicy(log, cfg, proposerConfDepth, l1OriginPolicy)
 	syncConfDepth := NewConfDepth(driverCfg.SyncerConfDepth, l1State.L1Head, l1)
 	derivationPipeline := derive.NewDerivationPipeline(log, cfg, syncConfDepth, l2, metrics)
+	derivationPipeline.SetHaltTarget(driverCfg.HaltTarget)
+	driverCtx, driverCancel := context.WithCancel(context.Background())
+	derivationPipeline.SetOriginPrefetch(driverCtx, driverCfg.SyncerPrefetch)
+	derivationPipeline.SetBlockLimits(driverCfg.ProposerBlockLimits)
+	if !driverCfg.ProposerEnabled {
+		divergence := NewDivergenceDetector(log, driverCfg.SyncerDivergenceWebhook, metrics)
+		derivationPipeline.SetUnsafeDivergenceHandler(divergence.OnDivergence)
+	}
 	attrBuilder := derive.NewFetchingAttributesBuilder(cfg, l1, l2)
 	engine := derivationPipeline
 	meteredEngine := NewMeteredEngine(cfg, engine, metrics, log)
-	proposer := NewProposer(log, cfg, meteredEngine, attrBuilder, findL1Origin, metrics)
+	var blockBuilder BlockBuilder = meteredEngine
+	if builder != nil {
+		blockBuilder = NewExternalBlockBuilder(log, meteredEngine, builder, driverCfg.ProposerBuilderTimeout)
+	}
+	var conditionalTxs *ConditionalTxPool
+	if driverCfg.ProposerEnabled && driverCfg.ProposerConditionalTxs {
+		conditionalTxs = NewConditionalTxPool(log, cfg.L2ChainID, l2)
+	}
+	gapFill, err := NewGapFillPolicy(driverCfg.ProposerGapFillPolicy)
+	if err != nil {
+		return nil, err
+	}
+	lateBuild, err := NewLateBuildPolicy(driverCfg.ProposerLateBuildPolicy)
+	if err != nil {
+		return nil, err
+	}
+	l1Outage, err := NewL1OutagePolicy(driverCfg.ProposerL1OutagePolicy, driverCfg.ProposerL1OutageTimeout)
+	if err != nil {
+		return nil, err
+	}
+	var journal *PayloadJournal
+	if driverCfg.ProposerEnabled && driverCfg.ProposerPayloadJournal != "" {
+		journal = NewPayloadJournal(driverCfg.ProposerPayloadJournal)
+		// Persist every new block before it is inserted, to replay it if the node crashes before it is published.
+		derivationPipeline.SetPayloadSealedHandler(func(payload *eth.ExecutionPayload) {
+			if err := journal.Write(payload); err != nil {
+				log.Warn("failed to journal newly created block", "id", payload.ID(), "err", err)
+			}
+		})
+	}
+	var throughput *ThroughputController
+	if driverCfg.ProposerTargetGasPerSecond != 0 {
+		throughput = NewThroughputController(log, cfg.BlockTime, driverCfg.ProposerTargetGasPerSecond,
+			driverCfg.ProposerBlockLimits, derivationPipeline.SetBlockLimits, metrics)
+	}
+	var deposits *DepositMonitor
+	if driverCfg.ProposerEnabled {
+		deposits = NewDepositMonitor(log, cfg, l1, metrics)
+	}
+	var dryRun *DryRunBuilder
+	if driverCfg.ProposerDryRun {
+		dryRun = NewDryRunBuilder(log, l2, metrics)
+	}
+	var emptyBlocks *EmptyBlockFastPath
+	if driverCfg.ProposerEmptyBlockFastPath {
+		// The tx pool can only be inspected if the engine supports it, otherwise only deposit-only blocks are fast-tracked.
+		txPool, _ := l2.(TxPoolReader)
+		emptyBlocks = NewEmptyBlockFastPath(log, txPool)
+	}
+	var txOrdering TxOrderingPolicy
+	if driverCfg.ProposerTxOrdering != "" {
+		txOrdering, err = NewTxOrderingPolicy(driverCfg.ProposerTxOrdering)
+		if err != nil {
+			return nil, err
+		}
+	}
+	prop := NewProposer(log, cfg, blockBuilder, attrBuilder, findL1Origin, ProposerConfig{
+		ConditionalTxs: conditionalTxs,
+		GapFill:        gapFill,
+		LateBuild:      lateBuild,
+		Pipelining:     driverCfg.ProposerPipelining,
+		Skew:           driverCfg.ProposerScheduleSkew,
+		Throughput:     throughput,
+		EmptyBlocks:    emptyBlocks,
+		TxOrdering:     txOrdering,
+		HaltTarget:     driverCfg.HaltTarget,
+	}, metrics)
 
 	return &Driver{
 		l1State:          l1State,
@@ -123,11 +226,19 @@ func NewDriver(driverCfg *Config, cfg *rollup.Config, l2 L2Chain, l1 L1Chain, al
 		config:           cfg,
 		driverConfig:     driverCfg,
 		done:             make(chan struct{}),
+		driverCtx:        driverCtx,
+		driverCancel:     driverCancel,
 		log:              log,
 		snapshotLog:      snapshotLog,
 		l1:               l1,
 		l2:               l2,
-		proposer:         proposer,
+		proposer:         prop,
+		conditionalTxs:   conditionalTxs,
+		l1Outage:         l1Outage,
+		journal:          journal,
+		dualEngine:       dualEngine,
+		deposits:         deposits,
+		dryRun:           dryRun,
 		network:          network,
 		metrics:          metrics,
 		l1HeadSig:        make(chan eth.L1BlockRef, 10),
@@ -135,5 +246,5 @@ func NewDriver(driverCfg *Config, cfg *rollup.Config, l2 L2Chain, l1 L1Chain, al
 		l1FinalizedSig:   make(chan eth.L1BlockRef, 10),
 		unsafeL2Payloads: make(chan *eth.ExecutionPayload, 10),
 		altSync:          altSync,
-	}
+	}, nil
 }
@@ -0,0 +1,104 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+)
+
+const (
+	// DryRunResultMatch is recorded when the local block matches the canonical block.
+	DryRunResultMatch = "match"
+	// DryRunResultDiverged is recorded when the local block differs from the canonical block.
+	DryRunResultDiverged = "diverged"
+	// DryRunResultError is recorded when the local block could not be built.
+	DryRunResultError = "error"
+)
+
+// dryRunTimeout is the maximum time to build a local block for comparison.
+const dryRunTimeout = 2 * time.Second
+
+type DryRunMetrics interface {
+	RecordDryRunBlock(result string)
+	RecordDryRunDivergence(field string)
+}
+
+// DryRunBuilder builds a local block for every block received from the canonical proposer,
+// and compares the two blocks without inserting the local block.
+// The local block is built on the same parent with the same transactions, so any divergence
+// is caused by the execution engine, e.g. to test an engine upgrade before it is used by the canonical proposer.
+type DryRunBuilder struct {
+	log     log.Logger
+	engine  derive.Engine
+	metrics DryRunMetrics
+}
+
+func NewDryRunBuilder(log log.Logger, engine derive.Engine, metrics DryRunMetrics) *DryRunBuilder {
+	return &DryRunBuilder{
+		log:     log,
+		engine:  engine,
+		metrics: metrics,
+	}
+}
+
+// Compare builds the local counterpart of the given canonical payload on top of the head of the given forkchoice state,
+// and reports the differences. The payload must extend the head of the forkchoice state.
+func (b *DryRunBuilder) Compare(ctx context.Context, fc eth.ForkchoiceState, payload *eth.ExecutionPayload) {
+	local, err := b.build(ctx, fc, payload)
+	if err != nil {
+		b.log.Warn("failed to build dry-run block", "canonical", payload.ID(), "err", err)
+		b.metrics.RecordDryRunBlock(DryRunResultError)
+		return
+	}
+	diverged := false
+	diverge := func(field string, canonical, local interface{}) {
+		diverged = true
+		b.log.Warn("dry-run block diverged from canonical block", "canonical", payload.ID(), "field", field,
+			"canonical_value", canonical, "local_value", local)
+		b.metrics.RecordDryRunDivergence(field)
+	}
+	if len(local.Transactions) != len(payload.Transactions) {
+		diverge("tx_count", len(payload.Transactions), len(local.Transactions))
+	}
+	if local.GasUsed != payload.GasUsed {
+		diverge("gas_used", uint64(payload.GasUsed), uint64(local.GasUsed))
+	}
+	if local.StateRoot != payload.StateRoot {
+		diverge("state_root", payload.StateRoot, local.StateRoot)
+	}
+	if diverged {
+		b.metrics.RecordDryRunBlock(DryRunResultDiverged)
+	} else {
+		b.log.Debug("dry-run block matches canonical block", "canonical", payload.ID())
+		b.metrics.RecordDryRunBlock(DryRunResultMatch)
+	}
+}
+
+// build builds a block with the attributes and transactions of the given payload, without inserting it.
+func (b *DryRunBuilder) build(ctx context.Context, fc eth.ForkchoiceState, payload *eth.ExecutionPayload) (*eth.ExecutionPayload, error) {
+	ctx, cancel := context.WithTimeout(ctx, dryRunTimeout)
+	defer cancel()
+	gasLimit := payload.GasLimit
+	attrs := &eth.PayloadAttributes{
+		Timestamp:             payload.Timestamp,
+		PrevRandao:            payload.PrevRandao,
+		SuggestedFeeRecipient: payload.FeeRecipient,
+		Transactions:          payload.Transactions,
+		NoTxPool:              true,
+		GasLimit:              &gasLimit,
+	}
+	id, errTyp, err := derive.StartPayload(ctx, b.engine, fc, attrs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start building, error (%d): %w", errTyp, err)
+	}
+	local, err := b.engine.GetPayload(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payload: %w", err)
+	}
+	return local, nil
+}
@@ -0,0 +1,77 @@
+package driver
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/testlog"
+	"github.com/kroma-network/kroma/components/node/testutils"
+)
+
+type fakeDryRunMetrics struct {
+	results     []string
+	divergences []string
+}
+
+func (f *fakeDryRunMetrics) RecordDryRunBlock(result string) {
+	f.results = append(f.results, result)
+}
+
+func (f *fakeDryRunMetrics) RecordDryRunDivergence(field string) {
+	f.divergences = append(f.divergences, field)
+}
+
+func TestDryRunBuilder(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	canonical := &eth.ExecutionPayload{
+		ParentHash:   testutils.RandomHash(rng),
+		FeeRecipient: testutils.RandomAddress(rng),
+		StateRoot:    eth.Bytes32(testutils.RandomHash(rng)),
+		PrevRandao:   eth.Bytes32(testutils.RandomHash(rng)),
+		BlockNumber:  10,
+		GasLimit:     30_000_000,
+		GasUsed:      100_000,
+		Timestamp:    20,
+		BlockHash:    testutils.RandomHash(rng),
+		Transactions: []eth.Data{{0x01}, {0x02}},
+	}
+	fc := eth.ForkchoiceState{HeadBlockHash: canonical.ParentHash}
+	gasLimit := canonical.GasLimit
+	attrs := &eth.PayloadAttributes{
+		Timestamp:             canonical.Timestamp,
+		PrevRandao:            canonical.PrevRandao,
+		SuggestedFeeRecipient: canonical.FeeRecipient,
+		Transactions:          canonical.Transactions,
+		NoTxPool:              true,
+		GasLimit:              &gasLimit,
+	}
+	id := eth.PayloadID{1}
+	fcRes := &eth.ForkchoiceUpdatedResult{PayloadStatus: eth.PayloadStatusV1{Status: eth.ExecutionValid}, PayloadID: &id}
+
+	engine := new(testutils.MockEngine)
+	m := &fakeDryRunMetrics{}
+	dryRun := NewDryRunBuilder(testlog.Logger(t, log.LvlError), engine, m)
+
+	local := *canonical
+	engine.ExpectForkchoiceUpdate(&fc, attrs, fcRes, nil)
+	engine.ExpectGetPayload(id, &local, nil)
+	dryRun.Compare(context.Background(), fc, canonical)
+	require.Equal(t, []string{DryRunResultMatch}, m.results)
+	require.Empty(t, m.divergences)
+
+	diverged := *canonical
+	diverged.GasUsed = 90_000
+	diverged.StateRoot = eth.Bytes32(testutils.RandomHash(rng))
+	engine.ExpectForkchoiceUpdate(&fc, attrs, fcRes, nil)
+	engine.ExpectGetPayload(id, &diverged, nil)
+	dryRun.Compare(context.Background(), fc, canonical)
+	require.Equal(t, []string{DryRunResultMatch, DryRunResultDiverged}, m.results)
+	require.Equal(t, []string{"gas_used", "state_root"}, m.divergences)
+
+	engine.AssertExpectations(t)
+}
@@ -0,0 +1,269 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// engineFailoverThreshold is the number of consecutive engine API failures of the active engine
+// after which the standby engine takes over.
+const engineFailoverThreshold = 3
+
+// standbyTimeout is the time the standby engine gets to process a forkchoice update or payload.
+const standbyTimeout = 5 * time.Second
+
+// standbyQueueSize is the number of forkchoice updates and payloads that can wait for the standby engine,
+// before the standby engine is considered to have fallen behind.
+const standbyQueueSize = 64
+
+var engineNames = [2]string{"primary", "secondary"}
+
+type DualEngineMetrics interface {
+	RecordEngineFailover(active string)
+	RecordEngineCrossValidationFailure()
+}
+
+// DualEngine is an L2Chain backed by a primary and a secondary execution engine.
+// Blocks are built and inserted on the active engine, and cross-validated by inserting them into the standby engine,
+// which follows the forkchoice of the active engine. The standby engine is updated in the background by Run,
+// so that a slow standby engine does not delay the active engine. After consecutive engine API failures
+// of the active engine, the standby engine takes over, unless the standby engine is unhealthy itself:
+// it failed or rejected the blocks accepted by the active engine the same number of consecutive times.
+type DualEngine struct {
+	log     log.Logger
+	metrics DualEngineMetrics
+
+	mu              sync.Mutex
+	engines         [2]L2Chain
+	active          int
+	failures        int
+	standbyFailures int
+	failovers       uint64
+
+	// standbyCalls are the calls waiting to be applied to the standby engine, in order.
+	standbyCalls chan standbyCall
+}
+
+// standbyCall applies a forkchoice update or payload, accepted by the active engine, to the standby engine.
+type standbyCall struct {
+	// idx is the index of the standby engine the call is for.
+	idx int
+	fn  func(ctx context.Context, standby L2Chain) error
+}
+
+var _ L2Chain = (*DualEngine)(nil)
+
+func NewDualEngine(log log.Logger, primary L2Chain, secondary L2Chain, metrics DualEngineMetrics) *DualEngine {
+	return &DualEngine{
+		log:          log,
+		metrics:      metrics,
+		engines:      [2]L2Chain{primary, secondary},
+		standbyCalls: make(chan standbyCall, standbyQueueSize),
+	}
+}
+
+// Run applies the forkchoice updates and payloads accepted by the active engine to the standby engine,
+// until ctx is done.
+func (d *DualEngine) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case call := <-d.standbyCalls:
+			d.runStandbyCall(ctx, call)
+		}
+	}
+}
+
+func (d *DualEngine) runStandbyCall(ctx context.Context, call standbyCall) {
+	d.mu.Lock()
+	stale := call.idx == d.active
+	d.mu.Unlock()
+	if stale {
+		return // the standby engine took over since the call was queued
+	}
+	ctx, cancel := context.WithTimeout(ctx, standbyTimeout)
+	defer cancel()
+	d.recordStandbyResult(call.idx, call.fn(ctx, d.engines[call.idx]))
+}
+
+// enqueueStandby queues the call for the standby engine. If the standby engine is too far behind,
+// the call is dropped and counted as a failure of the standby engine.
+func (d *DualEngine) enqueueStandby(idx int, fn func(ctx context.Context, standby L2Chain) error) {
+	select {
+	case d.standbyCalls <- standbyCall{idx: idx, fn: fn}:
+	default:
+		d.recordStandbyResult(idx, errors.New("standby engine fell behind the active engine"))
+	}
+}
+
+// current returns the index of the active engine, and the active engine.
+func (d *DualEngine) current() (idx int, active L2Chain) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.active, d.engines[d.active]
+}
+
+// Failovers returns the number of times the standby engine took over.
+func (d *DualEngine) Failovers() uint64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.failovers
+}
+
+// recordResult tracks the health of the active engine, and fails over to the standby engine if needed.
+func (d *DualEngine) recordResult(idx int, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if idx != d.active {
+		return // result of an engine that is not active anymore
+	}
+	if err == nil {
+		d.failures = 0
+		return
+	}
+	d.failures++
+	if d.failures < engineFailoverThreshold {
+		return
+	}
+	if d.standbyFailures >= engineFailoverThreshold {
+		d.log.Error("active engine is unhealthy, but standby engine is unhealthy too, not failing over",
+			"unhealthy", engineNames[d.active], "err", err)
+		d.failures = 0
+		return
+	}
+	d.log.Error("active engine is unhealthy, failing over to standby engine",
+		"unhealthy", engineNames[d.active], "active", engineNames[1-d.active], "err", err)
+	d.active = 1 - d.active
+	d.failures = 0
+	d.standbyFailures = 0
+	d.failovers++
+	d.metrics.RecordEngineFailover(engineNames[d.active])
+}
+
+// recordStandbyResult tracks the health of the standby engine, which must not take over while it is unhealthy.
+func (d *DualEngine) recordStandbyResult(idx int, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if idx == d.active {
+		return // result of an engine that is not standby anymore
+	}
+	if err == nil {
+		d.standbyFailures = 0
+		return
+	}
+	d.standbyFailures++
+	d.log.Warn("standby engine failed to follow active engine", "standby", engineNames[idx], "failures", d.standbyFailures, "err", err)
+}
+
+func (d *DualEngine) GetPayload(ctx context.Context, payloadId eth.PayloadID) (*eth.ExecutionPayload, error) {
+	idx, active := d.current()
+	payload, err := active.GetPayload(ctx, payloadId)
+	d.recordResult(idx, err)
+	return payload, err
+}
+
+func (d *DualEngine) ForkchoiceUpdate(ctx context.Context, state *eth.ForkchoiceState, attr *eth.PayloadAttributes) (*eth.ForkchoiceUpdatedResult, error) {
+	idx, active := d.current()
+	res, err := active.ForkchoiceUpdate(ctx, state, attr)
+	d.recordResult(idx, err)
+	if err != nil || res.PayloadStatus.Status != eth.ExecutionValid {
+		return res, err
+	}
+	// The standby engine follows the forkchoice, without building, to be ready to take over.
+	fc := *state
+	d.enqueueStandby(1-idx, func(ctx context.Context, standby L2Chain) error {
+		standbyRes, err := standby.ForkchoiceUpdate(ctx, &fc, nil)
+		if err != nil {
+			return fmt.Errorf("failed to follow forkchoice update to %s: %w", fc.HeadBlockHash, err)
+		}
+		if standbyRes.PayloadStatus.Status == eth.ExecutionInvalid {
+			d.log.Error("standby engine rejected forkchoice accepted by active engine", "head", fc.HeadBlockHash, "err", eth.ForkchoiceUpdateErr(standbyRes.PayloadStatus))
+			d.metrics.RecordEngineCrossValidationFailure()
+			return fmt.Errorf("rejected forkchoice update to %s: %w", fc.HeadBlockHash, eth.ForkchoiceUpdateErr(standbyRes.PayloadStatus))
+		}
+		return nil
+	})
+	return res, nil
+}
+
+func (d *DualEngine) NewPayload(ctx context.Context, payload *eth.ExecutionPayload) (*eth.PayloadStatusV1, error) {
+	idx, active := d.current()
+	status, err := active.NewPayload(ctx, payload)
+	d.recordResult(idx, err)
+	if err != nil || status.Status != eth.ExecutionValid {
+		return status, err
+	}
+	// Cross-validate the payload accepted by the active engine.
+	d.enqueueStandby(1-idx, func(ctx context.Context, standby L2Chain) error {
+		standbyStatus, err := standby.NewPayload(ctx, payload)
+		if err != nil {
+			return fmt.Errorf("failed to validate payload %s: %w", payload.ID(), err)
+		}
+		if standbyStatus.Status == eth.ExecutionInvalid || standbyStatus.Status == eth.ExecutionInvalidBlockHash {
+			d.log.Error("standby engine rejected payload accepted by active engine", "id", payload.ID(), "err", eth.NewPayloadErr(payload, standbyStatus))
+			d.metrics.RecordEngineCrossValidationFailure()
+			return fmt.Errorf("rejected payload %s: %w", payload.ID(), eth.NewPayloadErr(payload, standbyStatus))
+		}
+		return nil
+	})
+	return status, nil
+}
+
+func (d *DualEngine) PayloadByHash(ctx context.Context, hash common.Hash) (*eth.ExecutionPayload, error) {
+	_, active := d.current()
+	return active.PayloadByHash(ctx, hash)
+}
+
+func (d *DualEngine) PayloadByNumber(ctx context.Context, num uint64) (*eth.ExecutionPayload, error) {
+	_, active := d.current()
+	return active.PayloadByNumber(ctx, num)
+}
+
+func (d *DualEngine) L2BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L2BlockRef, error) {
+	_, active := d.current()
+	return active.L2BlockRefByLabel(ctx, label)
+}
+
+func (d *DualEngine) L2BlockRefByHash(ctx context.Context, l2Hash common.Hash) (eth.L2BlockRef, error) {
+	_, active := d.current()
+	return active.L2BlockRefByHash(ctx, l2Hash)
+}
+
+func (d *DualEngine) L2BlockRefByNumber(ctx context.Context, num uint64) (eth.L2BlockRef, error) {
+	_, active := d.current()
+	return active.L2BlockRefByNumber(ctx, num)
+}
+
+func (d *DualEngine) SystemConfigByL2Hash(ctx context.Context, hash common.Hash) (eth.SystemConfig, error) {
+	_, active := d.current()
+	return active.SystemConfigByL2Hash(ctx, hash)
+}
+
+func (d *DualEngine) GetProof(ctx context.Context, address common.Address, storage []common.Hash, blockTag string) (*eth.AccountResult, error) {
+	_, active := d.current()
+	return active.GetProof(ctx, address, storage, blockTag)
+}
+
+func (d *DualEngine) NextBaseFee(ctx context.Context, parent eth.BlockID) (*big.Int, error) {
+	_, active := d.current()
+	return active.NextBaseFee(ctx, parent)
+}
+
+func (d *DualEngine) PendingTxCount(ctx context.Context) (uint64, error) {
+	_, active := d.current()
+	txPool, ok := active.(TxPoolReader)
+	if !ok {
+		return 0, errors.New("engine does not support reading the tx pool")
+	}
+	return txPool.PendingTxCount(ctx)
+}
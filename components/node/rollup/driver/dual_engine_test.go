@@ -0,0 +1,122 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/metrics"
+	"github.com/kroma-network/kroma/components/node/testlog"
+	"github.com/kroma-network/kroma/components/node/testutils"
+)
+
+// runStandbyCalls applies the queued calls to the standby engine, like DualEngine.Run.
+func runStandbyCalls(dual *DualEngine) {
+	for len(dual.standbyCalls) > 0 {
+		dual.runStandbyCall(context.Background(), <-dual.standbyCalls)
+	}
+}
+
+func TestDualEngineCrossValidation(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	primary, secondary := new(testutils.MockEngine), new(testutils.MockEngine)
+	dual := NewDualEngine(testlog.Logger(t, log.LvlError), primary, secondary, metrics.NoopMetrics)
+
+	payload := &eth.ExecutionPayload{BlockHash: testutils.RandomHash(rng)}
+	valid := &eth.PayloadStatusV1{Status: eth.ExecutionValid}
+	primary.ExpectNewPayload(payload, valid, nil)
+	secondary.ExpectNewPayload(payload, &eth.PayloadStatusV1{Status: eth.ExecutionInvalid}, nil)
+	status, err := dual.NewPayload(context.Background(), payload)
+	require.NoError(t, err)
+	require.Equal(t, valid, status, "the result of the active engine is used")
+	runStandbyCalls(dual)
+	require.Equal(t, 1, dual.standbyFailures, "the standby engine rejected the payload")
+
+	fc := &eth.ForkchoiceState{HeadBlockHash: payload.BlockHash}
+	attrs := &eth.PayloadAttributes{Timestamp: 10}
+	id := eth.PayloadID{1}
+	fcRes := &eth.ForkchoiceUpdatedResult{PayloadStatus: *valid, PayloadID: &id}
+	primary.ExpectForkchoiceUpdate(fc, attrs, fcRes, nil)
+	secondary.ExpectForkchoiceUpdate(fc, nil, &eth.ForkchoiceUpdatedResult{PayloadStatus: *valid}, nil)
+	res, err := dual.ForkchoiceUpdate(context.Background(), fc, attrs)
+	require.NoError(t, err)
+	require.Equal(t, fcRes, res)
+	runStandbyCalls(dual)
+	require.Zero(t, dual.standbyFailures)
+
+	primary.AssertExpectations(t)
+	secondary.AssertExpectations(t)
+}
+
+func TestDualEngineFailover(t *testing.T) {
+	primary, secondary := new(testutils.MockEngine), new(testutils.MockEngine)
+	dual := NewDualEngine(testlog.Logger(t, log.LvlCrit), primary, secondary, metrics.NoopMetrics)
+
+	id := eth.PayloadID{1}
+	for i := 0; i < engineFailoverThreshold; i++ {
+		require.Zero(t, dual.Failovers())
+		primary.ExpectGetPayload(id, nil, errors.New("unavailable"))
+		_, err := dual.GetPayload(context.Background(), id)
+		require.Error(t, err)
+	}
+	require.Equal(t, uint64(1), dual.Failovers())
+
+	payload := &eth.ExecutionPayload{BlockNumber: 1}
+	secondary.ExpectGetPayload(id, payload, nil)
+	out, err := dual.GetPayload(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, payload, out, "the secondary engine took over")
+
+	primary.AssertExpectations(t)
+	secondary.AssertExpectations(t)
+}
+
+func TestDualEngineUnhealthyStandby(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	primary, secondary := new(testutils.MockEngine), new(testutils.MockEngine)
+	dual := NewDualEngine(testlog.Logger(t, log.LvlCrit), primary, secondary, metrics.NoopMetrics)
+
+	// the standby engine keeps rejecting the payloads accepted by the active engine
+	valid := &eth.PayloadStatusV1{Status: eth.ExecutionValid}
+	for i := 0; i < engineFailoverThreshold; i++ {
+		payload := &eth.ExecutionPayload{BlockHash: testutils.RandomHash(rng)}
+		primary.ExpectNewPayload(payload, valid, nil)
+		secondary.ExpectNewPayload(payload, &eth.PayloadStatusV1{Status: eth.ExecutionInvalid}, nil)
+		_, err := dual.NewPayload(context.Background(), payload)
+		require.NoError(t, err)
+	}
+	runStandbyCalls(dual)
+
+	id := eth.PayloadID{1}
+	for i := 0; i < engineFailoverThreshold; i++ {
+		primary.ExpectGetPayload(id, nil, errors.New("unavailable"))
+		_, err := dual.GetPayload(context.Background(), id)
+		require.Error(t, err)
+	}
+	require.Zero(t, dual.Failovers(), "the unhealthy standby engine does not take over")
+
+	primary.AssertExpectations(t)
+	secondary.AssertExpectations(t)
+}
+
+func TestDualEngineStandbyFellBehind(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	primary, secondary := new(testutils.MockEngine), new(testutils.MockEngine)
+	dual := NewDualEngine(testlog.Logger(t, log.LvlCrit), primary, secondary, metrics.NoopMetrics)
+
+	// the standby engine does not keep up, the active engine is not delayed
+	valid := &eth.PayloadStatusV1{Status: eth.ExecutionValid}
+	for i := 0; i < standbyQueueSize+1; i++ {
+		payload := &eth.ExecutionPayload{BlockHash: testutils.RandomHash(rng)}
+		primary.ExpectNewPayload(payload, valid, nil)
+		_, err := dual.NewPayload(context.Background(), payload)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, dual.standbyFailures, "the payload beyond the queue is dropped")
+	primary.AssertExpectations(t)
+}
@@ -0,0 +1,53 @@
+package driver
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// txPoolStatusTimeout is the maximum time to wait for the tx pool status, before assuming the tx pool is not empty.
+const txPoolStatusTimeout = 200 * time.Millisecond
+
+// TxPoolReader reads the status of the tx pool of the engine.
+type TxPoolReader interface {
+	// PendingTxCount returns the number of transactions in the tx pool that are ready to be included in a block.
+	PendingTxCount(ctx context.Context) (uint64, error)
+}
+
+// EmptyBlockFastPath detects blocks that are known to be empty when their building starts.
+// These blocks are sealed right away, instead of waiting for the sealing deadline of the slot.
+type EmptyBlockFastPath struct {
+	log log.Logger
+	// txPool is nil if the tx pool of the engine cannot be inspected
+	txPool TxPoolReader
+}
+
+func NewEmptyBlockFastPath(log log.Logger, txPool TxPoolReader) *EmptyBlockFastPath {
+	return &EmptyBlockFastPath{
+		log:    log,
+		txPool: txPool,
+	}
+}
+
+// isEmpty returns true if the block built with the given attributes cannot include
+// any transactions other than the ones in the attributes.
+func (f *EmptyBlockFastPath) isEmpty(ctx context.Context, attrs *eth.PayloadAttributes) bool {
+	if attrs.NoTxPool {
+		return true
+	}
+	if f.txPool == nil {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(ctx, txPoolStatusTimeout)
+	defer cancel()
+	pending, err := f.txPool.PendingTxCount(ctx)
+	if err != nil {
+		f.log.Debug("failed to read tx pool status, assuming block is not empty", "err", err)
+		return false
+	}
+	return pending == 0
+}
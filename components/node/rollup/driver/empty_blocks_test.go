@@ -0,0 +1,46 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+type fakeTxPool struct {
+	pending uint64
+	err     error
+}
+
+func (f *fakeTxPool) PendingTxCount(ctx context.Context) (uint64, error) {
+	return f.pending, f.err
+}
+
+func TestEmptyBlockFastPath(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlError)
+	ctx := context.Background()
+	noTxPool := &eth.PayloadAttributes{NoTxPool: true}
+	withTxPool := &eth.PayloadAttributes{}
+
+	withoutReader := NewEmptyBlockFastPath(logger, nil)
+	require.True(t, withoutReader.isEmpty(ctx, noTxPool), "deposits-only blocks are empty")
+	require.False(t, withoutReader.isEmpty(ctx, withTxPool), "tx pool cannot be inspected")
+
+	txPool := &fakeTxPool{}
+	fastPath := NewEmptyBlockFastPath(logger, txPool)
+	require.True(t, fastPath.isEmpty(ctx, withTxPool))
+	require.True(t, fastPath.isEmpty(ctx, noTxPool))
+
+	txPool.pending = 1
+	require.False(t, fastPath.isEmpty(ctx, withTxPool))
+	require.True(t, fastPath.isEmpty(ctx, noTxPool), "tx pool is not used")
+
+	txPool.pending = 0
+	txPool.err = errors.New("unavailable")
+	require.False(t, fastPath.isEmpty(ctx, withTxPool), "unknown tx pool status")
+}
@@ -0,0 +1,55 @@
+package driver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kroma-network/kroma/components/node/rollup/proposer"
+)
+
+// gradualCatchUpFactor is the number of blocks built per block time while catching up with the gradual policy.
+const gradualCatchUpFactor = 4
+
+// GapFillPolicy determines how the proposer backfills the blocks it missed, e.g. after downtime,
+// when the timestamp of the next block is behind the wall-clock.
+type GapFillPolicy struct {
+	name string
+}
+
+// NewGapFillPolicy creates the gap filling policy with the given name.
+func NewGapFillPolicy(name string) (GapFillPolicy, error) {
+	switch name {
+	case "":
+		return GapFillPolicy{name: proposer.GapFillImmediate}, nil
+	case proposer.GapFillImmediate, proposer.GapFillGradual, proposer.GapFillWallClock:
+		return GapFillPolicy{name: name}, nil
+	default:
+		return GapFillPolicy{}, fmt.Errorf("unknown gap fill policy %q, supported policies: %s", name, strings.Join(proposer.GapFillPolicies, ", "))
+	}
+}
+
+func (p GapFillPolicy) String() string {
+	if p.name == "" {
+		return proposer.GapFillImmediate
+	}
+	return p.name
+}
+
+// startDelay returns the time to wait before starting to build a block that is behind the wall-clock,
+// given the time since the previous block building was started.
+func (p GapFillPolicy) startDelay(blockTime time.Duration, sinceLastStart time.Duration) time.Duration {
+	if p.name != proposer.GapFillGradual {
+		return 0
+	}
+	if delay := blockTime/gradualCatchUpFactor - sinceLastStart; delay > 0 {
+		return delay
+	}
+	return 0
+}
+
+// skipTxPool returns true if a block with the given timestamp should not include transactions
+// from the tx pool, because it is more than a block time behind the wall-clock.
+func (p GapFillPolicy) skipTxPool(payloadTime time.Time, blockTime time.Duration, now time.Time) bool {
+	return p.name == proposer.GapFillWallClock && now.Sub(payloadTime) > blockTime
+}
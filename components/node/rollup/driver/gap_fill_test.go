@@ -0,0 +1,46 @@
+package driver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/rollup/proposer"
+)
+
+func TestNewGapFillPolicy(t *testing.T) {
+	policy, err := NewGapFillPolicy("")
+	require.NoError(t, err)
+	require.Equal(t, proposer.GapFillImmediate, policy.String())
+	for _, name := range proposer.GapFillPolicies {
+		policy, err := NewGapFillPolicy(name)
+		require.NoError(t, err)
+		require.Equal(t, name, policy.String())
+	}
+	_, err = NewGapFillPolicy("unknown")
+	require.Error(t, err)
+}
+
+func TestGapFillPolicyStartDelay(t *testing.T) {
+	blockTime := 2 * time.Second
+	immediate, _ := NewGapFillPolicy(proposer.GapFillImmediate)
+	require.Zero(t, immediate.startDelay(blockTime, 0))
+
+	gradual, _ := NewGapFillPolicy(proposer.GapFillGradual)
+	require.Equal(t, blockTime/gradualCatchUpFactor, gradual.startDelay(blockTime, 0))
+	require.Equal(t, blockTime/gradualCatchUpFactor-100*time.Millisecond, gradual.startDelay(blockTime, 100*time.Millisecond))
+	require.Zero(t, gradual.startDelay(blockTime, blockTime))
+}
+
+func TestGapFillPolicySkipTxPool(t *testing.T) {
+	blockTime := 2 * time.Second
+	now := time.Unix(1000, 0)
+	wallClock, _ := NewGapFillPolicy(proposer.GapFillWallClock)
+	require.False(t, wallClock.skipTxPool(now, blockTime, now))
+	require.False(t, wallClock.skipTxPool(now.Add(-blockTime), blockTime, now))
+	require.True(t, wallClock.skipTxPool(now.Add(-blockTime-time.Second), blockTime, now))
+
+	immediate, _ := NewGapFillPolicy(proposer.GapFillImmediate)
+	require.False(t, immediate.skipTxPool(now.Add(-time.Hour), blockTime, now))
+}
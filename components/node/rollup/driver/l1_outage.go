@@ -0,0 +1,50 @@
+package driver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kroma-network/kroma/components/node/rollup/proposer"
+)
+
+// L1OutagePolicy determines what the proposer does when no new L1 head has been observed for a while.
+type L1OutagePolicy struct {
+	name string
+	// timeout is the time without new L1 head after which the L1 is considered unavailable, disabled if 0.
+	timeout time.Duration
+}
+
+// NewL1OutagePolicy creates the L1 outage policy with the given name and timeout.
+func NewL1OutagePolicy(name string, timeout time.Duration) (L1OutagePolicy, error) {
+	switch name {
+	case "":
+		return L1OutagePolicy{name: proposer.L1OutageContinue, timeout: timeout}, nil
+	case proposer.L1OutageContinue, proposer.L1OutagePause, proposer.L1OutageEmpty:
+		return L1OutagePolicy{name: name, timeout: timeout}, nil
+	default:
+		return L1OutagePolicy{}, fmt.Errorf("unknown L1 outage policy %q, supported policies: %s", name, strings.Join(proposer.L1OutagePolicies, ", "))
+	}
+}
+
+func (p L1OutagePolicy) String() string {
+	if p.name == "" {
+		return proposer.L1OutageContinue
+	}
+	return p.name
+}
+
+// outage returns true if the L1 is considered unavailable, given the time the latest L1 head was observed.
+func (p L1OutagePolicy) outage(lastL1Head time.Time, now time.Time) bool {
+	return p.timeout > 0 && !lastL1Head.IsZero() && now.Sub(lastL1Head) > p.timeout
+}
+
+// pause returns true if the proposer stops building blocks during an L1 outage.
+func (p L1OutagePolicy) pause() bool {
+	return p.name == proposer.L1OutagePause
+}
+
+// skipTxPool returns true if the proposer builds blocks without the tx pool during an L1 outage.
+func (p L1OutagePolicy) skipTxPool() bool {
+	return p.name == proposer.L1OutageEmpty
+}
@@ -0,0 +1,40 @@
+package driver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/rollup/proposer"
+)
+
+func TestL1OutagePolicy(t *testing.T) {
+	_, err := NewL1OutagePolicy("unknown", time.Minute)
+	require.Error(t, err)
+
+	def, err := NewL1OutagePolicy("", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, proposer.L1OutageContinue, def.String())
+	require.False(t, def.pause())
+	require.False(t, def.skipTxPool())
+
+	now := time.Unix(1000, 0)
+	require.False(t, def.outage(time.Time{}, now), "no L1 head observed yet")
+	require.False(t, def.outage(now.Add(-time.Minute), now))
+	require.True(t, def.outage(now.Add(-time.Minute-time.Second), now))
+
+	disabled, err := NewL1OutagePolicy(proposer.L1OutagePause, 0)
+	require.NoError(t, err)
+	require.False(t, disabled.outage(now.Add(-time.Hour), now))
+
+	pause, err := NewL1OutagePolicy(proposer.L1OutagePause, time.Minute)
+	require.NoError(t, err)
+	require.True(t, pause.pause())
+	require.False(t, pause.skipTxPool())
+
+	empty, err := NewL1OutagePolicy(proposer.L1OutageEmpty, time.Minute)
+	require.NoError(t, err)
+	require.False(t, empty.pause())
+	require.True(t, empty.skipTxPool())
+}
@@ -0,0 +1,49 @@
+package driver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kroma-network/kroma/components/node/rollup/proposer"
+)
+
+// LateBuildPolicy determines what the proposer does with a block that is still being built
+// when the slot of the next block has already passed, and sealing it would make the chain drift behind the wall-clock.
+type LateBuildPolicy struct {
+	name string
+}
+
+// NewLateBuildPolicy creates the late build policy with the given name.
+func NewLateBuildPolicy(name string) (LateBuildPolicy, error) {
+	switch name {
+	case "":
+		return LateBuildPolicy{name: proposer.LateBuildSeal}, nil
+	case proposer.LateBuildSeal, proposer.LateBuildCancel, proposer.LateBuildEmpty:
+		return LateBuildPolicy{name: name}, nil
+	default:
+		return LateBuildPolicy{}, fmt.Errorf("unknown late build policy %q, supported policies: %s", name, strings.Join(proposer.LateBuildPolicies, ", "))
+	}
+}
+
+func (p LateBuildPolicy) String() string {
+	if p.name == "" {
+		return proposer.LateBuildSeal
+	}
+	return p.name
+}
+
+// cancel returns true if the block with the given timestamp, of which the building started at the given time,
+// should be cancelled instead of sealed. Only blocks that started building before their timestamp are cancelled:
+// blocks that started late already are filling a gap, and follow the gap fill policy.
+func (p LateBuildPolicy) cancel(payloadTime time.Time, blockTime time.Duration, started time.Time, now time.Time) bool {
+	if p.name != proposer.LateBuildCancel && p.name != proposer.LateBuildEmpty {
+		return false
+	}
+	return started.Before(payloadTime) && !now.Before(payloadTime.Add(blockTime))
+}
+
+// skipTxPool returns true if a cancelled block is rebuilt without transactions from the tx pool.
+func (p LateBuildPolicy) skipTxPool() bool {
+	return p.name == proposer.LateBuildEmpty
+}
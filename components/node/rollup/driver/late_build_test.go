@@ -0,0 +1,44 @@
+package driver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/rollup/proposer"
+)
+
+func TestNewLateBuildPolicy(t *testing.T) {
+	policy, err := NewLateBuildPolicy("")
+	require.NoError(t, err)
+	require.Equal(t, proposer.LateBuildSeal, policy.String())
+	for _, name := range proposer.LateBuildPolicies {
+		policy, err := NewLateBuildPolicy(name)
+		require.NoError(t, err)
+		require.Equal(t, name, policy.String())
+	}
+	_, err = NewLateBuildPolicy("unknown")
+	require.Error(t, err)
+}
+
+func TestLateBuildPolicyCancel(t *testing.T) {
+	blockTime := 2 * time.Second
+	payloadTime := time.Unix(1000, 0)
+	started := payloadTime.Add(-blockTime)
+	late := payloadTime.Add(blockTime)
+
+	seal, _ := NewLateBuildPolicy(proposer.LateBuildSeal)
+	require.False(t, seal.cancel(payloadTime, blockTime, started, late))
+	require.False(t, seal.skipTxPool())
+
+	cancel, _ := NewLateBuildPolicy(proposer.LateBuildCancel)
+	require.False(t, cancel.cancel(payloadTime, blockTime, started, late.Add(-time.Millisecond)), "next slot not missed yet")
+	require.True(t, cancel.cancel(payloadTime, blockTime, started, late))
+	require.False(t, cancel.cancel(payloadTime, blockTime, payloadTime, late), "started late, filling a gap")
+	require.False(t, cancel.skipTxPool())
+
+	empty, _ := NewLateBuildPolicy(proposer.LateBuildEmpty)
+	require.True(t, empty.cancel(payloadTime, blockTime, started, late))
+	require.True(t, empty.skipTxPool())
+}
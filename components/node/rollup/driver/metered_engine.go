@@ -71,6 +71,37 @@ func (m *MeteredEngine) ConfirmPayload(ctx context.Context) (out *eth.ExecutionP
 		m.metrics.RecordSequencingError()
 		return payload, errType, err
 	}
+	m.recordSealed("Processed new L2 block", payload, sealingStart)
+	return payload, errType, err
+}
+
+func (m *MeteredEngine) ConfirmPayloadAndStart(ctx context.Context, nextAttrs func(parent eth.L2BlockRef) (*eth.PayloadAttributes, error)) (out *eth.ExecutionPayload, errTyp derive.BlockInsertionErrType, err error) {
+	sealingStart := time.Now()
+	payload, errType, err := m.inner.ConfirmPayloadAndStart(ctx, nextAttrs)
+	if err != nil {
+		m.metrics.RecordSequencingError()
+		return payload, errType, err
+	}
+	m.recordSealed("Processed new L2 block", payload, sealingStart)
+	if _, id, _ := m.inner.BuildingPayload(); id != (eth.PayloadID{}) {
+		m.buildingStartTime = time.Now()
+	}
+	return payload, errType, err
+}
+
+func (m *MeteredEngine) InsertPayload(ctx context.Context, payload *eth.ExecutionPayload) (errTyp derive.BlockInsertionErrType, err error) {
+	sealingStart := time.Now()
+	errTyp, err = m.inner.InsertPayload(ctx, payload)
+	if err != nil {
+		m.metrics.RecordSequencingError()
+		return errTyp, err
+	}
+	m.recordSealed("Inserted new L2 block", payload, sealingStart)
+	return errTyp, err
+}
+
+// recordSealed records the metrics of the block that got sealed and inserted as the new unsafe head.
+func (m *MeteredEngine) recordSealed(msg string, payload *eth.ExecutionPayload, sealingStart time.Time) {
 	now := time.Now()
 	sealTime := now.Sub(sealingStart)
 	buildTime := now.Sub(m.buildingStartTime)
@@ -80,10 +111,8 @@ func (m *MeteredEngine) ConfirmPayload(ctx context.Context) (out *eth.ExecutionP
 
 	ref := m.inner.UnsafeL2Head()
 
-	m.log.Debug("Processed new L2 block", "l2_unsafe", ref, "l1_origin", ref.L1Origin,
+	m.log.Debug(msg, "l2_unsafe", ref, "l1_origin", ref.L1Origin,
 		"txs", len(payload.Transactions), "time", ref.Time, "seal_time", sealTime, "build_time", buildTime)
-
-	return payload, errType, err
 }
 
 func (m *MeteredEngine) CancelPayload(ctx context.Context, force bool) error {
@@ -94,6 +123,6 @@ func (m *MeteredEngine) BuildingPayload() (onto eth.L2BlockRef, id eth.PayloadID
 	return m.inner.BuildingPayload()
 }
 
-func (m *MeteredEngine) Reset() {
-	m.inner.Reset()
+func (m *MeteredEngine) Reset(reason derive.ResetReason) {
+	m.inner.Reset(reason)
 }
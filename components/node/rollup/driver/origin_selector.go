@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/log"
@@ -11,6 +12,7 @@ import (
 	"github.com/kroma-network/kroma/components/node/eth"
 	"github.com/kroma-network/kroma/components/node/rollup"
 	"github.com/kroma-network/kroma/components/node/rollup/derive"
+	"github.com/kroma-network/kroma/components/node/rollup/proposer"
 )
 
 type L1Blocks interface {
@@ -18,18 +20,65 @@ type L1Blocks interface {
 	derive.L1BlockRefByNumberFetcher
 }
 
+// L1OriginPolicy determines how the proposer selects the L1 origin of new L2 blocks,
+// trading off block inclusion latency against exposure to L1 reorgs.
+type L1OriginPolicy interface {
+	// ConfDepth returns the distance to keep from the L1 head when looking for the next L1 origin.
+	ConfDepth() uint64
+	// AdoptNextOrigin returns true if the next L2 block, with the given timestamp,
+	// should adopt nextOrigin as L1 origin instead of repeating the current origin.
+	AdoptNextOrigin(nextL2Time uint64, nextOrigin eth.L1BlockRef) bool
+}
+
+// confirmationsPolicy adopts the next L1 origin as soon as it is allowed to,
+// but only once it has the configured number of confirmations. The head policy
+// is the confirmations policy without any confirmations.
+type confirmationsPolicy struct {
+	confDepth uint64
+}
+
+func (p *confirmationsPolicy) ConfDepth() uint64 {
+	return p.confDepth
+}
+
+func (p *confirmationsPolicy) AdoptNextOrigin(nextL2Time uint64, nextOrigin eth.L1BlockRef) bool {
+	return nextL2Time >= nextOrigin.Time
+}
+
+// NewL1OriginPolicy creates the L1 origin policy with the given name.
+// The confDepth is only used by policies that wait for L1 confirmations.
+func NewL1OriginPolicy(name string, confDepth uint64) (L1OriginPolicy, error) {
+	switch name {
+	case "", proposer.L1OriginConfirmations:
+		return &confirmationsPolicy{confDepth: confDepth}, nil
+	case proposer.L1OriginHead:
+		return &confirmationsPolicy{confDepth: 0}, nil
+	default:
+		return nil, fmt.Errorf("unknown L1 origin policy %q, supported policies: %s", name, strings.Join(proposer.L1OriginPolicies, ", "))
+	}
+}
+
 type L1OriginSelector struct {
 	log log.Logger
 	cfg *rollup.Config
 
-	l1 L1Blocks
+	l1     L1Blocks
+	policy L1OriginPolicy
 }
 
+// NewL1OriginSelector creates a L1OriginSelector that adopts the next L1 origin as soon as it is allowed to.
+// Confirmation depth, if any, is expected to be applied by the given L1 source.
 func NewL1OriginSelector(log log.Logger, cfg *rollup.Config, l1 L1Blocks) *L1OriginSelector {
+	return NewL1OriginSelectorWithPolicy(log, cfg, l1, &confirmationsPolicy{})
+}
+
+// NewL1OriginSelectorWithPolicy creates a L1OriginSelector that selects the next L1 origin following the given policy.
+func NewL1OriginSelectorWithPolicy(log log.Logger, cfg *rollup.Config, l1 L1Blocks, policy L1OriginPolicy) *L1OriginSelector {
 	return &L1OriginSelector{
-		log: log,
-		cfg: cfg,
-		l1:  l1,
+		log:    log,
+		cfg:    cfg,
+		l1:     l1,
+		policy: policy,
 	}
 }
 
@@ -71,9 +120,8 @@ func (los *L1OriginSelector) FindL1Origin(ctx context.Context, l2Head eth.L2Bloc
 	// If the next L2 block time is greater than the next origin block's time, we can choose to
 	// start building on top of the next origin. Proposer implementation has some leeway here and
 	// could decide to continue to build on top of the previous origin until the Proposer runs out
-	// of slack. For simplicity, we implement our Proposer to always start building on the latest
-	// L1 block when we can.
-	if l2Head.Time+los.cfg.BlockTime >= nextOrigin.Time {
+	// of slack. The policy decides whether the next origin is adopted.
+	if los.policy.AdoptNextOrigin(l2Head.Time+los.cfg.BlockTime, nextOrigin) {
 		return nextOrigin, nil
 	}
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/kroma-network/kroma/components/node/eth"
 	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/rollup/proposer"
 	"github.com/kroma-network/kroma/components/node/testlog"
 	"github.com/kroma-network/kroma/components/node/testutils"
 )
@@ -283,3 +284,67 @@ func TestOriginSelectorHandlesLateL1Blocks(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, a, next, "must stay on a because the L1 time may not be higher than the L2 time")
 }
+
+// TestL1OriginPolicies ensures that the L1 origin policies apply the expected confirmation depth,
+// and that unknown policies are rejected.
+func TestL1OriginPolicies(t *testing.T) {
+	p, err := NewL1OriginPolicy(proposer.L1OriginConfirmations, 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), p.ConfDepth())
+
+	p, err = NewL1OriginPolicy("", 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), p.ConfDepth())
+
+	p, err = NewL1OriginPolicy(proposer.L1OriginHead, 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), p.ConfDepth())
+
+	_, err = NewL1OriginPolicy("unknown", 4)
+	require.Error(t, err)
+}
+
+// TestOriginSelectorPolicyRejectsNextOrigin ensures that the origin selector
+// repeats the current origin if the policy does not adopt the next origin.
+func TestOriginSelectorPolicyRejectsNextOrigin(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	cfg := &rollup.Config{
+		MaxProposerDrift: 500,
+		BlockTime:        2,
+	}
+	l1 := &testutils.MockL1Source{}
+	defer l1.AssertExpectations(t)
+	a := eth.L1BlockRef{
+		Hash:   common.Hash{'a'},
+		Number: 10,
+		Time:   20,
+	}
+	b := eth.L1BlockRef{
+		Hash:       common.Hash{'b'},
+		Number:     11,
+		Time:       25,
+		ParentHash: a.Hash,
+	}
+	l2Head := eth.L2BlockRef{
+		L1Origin: a.ID(),
+		Time:     24,
+	}
+
+	l1.ExpectL1BlockRefByHash(a.Hash, a, nil)
+	l1.ExpectL1BlockRefByNumber(b.Number, b, nil)
+
+	s := NewL1OriginSelectorWithPolicy(log, cfg, l1, neverAdoptPolicy{})
+	next, err := s.FindL1Origin(context.Background(), l2Head)
+	require.Nil(t, err)
+	require.Equal(t, a, next)
+}
+
+type neverAdoptPolicy struct{}
+
+func (neverAdoptPolicy) ConfDepth() uint64 {
+	return 0
+}
+
+func (neverAdoptPolicy) AdoptNextOrigin(uint64, eth.L1BlockRef) bool {
+	return false
+}
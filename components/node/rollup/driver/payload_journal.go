@@ -0,0 +1,83 @@
+package driver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// PayloadJournal persists the latest block sealed by the proposer until it is published,
+// so that it can be replayed after a crash instead of skipping the slot.
+type PayloadJournal struct {
+	path string
+}
+
+func NewPayloadJournal(path string) *PayloadJournal {
+	return &PayloadJournal{path: path}
+}
+
+// Write persists the payload, replacing any previously journaled payload.
+// The payload is synced to disk before it replaces the previous one, so a crash never leaves a partial payload.
+func (j *PayloadJournal) Write(payload *eth.ExecutionPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+	tmp := j.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open payload journal: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write payload journal: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to sync payload journal: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close payload journal: %w", err)
+	}
+	if err := os.Rename(tmp, j.path); err != nil {
+		return fmt.Errorf("failed to replace payload journal: %w", err)
+	}
+	return nil
+}
+
+// Read returns the journaled payload, or nil if there is none.
+// A journal that cannot be decoded is quarantined, and an error is returned.
+func (j *PayloadJournal) Read() (*eth.ExecutionPayload, error) {
+	data, err := os.ReadFile(j.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read payload journal: %w", err)
+	}
+	var payload eth.ExecutionPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		// Move the corrupted journal out of the way, so that it is kept for inspection but not read again.
+		if qErr := os.Rename(j.path, j.quarantinePath()); qErr != nil {
+			return nil, fmt.Errorf("failed to decode journaled payload: %w, and failed to quarantine it: %v", err, qErr)
+		}
+		return nil, fmt.Errorf("failed to decode journaled payload, quarantined it to %s: %w", j.quarantinePath(), err)
+	}
+	return &payload, nil
+}
+
+// quarantinePath is the path a corrupted journal is moved to.
+func (j *PayloadJournal) quarantinePath() string {
+	return j.path + ".corrupted"
+}
+
+// Clear removes the journaled payload, after it got published.
+func (j *PayloadJournal) Clear() error {
+	if err := os.Remove(j.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to clear payload journal: %w", err)
+	}
+	return nil
+}
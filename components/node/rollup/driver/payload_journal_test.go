@@ -0,0 +1,65 @@
+package driver
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/testutils"
+)
+
+func TestPayloadJournal(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	journal := NewPayloadJournal(filepath.Join(t.TempDir(), "payload.json"))
+
+	payload, err := journal.Read()
+	require.NoError(t, err)
+	require.Nil(t, payload, "no payload journaled yet")
+	require.NoError(t, journal.Clear(), "clearing an empty journal is fine")
+
+	first := &eth.ExecutionPayload{
+		ParentHash:   testutils.RandomHash(rng),
+		BlockHash:    testutils.RandomHash(rng),
+		BlockNumber:  10,
+		Timestamp:    1000,
+		Transactions: []eth.Data{{0x01, 0x02}},
+	}
+	require.NoError(t, journal.Write(first))
+	second := &eth.ExecutionPayload{
+		ParentHash:  first.BlockHash,
+		BlockHash:   testutils.RandomHash(rng),
+		BlockNumber: 11,
+		Timestamp:   1002,
+	}
+	require.NoError(t, journal.Write(second))
+
+	payload, err = journal.Read()
+	require.NoError(t, err)
+	require.Equal(t, second.BlockHash, payload.BlockHash)
+	require.Equal(t, second.BlockNumber, payload.BlockNumber)
+
+	require.NoError(t, journal.Clear())
+	payload, err = journal.Read()
+	require.NoError(t, err)
+	require.Nil(t, payload)
+}
+
+func TestPayloadJournalCorrupted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "payload.json")
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+	journal := NewPayloadJournal(path)
+	_, err := journal.Read()
+	require.Error(t, err)
+
+	require.NoFileExists(t, path, "corrupted journal is moved out of the way")
+	data, err := os.ReadFile(path + ".corrupted")
+	require.NoError(t, err)
+	require.Equal(t, []byte("{"), data, "corrupted journal is kept for inspection")
+	payload, err := journal.Read()
+	require.NoError(t, err)
+	require.Nil(t, payload)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -27,6 +28,7 @@ type L1OriginSelectorIface interface {
 type ProposerMetrics interface {
 	RecordProposerInconsistentL1Origin(from eth.BlockID, to eth.BlockID)
 	RecordProposerReset()
+	RecordProposerLateBuildCancel()
 }
 
 // Proposer implements the proposing interface of the driver: it starts and completes block building jobs.
@@ -34,20 +36,95 @@ type Proposer struct {
 	log    log.Logger
 	config *rollup.Config
 
-	engine derive.ResettableEngineControl
+	engine BlockBuilder
 
 	attrBuilder      derive.AttributesBuilder
 	l1OriginSelector L1OriginSelectorIface
 
+	// conditionalTxs holds the conditional transactions to include in new blocks, nil if disabled.
+	conditionalTxs *ConditionalTxPool
+
 	metrics ProposerMetrics
 
+	// sealing estimates the time it takes to seal a block
+	sealing *sealingEstimator
+
+	// gapFill determines how blocks that are behind the wall-clock are built
+	gapFill GapFillPolicy
+	// lastStart is the time the latest block building was started
+	lastStart time.Time
+
+	// lateBuild determines whether blocks that are still being built after the next slot passed are sealed or cancelled
+	lateBuild LateBuildPolicy
+	// skipNextTxPool forces building the next block without the tx pool, after a late block was cancelled
+	skipNextTxPool bool
+
+	// stats tracks the block production, for health reporting
+	stats *proposerStats
+
+	// pipelining enables starting the next block together with the insertion of the sealed block,
+	// when there is no time left to wait before building the next block
+	pipelining bool
+
+	// skew shifts the start of the block building within the slot
+	skew ScheduleSkew
+	rng  *rand.Rand
+	// skewTime is the timestamp of the block that skewDelay was sampled for
+	skewTime  uint64
+	skewDelay time.Duration
+
+	// throughput adjusts the gas target of the blocks to sustain a target gas per second, nil if disabled
+	throughput *ThroughputController
+
+	// skipTxPool forces building blocks without the tx pool, e.g. during an L1 outage
+	skipTxPool bool
+
+	// txOrdering is the ordering of the tx pool transactions in new blocks, nil to use the default of the engine
+	txOrdering TxOrderingPolicy
+
+	// depositsPending is true if there are L1 deposits that are not yet included in the L2 chain
+	depositsPending bool
+
+	// haltTarget is the point beyond which no blocks are built
+	haltTarget derive.HaltTarget
+	// halted is true once the next block would be beyond the halt target
+	halted bool
+
+	// emptyBlocks detects blocks that can be sealed right away since they are empty, nil if disabled
+	emptyBlocks *EmptyBlockFastPath
+	// buildingEmpty is true if the block that is being built is known to be empty
+	buildingEmpty bool
+
 	// timeNow enables proposer testing to mock the time
 	timeNow func() time.Time
 
 	nextAction time.Time
 }
 
-func NewProposer(log log.Logger, cfg *rollup.Config, engine derive.ResettableEngineControl, attributesBuilder derive.AttributesBuilder, l1OriginSelector L1OriginSelectorIface, metrics ProposerMetrics) *Proposer {
+// ProposerConfig configures the optional block building features of the proposer.
+// The zero value disables all of them, and builds blocks with the default policies.
+type ProposerConfig struct {
+	// ConditionalTxs holds the conditional transactions to include in new blocks, nil if disabled.
+	ConditionalTxs *ConditionalTxPool
+	// GapFill determines how blocks that are behind the wall-clock are built.
+	GapFill GapFillPolicy
+	// LateBuild determines whether blocks that are still being built after the next slot passed are sealed or cancelled.
+	LateBuild LateBuildPolicy
+	// Pipelining enables starting the next block together with the insertion of the sealed block.
+	Pipelining bool
+	// Skew shifts the start of the block building within the slot.
+	Skew ScheduleSkew
+	// Throughput adjusts the gas target of the blocks to sustain a target gas per second, nil if disabled.
+	Throughput *ThroughputController
+	// EmptyBlocks detects blocks that can be sealed right away since they are empty, nil if disabled.
+	EmptyBlocks *EmptyBlockFastPath
+	// TxOrdering is the ordering of the tx pool transactions in new blocks, nil to use the default of the engine.
+	TxOrdering TxOrderingPolicy
+	// HaltTarget is the point beyond which no blocks are built.
+	HaltTarget derive.HaltTarget
+}
+
+func NewProposer(log log.Logger, cfg *rollup.Config, engine BlockBuilder, attributesBuilder derive.AttributesBuilder, l1OriginSelector L1OriginSelectorIface, propCfg ProposerConfig, metrics ProposerMetrics) *Proposer {
 	return &Proposer{
 		log:              log,
 		config:           cfg,
@@ -55,7 +132,19 @@ func NewProposer(log log.Logger, cfg *rollup.Config, engine derive.ResettableEng
 		timeNow:          time.Now,
 		attrBuilder:      attributesBuilder,
 		l1OriginSelector: l1OriginSelector,
+		conditionalTxs:   propCfg.ConditionalTxs,
+		gapFill:          propCfg.GapFill,
+		lateBuild:        propCfg.LateBuild,
+		pipelining:       propCfg.Pipelining,
+		skew:             propCfg.Skew,
+		throughput:       propCfg.Throughput,
+		emptyBlocks:      propCfg.EmptyBlocks,
+		txOrdering:       propCfg.TxOrdering,
+		haltTarget:       propCfg.HaltTarget,
+		rng:              rand.New(rand.NewSource(time.Now().UnixNano())),
 		metrics:          metrics,
+		sealing:          newSealingEstimator(time.Duration(cfg.BlockTime) * time.Second),
+		stats:            newProposerStats(time.Duration(cfg.BlockTime) * time.Second),
 	}
 }
 
@@ -63,16 +152,37 @@ func NewProposer(log log.Logger, cfg *rollup.Config, engine derive.ResettableEng
 func (p *Proposer) StartBuildingBlock(ctx context.Context) error {
 	l2Head := p.engine.UnsafeL2Head()
 
+	attrs, err := p.prepareAttributes(ctx, l2Head)
+	if err != nil {
+		return err
+	}
+
+	// Start a payload building process.
+	errTyp, err := p.engine.StartPayload(ctx, l2Head, attrs, false)
+	if err != nil {
+		return fmt.Errorf("failed to start building on top of L2 chain %s, error (%d): %w", l2Head, errTyp, err)
+	}
+	return nil
+}
+
+// prepareAttributes prepares the attributes of the next block on top of the given L2 head.
+func (p *Proposer) prepareAttributes(ctx context.Context, l2Head eth.L2BlockRef) (*eth.PayloadAttributes, error) {
 	// Figure out which L1 origin block we're going to be building on top of.
 	l1Origin, err := p.l1OriginSelector.FindL1Origin(ctx, l2Head)
 	if err != nil {
 		p.log.Error("Error finding next L1 Origin", "err", err)
-		return err
+		return nil, err
 	}
 
 	if !(l2Head.L1Origin.Hash == l1Origin.ParentHash || l2Head.L1Origin.Hash == l1Origin.Hash) {
 		p.metrics.RecordProposerInconsistentL1Origin(l2Head.L1Origin, l1Origin.ID())
-		return derive.NewResetError(fmt.Errorf("cannot build new L2 block with L1 origin %s (parent L1 %s) on current L2 head %s with L1 origin %s", l1Origin, l1Origin.ParentHash, l2Head, l2Head.L1Origin))
+		return nil, derive.NewResetErrorWithReason(fmt.Errorf("cannot build new L2 block with L1 origin %s (parent L1 %s) on current L2 head %s with L1 origin %s", l1Origin, l1Origin.ParentHash, l2Head, l2Head.L1Origin), derive.ResetReasonL1Reorg)
+	}
+
+	// The L1 origin of the next block is only known once it is selected, so the halt target is checked here.
+	if p.haltTarget.Exceeds(l2Head.Number+1, l1Origin.Number) {
+		p.halted = true
+		return nil, fmt.Errorf("cannot build block %d with L1 origin %s: %w", l2Head.Number+1, l1Origin, derive.ErrHalted)
 	}
 
 	p.log.Info("creating new block", "parent", l2Head, "l1Origin", l1Origin)
@@ -82,7 +192,7 @@ func (p *Proposer) StartBuildingBlock(ctx context.Context) error {
 
 	attrs, err := p.attrBuilder.PreparePayloadAttributes(fetchCtx, l2Head, l1Origin.ID())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// If our next L2 block timestamp is beyond the Proposer drift threshold, then we must produce
@@ -91,16 +201,47 @@ func (p *Proposer) StartBuildingBlock(ctx context.Context) error {
 	// from the transaction pool.
 	attrs.NoTxPool = uint64(attrs.Timestamp) > l1Origin.Time+p.config.MaxProposerDrift
 
-	p.log.Debug("prepared attributes for new block",
-		"num", l2Head.Number+1, "time", uint64(attrs.Timestamp),
-		"origin", l1Origin, "origin_time", l1Origin.Time, "noTxPool", attrs.NoTxPool)
+	// Depending on the gap fill policy, blocks far behind the wall-clock are built without the tx pool,
+	// to catch up as fast as possible.
+	blockTime := time.Duration(p.config.BlockTime) * time.Second
+	if !attrs.NoTxPool && p.gapFill.skipTxPool(time.Unix(int64(attrs.Timestamp), 0), blockTime, p.timeNow()) {
+		p.log.Info("filling gap to wall-clock without tx pool", "num", l2Head.Number+1, "time", uint64(attrs.Timestamp))
+		attrs.NoTxPool = true
+	}
 
-	// Start a payload building process.
-	errTyp, err := p.engine.StartPayload(ctx, l2Head, attrs, false)
-	if err != nil {
-		return fmt.Errorf("failed to start building on top of L2 chain %s, error (%d): %w", l2Head, errTyp, err)
+	if !attrs.NoTxPool && p.skipNextTxPool {
+		p.log.Info("rebuilding cancelled late block without tx pool", "num", l2Head.Number+1, "time", uint64(attrs.Timestamp))
+		attrs.NoTxPool = true
 	}
-	return nil
+	p.skipNextTxPool = false
+
+	if !attrs.NoTxPool && p.skipTxPool {
+		p.log.Info("building block without tx pool during L1 outage", "num", l2Head.Number+1, "time", uint64(attrs.Timestamp))
+		attrs.NoTxPool = true
+	}
+
+	// Force-include the conditional transactions whose conditionals are met by the new block, right after the deposits.
+	if !attrs.NoTxPool && p.conditionalTxs != nil {
+		for _, tx := range p.conditionalTxs.Select(l2Head, attrs) {
+			data, err := tx.MarshalBinary()
+			if err != nil {
+				p.log.Warn("failed to encode conditional transaction", "tx", tx.Hash(), "err", err)
+				continue
+			}
+			attrs.Transactions = append(attrs.Transactions, data)
+		}
+	}
+
+	if !attrs.NoTxPool && p.txOrdering != nil {
+		attrs.TxOrdering = p.txOrdering.Name()
+	}
+
+	p.buildingEmpty = p.emptyBlocks != nil && p.emptyBlocks.isEmpty(ctx, attrs)
+
+	p.log.Debug("prepared attributes for new block",
+		"num", l2Head.Number+1, "time", uint64(attrs.Timestamp),
+		"origin", l1Origin, "origin_time", l1Origin.Time, "noTxPool", attrs.NoTxPool, "empty", p.buildingEmpty)
+	return attrs, nil
 }
 
 // CompleteBuildingBlock takes the current block that is being built, and asks the engine to complete the building, seal the block, and persist it as canonical.
@@ -114,6 +255,20 @@ func (p *Proposer) CompleteBuildingBlock(ctx context.Context) (*eth.ExecutionPay
 	return payload, nil
 }
 
+// completeAndStartBuildingBlock completes the current block like CompleteBuildingBlock,
+// and starts building the next block on top of it with the same forkchoice update.
+// It returns true if the next block is being built.
+func (p *Proposer) completeAndStartBuildingBlock(ctx context.Context) (*eth.ExecutionPayload, bool, error) {
+	payload, errTyp, err := p.engine.ConfirmPayloadAndStart(ctx, func(parent eth.L2BlockRef) (*eth.PayloadAttributes, error) {
+		return p.prepareAttributes(ctx, parent)
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to complete building block: error (%d): %w", errTyp, err)
+	}
+	_, buildingID, _ := p.engine.BuildingPayload()
+	return payload, buildingID != (eth.PayloadID{}), nil
+}
+
 // CancelBuildingBlock cancels the current open block building job.
 // This proposer only maintains one block building job at a time.
 func (p *Proposer) CancelBuildingBlock(ctx context.Context) {
@@ -150,7 +305,10 @@ func (p *Proposer) PlanNextProposerAction() time.Duration {
 	// then we would like to finish it by sealing the block.
 	if buildingID != (eth.PayloadID{}) && buildingOnto.Hash == head.Hash {
 		// if we started building already, then we will schedule the sealing.
-		if remainingTime < sealingDuration {
+		sealingDuration := p.sealing.Estimate()
+		if p.buildingEmpty {
+			return 0 // the block is known to be empty, there is nothing to wait for.
+		} else if remainingTime < sealingDuration {
 			return 0 // if there's not enough time for sealing, don't wait.
 		} else {
 			// finish with margin of sealing duration before payloadTime
@@ -158,9 +316,16 @@ func (p *Proposer) PlanNextProposerAction() time.Duration {
 		}
 	} else {
 		// if we did not yet start building, then we will schedule the start.
-		if remainingTime > blockTime {
+		if delay := remainingTime - blockTime + p.startSkew(head.Time+p.config.BlockTime); delay > 0 {
 			// if we have too much time, then wait before starting the build
-			return remainingTime - blockTime
+			return delay
+		} else if remainingTime < 0 {
+			// if we are behind, then the gap fill policy determines the pace of catching up,
+			// unless L1 deposits are pending: then reaching the L1 origins that include them takes priority.
+			if p.depositsPending {
+				return 0
+			}
+			return p.gapFill.startDelay(blockTime, now.Sub(p.lastStart))
 		} else {
 			// otherwise start instantly
 			return 0
@@ -168,6 +333,36 @@ func (p *Proposer) PlanNextProposerAction() time.Duration {
 	}
 }
 
+// startSkew returns the skew of the start of the building of the block with the given timestamp.
+// It is sampled once per block, to keep the start stable when re-planning.
+func (p *Proposer) startSkew(payloadTime uint64) time.Duration {
+	if p.skewTime != payloadTime {
+		p.skewTime = payloadTime
+		p.skewDelay = p.skew.sample(p.rng)
+	}
+	return p.skewDelay
+}
+
+// SetSkipTxPool sets whether new blocks are built without transactions from the tx pool.
+func (p *Proposer) SetSkipTxPool(skip bool) {
+	p.skipTxPool = skip
+}
+
+// SetDepositsPending sets whether there are L1 deposits that are not yet included in the L2 chain.
+func (p *Proposer) SetDepositsPending(pending bool) {
+	p.depositsPending = pending
+}
+
+// Halted returns true once the proposer refused to build a block beyond the halt target.
+func (p *Proposer) Halted() bool {
+	return p.halted
+}
+
+// Health returns the statistics of the recent block production.
+func (p *Proposer) Health() eth.ProposerHealth {
+	return p.stats.Health(p.timeNow())
+}
+
 // BuildingOnto returns the L2 head reference that the latest block is or was being built on top of.
 func (p *Proposer) BuildingOnto() eth.L2BlockRef {
 	ref, _, _ := p.engine.BuildingPayload()
@@ -204,7 +399,28 @@ func (p *Proposer) RunNextProposerAction(ctx context.Context) (*eth.ExecutionPay
 			p.nextAction = p.timeNow().Add(time.Second * time.Duration(p.config.BlockTime))
 			return nil, nil
 		}
-		payload, err := p.CompleteBuildingBlock(ctx)
+		sealingStart := p.timeNow()
+		blockTime := time.Duration(p.config.BlockTime) * time.Second
+		payloadTime := time.Unix(int64(onto.Time+p.config.BlockTime), 0)
+		if p.lateBuild.cancel(payloadTime, blockTime, p.lastStart, sealingStart) {
+			p.log.Warn("cancelling block that is still being built after the next slot passed", "onto", onto, "payload_time", payloadTime,
+				"started", p.lastStart, "policy", p.lateBuild)
+			p.metrics.RecordProposerLateBuildCancel()
+			p.CancelBuildingBlock(ctx)
+			p.skipNextTxPool = p.lateBuild.skipTxPool()
+			return nil, nil
+		}
+		empty := p.buildingEmpty
+		var payload *eth.ExecutionPayload
+		var startedNext bool
+		var err error
+		// Without time left to wait before building the next block, it is started with the insertion of this block.
+		nextPayloadTime := time.Unix(int64(onto.Time+2*p.config.BlockTime), 0)
+		if p.pipelining && nextPayloadTime.Sub(sealingStart) <= blockTime {
+			payload, startedNext, err = p.completeAndStartBuildingBlock(ctx)
+		} else {
+			payload, err = p.CompleteBuildingBlock(ctx)
+		}
 		if err != nil {
 			if errors.Is(err, derive.ErrCritical) {
 				return nil, err // bubble up critical errors.
@@ -213,7 +429,7 @@ func (p *Proposer) RunNextProposerAction(ctx context.Context) (*eth.ExecutionPay
 				p.metrics.RecordProposerReset()
 				p.nextAction = p.timeNow().Add(time.Second * time.Duration(p.config.BlockTime)) // hold off from proposing for a full block
 				p.CancelBuildingBlock(ctx)
-				p.engine.Reset()
+				p.engine.Reset(derive.ResetReasonOf(err))
 			} else if errors.Is(err, derive.ErrTemporary) {
 				p.log.Error("proposer failed temporarily to seal new block", "err", err)
 				p.nextAction = p.timeNow().Add(time.Second)
@@ -226,7 +442,21 @@ func (p *Proposer) RunNextProposerAction(ctx context.Context) (*eth.ExecutionPay
 			}
 			return nil, nil
 		} else {
-			p.log.Info("proposer successfully built a new block", "block", payload.ID(), "time", uint64(payload.Timestamp), "txs", len(payload.Transactions))
+			now := p.timeNow()
+			// Empty blocks are sealed without waiting for the sealing deadline, and do not represent the sealing duration of regular blocks.
+			if !empty {
+				p.sealing.Record(now.Sub(sealingStart))
+			}
+			p.stats.RecordBlock(now.Sub(p.lastStart), time.Unix(int64(payload.Timestamp), 0), now)
+			p.log.Info("proposer successfully built a new block", "block", payload.ID(), "time", uint64(payload.Timestamp), "txs", len(payload.Transactions), "sealing_estimate", p.sealing.Estimate(), "empty", empty)
+			if p.throughput != nil {
+				p.throughput.OnBlock(payload)
+			}
+			if startedNext {
+				p.lastStart = now
+				_, buildingID, _ := p.engine.BuildingPayload()
+				p.log.Info("proposer started building next block with the insertion of the new block", "payload_id", buildingID, "l2_parent_block", payload.ID())
+			}
 			return payload, nil
 		}
 	} else {
@@ -234,11 +464,13 @@ func (p *Proposer) RunNextProposerAction(ctx context.Context) (*eth.ExecutionPay
 		if err != nil {
 			if errors.Is(err, derive.ErrCritical) {
 				return nil, err
+			} else if errors.Is(err, derive.ErrHalted) {
+				p.log.Info("proposer reached halt target, not building new blocks", "err", err)
 			} else if errors.Is(err, derive.ErrReset) {
 				p.log.Error("proposer failed to seal new block, requiring derivation reset", "err", err)
 				p.metrics.RecordProposerReset()
 				p.nextAction = p.timeNow().Add(time.Second * time.Duration(p.config.BlockTime)) // hold off from proposing for a full block
-				p.engine.Reset()
+				p.engine.Reset(derive.ResetReasonOf(err))
 			} else if errors.Is(err, derive.ErrTemporary) {
 				p.log.Error("proposer temporarily failed to start building new block", "err", err)
 				p.nextAction = p.timeNow().Add(time.Second)
@@ -247,6 +479,7 @@ func (p *Proposer) RunNextProposerAction(ctx context.Context) (*eth.ExecutionPay
 				p.nextAction = p.timeNow().Add(time.Second)
 			}
 		} else {
+			p.lastStart = p.timeNow()
 			parent, buildingID, _ := p.engine.BuildingPayload() // we should have a new payload ID now that we're building a block
 			p.log.Info("proposer started building new block", "payload_id", buildingID, "l2_parent_block", parent, "l2_parent_block_time", parent.Time)
 		}
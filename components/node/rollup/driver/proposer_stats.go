@@ -0,0 +1,78 @@
+package driver
+
+import (
+	"sort"
+	"time"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// proposerStatsWindow is the number of recent blocks to compute the latency percentiles over.
+const proposerStatsWindow = 128
+
+// proposerStats tracks the block production of the proposer.
+// It is not safe for concurrent use, and is only accessed synchronously with the driver event loop.
+type proposerStats struct {
+	blockTime time.Duration
+
+	// latencies is a ring buffer of the building durations of the recent blocks
+	latencies []time.Duration
+	next      int
+
+	builtBlocks uint64
+	missedSlots uint64
+	lastBuilt   time.Time
+}
+
+func newProposerStats(blockTime time.Duration) *proposerStats {
+	return &proposerStats{
+		blockTime: blockTime,
+		latencies: make([]time.Duration, 0, proposerStatsWindow),
+	}
+}
+
+// RecordBlock records a block with the given timestamp, sealed at now after building it for the given duration.
+// Every full block time that passed between the timestamp and the sealing counts as missed slot.
+func (s *proposerStats) RecordBlock(latency time.Duration, payloadTime time.Time, now time.Time) {
+	if len(s.latencies) < proposerStatsWindow {
+		s.latencies = append(s.latencies, latency)
+	} else {
+		s.latencies[s.next] = latency
+	}
+	s.next = (s.next + 1) % proposerStatsWindow
+	s.builtBlocks += 1
+	if late := now.Sub(payloadTime); s.blockTime > 0 && late >= s.blockTime {
+		s.missedSlots += uint64(late / s.blockTime)
+	}
+	s.lastBuilt = now
+}
+
+// Health returns the block production statistics at the given time.
+func (s *proposerStats) Health(now time.Time) eth.ProposerHealth {
+	health := eth.ProposerHealth{
+		BuiltBlocks: s.builtBlocks,
+		MissedSlots: s.missedSlots,
+	}
+	if len(s.latencies) > 0 {
+		sorted := make([]time.Duration, len(s.latencies))
+		copy(sorted, s.latencies)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		health.LatencyP50 = percentile(sorted, 50)
+		health.LatencyP90 = percentile(sorted, 90)
+		health.LatencyP99 = percentile(sorted, 99)
+	}
+	if !s.lastBuilt.IsZero() {
+		health.LastBlockTime = uint64(s.lastBuilt.Unix())
+		health.SinceLastBlock = now.Sub(s.lastBuilt)
+	}
+	return health
+}
+
+// percentile returns the nearest-rank percentile p of the sorted values.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,44 @@
+package driver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposerStats(t *testing.T) {
+	blockTime := 2 * time.Second
+	stats := newProposerStats(blockTime)
+	now := time.Unix(1000, 0)
+
+	health := stats.Health(now)
+	require.Zero(t, health.BuiltBlocks)
+	require.Zero(t, health.LatencyP50)
+	require.Zero(t, health.LastBlockTime)
+
+	// blocks sealed in time, with latencies of 1ms up to 100ms
+	for i := 1; i <= 100; i++ {
+		stats.RecordBlock(time.Duration(i)*time.Millisecond, now, now)
+	}
+	health = stats.Health(now.Add(time.Second))
+	require.Equal(t, uint64(100), health.BuiltBlocks)
+	require.Equal(t, 50*time.Millisecond, health.LatencyP50)
+	require.Equal(t, 90*time.Millisecond, health.LatencyP90)
+	require.Equal(t, 99*time.Millisecond, health.LatencyP99)
+	require.Zero(t, health.MissedSlots)
+	require.Equal(t, uint64(1000), health.LastBlockTime)
+	require.Equal(t, time.Second, health.SinceLastBlock)
+
+	// a block sealed 5 seconds after its timestamp missed 2 slots
+	stats.RecordBlock(time.Millisecond, now, now.Add(5*time.Second))
+	require.Equal(t, uint64(2), stats.Health(now).MissedSlots)
+
+	// only the most recent blocks are considered for the latencies
+	for i := 0; i < proposerStatsWindow; i++ {
+		stats.RecordBlock(time.Second, now, now)
+	}
+	health = stats.Health(now)
+	require.Equal(t, time.Second, health.LatencyP50)
+	require.Equal(t, time.Second, health.LatencyP99)
+}
@@ -95,6 +95,37 @@ func (m *FakeEngineControl) ConfirmPayload(ctx context.Context) (out *eth.Execut
 	return payload, derive.BlockInsertOK, nil
 }
 
+func (m *FakeEngineControl) ConfirmPayloadAndStart(ctx context.Context, nextAttrs func(parent eth.L2BlockRef) (*eth.PayloadAttributes, error)) (out *eth.ExecutionPayload, errTyp derive.BlockInsertionErrType, err error) {
+	payload, errTyp, err := m.ConfirmPayload(ctx)
+	if err != nil {
+		return nil, errTyp, err
+	}
+	attrs, err := nextAttrs(m.unsafe)
+	if err != nil {
+		return payload, derive.BlockInsertOK, nil
+	}
+	_, _ = m.StartPayload(ctx, m.unsafe, attrs, false)
+	return payload, derive.BlockInsertOK, nil
+}
+
+func (m *FakeEngineControl) InsertPayload(ctx context.Context, payload *eth.ExecutionPayload) (errTyp derive.BlockInsertionErrType, err error) {
+	if m.err != nil {
+		return m.errTyp, m.err
+	}
+	if payload.ParentHash != m.buildingOnto.Hash {
+		return derive.BlockInsertPayloadErr, fmt.Errorf("payload parent %s does not match %s", payload.ParentHash, m.buildingOnto.Hash)
+	}
+	ref, err := derive.PayloadToBlockRef(payload, &m.cfg.Genesis)
+	if err != nil {
+		return derive.BlockInsertPayloadErr, err
+	}
+	m.unsafe = ref
+	m.totalBuiltBlocks += 1
+	m.resetBuildingState()
+	m.totalTxs += len(payload.Transactions)
+	return derive.BlockInsertOK, nil
+}
+
 func (m *FakeEngineControl) CancelPayload(ctx context.Context, force bool) error {
 	if force {
 		m.resetBuildingState()
@@ -125,7 +156,7 @@ func (m *FakeEngineControl) resetBuildingState() {
 	m.buildingAttrs = nil
 }
 
-func (m *FakeEngineControl) Reset() {
+func (m *FakeEngineControl) Reset(reason derive.ResetReason) {
 	m.err = nil
 }
 
@@ -308,7 +339,7 @@ func TestProposerChaosMonkey(t *testing.T) {
 		}
 	})
 
-	proposer := NewProposer(log, cfg, engControl, attrBuilder, originSelector, metrics.NoopMetrics)
+	proposer := NewProposer(log, cfg, engControl, attrBuilder, originSelector, ProposerConfig{}, metrics.NoopMetrics)
 	proposer.timeNow = clockFn
 
 	// try to build 1000 blocks, with 5x as many planning attempts, to handle errors and clock problems
@@ -382,3 +413,35 @@ func TestProposerChaosMonkey(t *testing.T) {
 	require.Greater(t, engControl.avgBuildingTime(), time.Second, "With 2 second block time and 1 second error backoff and healthy-on-average errors, building time should at least be a second")
 	require.Greater(t, engControl.avgTxsPerBlock(), 3.0, "We expect at least 1 system tx per block, but with a mocked 0-10 txs we expect an higher avg")
 }
+
+// TestProposerHaltTarget ensures that the proposer does not build blocks with an L1 origin beyond the halt target.
+func TestProposerHaltTarget(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	cfg := &rollup.Config{BlockTime: 2, MaxProposerDrift: 600}
+	l1Origin := testutils.RandomBlockRef(rng)
+	head := testutils.RandomL2BlockRef(rng)
+	head.L1Origin = l1Origin.ID()
+	l1Origin.Time = 1000
+	head.Time = 1000
+
+	engControl := &FakeEngineControl{cfg: cfg, unsafe: head, timeNow: time.Now}
+	attrBuilder := testAttrBuilderFn(func(ctx context.Context, l2Parent eth.L2BlockRef, epoch eth.BlockID) (*eth.PayloadAttributes, error) {
+		return &eth.PayloadAttributes{Timestamp: eth.Uint64Quantity(l2Parent.Time + cfg.BlockTime)}, nil
+	})
+	originSelector := testOriginSelectorFn(func(ctx context.Context, l2Head eth.L2BlockRef) (eth.L1BlockRef, error) {
+		return l1Origin, nil
+	})
+
+	propCfg := ProposerConfig{HaltTarget: derive.HaltTarget{L1Origin: l1Origin.Number}}
+	p := NewProposer(testlog.Logger(t, log.LvlError), cfg, engControl, attrBuilder, originSelector, propCfg, metrics.NoopMetrics)
+	require.NoError(t, p.StartBuildingBlock(context.Background()), "blocks with the target L1 origin are built")
+	require.False(t, p.Halted())
+
+	engControl.resetBuildingState()
+	propCfg.HaltTarget.L1Origin = l1Origin.Number - 1
+	p = NewProposer(testlog.Logger(t, log.LvlError), cfg, engControl, attrBuilder, originSelector, propCfg, metrics.NoopMetrics)
+	require.ErrorIs(t, p.StartBuildingBlock(context.Background()), derive.ErrHalted)
+	require.True(t, p.Halted())
+	_, buildingID, _ := engControl.BuildingPayload()
+	require.Equal(t, eth.PayloadID{}, buildingID, "no block is built beyond the halt target")
+}
@@ -0,0 +1,34 @@
+package driver
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// ScheduleSkew shifts the start of the block building within the slot, e.g. for latency experiments,
+// or to stagger the block production of a failover proposer.
+// The sealing is still scheduled by the timestamp of the block. Disabled if zeroed.
+type ScheduleSkew struct {
+	// Offset is added to the start of every block building, it may be negative to start earlier.
+	Offset time.Duration `json:"offset"`
+	// Jitter is the upper bound of a random delay added to the start of every block building.
+	Jitter time.Duration `json:"jitter"`
+}
+
+// Check verifies that the skew is valid.
+func (s ScheduleSkew) Check() error {
+	if s.Jitter < 0 {
+		return errors.New("schedule jitter must not be negative")
+	}
+	return nil
+}
+
+// sample returns the skew of the start of a block building.
+func (s ScheduleSkew) sample(rng *rand.Rand) time.Duration {
+	skew := s.Offset
+	if s.Jitter > 0 {
+		skew += time.Duration(rng.Int63n(int64(s.Jitter)))
+	}
+	return skew
+}
@@ -0,0 +1,27 @@
+package driver
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleSkew(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+
+	require.NoError(t, ScheduleSkew{}.Check())
+	require.Error(t, ScheduleSkew{Jitter: -time.Millisecond}.Check())
+	require.Zero(t, ScheduleSkew{}.sample(rng))
+
+	offset := ScheduleSkew{Offset: -300 * time.Millisecond}
+	require.Equal(t, -300*time.Millisecond, offset.sample(rng))
+
+	jitter := ScheduleSkew{Offset: 100 * time.Millisecond, Jitter: 200 * time.Millisecond}
+	for i := 0; i < 100; i++ {
+		skew := jitter.sample(rng)
+		require.GreaterOrEqual(t, skew, 100*time.Millisecond)
+		require.Less(t, skew, 300*time.Millisecond)
+	}
+}
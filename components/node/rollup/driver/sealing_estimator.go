@@ -0,0 +1,51 @@
+package driver
+
+import (
+	"time"
+)
+
+const (
+	// defaultSealingDuration is the expected time it takes to seal a block, before any block was sealed.
+	defaultSealingDuration = time.Millisecond * 50
+	// minSealingDuration is the lower bound of the estimated sealing time.
+	minSealingDuration = time.Millisecond * 10
+	// sealingSmoothingFactor is the weight of the latest measurement in the moving average.
+	sealingSmoothingFactor = 0.2
+)
+
+// sealingEstimator estimates the time it takes the engine to seal a block,
+// as an exponentially weighted moving average of the measured sealing times.
+// Engines differ in performance, and the proposer uses the estimate to start
+// sealing early enough to hit the block timestamp.
+type sealingEstimator struct {
+	estimate time.Duration
+	// max bounds the estimate, so that a single slow sealing does not take over the block building time.
+	max time.Duration
+}
+
+func newSealingEstimator(blockTime time.Duration) *sealingEstimator {
+	max := blockTime / 2
+	if max < defaultSealingDuration {
+		max = defaultSealingDuration
+	}
+	return &sealingEstimator{
+		estimate: defaultSealingDuration,
+		max:      max,
+	}
+}
+
+// Record adds a measured sealing time to the estimate.
+func (s *sealingEstimator) Record(d time.Duration) {
+	next := time.Duration(sealingSmoothingFactor*float64(d) + (1-sealingSmoothingFactor)*float64(s.estimate))
+	if next < minSealingDuration {
+		next = minSealingDuration
+	} else if next > s.max {
+		next = s.max
+	}
+	s.estimate = next
+}
+
+// Estimate returns the expected time it takes to seal the next block.
+func (s *sealingEstimator) Estimate() time.Duration {
+	return s.estimate
+}
@@ -0,0 +1,35 @@
+package driver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealingEstimator(t *testing.T) {
+	s := newSealingEstimator(2 * time.Second)
+	require.Equal(t, defaultSealingDuration, s.Estimate())
+
+	// converges towards the measured sealing time
+	for i := 0; i < 50; i++ {
+		s.Record(200 * time.Millisecond)
+	}
+	require.InDelta(t, float64(200*time.Millisecond), float64(s.Estimate()), float64(time.Millisecond))
+
+	// a single outlier only partially moves the estimate
+	s.Record(500 * time.Millisecond)
+	require.Less(t, s.Estimate(), 300*time.Millisecond)
+
+	// bounded by half of the block time
+	for i := 0; i < 50; i++ {
+		s.Record(10 * time.Second)
+	}
+	require.Equal(t, time.Second, s.Estimate())
+
+	// bounded by the minimum
+	for i := 0; i < 100; i++ {
+		s.Record(0)
+	}
+	require.Equal(t, minSealingDuration, s.Estimate())
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/kroma-network/kroma/components/node/eth"
@@ -22,9 +23,6 @@ import (
 // Deprecated: use eth.SyncStatus instead.
 type SyncStatus = eth.SyncStatus
 
-// sealingDuration defines the expected time it takes to seal the block
-const sealingDuration = time.Millisecond * 50
-
 type Driver struct {
 	l1State L1StateIface
 
@@ -74,27 +72,77 @@ type Driver struct {
 	proposer ProposerIface
 	network  Network // may be nil, network for is optional
 
+	// publishFailures counts the proposed blocks that failed to be published
+	publishFailures uint64
+
+	// l1Outage determines what the proposer does when no new L1 head has been observed for a while
+	l1Outage L1OutagePolicy
+	// lastL1HeadTime is the time the latest new L1 head was observed
+	lastL1HeadTime time.Time
+	// l1OutageActive is true while the L1 is considered unavailable
+	l1OutageActive bool
+
+	// journal persists the latest proposed block until it is published, nil if disabled
+	journal *PayloadJournal
+	// journalReplayed is true once the journaled block, if any, was replayed after startup
+	journalReplayed bool
+
+	// dualEngine is the L2 engine if a secondary engine is configured, nil otherwise
+	dualEngine *DualEngine
+	// engineFailovers is the number of engine failovers handled by the driver
+	engineFailovers uint64
+
+	// dryRun builds local blocks to compare with the received blocks, nil if disabled
+	dryRun *DryRunBuilder
+
+	// deposits tracks the L1 deposits that are not yet included in the L2 chain, nil if not proposing
+	deposits *DepositMonitor
+
+	// conditionalTxs is shared with the proposer, nil if conditional transactions are disabled.
+	conditionalTxs *ConditionalTxPool
+
 	metrics     Metrics
 	log         log.Logger
 	snapshotLog log.Logger
 	done        chan struct{}
 
+	// driverCtx is canceled when the driver is closed, to stop the background work of the driver.
+	driverCtx    context.Context
+	driverCancel context.CancelFunc
+
 	wg gosync.WaitGroup
 }
 
 // Start starts up the state loop.
 // The loop will have been started iff err is not nil.
 func (d *Driver) Start() error {
-	d.derivation.Reset()
+	d.derivation.Reset(derive.ResetReasonStartup)
 
 	d.wg.Add(1)
 	go d.eventLoop()
 
+	if d.dualEngine != nil {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.dualEngine.Run(d.driverCtx)
+		}()
+	}
+
+	if d.conditionalTxs != nil {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.conditionalTxs.Run(d.driverCtx)
+		}()
+	}
+
 	return nil
 }
 
 func (d *Driver) Close() error {
 	d.done <- struct{}{}
+	d.driverCancel()
 	d.wg.Wait()
 	return nil
 }
@@ -144,7 +192,7 @@ func (d *Driver) eventLoop() {
 	defer d.wg.Done()
 	d.log.Info("State loop started")
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(d.driverCtx)
 	defer cancel()
 
 	// stepReqCh is used to request that the driver attempts to step forward by one L1 block.
@@ -205,13 +253,41 @@ func (d *Driver) eventLoop() {
 	defer altSyncTicker.Stop()
 	lastUnsafeL2 := d.derivation.UnsafeL2Head()
 
+	// halted is true when the derivation reached the configured halt target.
+	halted := false
+
 	for {
+		d.updateL1Outage()
+
+		// After an engine failover, the forkchoice state is re-established on the new active engine,
+		// and the block building on the previous engine is dropped.
+		if d.dualEngine != nil {
+			if failovers := d.dualEngine.Failovers(); failovers != d.engineFailovers {
+				d.engineFailovers = failovers
+				d.log.Warn("Execution engine failed over, resetting derivation pipeline")
+				d.derivation.Reset(derive.ResetReasonEngineFailover)
+			}
+		}
+
+		// Once the engine is ready after startup, replay the block that may not have been published before a crash.
+		if d.journal != nil && !d.journalReplayed && d.derivation.EngineReady() {
+			d.journalReplayed = true
+			d.replayJournaledPayload(ctx)
+		}
+
 		// If we are proposing, and the L1 state is ready, update the trigger for the next proposer action.
 		// This may adjust at any time based on fork-choice changes or previous errors.
 		// And avoid sequencing if the derivation pipeline indicates the engine is not ready.
 		if d.driverConfig.ProposerEnabled && !d.driverConfig.ProposerStopped &&
 			d.l1State.L1Head() != (eth.L1BlockRef{}) && d.derivation.EngineReady() {
-			if d.driverConfig.ProposerMaxSafeLag > 0 && d.derivation.SafeL2Head().Number+d.driverConfig.ProposerMaxSafeLag <= d.derivation.UnsafeL2Head().Number {
+			if target := d.driverConfig.HaltTarget; (target.L2Block != 0 && d.derivation.UnsafeL2Head().Number >= target.L2Block) || d.proposer.Halted() {
+				// Do not create new blocks beyond the halt target.
+				// The L1 origin target is checked by the proposer, since the origin of the next block is not known here.
+				if proposerCh != nil {
+					d.log.Warn("Stop creating new blocks since halt target is reached", "unsafe_l2", d.derivation.UnsafeL2Head(), "target", target)
+					proposerCh = nil
+				}
+			} else if d.driverConfig.ProposerMaxSafeLag > 0 && d.derivation.SafeL2Head().Number+d.driverConfig.ProposerMaxSafeLag <= d.derivation.UnsafeL2Head().Number {
 				// If the safe head has fallen behind by a significant number of blocks, delay creating new blocks
 				// until the safe lag is below ProposerMaxSafeLag.
 				if proposerCh != nil {
@@ -222,6 +298,12 @@ func (d *Driver) eventLoop() {
 					)
 					proposerCh = nil
 				}
+			} else if d.l1OutageActive && d.l1Outage.pause() {
+				// Do not create new blocks until a new L1 head is observed.
+				if proposerCh != nil {
+					d.log.Warn("Pause creating new blocks since no new L1 head was observed", "l1_head", d.l1State.L1Head(), "since", d.lastL1HeadTime)
+					proposerCh = nil
+				}
 			} else if d.proposer.BuildingOnto().ID() != d.derivation.UnsafeL2Head().ID() {
 				// If we are sequencing, and the L1 state is ready, update the trigger for the next proposer action.
 				// This may adjust at any time based on fork-choice changes or previous errors.
@@ -233,6 +315,11 @@ func (d *Driver) eventLoop() {
 			proposerCh = nil
 		}
 
+		// Check the conditional transactions against the new head in the background, ahead of building on top of it.
+		if d.conditionalTxs != nil {
+			d.conditionalTxs.OnUnsafeHead(d.derivation.UnsafeL2Head())
+		}
+
 		// If the engine is not ready, or if the L2 head is actively changing, then reset the alt-sync:
 		// there is no need to request L2 blocks when we are syncing already.
 		if head := d.derivation.UnsafeL2Head(); head != lastUnsafeL2 || !d.derivation.EngineReady() {
@@ -250,11 +337,25 @@ func (d *Driver) eventLoop() {
 			if d.network != nil && payload != nil {
 				// Publishing of unsafe data via p2p is optional.
 				// Errors are not severe enough to change/halt proposing but should be logged and metered.
+				// The preconfirmation is published first, since it is much smaller than the payload.
+				if err := d.network.PublishPreconfirmation(ctx, eth.PreconfirmationFromPayload(payload)); err != nil {
+					d.log.Warn("failed to publish preconfirmation of newly created block", "id", payload.ID(), "err", err)
+					d.metrics.RecordPublishingError()
+				}
 				if err := d.network.PublishL2Payload(ctx, payload); err != nil {
 					d.log.Warn("failed to publish newly created block", "id", payload.ID(), "err", err)
 					d.metrics.RecordPublishingError()
+					d.publishFailures += 1
+				}
+			}
+			if d.journal != nil && payload != nil {
+				if err := d.journal.Clear(); err != nil {
+					d.log.Warn("failed to clear journal of published block", "id", payload.ID(), "err", err)
 				}
 			}
+			if payload != nil {
+				d.updateDeposits(ctx)
+			}
 			planProposerAction() // schedule the next proposer action to keep the proposing looping
 		case <-altSyncTicker.C:
 			// Check if there is a gap in the current unsafe payload queue.
@@ -267,12 +368,24 @@ func (d *Driver) eventLoop() {
 		case payload := <-d.unsafeL2Payloads:
 			d.snapshot("New unsafe payload")
 			d.log.Info("Optimistically queueing unsafe L2 execution payload", "id", payload.ID())
+			// The local block can only be built if the payload extends the head, without changing the forkchoice state.
+			if head := d.derivation.UnsafeL2Head(); d.dryRun != nil && payload.ParentHash == head.Hash {
+				d.dryRun.Compare(ctx, eth.ForkchoiceState{
+					HeadBlockHash:      head.Hash,
+					SafeBlockHash:      d.derivation.SafeL2Head().Hash,
+					FinalizedBlockHash: d.derivation.Finalized().Hash,
+				}, payload)
+			}
 			d.derivation.AddUnsafePayload(payload)
 			d.metrics.RecordReceivedUnsafePayload(payload)
 			reqStep()
 
 		case newL1Head := <-d.l1HeadSig:
+			if newL1Head.Hash != d.l1State.L1Head().Hash {
+				d.lastL1HeadTime = time.Now()
+			}
 			d.l1State.HandleNewL1HeadBlock(newL1Head)
+			d.updateDeposits(ctx)
 			reqStep() // a new L1 head may mean we have the data to not get an EOF again.
 		case newL1Safe := <-d.l1SafeSig:
 			d.l1State.HandleNewL1SafeBlock(newL1Safe)
@@ -294,11 +407,19 @@ func (d *Driver) eventLoop() {
 				stepAttempts = 0
 				d.metrics.SetDerivationIdle(true)
 				continue
+			} else if err != nil && errors.Is(err, derive.ErrHalted) {
+				if !halted {
+					d.log.Info("Derivation halted at target", "target", d.driverConfig.HaltTarget, "safe_l2", d.derivation.SafeL2Head(), "unsafe_l2", d.derivation.UnsafeL2Head())
+					halted = true
+				}
+				stepAttempts = 0
+				d.metrics.SetDerivationIdle(true)
+				continue
 			} else if err != nil && errors.Is(err, derive.ErrReset) {
 				// If the pipeline corrupts, e.g. due to a reorg, simply reset it
-				d.log.Warn("Derivation pipeline is reset", "err", err)
-				d.derivation.Reset()
-				d.metrics.RecordPipelineReset()
+				reason := derive.ResetReasonOf(err)
+				d.log.Warn("Derivation pipeline is reset", "reason", reason, "err", err)
+				d.derivation.Reset(reason)
 				continue
 			} else if err != nil && errors.Is(err, derive.ErrTemporary) {
 				d.log.Warn("Derivation process temporary error", "attempts", stepAttempts, "err", err)
@@ -323,8 +444,7 @@ func (d *Driver) eventLoop() {
 			respCh <- struct{}{}
 		case respCh := <-d.forceReset:
 			d.log.Warn("Derivation pipeline is manually reset")
-			d.derivation.Reset()
-			d.metrics.RecordPipelineReset()
+			d.derivation.Reset(derive.ResetReasonManual)
 			close(respCh)
 		case resp := <-d.startProposer:
 			unsafeHead := d.derivation.UnsafeL2Head().Hash
@@ -409,6 +529,15 @@ func (d *Driver) StopProposer(ctx context.Context) (common.Hash, error) {
 	}
 }
 
+// SendConditionalTransaction queues up the transaction to be included by the proposer
+// in the first block that meets the given conditional.
+func (d *Driver) SendConditionalTransaction(ctx context.Context, tx *types.Transaction, cond TransactionConditional) error {
+	if d.conditionalTxs == nil {
+		return ErrConditionalTxsDisabled
+	}
+	return d.conditionalTxs.Add(tx, cond)
+}
+
 // syncStatus returns the current sync status, and should only be called synchronously with
 // the driver event loop to avoid retrieval of an inconsistent status.
 func (d *Driver) syncStatus() *eth.SyncStatus {
@@ -422,6 +551,7 @@ func (d *Driver) syncStatus() *eth.SyncStatus {
 		SafeL2:             d.derivation.SafeL2Head(),
 		FinalizedL2:        d.derivation.Finalized(),
 		UnsafeL2SyncTarget: d.derivation.UnsafeL2SyncTarget(),
+		LastReset:          d.derivation.LastReset(),
 	}
 }
 
@@ -439,6 +569,23 @@ func (d *Driver) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
 	}
 }
 
+// ProposerHealth blocks the driver event loop and captures the block production statistics of the proposer.
+// If the event loop is too busy and the context expires, a context error is returned.
+func (d *Driver) ProposerHealth(ctx context.Context) (*eth.ProposerHealth, error) {
+	wait := make(chan struct{})
+	select {
+	case d.stateReq <- wait:
+		resp := d.proposer.Health()
+		resp.Enabled = d.driverConfig.ProposerEnabled
+		resp.Active = d.driverConfig.ProposerEnabled && !d.driverConfig.ProposerStopped
+		resp.PublishFailures = d.publishFailures
+		<-wait
+		return &resp, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 // BlockRefsWithStatus blocks the driver event loop and captures the syncing status,
 // along with L2 blocks reference by number and number plus 1 consistent with that same status.
 // If the event loop is too busy and the context expires, a context error is returned.
@@ -461,6 +608,20 @@ func (d *Driver) BlockRefsWithStatus(ctx context.Context, num uint64) (eth.L2Blo
 	}
 }
 
+// DroppedBatches blocks the driver event loop and captures the batches most recently dropped by the derivation pipeline.
+// If the event loop is too busy and the context expires, a context error is returned.
+func (d *Driver) DroppedBatches(ctx context.Context) ([]derive.DroppedBatch, error) {
+	wait := make(chan struct{})
+	select {
+	case d.stateReq <- wait:
+		resp := d.derivation.DroppedBatches()
+		<-wait
+		return resp, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 // deferJSONString helps avoid a JSON-encoding performance hit if the snapshot logger does not run
 type deferJSONString struct {
 	x any
@@ -507,3 +668,69 @@ func (d *Driver) checkForGapInUnsafeQueue(ctx context.Context) error {
 	}
 	return nil
 }
+
+// updateL1Outage tracks whether the L1 is considered unavailable, and applies the L1 outage policy on changes.
+func (d *Driver) updateL1Outage() {
+	outage := d.l1Outage.outage(d.lastL1HeadTime, time.Now())
+	if outage == d.l1OutageActive {
+		return
+	}
+	d.l1OutageActive = outage
+	if outage {
+		d.log.Warn("No new L1 head observed, applying L1 outage policy", "policy", d.l1Outage, "l1_head", d.l1State.L1Head(), "since", d.lastL1HeadTime)
+	} else {
+		d.log.Info("New L1 head observed, L1 outage is over", "policy", d.l1Outage, "l1_head", d.l1State.L1Head())
+	}
+	d.metrics.RecordProposerL1Outage(d.l1Outage.String(), outage)
+	d.proposer.SetSkipTxPool(outage && d.l1Outage.skipTxPool())
+}
+
+// updateDeposits tracks the L1 deposits that are not yet included in the L2 chain,
+// and lets the proposer prioritize their inclusion while they are pending.
+func (d *Driver) updateDeposits(ctx context.Context) {
+	if d.deposits == nil || d.l1State.L1Head() == (eth.L1BlockRef{}) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := d.deposits.Update(ctx, d.derivation.UnsafeL2Head().L1Origin, d.l1State.L1Head(), time.Now()); err != nil {
+		d.log.Warn("failed to check pending L1 deposits", "err", err)
+	}
+	d.proposer.SetDepositsPending(d.deposits.Pending())
+}
+
+// replayJournaledPayload replays the journaled block, if it is still the tip candidate:
+// it is published if it is the unsafe head, or inserted and published if it extends the unsafe head.
+// Otherwise, the journaled block is stale and dropped.
+func (d *Driver) replayJournaledPayload(ctx context.Context) {
+	payload, err := d.journal.Read()
+	if err != nil {
+		d.log.Error("failed to read journaled block", "err", err)
+	}
+	if payload == nil {
+		return
+	}
+	defer func() {
+		if err := d.journal.Clear(); err != nil {
+			d.log.Warn("failed to clear journal of replayed block", "id", payload.ID(), "err", err)
+		}
+	}()
+
+	head := d.derivation.UnsafeL2Head()
+	switch {
+	case payload.BlockHash == head.Hash:
+		d.log.Info("Replaying journaled block, publishing unsafe head", "id", payload.ID())
+	case payload.ParentHash == head.Hash:
+		d.log.Info("Replaying journaled block, inserting on top of unsafe head", "id", payload.ID(), "unsafe", head)
+		d.derivation.AddUnsafePayload(payload)
+	default:
+		d.log.Info("Dropping stale journaled block", "id", payload.ID(), "unsafe", head)
+		return
+	}
+	if d.network != nil {
+		if err := d.network.PublishL2Payload(ctx, payload); err != nil {
+			d.log.Warn("failed to publish journaled block", "id", payload.ID(), "err", err)
+			d.metrics.RecordPublishingError()
+		}
+	}
+}
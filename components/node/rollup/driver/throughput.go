@@ -0,0 +1,91 @@
+package driver
+
+import (
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+)
+
+const (
+	// throughputIntegralGain is the inverse of the share of the accumulated gas deviation compensated in the next block.
+	throughputIntegralGain = 8
+	// throughputWindow bounds the accumulated gas deviation, in number of blocks.
+	throughputWindow = 32
+	// throughputSmoothing is the weight of the latest block in the average gas used per block.
+	throughputSmoothing = 0.1
+)
+
+type ThroughputMetrics interface {
+	RecordThroughputController(gasTarget uint64, gasPerSecond float64)
+}
+
+// ThroughputController adjusts the soft gas limit of the blocks built by the proposer, to sustain a target gas per second.
+// Blocks below the target allow the next blocks to use more gas, up to the gas limit of the system config,
+// and blocks above the target lower the soft gas limit of the next blocks.
+type ThroughputController struct {
+	log     log.Logger
+	metrics ThroughputMetrics
+
+	blockTime uint64
+	// blockGas is the target gas used per block
+	blockGas uint64
+
+	// limits are the static block limits, the adjusted gas limit never exceeds them
+	limits    derive.BlockLimits
+	setLimits func(limits derive.BlockLimits)
+
+	// integral is the accumulated difference between the target and the gas used, bounded by throughputWindow
+	integral int64
+	// avgGasUsed is the exponential moving average of the gas used per block
+	avgGasUsed float64
+}
+
+func NewThroughputController(log log.Logger, blockTime uint64, targetGasPerSecond uint64, limits derive.BlockLimits, setLimits func(limits derive.BlockLimits), metrics ThroughputMetrics) *ThroughputController {
+	return &ThroughputController{
+		log:        log,
+		metrics:    metrics,
+		blockTime:  blockTime,
+		blockGas:   targetGasPerSecond * blockTime,
+		limits:     limits,
+		setLimits:  setLimits,
+		avgGasUsed: float64(targetGasPerSecond * blockTime),
+	}
+}
+
+// OnBlock updates the controller with the gas used by the new block, and sets the soft gas limit of the next block.
+func (c *ThroughputController) OnBlock(payload *eth.ExecutionPayload) {
+	gasUsed := uint64(payload.GasUsed)
+	c.avgGasUsed = (1-throughputSmoothing)*c.avgGasUsed + throughputSmoothing*float64(gasUsed)
+
+	bound := int64(c.blockGas) * throughputWindow
+	c.integral += int64(c.blockGas) - int64(gasUsed)
+	if c.integral > bound {
+		c.integral = bound
+	} else if c.integral < -bound {
+		c.integral = -bound
+	}
+
+	target := c.nextTarget(uint64(payload.GasLimit))
+	limits := c.limits
+	if limits.MaxGasUsed == 0 || target < limits.MaxGasUsed {
+		limits.MaxGasUsed = target
+	}
+	c.setLimits(limits)
+
+	c.log.Debug("adjusted block gas target", "gas_used", gasUsed, "next_target", limits.MaxGasUsed, "integral", c.integral)
+	c.metrics.RecordThroughputController(limits.MaxGasUsed, c.avgGasUsed/float64(c.blockTime))
+}
+
+// nextTarget returns the gas target of the next block, within the gas limit of the system config.
+func (c *ThroughputController) nextTarget(gasLimit uint64) uint64 {
+	target := int64(c.blockGas) + c.integral/throughputIntegralGain
+	if target < 1 {
+		// a zero limit would disable the block limits
+		return 1
+	}
+	if uint64(target) > gasLimit {
+		return gasLimit
+	}
+	return uint64(target)
+}
@@ -0,0 +1,49 @@
+package driver
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/metrics"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+func TestThroughputController(t *testing.T) {
+	var limits derive.BlockLimits
+	setLimits := func(l derive.BlockLimits) { limits = l }
+	// 1M gas per second with 2 second blocks is a target of 2M gas per block
+	c := NewThroughputController(testlog.Logger(t, log.LvlError), 2, 1_000_000, derive.BlockLimits{MaxTxs: 100}, setLimits, metrics.NoopMetrics)
+	block := func(gasUsed uint64) *eth.ExecutionPayload {
+		return &eth.ExecutionPayload{GasUsed: eth.Uint64Quantity(gasUsed), GasLimit: 30_000_000}
+	}
+
+	c.OnBlock(block(2_000_000))
+	require.Equal(t, uint64(2_000_000), limits.MaxGasUsed, "on target")
+	require.Equal(t, uint64(100), limits.MaxTxs, "static limits are kept")
+
+	c.OnBlock(block(0))
+	require.Greater(t, limits.MaxGasUsed, uint64(2_000_000), "empty block allows more gas in the next blocks")
+
+	for i := 0; i < 10; i++ {
+		c.OnBlock(block(30_000_000))
+	}
+	require.Less(t, limits.MaxGasUsed, uint64(2_000_000), "full blocks lower the gas of the next blocks")
+	require.Greater(t, limits.MaxGasUsed, uint64(0), "the limit is never disabled")
+
+	for i := 0; i < 1000; i++ {
+		c.OnBlock(block(0))
+	}
+	require.LessOrEqual(t, limits.MaxGasUsed, uint64(30_000_000), "within the system config gas limit")
+}
+
+func TestThroughputControllerStaticLimit(t *testing.T) {
+	var limits derive.BlockLimits
+	c := NewThroughputController(testlog.Logger(t, log.LvlError), 2, 1_000_000, derive.BlockLimits{MaxGasUsed: 1_500_000},
+		func(l derive.BlockLimits) { limits = l }, metrics.NoopMetrics)
+	c.OnBlock(&eth.ExecutionPayload{GasUsed: 0, GasLimit: 30_000_000})
+	require.Equal(t, uint64(1_500_000), limits.MaxGasUsed, "the static limit is not exceeded")
+}
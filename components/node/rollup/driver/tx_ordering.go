@@ -0,0 +1,35 @@
+package driver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kroma-network/kroma/components/node/rollup/proposer"
+)
+
+// TxOrderingPolicy determines the order of the tx pool transactions in the blocks built by the proposer.
+// The ordering is applied by the engine, or the external builder, which are informed of the policy
+// with the payload attributes of every block. Other implementations can be plugged into the proposer,
+// to select an ordering that is specific to the engine or builder in use.
+type TxOrderingPolicy interface {
+	// Name identifies the ordering to the engine and the external builder.
+	Name() string
+}
+
+// feePriorityPolicy orders transactions by fee, which is the default ordering of the engine.
+type feePriorityPolicy struct{}
+
+func (p *feePriorityPolicy) Name() string {
+	return proposer.TxOrderingFeePriority
+}
+
+// NewTxOrderingPolicy creates the transaction ordering policy with the given name.
+// Only the orderings that the engine applies are supported, since the engine ignores other orderings.
+func NewTxOrderingPolicy(name string) (TxOrderingPolicy, error) {
+	switch name {
+	case "", proposer.TxOrderingFeePriority:
+		return &feePriorityPolicy{}, nil
+	default:
+		return nil, fmt.Errorf("unknown tx ordering policy %q, supported policies: %s", name, strings.Join(proposer.TxOrderingPolicies, ", "))
+	}
+}
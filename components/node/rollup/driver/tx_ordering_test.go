@@ -0,0 +1,26 @@
+package driver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/rollup/proposer"
+)
+
+func TestTxOrderingPolicy(t *testing.T) {
+	_, err := NewTxOrderingPolicy("unknown")
+	require.Error(t, err)
+	_, err = NewTxOrderingPolicy("fifo")
+	require.Error(t, err, "orderings that the engine does not apply are refused")
+
+	def, err := NewTxOrderingPolicy("")
+	require.NoError(t, err)
+	require.Equal(t, proposer.TxOrderingFeePriority, def.Name())
+
+	for _, name := range proposer.TxOrderingPolicies {
+		policy, err := NewTxOrderingPolicy(name)
+		require.NoError(t, err)
+		require.Equal(t, name, policy.Name())
+	}
+}
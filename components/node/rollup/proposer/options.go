@@ -0,0 +1,64 @@
+// Package proposer names the proposer policies and defaults that are selectable by configuration,
+// so that the CLI flags can refer to them without depending on the driver that implements them.
+package proposer
+
+import "time"
+
+const (
+	// L1OriginConfirmations only considers L1 blocks with sufficient confirmations as L1 origin.
+	L1OriginConfirmations = "confirmations"
+	// L1OriginHead follows the L1 head, and adopts new L1 blocks as origin as soon as they are seen.
+	L1OriginHead = "head"
+)
+
+// L1OriginPolicies lists the supported L1 origin selection policies.
+var L1OriginPolicies = []string{L1OriginConfirmations, L1OriginHead}
+
+const (
+	// GapFillImmediate builds the missed blocks back-to-back, as fast as the engine can build them.
+	GapFillImmediate = "immediate"
+	// GapFillGradual builds the missed blocks at a bounded rate.
+	GapFillGradual = "gradual"
+	// GapFillWallClock builds the missed blocks back-to-back without transactions from the tx pool,
+	// to get back to the wall-clock as fast as possible.
+	GapFillWallClock = "wall-clock"
+)
+
+// GapFillPolicies lists the supported gap filling policies.
+var GapFillPolicies = []string{GapFillImmediate, GapFillGradual, GapFillWallClock}
+
+const (
+	// LateBuildSeal seals late blocks, however late they are.
+	LateBuildSeal = "seal"
+	// LateBuildCancel cancels late blocks, and rebuilds them following the gap fill policy.
+	LateBuildCancel = "cancel"
+	// LateBuildEmpty cancels late blocks, and rebuilds them without transactions from the tx pool,
+	// to get back on schedule as fast as possible.
+	LateBuildEmpty = "empty"
+)
+
+// LateBuildPolicies lists the supported late build policies.
+var LateBuildPolicies = []string{LateBuildSeal, LateBuildCancel, LateBuildEmpty}
+
+const (
+	// L1OutageContinue keeps building blocks, reusing the L1 origin up to the max proposer drift.
+	L1OutageContinue = "continue"
+	// L1OutagePause stops building blocks until a new L1 head is observed.
+	L1OutagePause = "pause"
+	// L1OutageEmpty keeps building blocks, without transactions from the tx pool.
+	L1OutageEmpty = "empty"
+)
+
+// L1OutagePolicies lists the supported L1 outage policies.
+var L1OutagePolicies = []string{L1OutageContinue, L1OutagePause, L1OutageEmpty}
+
+// TxOrderingFeePriority orders the tx pool transactions by effective gas price, highest first.
+// It is the ordering of the engine, which does not support other orderings yet.
+const TxOrderingFeePriority = "fee-priority"
+
+// TxOrderingPolicies lists the supported transaction ordering policies.
+var TxOrderingPolicies = []string{TxOrderingFeePriority}
+
+// DefaultBuilderTimeout is the time the proposer waits for the external builder to return a payload,
+// before falling back to the payload built by the local engine.
+const DefaultBuilderTimeout = 200 * time.Millisecond
@@ -1,13 +1,16 @@
 package rollup
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
@@ -34,8 +37,30 @@ var (
 	ErrChainIDsSame                  = errors.New("L1 and L2 chain IDs must be different")
 	ErrL1ChainIDNotPositive          = errors.New("L1 chain ID must be non-zero and positive")
 	ErrL2ChainIDNotPositive          = errors.New("L2 chain ID must be non-zero and positive")
+	ErrInvalidZstdDictionary         = errors.New("invalid zstd dictionary")
 )
 
+// zstdDictionaryMagic starts every zstd dictionary, followed by its 4 byte little-endian ID.
+var zstdDictionaryMagic = []byte{0x37, 0xa4, 0x30, 0xec}
+
+// ZstdDictionary is a dictionary that zstd compressed channels may be compressed with.
+// Batch data is highly repetitive, so a dictionary trained on historical batches, e.g. with
+// `zstd --train` on RLP encoded batches, cuts the size of small channels substantially.
+type ZstdDictionary struct {
+	// Time is the L1 timestamp from which channels may be compressed with the dictionary.
+	Time uint64 `json:"time"`
+	// Dictionary is the zstd dictionary. Compressed channels refer to it by the ID in its header.
+	Dictionary hexutil.Bytes `json:"dictionary"`
+}
+
+// ID returns the ID of the dictionary, which compressed channels refer to.
+func (d *ZstdDictionary) ID() uint32 {
+	if len(d.Dictionary) < 8 {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(d.Dictionary[4:8])
+}
+
 type Genesis struct {
 	// The L1 block that the rollup starts *after* (no derived transactions)
 	L1 eth.BlockID `json:"l1"`
@@ -78,6 +103,90 @@ type Config struct {
 	DepositContractAddress common.Address `json:"deposit_contract_address"`
 	// L1 System Config Address
 	L1SystemConfigAddress common.Address `json:"l1_system_config_address"`
+
+	// FeeRecipientUpdateTime sets the activation time of the fee recipient updates through the SystemConfig.
+	// Active if FeeRecipientUpdateTime != nil && L1 timestamp >= *FeeRecipientUpdateTime, inactive otherwise.
+	FeeRecipientUpdateTime *uint64 `json:"fee_recipient_update_time,omitempty"`
+
+	// ZstdCompressionTime sets the activation time of zstd compressed channels, in addition to zlib compressed channels.
+	// Active if ZstdCompressionTime != nil && L1 timestamp >= *ZstdCompressionTime, inactive otherwise.
+	ZstdCompressionTime *uint64 `json:"zstd_compression_time,omitempty"`
+	// ZstdDictionaries are the shared dictionaries of zstd compressed channels, ordered by activation time.
+	// A dictionary must not be activated before zstd compression.
+	ZstdDictionaries []ZstdDictionary `json:"zstd_dictionaries,omitempty"`
+
+	// BlocksV2Time sets the activation time of the v2 blocks gossip topic.
+	// Active if BlocksV2Time != nil && L2 block timestamp >= *BlocksV2Time, inactive otherwise.
+	BlocksV2Time *uint64 `json:"blocks_v2_time,omitempty"`
+
+	// DACommitmentTime sets the activation time of batches submitted as commitments to an external DA provider.
+	// Active if DACommitmentTime != nil && L1 timestamp >= *DACommitmentTime, inactive otherwise.
+	// Once active, every node needs the DA provider to resolve the commitments with.
+	DACommitmentTime *uint64 `json:"da_commitment_time,omitempty"`
+}
+
+// IsFeeRecipientUpdate returns true if the fee recipient updates are active at or past the given L1 timestamp.
+func (c *Config) IsFeeRecipientUpdate(timestamp uint64) bool {
+	return c.FeeRecipientUpdateTime != nil && timestamp >= *c.FeeRecipientUpdateTime
+}
+
+// IsZstdCompression returns true if zstd compressed channels are accepted at or past the given L1 timestamp.
+func (c *Config) IsZstdCompression(timestamp uint64) bool {
+	return c.ZstdCompressionTime != nil && timestamp >= *c.ZstdCompressionTime
+}
+
+// IsBlocksV2 returns true if blocks at or past the given L2 timestamp are gossiped on the v2 blocks topic.
+func (c *Config) IsBlocksV2(timestamp uint64) bool {
+	return c.BlocksV2Time != nil && timestamp >= *c.BlocksV2Time
+}
+
+// IsDACommitment returns true if DA commitments are resolved at or past the given L1 timestamp.
+func (c *Config) IsDACommitment(timestamp uint64) bool {
+	return c.DACommitmentTime != nil && timestamp >= *c.DACommitmentTime
+}
+
+// ActiveZstdDictionaries returns the zstd dictionaries that channels may be compressed with
+// at or past the given L1 timestamp.
+func (c *Config) ActiveZstdDictionaries(timestamp uint64) [][]byte {
+	var dicts [][]byte
+	for _, d := range c.ZstdDictionaries {
+		if timestamp >= d.Time {
+			dicts = append(dicts, d.Dictionary)
+		}
+	}
+	return dicts
+}
+
+// LatestZstdDictionary returns the most recently activated zstd dictionary at the given L1 timestamp,
+// or nil if there is none.
+func (c *Config) LatestZstdDictionary(timestamp uint64) []byte {
+	dicts := c.ActiveZstdDictionaries(timestamp)
+	if len(dicts) == 0 {
+		return nil
+	}
+	return dicts[len(dicts)-1]
+}
+
+// checkZstdDictionaries checks that the dictionaries are well-formed zstd dictionaries with
+// distinct IDs, activated in order and not before zstd compression.
+func (c *Config) checkZstdDictionaries() error {
+	ids := make(map[uint32]bool)
+	for i, d := range c.ZstdDictionaries {
+		if len(d.Dictionary) < 8 || !bytes.HasPrefix(d.Dictionary, zstdDictionaryMagic) {
+			return fmt.Errorf("%w %d: missing dictionary header", ErrInvalidZstdDictionary, i)
+		}
+		if ids[d.ID()] {
+			return fmt.Errorf("%w %d: duplicate dictionary ID %d", ErrInvalidZstdDictionary, i, d.ID())
+		}
+		ids[d.ID()] = true
+		if c.ZstdCompressionTime == nil || d.Time < *c.ZstdCompressionTime {
+			return fmt.Errorf("%w %d: activated before zstd compression", ErrInvalidZstdDictionary, i)
+		}
+		if i > 0 && d.Time < c.ZstdDictionaries[i-1].Time {
+			return fmt.Errorf("%w %d: activated before the previous dictionary", ErrInvalidZstdDictionary, i)
+		}
+	}
+	return nil
 }
 
 // ValidateL1Config checks L1 config variables for errors.
@@ -238,7 +347,7 @@ func (cfg *Config) Check() error {
 	if cfg.L2ChainID.Sign() < 1 {
 		return ErrL2ChainIDNotPositive
 	}
-	return nil
+	return cfg.checkZstdDictionaries()
 }
 
 func (c *Config) L1Signer() types.Signer {
@@ -273,6 +382,15 @@ func (c *Config) Description(l2Chains map[string]string) string {
 	banner += fmt.Sprintf("  L2 starting time: %d ~ %s\n", c.Genesis.L2Time, fmtTime(c.Genesis.L2Time))
 	banner += fmt.Sprintf("  L2 block: %s %d\n", c.Genesis.L2.Hash, c.Genesis.L2.Number)
 	banner += fmt.Sprintf("  L1 block: %s %d\n", c.Genesis.L1.Hash, c.Genesis.L1.Number)
+	// Report the upgrade configuration
+	banner += "Post-Kroma Network Upgrades (timestamp based):\n"
+	banner += fmt.Sprintf("  - Fee recipient update: %s\n", fmtForkTimeOrUnset(c.FeeRecipientUpdateTime))
+	banner += fmt.Sprintf("  - Zstd compression: %s\n", fmtForkTimeOrUnset(c.ZstdCompressionTime))
+	for _, d := range c.ZstdDictionaries {
+		banner += fmt.Sprintf("  - Zstd dictionary %d: %s\n", d.ID(), fmtForkTimeOrUnset(&d.Time))
+	}
+	banner += fmt.Sprintf("  - Blocks gossip v2: %s\n", fmtForkTimeOrUnset(c.BlocksV2Time))
+	banner += fmt.Sprintf("  - DA commitments: %s\n", fmtForkTimeOrUnset(c.DACommitmentTime))
 	return banner
 }
 
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -356,3 +357,37 @@ func TestConfig_Check(t *testing.T) {
 		})
 	}
 }
+
+func TestZstdDictionaries(t *testing.T) {
+	zstdTime := uint64(100)
+	dict := func(id byte) hexutil.Bytes {
+		return hexutil.Bytes{0x37, 0xa4, 0x30, 0xec, id, 0, 0, 0, 0xff}
+	}
+	cfg := randConfig()
+	cfg.ZstdCompressionTime = &zstdTime
+	cfg.ZstdDictionaries = []ZstdDictionary{{Time: 100, Dictionary: dict(1)}, {Time: 200, Dictionary: dict(2)}}
+	require.NoError(t, cfg.Check())
+
+	require.Nil(t, cfg.LatestZstdDictionary(99))
+	require.Equal(t, []byte(dict(1)), cfg.LatestZstdDictionary(150))
+	require.Len(t, cfg.ActiveZstdDictionaries(200), 2)
+	require.Equal(t, []byte(dict(2)), cfg.LatestZstdDictionary(200))
+	require.Equal(t, uint32(2), cfg.ZstdDictionaries[1].ID())
+
+	for name, modifier := range map[string]func(cfg *Config){
+		"Unordered":       func(cfg *Config) { cfg.ZstdDictionaries[1].Time = 99 },
+		"BeforeZstd":      func(cfg *Config) { cfg.ZstdCompressionTime = nil },
+		"DuplicateID":     func(cfg *Config) { cfg.ZstdDictionaries[1].Dictionary = dict(1) },
+		"MissingHeader":   func(cfg *Config) { cfg.ZstdDictionaries[0].Dictionary = hexutil.Bytes{0x28, 0xb5, 0x2f, 0xfd} },
+		"EmptyDictionary": func(cfg *Config) { cfg.ZstdDictionaries[0].Dictionary = nil },
+	} {
+		modifier := modifier
+		t.Run(name, func(t *testing.T) {
+			cfg := randConfig()
+			cfg.ZstdCompressionTime = &zstdTime
+			cfg.ZstdDictionaries = []ZstdDictionary{{Time: 100, Dictionary: dict(1)}, {Time: 200, Dictionary: dict(2)}}
+			modifier(cfg)
+			require.ErrorIs(t, cfg.Check(), ErrInvalidZstdDictionary)
+		})
+	}
+}
@@ -18,9 +18,11 @@ import (
 	"github.com/kroma-network/kroma/components/node/node"
 	p2pcli "github.com/kroma-network/kroma/components/node/p2p/cli"
 	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
 	"github.com/kroma-network/kroma/components/node/rollup/driver"
 	"github.com/kroma-network/kroma/components/node/sources"
 	kpprof "github.com/kroma-network/kroma/utils/service/pprof"
+	krpc "github.com/kroma-network/kroma/utils/service/rpc"
 )
 
 // NewConfig creates a Config from the provided flags or environment variables.
@@ -53,18 +55,36 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		return nil, fmt.Errorf("failed to load l2 endpoints info: %w", err)
 	}
 
+	l2SecondaryEndpoint, err := NewL2SecondaryEndpointConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load secondary l2 endpoints info: %w", err)
+	}
+
 	l2SyncEndpoint := NewL2SyncEndpointConfig(ctx)
 
+	adminSecret, err := NewAdminJWTSecret(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load admin jwt secret: %w", err)
+	}
+
 	cfg := &node.Config{
-		L1:     l1Endpoint,
-		L2:     l2Endpoint,
-		L2Sync: l2SyncEndpoint,
+		L1:          l1Endpoint,
+		L2:          l2Endpoint,
+		L2Sync:      l2SyncEndpoint,
+		L2Secondary: l2SecondaryEndpoint,
+		L2Builder: &node.L2BuilderEndpointConfig{
+			BuilderAddr: ctx.GlobalString(flags.ProposerBuilderAddrFlag.Name),
+		},
+		DA: &node.DAEndpointConfig{
+			DAServerAddr: ctx.GlobalString(flags.DAServerAddrFlag.Name),
+		},
 		Rollup: *rollupConfig,
 		Driver: *driverConfig,
 		RPC: node.RPCConfig{
-			ListenAddr:  ctx.GlobalString(flags.RPCListenAddr.Name),
-			ListenPort:  ctx.GlobalInt(flags.RPCListenPort.Name),
-			EnableAdmin: ctx.GlobalBool(flags.RPCEnableAdmin.Name),
+			ListenAddr:     ctx.GlobalString(flags.RPCListenAddr.Name),
+			ListenPort:     ctx.GlobalInt(flags.RPCListenPort.Name),
+			EnableAdmin:    ctx.GlobalBool(flags.RPCEnableAdmin.Name),
+			AdminJWTSecret: adminSecret,
 		},
 		Metrics: node.MetricsConfig{
 			Enabled:    ctx.GlobalBool(flags.MetricsEnabledFlag.Name),
@@ -79,6 +99,11 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		P2P:                 p2pConfig,
 		P2PSigner:           p2pSignerSetup,
 		L1EpochPollInterval: ctx.GlobalDuration(flags.L1EpochPollIntervalFlag.Name),
+		P2PSignerRotation: node.P2PSignerRotationConfig{
+			Window:          ctx.GlobalDuration(flags.ProposerP2PRotationWindowFlag.Name),
+			PreviousAddress: common.HexToAddress(ctx.GlobalString(flags.ProposerP2PPreviousAddressFlag.Name)),
+			PreviousUntil:   ctx.GlobalUint64(flags.ProposerP2PPreviousUntilFlag.Name),
+		},
 		Heartbeat: node.HeartbeatConfig{
 			Enabled: ctx.GlobalBool(flags.HeartbeatEnabledFlag.Name),
 			Moniker: ctx.GlobalString(flags.HeartbeatMonikerFlag.Name),
@@ -132,6 +157,43 @@ func NewL2EndpointConfig(ctx *cli.Context, log log.Logger) (*node.L2EndpointConf
 	}, nil
 }
 
+// NewL2SecondaryEndpointConfig returns the configuration of the secondary engine,
+// with an empty address if no secondary engine is configured.
+func NewL2SecondaryEndpointConfig(ctx *cli.Context) (*node.L2SecondaryEndpointConfig, error) {
+	addr := ctx.GlobalString(flags.L2SecondaryEngineAddr.Name)
+	if addr == "" {
+		return &node.L2SecondaryEndpointConfig{}, nil
+	}
+	fileName := strings.TrimSpace(ctx.GlobalString(flags.L2SecondaryEngineJWTSecret.Name))
+	if fileName == "" {
+		return nil, fmt.Errorf("file-name of secondary jwt secret is empty")
+	}
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secondary jwt secret: %w", err)
+	}
+	jwtSecret := common.FromHex(strings.TrimSpace(string(data)))
+	if len(jwtSecret) != 32 {
+		return nil, fmt.Errorf("invalid jwt secret in path %s, not 32 hex-formatted bytes", fileName)
+	}
+	var secret [32]byte
+	copy(secret[:], jwtSecret)
+	return &node.L2SecondaryEndpointConfig{
+		L2EngineAddr:      addr,
+		L2EngineJWTSecret: secret,
+	}, nil
+}
+
+// NewAdminJWTSecret returns the secret to authenticate admin requests with,
+// or nil if admin authentication is not configured.
+func NewAdminJWTSecret(ctx *cli.Context) ([]byte, error) {
+	fileName := strings.TrimSpace(ctx.GlobalString(flags.RPCAdminJWTSecret.Name))
+	if fileName == "" {
+		return nil, nil
+	}
+	return krpc.ReadJWTSecret(fileName)
+}
+
 // NewL2SyncEndpointConfig returns a pointer to a L2SyncEndpointConfig if the
 // flag is set, otherwise nil.
 func NewL2SyncEndpointConfig(ctx *cli.Context) *node.L2SyncEndpointConfig {
@@ -143,11 +205,41 @@ func NewL2SyncEndpointConfig(ctx *cli.Context) *node.L2SyncEndpointConfig {
 
 func NewDriverConfig(ctx *cli.Context) *driver.Config {
 	return &driver.Config{
-		SyncerConfDepth:    ctx.GlobalUint64(flags.SyncerL1Confs.Name),
-		ProposerConfDepth:  ctx.GlobalUint64(flags.ProposerL1Confs.Name),
-		ProposerEnabled:    ctx.GlobalBool(flags.ProposerEnabledFlag.Name),
-		ProposerStopped:    ctx.GlobalBool(flags.ProposerStoppedFlag.Name),
-		ProposerMaxSafeLag: ctx.GlobalUint64(flags.ProposerMaxSafeLagFlag.Name),
+		SyncerConfDepth: ctx.GlobalUint64(flags.SyncerL1Confs.Name),
+		SyncerPrefetch: derive.PrefetchConfig{
+			Depth:       ctx.GlobalUint64(flags.SyncerPrefetchDepthFlag.Name),
+			Concurrency: ctx.GlobalUint64(flags.SyncerPrefetchConcurrencyFlag.Name),
+		},
+		SyncerDivergenceWebhook: ctx.GlobalString(flags.SyncerDivergenceWebhook.Name),
+		ProposerConfDepth:       ctx.GlobalUint64(flags.ProposerL1Confs.Name),
+		ProposerEnabled:         ctx.GlobalBool(flags.ProposerEnabledFlag.Name),
+		ProposerStopped:         ctx.GlobalBool(flags.ProposerStoppedFlag.Name),
+		ProposerDryRun:          ctx.GlobalBool(flags.ProposerDryRunFlag.Name),
+		ProposerMaxSafeLag:      ctx.GlobalUint64(flags.ProposerMaxSafeLagFlag.Name),
+		ProposerL1OriginPolicy:  ctx.GlobalString(flags.ProposerL1OriginPolicyFlag.Name),
+		ProposerGapFillPolicy:   ctx.GlobalString(flags.ProposerGapFillPolicyFlag.Name),
+		ProposerLateBuildPolicy: ctx.GlobalString(flags.ProposerLateBuildPolicyFlag.Name),
+		ProposerL1OutageTimeout: ctx.GlobalDuration(flags.ProposerL1OutageTimeoutFlag.Name),
+		ProposerL1OutagePolicy:  ctx.GlobalString(flags.ProposerL1OutagePolicyFlag.Name),
+		ProposerTxOrdering:      ctx.GlobalString(flags.ProposerTxOrderingFlag.Name),
+		ProposerPipelining:      ctx.GlobalBool(flags.ProposerPipeliningFlag.Name),
+		ProposerScheduleSkew: driver.ScheduleSkew{
+			Offset: ctx.GlobalDuration(flags.ProposerScheduleOffsetFlag.Name),
+			Jitter: ctx.GlobalDuration(flags.ProposerScheduleJitterFlag.Name),
+		},
+		ProposerPayloadJournal: ctx.GlobalString(flags.ProposerPayloadJournalFlag.Name),
+		ProposerConditionalTxs: ctx.GlobalBool(flags.ProposerConditionalTxsFlag.Name),
+		ProposerBuilderTimeout: ctx.GlobalDuration(flags.ProposerBuilderTimeoutFlag.Name),
+		ProposerBlockLimits: derive.BlockLimits{
+			MaxGasUsed: ctx.GlobalUint64(flags.ProposerMaxBlockGasFlag.Name),
+			MaxTxs:     ctx.GlobalUint64(flags.ProposerMaxBlockTxsFlag.Name),
+		},
+		ProposerTargetGasPerSecond: ctx.GlobalUint64(flags.ProposerTargetGasPerSecondFlag.Name),
+		ProposerEmptyBlockFastPath: ctx.GlobalBool(flags.ProposerEmptyBlockFastPathFlag.Name),
+		HaltTarget: derive.HaltTarget{
+			L2Block:  ctx.GlobalUint64(flags.HaltL2BlockFlag.Name),
+			L1Origin: ctx.GlobalUint64(flags.HaltL1OriginFlag.Name),
+		},
 	}
 }
 
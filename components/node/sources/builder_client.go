@@ -0,0 +1,23 @@
+package sources
+
+import (
+	"context"
+
+	"github.com/kroma-network/kroma/components/node/client"
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+// BuilderClient requests payloads from an external block builder.
+type BuilderClient struct {
+	rpc client.RPC
+}
+
+func NewBuilderClient(rpc client.RPC) *BuilderClient {
+	return &BuilderClient{rpc}
+}
+
+func (b *BuilderClient) GetPayload(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error) {
+	var payload *eth.ExecutionPayload
+	err := b.rpc.CallContext(ctx, &payload, "builder_getPayload", parent.Hash, attrs)
+	return payload, err
+}
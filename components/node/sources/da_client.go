@@ -0,0 +1,33 @@
+package sources
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/kroma-network/kroma/components/node/client"
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup/derive"
+)
+
+// DAClient stores and retrieves batcher data on an external DA provider, keyed by DA commitment.
+type DAClient struct {
+	rpc client.RPC
+}
+
+func NewDAClient(rpc client.RPC) *DAClient {
+	return &DAClient{rpc}
+}
+
+// GetInput retrieves the batcher data committed to by the commitment.
+// The data is not verified against the commitment, this is up to the caller.
+func (d *DAClient) GetInput(ctx context.Context, commitment derive.DACommitment) (eth.Data, error) {
+	var data hexutil.Bytes
+	err := d.rpc.CallContext(ctx, &data, "da_getInput", hexutil.Bytes(commitment))
+	return eth.Data(data), err
+}
+
+// SetInput stores the batcher data under the commitment.
+func (d *DAClient) SetInput(ctx context.Context, commitment derive.DACommitment, data []byte) error {
+	return d.rpc.CallContext(ctx, nil, "da_setInput", hexutil.Bytes(commitment), hexutil.Bytes(data))
+}
@@ -397,6 +397,24 @@ func (c *EthClient) GetProof(ctx context.Context, address common.Address, storag
 	return getProofResponse, nil
 }
 
+// NextBaseFee returns the base fee of the block built on top of the given block, as computed by the RPC provider.
+// The given block must be canonical.
+func (c *EthClient) NextBaseFee(ctx context.Context, parent eth.BlockID) (*big.Int, error) {
+	var feeHistory struct {
+		OldestBlock   hexutil.Uint64 `json:"oldestBlock"`
+		BaseFeePerGas []*hexutil.Big `json:"baseFeePerGas"`
+	}
+	err := c.client.CallContext(ctx, &feeHistory, "eth_feeHistory", hexutil.Uint64(1), hexutil.Uint64(parent.Number), []float64{})
+	if err != nil {
+		return nil, err
+	}
+	// The base fees include the block after the newest block of the range.
+	if uint64(feeHistory.OldestBlock) != parent.Number || len(feeHistory.BaseFeePerGas) != 2 || feeHistory.BaseFeePerGas[1] == nil {
+		return nil, fmt.Errorf("unexpected fee history of block %s: oldest block %d, %d base fees", parent, feeHistory.OldestBlock, len(feeHistory.BaseFeePerGas))
+	}
+	return feeHistory.BaseFeePerGas[1].ToInt(), nil
+}
+
 // GetStorageAt returns the storage value at the given address and storage slot, **without verifying the correctness of the result**.
 // This should only ever be used as alternative to GetProof when the user opts in.
 // E.g. Erigon L1 node users may have to use this, since Erigon does not support eth_getProof, see https://github.com/ledgerwatch/erigon/issues/1349
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/kroma-network/kroma/components/node/client"
@@ -165,3 +166,15 @@ func (s *L2Client) SystemConfigByL2Hash(ctx context.Context, hash common.Hash) (
 	s.systemConfigsCache.Add(hash, cfg)
 	return cfg, nil
 }
+
+// PendingTxCount returns the number of transactions in the tx pool that are ready to be included in a block.
+func (s *L2Client) PendingTxCount(ctx context.Context) (uint64, error) {
+	var status struct {
+		Pending hexutil.Uint64 `json:"pending"`
+		Queued  hexutil.Uint64 `json:"queued"`
+	}
+	if err := s.client.CallContext(ctx, &status, "txpool_status"); err != nil {
+		return 0, fmt.Errorf("failed to fetch tx pool status: %w", err)
+	}
+	return uint64(status.Pending), nil
+}
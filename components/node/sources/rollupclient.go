@@ -2,8 +2,10 @@ package sources
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/kroma-network/kroma/components/node/client"
 	"github.com/kroma-network/kroma/components/node/eth"
@@ -24,6 +26,28 @@ func (r *RollupClient) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth
 	return output, err
 }
 
+// OutputsAtBlocks fetches the outputs at the given blocks in a single batch request.
+func (r *RollupClient) OutputsAtBlocks(ctx context.Context, blockNums []uint64) ([]*eth.OutputResponse, error) {
+	outputs := make([]*eth.OutputResponse, len(blockNums))
+	batch := make([]rpc.BatchElem, len(blockNums))
+	for i, blockNum := range blockNums {
+		batch[i] = rpc.BatchElem{
+			Method: "kroma_outputAtBlock",
+			Args:   []any{hexutil.Uint64(blockNum)},
+			Result: &outputs[i],
+		}
+	}
+	if err := r.rpc.BatchCallContext(ctx, batch); err != nil {
+		return nil, err
+	}
+	for i, elem := range batch {
+		if elem.Error != nil {
+			return nil, fmt.Errorf("failed to fetch output at block %d: %w", blockNums[i], elem.Error)
+		}
+	}
+	return outputs, nil
+}
+
 func (r *RollupClient) OutputWithProofAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
 	var output *eth.OutputResponse
 	err := r.rpc.CallContext(ctx, &output, "kroma_outputWithProofAtBlock", hexutil.Uint64(blockNum))
@@ -36,6 +60,12 @@ func (r *RollupClient) SyncStatus(ctx context.Context) (*eth.SyncStatus, error)
 	return output, err
 }
 
+func (r *RollupClient) SequencerHealth(ctx context.Context) (*eth.ProposerHealth, error) {
+	var output *eth.ProposerHealth
+	err := r.rpc.CallContext(ctx, &output, "kroma_sequencerHealth")
+	return output, err
+}
+
 func (r *RollupClient) RollupConfig(ctx context.Context) (*rollup.Config, error) {
 	var output *rollup.Config
 	err := r.rpc.CallContext(ctx, &output, "kroma_rollupConfig")
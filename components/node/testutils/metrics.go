@@ -10,6 +10,8 @@ type TestDerivationMetrics struct {
 	FnRecordL2Ref             func(name string, ref eth.L2BlockRef)
 	FnRecordUnsafePayloads    func(length uint64, memSize uint64, next eth.BlockID)
 	FnRecordChannelInputBytes func(inputCompressedBytes int)
+	FnRecordDroppedBatch      func(reason string)
+	FnRecordPipelineReset     func(reason string)
 }
 
 func (t *TestDerivationMetrics) RecordL1ReorgDepth(d uint64) {
@@ -42,6 +44,18 @@ func (t *TestDerivationMetrics) RecordChannelInputBytes(inputCompressedBytes int
 	}
 }
 
+func (t *TestDerivationMetrics) RecordDroppedBatch(reason string) {
+	if t.FnRecordDroppedBatch != nil {
+		t.FnRecordDroppedBatch(reason)
+	}
+}
+
+func (t *TestDerivationMetrics) RecordPipelineReset(reason string) {
+	if t.FnRecordPipelineReset != nil {
+		t.FnRecordPipelineReset(reason)
+	}
+}
+
 type TestRPCMetrics struct{}
 
 func (n *TestRPCMetrics) RecordRPCServerRequest(method string) func() {
@@ -4,8 +4,20 @@ import "github.com/ethereum/go-ethereum/common"
 
 type MockRuntimeConfig struct {
 	P2PPropAddress common.Address
+	// PrevP2PPropAddress is accepted alongside P2PPropAddress, as during a key rotation
+	PrevP2PPropAddress common.Address
 }
 
 func (m *MockRuntimeConfig) P2PProposerAddress() common.Address {
 	return m.P2PPropAddress
 }
+
+func (m *MockRuntimeConfig) P2PProposerAddresses(now uint64) []common.Address {
+	var addrs []common.Address
+	for _, addr := range []common.Address{m.P2PPropAddress, m.PrevP2PPropAddress} {
+		if addr != (common.Address{}) {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
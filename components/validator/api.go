@@ -0,0 +1,98 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	chal "github.com/kroma-network/kroma/components/validator/challenge"
+)
+
+type validatorAPI struct {
+	v *Validator
+}
+
+func NewValidatorAPI(v *Validator) *validatorAPI {
+	return &validatorAPI{
+		v: v,
+	}
+}
+
+// GetValidatorAPI returns the API of the validator to register at the RPC server.
+func GetValidatorAPI(api *validatorAPI) rpc.API {
+	return rpc.API{
+		Namespace: "validator",
+		Service:   api,
+	}
+}
+
+// ProofJobs returns the status of all the proof generation jobs of the challenger.
+func (a *validatorAPI) ProofJobs(_ context.Context) ([]*chal.ProofJob, error) {
+	return a.v.challenger.ProofJobs(), nil
+}
+
+// ProofJob returns the status of the proof generation job of the given block, or null if there is none.
+func (a *validatorAPI) ProofJob(_ context.Context, blockNumber hexutil.Uint64) (*chal.ProofJob, error) {
+	return a.v.challenger.ProofJob(uint64(blockNumber)), nil
+}
+
+// DisputeState returns the state of the challenge of the given output, or null if the output is not challenged.
+func (a *validatorAPI) DisputeState(_ context.Context, outputIndex hexutil.Uint64) (*DisputeState, error) {
+	return a.v.challenger.DisputeState(new(big.Int).SetUint64(uint64(outputIndex)))
+}
+
+// ActiveDisputeStates returns the states of the challenges being handled by the challenger.
+func (a *validatorAPI) ActiveDisputeStates(_ context.Context) ([]*DisputeState, error) {
+	states := []*DisputeState{}
+	for _, outputIndex := range a.v.challenger.ActiveChallenges() {
+		state, err := a.v.challenger.DisputeState(new(big.Int).SetUint64(outputIndex))
+		if err != nil {
+			return nil, err
+		}
+		if state != nil {
+			states = append(states, state)
+		}
+	}
+	return states, nil
+}
+
+// CouncilEscalations returns the escalations of the challenges to the Security Council.
+func (a *validatorAPI) CouncilEscalations(_ context.Context) ([]*Escalation, error) {
+	if !a.v.cfg.CouncilEscalationEnabled {
+		return nil, errCouncilEscalationDisabled
+	}
+	return a.v.challenger.Escalations(), nil
+}
+
+var errProfitEstimatorDisabled = errors.New("profit estimator is disabled")
+
+// ProfitEstimate returns the estimated reward and gas cost of the last output submission considered,
+// or null if none has been estimated yet.
+func (a *validatorAPI) ProfitEstimate(_ context.Context) (*ProfitEstimate, error) {
+	if !a.v.cfg.ProfitEstimatorEnabled {
+		return nil, errProfitEstimatorDisabled
+	}
+	return a.v.l2os.ProfitEstimate(), nil
+}
+
+var errFinalityTrackerDisabled = errors.New("finality tracker is disabled")
+
+// OutputFinality returns the finalization status of the given output, or null if it is not tracked.
+func (a *validatorAPI) OutputFinality(_ context.Context, outputIndex hexutil.Uint64) (*OutputFinality, error) {
+	if !a.v.cfg.FinalityTrackerEnabled {
+		return nil, errFinalityTrackerDisabled
+	}
+	return a.v.finality.Output(uint64(outputIndex)), nil
+}
+
+// OutputFinalities returns the finalization status of the tracked outputs in the given status
+// (pending, challenged, finalized or deleted), or of all of them if the status is empty.
+func (a *validatorAPI) OutputFinalities(_ context.Context, status string) ([]*OutputFinality, error) {
+	if !a.v.cfg.FinalityTrackerEnabled {
+		return nil, errFinalityTrackerDisabled
+	}
+	return a.v.finality.Outputs(status), nil
+}
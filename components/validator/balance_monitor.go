@@ -0,0 +1,179 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/utils"
+)
+
+const (
+	BalanceKindL1      = "l1_balance"
+	BalanceKindDeposit = "deposit"
+)
+
+// BalanceAlert is posted to the webhook when a balance of the validator falls below its threshold,
+// and when it recovers.
+type BalanceAlert struct {
+	Kind      string         `json:"kind"`
+	Address   common.Address `json:"address"`
+	Balance   *big.Int       `json:"balance"`
+	Threshold *big.Int       `json:"threshold"`
+	Resolved  bool           `json:"resolved"`
+}
+
+// balanceAlarm fires once when a balance falls below the threshold, and resolves once when it recovers.
+type balanceAlarm struct {
+	kind      string
+	threshold *big.Int
+	firing    bool
+}
+
+// update returns the alert to raise for the given balance, or nil if the state of the alarm is unchanged.
+func (a *balanceAlarm) update(address common.Address, balance *big.Int) *BalanceAlert {
+	if a.threshold == nil {
+		return nil
+	}
+	below := balance.Cmp(a.threshold) < 0
+	if below == a.firing {
+		return nil
+	}
+	a.firing = below
+	return &BalanceAlert{
+		Kind:      a.kind,
+		Address:   address,
+		Balance:   balance,
+		Threshold: a.threshold,
+		Resolved:  !below,
+	}
+}
+
+// BalanceMonitor tracks the L1 balance of the validator, which pays for the output submissions and
+// the challenge txs, and its deposit in the ValidatorPool, which bonds the outputs. It alerts in logs,
+// metrics and optionally to a webhook when they fall below their thresholds, before txs start failing.
+type BalanceMonitor struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	cfg  Config
+	log  log.Logger
+	metr metrics.Metricer
+
+	valpoolContract *bindings.ValidatorPoolCaller
+	l1Alarm         *balanceAlarm
+	depositAlarm    *balanceAlarm
+	client          *http.Client
+
+	wg sync.WaitGroup
+}
+
+// NewBalanceMonitor creates a new BalanceMonitor.
+func NewBalanceMonitor(cfg Config, l log.Logger, m metrics.Metricer) (*BalanceMonitor, error) {
+	valpoolContract, err := bindings.NewValidatorPoolCaller(cfg.ValidatorPoolAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BalanceMonitor{
+		cfg:             cfg,
+		log:             l,
+		metr:            m,
+		valpoolContract: valpoolContract,
+		l1Alarm:         &balanceAlarm{kind: BalanceKindL1, threshold: cfg.BalanceMonitorMinBalance},
+		depositAlarm:    &balanceAlarm{kind: BalanceKindDeposit, threshold: cfg.BalanceMonitorMinDeposit},
+		client:          &http.Client{Timeout: webhookTimeout},
+	}, nil
+}
+
+func (b *BalanceMonitor) Start(ctx context.Context) error {
+	b.ctx, b.cancel = context.WithCancel(ctx)
+	b.log.Info("starting balance monitor", "minBalance", b.cfg.BalanceMonitorMinBalance,
+		"minDeposit", b.cfg.BalanceMonitorMinDeposit)
+
+	b.wg.Add(1)
+	go b.loop()
+
+	return nil
+}
+
+func (b *BalanceMonitor) Stop() error {
+	b.log.Info("stopping balance monitor")
+	b.cancel()
+	b.wg.Wait()
+
+	return nil
+}
+
+func (b *BalanceMonitor) loop() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(b.cfg.BalanceMonitorInterval)
+	defer ticker.Stop()
+
+	for ; ; <-ticker.C {
+		select {
+		case <-b.ctx.Done():
+			return
+		default:
+			if err := b.check(b.ctx); err != nil {
+				b.log.Warn("failed to check balances", "err", err)
+			}
+		}
+	}
+}
+
+// check fetches the balances of the validator, records them and alerts on threshold crossings.
+func (b *BalanceMonitor) check(ctx context.Context) error {
+	cCtx, cCancel := context.WithTimeout(ctx, b.cfg.NetworkTimeout)
+	defer cCancel()
+	from := b.cfg.TxManager.From()
+
+	balance, err := b.cfg.L1Client.BalanceAt(cCtx, from, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 balance: %w", err)
+	}
+	b.metr.RecordL1Balance(balance)
+	b.alert(b.l1Alarm.update(from, balance))
+
+	deposit, err := b.valpoolContract.BalanceOf(utils.NewSimpleCallOpts(cCtx), from)
+	if err != nil {
+		return fmt.Errorf("failed to fetch validator deposit amount: %w", err)
+	}
+	b.metr.RecordDepositAmount(deposit)
+	b.alert(b.depositAlarm.update(from, deposit))
+
+	return nil
+}
+
+// alert reports the given alert if any. The webhook is notified in the background.
+func (b *BalanceMonitor) alert(alert *BalanceAlert) {
+	if alert == nil {
+		return
+	}
+	if alert.Resolved {
+		b.log.Info("balance recovered above threshold", "kind", alert.Kind, "balance", alert.Balance,
+			"threshold", alert.Threshold)
+	} else {
+		b.log.Error("balance fell below threshold, txs may start failing", "kind", alert.Kind,
+			"balance", alert.Balance, "threshold", alert.Threshold)
+		b.metr.RecordBalanceAlert(alert.Kind)
+	}
+	if b.cfg.BalanceMonitorWebhookURL != "" {
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+			if err := postWebhook(b.ctx, b.client, b.cfg.BalanceMonitorWebhookURL, alert); err != nil {
+				b.log.Warn("failed to notify balance monitor webhook", "kind", alert.Kind, "err", err)
+			}
+		}()
+	}
+}
@@ -0,0 +1,33 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceAlarm(t *testing.T) {
+	addr := common.Address{1}
+
+	disabled := &balanceAlarm{kind: BalanceKindL1}
+	require.Nil(t, disabled.update(addr, big.NewInt(0)))
+
+	alarm := &balanceAlarm{kind: BalanceKindDeposit, threshold: big.NewInt(100)}
+	require.Nil(t, alarm.update(addr, big.NewInt(150)))
+
+	alert := alarm.update(addr, big.NewInt(99))
+	require.NotNil(t, alert)
+	require.Equal(t, BalanceKindDeposit, alert.Kind)
+	require.Equal(t, addr, alert.Address)
+	require.False(t, alert.Resolved)
+
+	// Fires only once while the balance stays below the threshold.
+	require.Nil(t, alarm.update(addr, big.NewInt(50)))
+
+	alert = alarm.update(addr, big.NewInt(100))
+	require.NotNil(t, alert)
+	require.True(t, alert.Resolved)
+	require.Nil(t, alarm.update(addr, big.NewInt(200)))
+}
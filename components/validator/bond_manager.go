@@ -0,0 +1,170 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/utils"
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+)
+
+// BondManager is responsible for keeping the validator's balance in the ValidatorPool
+// high enough to bond the upcoming output submissions.
+type BondManager struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	cfg  Config
+	log  log.Logger
+	metr metrics.Metricer
+
+	valpoolContract *bindings.ValidatorPoolCaller
+	valpoolABI      *abi.ABI
+
+	wg sync.WaitGroup
+}
+
+// NewBondManager creates a new BondManager.
+func NewBondManager(cfg Config, l log.Logger, m metrics.Metricer) (*BondManager, error) {
+	valpoolContract, err := bindings.NewValidatorPoolCaller(cfg.ValidatorPoolAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := bindings.ValidatorPoolMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return &BondManager{
+		cfg:             cfg,
+		log:             l,
+		metr:            m,
+		valpoolContract: valpoolContract,
+		valpoolABI:      parsed,
+	}, nil
+}
+
+func (b *BondManager) Start(ctx context.Context) error {
+	b.ctx, b.cancel = context.WithCancel(ctx)
+	b.log.Info("starting bond manager")
+
+	b.wg.Add(1)
+	go b.loop()
+
+	return nil
+}
+
+func (b *BondManager) Stop() error {
+	b.log.Info("stopping bond manager")
+	b.cancel()
+	b.wg.Wait()
+
+	return nil
+}
+
+func (b *BondManager) loop() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(b.cfg.BondTopUpInterval)
+	defer ticker.Stop()
+
+	for ; ; <-ticker.C {
+		select {
+		case <-b.ctx.Done():
+			return
+		default:
+			if err := b.tryTopUp(b.ctx); err != nil {
+				b.log.Error("failed to top up bond", "err", err)
+			}
+		}
+	}
+}
+
+// requiredBalance returns the balance needed to bond the configured number of upcoming submissions.
+func (b *BondManager) requiredBalance() *big.Int {
+	return new(big.Int).Mul(
+		new(big.Int).SetUint64(b.cfg.OutputSubmitterBondAmount),
+		new(big.Int).SetUint64(b.cfg.BondTopUpSubmissions),
+	)
+}
+
+// tryTopUp deposits additional bond into the ValidatorPool if the balance of the validator
+// is not enough for the upcoming submissions.
+func (b *BondManager) tryTopUp(ctx context.Context) error {
+	cCtx, cCancel := context.WithTimeout(ctx, b.cfg.NetworkTimeout)
+	defer cCancel()
+	from := b.cfg.TxManager.From()
+	balance, err := b.valpoolContract.BalanceOf(utils.NewSimpleCallOpts(cCtx), from)
+	if err != nil {
+		return fmt.Errorf("failed to fetch validator deposit amount: %w", err)
+	}
+
+	amount := topUpAmount(balance, b.requiredBalance(), b.cfg.BondTopUpCap)
+	if amount == nil {
+		return nil
+	}
+	b.log.Info("validator deposit is below the required amount, topping up", "deposit", balance,
+		"required", b.requiredBalance(), "cap", b.cfg.BondTopUpCap, "amount", amount)
+
+	if b.cfg.FundingTxManager != nil {
+		if err := b.fund(ctx, amount); err != nil {
+			return err
+		}
+	}
+
+	data, err := b.valpoolABI.Pack("deposit")
+	if err != nil {
+		return fmt.Errorf("failed to create deposit transaction data: %w", err)
+	}
+
+	txResponse := b.cfg.TxManager.SendTxCandidate(ctx, &txmgr.TxCandidate{
+		TxData:   data,
+		To:       &b.cfg.ValidatorPoolAddr,
+		GasLimit: 0,
+		Value:    amount,
+	})
+	if txResponse.Err != nil {
+		return fmt.Errorf("failed to deposit bond: %w", txResponse.Err)
+	}
+
+	b.log.Info("bond successfully topped up", "amount", amount)
+	b.metr.RecordBondTopUp(amount)
+	b.metr.RecordDepositAmount(new(big.Int).Add(balance, amount))
+
+	return nil
+}
+
+// fund transfers the given amount from the funding account to the validator account,
+// so that the validator can deposit it into the ValidatorPool.
+func (b *BondManager) fund(ctx context.Context, amount *big.Int) error {
+	to := b.cfg.TxManager.From()
+	_, err := b.cfg.FundingTxManager.Send(ctx, txmgr.TxCandidate{
+		To:       &to,
+		GasLimit: 0,
+		Value:    amount,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to transfer bond from funding account: %w", err)
+	}
+
+	b.log.Info("transferred bond from funding account", "from", b.cfg.FundingTxManager.From(), "amount", amount)
+	return nil
+}
+
+// topUpAmount returns the amount to deposit to refill the balance up to the cap,
+// or nil if the balance is still enough to cover the required amount.
+func topUpAmount(balance, required, capAmount *big.Int) *big.Int {
+	if balance.Cmp(required) >= 0 || balance.Cmp(capAmount) >= 0 {
+		return nil
+	}
+	return new(big.Int).Sub(capAmount, balance)
+}
@@ -0,0 +1,18 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopUpAmount(t *testing.T) {
+	required := big.NewInt(100)
+	limit := big.NewInt(500)
+
+	require.Nil(t, topUpAmount(big.NewInt(100), required, limit))
+	require.Nil(t, topUpAmount(big.NewInt(200), required, limit))
+	require.Equal(t, big.NewInt(401), topUpAmount(big.NewInt(99), required, limit))
+	require.Equal(t, big.NewInt(500), topUpAmount(big.NewInt(0), required, limit))
+}
@@ -0,0 +1,130 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/utils"
+)
+
+var (
+	errBootstrapL1ChainID          = errors.New("L1 chain ID does not match the rollup config")
+	errBootstrapBlockTime          = errors.New("L2 block time of the L2OutputOracle does not match the rollup config")
+	errBootstrapStartingBlock      = errors.New("starting block of the L2OutputOracle is before the L2 genesis")
+	errBootstrapStartingTimestamp  = errors.New("starting timestamp of the L2OutputOracle does not match the rollup config")
+	errBootstrapSubmissionInterval = errors.New("submission interval of the L2OutputOracle must not be 0")
+	errBootstrapNextBlockNumber    = errors.New("next block number of the L2OutputOracle is not aligned to the submission interval")
+)
+
+// BootstrapState is the starting point of the validator, derived from the L2OutputOracle and the rollup node
+// instead of being configured.
+type BootstrapState struct {
+	L1ChainID           *big.Int
+	L2BlockTime         uint64
+	SubmissionInterval  uint64
+	StartingBlockNumber uint64
+	StartingTimestamp   uint64
+	NextOutputIndex     uint64
+	NextBlockNumber     uint64
+	// SafeL2 is the safe L2 block of the rollup node.
+	SafeL2 uint64
+	// CurrentL1 is the L1 block the rollup node derived the L2 chain up to.
+	CurrentL1 uint64
+}
+
+// fetchBootstrapState reads the starting point of the validator from the L2OutputOracle and the rollup node.
+func fetchBootstrapState(ctx context.Context, cfg Config) (*BootstrapState, error) {
+	l2ooContract, err := bindings.NewL2OutputOracleCaller(cfg.L2OutputOracleAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	cCtx, cCancel := context.WithTimeout(ctx, cfg.NetworkTimeout)
+	defer cCancel()
+	opts := utils.NewSimpleCallOpts(cCtx)
+
+	var s BootstrapState
+	if s.L1ChainID, err = cfg.L1Client.ChainID(cCtx); err != nil {
+		return nil, fmt.Errorf("failed to get L1 chain ID: %w", err)
+	}
+	for _, field := range []struct {
+		name string
+		dst  *uint64
+		call func(*bind.CallOpts) (*big.Int, error)
+	}{
+		{"L2_BLOCK_TIME", &s.L2BlockTime, l2ooContract.L2BLOCKTIME},
+		{"SUBMISSION_INTERVAL", &s.SubmissionInterval, l2ooContract.SUBMISSIONINTERVAL},
+		{"startingBlockNumber", &s.StartingBlockNumber, l2ooContract.StartingBlockNumber},
+		{"startingTimestamp", &s.StartingTimestamp, l2ooContract.StartingTimestamp},
+		{"nextOutputIndex", &s.NextOutputIndex, l2ooContract.NextOutputIndex},
+		{"nextBlockNumber", &s.NextBlockNumber, l2ooContract.NextBlockNumber},
+	} {
+		v, err := field.call(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s of L2OutputOracle: %w", field.name, err)
+		}
+		*field.dst = v.Uint64()
+	}
+
+	status, err := cfg.RollupClient.SyncStatus(cCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sync status: %w", err)
+	}
+	s.SafeL2 = status.SafeL2.Number
+	s.CurrentL1 = status.CurrentL1.Number
+
+	return &s, nil
+}
+
+// check returns an error if the L2OutputOracle, the L1 client and the rollup node are not consistent,
+// which means that one of them is misconfigured.
+func (s *BootstrapState) check(rollupCfg *rollup.Config) error {
+	if rollupCfg.L1ChainID.Cmp(s.L1ChainID) != 0 {
+		return fmt.Errorf("%w: %d, expected %d", errBootstrapL1ChainID, s.L1ChainID, rollupCfg.L1ChainID)
+	}
+	if s.L2BlockTime != rollupCfg.BlockTime {
+		return fmt.Errorf("%w: %d, expected %d", errBootstrapBlockTime, s.L2BlockTime, rollupCfg.BlockTime)
+	}
+	if s.StartingBlockNumber < rollupCfg.Genesis.L2.Number {
+		return fmt.Errorf("%w: %d, genesis %d", errBootstrapStartingBlock, s.StartingBlockNumber, rollupCfg.Genesis.L2.Number)
+	}
+	if expected := rollupCfg.ComputeTimestamp(s.StartingBlockNumber); s.StartingTimestamp != expected {
+		return fmt.Errorf("%w: %d, expected %d", errBootstrapStartingTimestamp, s.StartingTimestamp, expected)
+	}
+	if s.SubmissionInterval == 0 {
+		return errBootstrapSubmissionInterval
+	}
+	if s.NextBlockNumber < s.StartingBlockNumber || (s.NextBlockNumber-s.StartingBlockNumber)%s.SubmissionInterval != 0 {
+		return fmt.Errorf("%w: %d, starting block %d, interval %d", errBootstrapNextBlockNumber,
+			s.NextBlockNumber, s.StartingBlockNumber, s.SubmissionInterval)
+	}
+	return nil
+}
+
+// bootstrap derives the starting point of the validator and checks it against the rollup config.
+func bootstrap(ctx context.Context, cfg Config, l log.Logger) (*BootstrapState, error) {
+	s, err := fetchBootstrapState(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.check(cfg.RollupConfig); err != nil {
+		return nil, fmt.Errorf("inconsistent configuration: %w", err)
+	}
+
+	l.Info("bootstrapped from L2OutputOracle", "nextOutputIndex", s.NextOutputIndex,
+		"nextBlockNumber", s.NextBlockNumber, "submissionInterval", s.SubmissionInterval,
+		"startingBlockNumber", s.StartingBlockNumber, "safeL2", s.SafeL2, "currentL1", s.CurrentL1)
+	// outputs are validated against the safe chain, so nothing can be validated until the node catches up.
+	if latest := s.NextBlockNumber - s.SubmissionInterval; s.NextOutputIndex > 0 && s.SafeL2 < latest {
+		l.Warn("rollup node is behind the latest output, outputs cannot be validated until it catches up",
+			"safeL2", s.SafeL2, "latestBlockNumber", latest)
+	}
+	return s, nil
+}
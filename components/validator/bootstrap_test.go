@@ -0,0 +1,50 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/rollup"
+)
+
+func TestBootstrapStateCheck(t *testing.T) {
+	rollupCfg := &rollup.Config{
+		L1ChainID: big.NewInt(900),
+		BlockTime: 2,
+		Genesis:   rollup.Genesis{L2Time: 1000},
+	}
+	valid := func() *BootstrapState {
+		return &BootstrapState{
+			L1ChainID:           big.NewInt(900),
+			L2BlockTime:         2,
+			SubmissionInterval:  10,
+			StartingBlockNumber: 5,
+			StartingTimestamp:   1010,
+			NextOutputIndex:     3,
+			NextBlockNumber:     45,
+		}
+	}
+	require.NoError(t, valid().check(rollupCfg))
+
+	tests := []struct {
+		name   string
+		modify func(s *BootstrapState)
+		err    error
+	}{
+		{"l1 chain id", func(s *BootstrapState) { s.L1ChainID = big.NewInt(1) }, errBootstrapL1ChainID},
+		{"block time", func(s *BootstrapState) { s.L2BlockTime = 1 }, errBootstrapBlockTime},
+		{"starting timestamp", func(s *BootstrapState) { s.StartingTimestamp = 1000 }, errBootstrapStartingTimestamp},
+		{"submission interval", func(s *BootstrapState) { s.SubmissionInterval = 0 }, errBootstrapSubmissionInterval},
+		{"unaligned next block", func(s *BootstrapState) { s.NextBlockNumber = 44 }, errBootstrapNextBlockNumber},
+		{"next block before start", func(s *BootstrapState) { s.NextBlockNumber = 0 }, errBootstrapNextBlockNumber},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid()
+			tt.modify(s)
+			require.ErrorIs(t, s.check(rollupCfg), tt.err)
+		})
+	}
+}
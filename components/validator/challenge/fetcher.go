@@ -8,11 +8,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
 
 	pb "github.com/kroma-network/kroma/components/validator/challenge/prover-grpc-proto"
 )
 
+// Fetcher is the prover backend requesting proofs to a kroma-prover gRPC server.
 type Fetcher struct {
 	Client  pb.ProofClient
 	logger  log.Logger
@@ -43,6 +45,29 @@ type ProofAndPair struct {
 	Pair  []*big.Int
 }
 
+func (f *Fetcher) Name() string {
+	return ProverBackendRPC
+}
+
+// Capabilities returns the default capabilities, since the gRPC server does not advertise them.
+func (f *Fetcher) Capabilities(_ context.Context) (Capabilities, error) {
+	return DefaultCapabilities, nil
+}
+
+// HealthCheck connects to the gRPC server if idle, and fails if the connection is broken.
+func (f *Fetcher) HealthCheck(ctx context.Context) error {
+	state := f.conn.GetState()
+	if state == connectivity.Idle {
+		f.conn.Connect()
+		f.conn.WaitForStateChange(ctx, state)
+		state = f.conn.GetState()
+	}
+	if state == connectivity.TransientFailure || state == connectivity.Shutdown {
+		return fmt.Errorf("grpc connection is %s", state)
+	}
+	return nil
+}
+
 func (f *Fetcher) FetchProofAndPair(blockNumber uint64) (*ProofAndPair, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
 	defer cancel()
@@ -0,0 +1,127 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	ProofFileName     = "verify_circuit_proof.data"
+	FinalPairFileName = "verify_circuit_final_pair.data"
+)
+
+// LocalProver generates proofs by running a prover binary on the local machine.
+// The binary is invoked as `<binary> prove --block <hex> --out <dir>` and must write
+// the proof and the final pair into the output directory. Its capabilities are read
+// from the JSON printed by `<binary> capabilities`. If the binary supports checkpoints, it is invoked
+// with `--checkpoint-dir <dir>` to save its progress in and resume from.
+type LocalProver struct {
+	binaryPath string
+	timeout    time.Duration
+	logger     log.Logger
+}
+
+func NewLocalProver(binaryPath string, timeout time.Duration, logger log.Logger) (*LocalProver, error) {
+	if binaryPath == "" {
+		return nil, errors.New("no prover binary specified")
+	}
+	return &LocalProver{
+		binaryPath: binaryPath,
+		timeout:    timeout,
+		logger:     logger,
+	}, nil
+}
+
+func (p *LocalProver) Name() string {
+	return ProverBackendLocal
+}
+
+func (p *LocalProver) Capabilities(ctx context.Context) (Capabilities, error) {
+	out, err := exec.CommandContext(ctx, p.binaryPath, "capabilities").Output()
+	if err != nil {
+		return Capabilities{}, fmt.Errorf("failed to run prover binary: %w", err)
+	}
+	var caps Capabilities
+	if err := json.Unmarshal(out, &caps); err != nil {
+		return Capabilities{}, fmt.Errorf("invalid capabilities from prover binary: %w", err)
+	}
+	return caps, nil
+}
+
+func (p *LocalProver) HealthCheck(_ context.Context) error {
+	info, err := os.Stat(p.binaryPath)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() || info.Mode().Perm()&0o111 == 0 {
+		return fmt.Errorf("prover binary is not executable: %s", p.binaryPath)
+	}
+	return nil
+}
+
+func (p *LocalProver) FetchProofAndPair(blockNumber uint64) (*ProofAndPair, error) {
+	return p.prove(context.Background(), blockNumber, "")
+}
+
+func (p *LocalProver) ResumeProofAndPair(ctx context.Context, blockNumber uint64, checkpointDir string) (*ProofAndPair, error) {
+	if err := os.MkdirAll(checkpointDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint directory: %w", err)
+	}
+	return p.prove(ctx, blockNumber, checkpointDir)
+}
+
+// prove runs the prover binary, with the given checkpoint directory if not empty.
+func (p *LocalProver) prove(ctx context.Context, blockNumber uint64, checkpointDir string) (*ProofAndPair, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	outDir, err := os.MkdirTemp("", "kroma-proof-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create proof directory: %w", err)
+	}
+	defer os.RemoveAll(outDir)
+
+	blockNumberHex := fmt.Sprintf("0x%x", blockNumber)
+	p.logger.Info("running prover binary", "hex", blockNumberHex)
+
+	args := []string{"prove", "--block", blockNumberHex, "--out", outDir}
+	if checkpointDir != "" {
+		args = append(args, "--checkpoint-dir", checkpointDir)
+	}
+	cmd := exec.CommandContext(ctx, p.binaryPath, args...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		p.logger.Warn("prover binary failed", "err", err, "output", string(out))
+		return nil, fmt.Errorf("failed to run prover binary: %w", err)
+	}
+
+	return readProofAndPair(outDir)
+}
+
+func (p *LocalProver) Close() error {
+	return nil
+}
+
+// readProofAndPair reads the proof and the final pair written in the given directory.
+func readProofAndPair(dir string) (*ProofAndPair, error) {
+	proof, err := os.ReadFile(filepath.Join(dir, ProofFileName))
+	if err != nil {
+		return nil, err
+	}
+	pair, err := os.ReadFile(filepath.Join(dir, FinalPairFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProofAndPair{
+		Proof: Decode(proof),
+		Pair:  Decode(pair),
+	}, nil
+}
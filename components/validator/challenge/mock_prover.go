@@ -0,0 +1,55 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// MockProver returns the proof stored in a directory for every block, regardless of its contents.
+// It is only meant for testing.
+type MockProver struct {
+	dir    string
+	logger log.Logger
+}
+
+func NewMockProver(dir string, logger log.Logger) (*MockProver, error) {
+	if dir == "" {
+		return nil, errors.New("no mock proof directory specified")
+	}
+	return &MockProver{
+		dir:    dir,
+		logger: logger,
+	}, nil
+}
+
+func (p *MockProver) Name() string {
+	return ProverBackendMock
+}
+
+func (p *MockProver) Capabilities(_ context.Context) (Capabilities, error) {
+	return Capabilities{ProofTypes: []string{ProofTypeVerifyCircuit}}, nil
+}
+
+func (p *MockProver) HealthCheck(_ context.Context) error {
+	info, err := os.Stat(p.dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("mock proof path is not a directory: %s", p.dir)
+	}
+	return nil
+}
+
+func (p *MockProver) FetchProofAndPair(blockNumber uint64) (*ProofAndPair, error) {
+	p.logger.Info("returning mock proof", "blockNumber", blockNumber)
+	return readProofAndPair(p.dir)
+}
+
+func (p *MockProver) Close() error {
+	return nil
+}
@@ -0,0 +1,496 @@
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	// ErrProofPending is returned when the proof of a block is still being generated.
+	ErrProofPending = errors.New("proof is being generated")
+	// ErrProofDeadline is returned when the proof of a block cannot be generated before its deadline.
+	ErrProofDeadline = errors.New("proof cannot be generated before the deadline")
+)
+
+type ProofJobStatus string
+
+const (
+	ProofJobPending ProofJobStatus = "pending"
+	ProofJobRunning ProofJobStatus = "running"
+	ProofJobDone    ProofJobStatus = "done"
+	ProofJobFailed  ProofJobStatus = "failed"
+	// ProofJobAborted is the status of a job aborted because its proof cannot be generated before its deadline.
+	ProofJobAborted ProofJobStatus = "aborted"
+)
+
+const (
+	proofJobFileExt       = ".json"
+	proofCheckpointDirExt = ".checkpoint"
+)
+
+// ProofJob is a request to generate the proof of a block.
+type ProofJob struct {
+	BlockNumber uint64         `json:"blockNumber"`
+	Status      ProofJobStatus `json:"status"`
+	Attempts    uint64         `json:"attempts"`
+	LastError   string         `json:"lastError,omitempty"`
+	NextAttempt time.Time      `json:"nextAttempt"`
+	CreatedAt   time.Time      `json:"createdAt"`
+	UpdatedAt   time.Time      `json:"updatedAt"`
+	// Deadline is the time by which the proof must be generated, zero if there is none.
+	Deadline time.Time     `json:"deadline,omitempty"`
+	Result   *ProofAndPair `json:"result,omitempty"`
+}
+
+type ProofQueueConfig struct {
+	// Dir is the directory the jobs are persisted in. If empty, the jobs are kept in memory only.
+	Dir string
+	// MaxAttempts is the number of times a job is tried before it is marked as failed.
+	MaxAttempts uint64
+	// RetryBackoff is the delay before retrying a failed attempt, doubled on every attempt.
+	RetryBackoff time.Duration
+	// MaxRetryBackoff caps the delay between attempts.
+	MaxRetryBackoff time.Duration
+	// ExpectedProvingTime is how long a proof generation is expected to take. A job is aborted instead of
+	// started if its deadline is closer than that. If 0, the jobs are only aborted once their deadline is passed.
+	ExpectedProvingTime time.Duration
+}
+
+// ProofQueue is a durable queue of proof generation jobs. Since generating a zkEVM proof can take hours,
+// the jobs are persisted on disk so that they survive restarts, and failed attempts are retried with
+// exponential backoff. If the prover is resumable, its checkpoints are kept next to the jobs, so that
+// the retried attempts resume from where the failed ones stopped.
+type ProofQueue struct {
+	cfg    ProofQueueConfig
+	prover ProverBackend
+	log    log.Logger
+	now    func() time.Time
+
+	mu   sync.Mutex
+	jobs map[uint64]*ProofJob
+
+	wakeCh chan struct{}
+	sem    chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewProofQueue creates a proof queue, loading the jobs persisted by a previous run.
+// Jobs that were running when the process stopped are scheduled again.
+func NewProofQueue(cfg ProofQueueConfig, prover ProverBackend, logger log.Logger) (*ProofQueue, error) {
+	if cfg.MaxAttempts == 0 {
+		return nil, errors.New("max attempts of proof jobs must not be 0")
+	}
+	q := &ProofQueue{
+		cfg:    cfg,
+		prover: prover,
+		log:    logger,
+		now:    time.Now,
+		jobs:   make(map[uint64]*ProofJob),
+		wakeCh: make(chan struct{}, 1),
+	}
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+func (q *ProofQueue) load() error {
+	if q.cfg.Dir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(q.cfg.Dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create proof queue directory: %w", err)
+	}
+	entries, err := os.ReadDir(q.cfg.Dir)
+	if err != nil {
+		return fmt.Errorf("failed to read proof queue directory: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), proofJobFileExt) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(q.cfg.Dir, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read proof job: %w", err)
+		}
+		var job ProofJob
+		if err := json.Unmarshal(data, &job); err != nil {
+			q.log.Warn("ignoring corrupted proof job", "file", entry.Name(), "err", err)
+			continue
+		}
+		if job.Status == ProofJobRunning {
+			job.Status = ProofJobPending
+		}
+		q.jobs[job.BlockNumber] = &job
+		q.log.Info("loaded proof job", "blockNumber", job.BlockNumber, "status", job.Status, "attempts", job.Attempts)
+	}
+	return nil
+}
+
+// persist writes the job atomically. The caller must hold the lock.
+func (q *ProofQueue) persist(job *ProofJob) error {
+	if q.cfg.Dir == "" {
+		return nil
+	}
+	data, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("failed to encode proof job: %w", err)
+	}
+	path := q.jobPath(job.BlockNumber)
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open proof job: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write proof job: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to sync proof job: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close proof job: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to replace proof job: %w", err)
+	}
+	return nil
+}
+
+func (q *ProofQueue) jobPath(blockNumber uint64) string {
+	return filepath.Join(q.cfg.Dir, strconv.FormatUint(blockNumber, 10)+proofJobFileExt)
+}
+
+// checkpointDir returns the directory of the checkpoints of the job of the given block,
+// or an empty string if the jobs are not persisted.
+func (q *ProofQueue) checkpointDir(blockNumber uint64) string {
+	if q.cfg.Dir == "" {
+		return ""
+	}
+	return filepath.Join(q.cfg.Dir, strconv.FormatUint(blockNumber, 10)+proofCheckpointDirExt)
+}
+
+// removeCheckpoints removes the checkpoints of the job of the given block, once its proof is not needed anymore.
+func (q *ProofQueue) removeCheckpoints(blockNumber uint64) {
+	dir := q.checkpointDir(blockNumber)
+	if dir == "" {
+		return
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		q.log.Warn("failed to remove proof checkpoints", "blockNumber", blockNumber, "err", err)
+	}
+}
+
+// Start runs the jobs of the queue in the background, at most maxConcurrency at once.
+// A maxConcurrency of 0 means there is no limit.
+func (q *ProofQueue) Start(ctx context.Context, maxConcurrency uint64) {
+	if maxConcurrency > 0 {
+		q.sem = make(chan struct{}, maxConcurrency)
+	}
+	q.wg.Add(1)
+	go q.loop(ctx)
+}
+
+// Wait waits for the queue to stop after its context is done.
+func (q *ProofQueue) Wait() {
+	q.wg.Wait()
+}
+
+func (q *ProofQueue) loop(ctx context.Context) {
+	defer q.wg.Done()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+		case <-q.wakeCh:
+		case <-ctx.Done():
+			return
+		}
+
+		next := q.dispatch(ctx)
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(next)
+	}
+}
+
+// dispatch starts the jobs that are due, and returns the duration until the next job is due.
+func (q *ProofQueue) dispatch(ctx context.Context) time.Duration {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	next := time.Minute
+	for _, job := range q.sortedJobs() {
+		if job.Status != ProofJobPending {
+			continue
+		}
+		if wait := job.NextAttempt.Sub(now); wait > 0 {
+			if wait < next {
+				next = wait
+			}
+			continue
+		}
+		if !job.Deadline.IsZero() && now.Add(q.cfg.ExpectedProvingTime).After(job.Deadline) {
+			q.abort(job, now)
+			continue
+		}
+		if q.sem != nil {
+			select {
+			case q.sem <- struct{}{}:
+			default:
+				// the prover is busy, the finished job will wake the loop up.
+				return next
+			}
+		}
+		job.Status = ProofJobRunning
+		job.Attempts++
+		job.UpdatedAt = now
+		if err := q.persist(job); err != nil {
+			q.log.Error("failed to persist proof job", "blockNumber", job.BlockNumber, "err", err)
+		}
+		// The prover cannot be interrupted, so the running jobs are not waited for on shutdown.
+		// They are left running on disk and resumed on the next start.
+		go q.run(ctx, job.BlockNumber, job.Deadline)
+	}
+	return next
+}
+
+// abort gives up the job, since its proof cannot be generated before its deadline.
+// The caller must hold the lock.
+func (q *ProofQueue) abort(job *ProofJob, now time.Time) {
+	job.Status = ProofJobAborted
+	job.LastError = ErrProofDeadline.Error()
+	job.UpdatedAt = now
+	q.log.Error("aborting proof job, the proof cannot be generated before the deadline", "blockNumber", job.BlockNumber,
+		"deadline", job.Deadline, "expectedProvingTime", q.cfg.ExpectedProvingTime, "attempts", job.Attempts)
+	if err := q.persist(job); err != nil {
+		q.log.Error("failed to persist proof job", "blockNumber", job.BlockNumber, "err", err)
+	}
+	q.removeCheckpoints(job.BlockNumber)
+}
+
+// prove generates the proof of the given block. If the prover is resumable and the jobs are persisted,
+// the generation is checkpointed and interrupted at the deadline.
+func (q *ProofQueue) prove(ctx context.Context, blockNumber uint64, deadline time.Time) (*ProofAndPair, error) {
+	prover, ok := q.prover.(ResumableProver)
+	checkpointDir := q.checkpointDir(blockNumber)
+	if !ok || checkpointDir == "" {
+		return q.prover.FetchProofAndPair(blockNumber)
+	}
+	if !deadline.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
+	return prover.ResumeProofAndPair(ctx, blockNumber, checkpointDir)
+}
+
+func (q *ProofQueue) run(ctx context.Context, blockNumber uint64, deadline time.Time) {
+	defer func() {
+		if q.sem != nil {
+			<-q.sem
+		}
+		q.wake()
+	}()
+
+	q.log.Info("generating proof", "blockNumber", blockNumber, "deadline", deadline)
+	result, err := q.prove(ctx, blockNumber, deadline)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.jobs[blockNumber]
+	if !ok {
+		// the job was removed in the meantime.
+		return
+	}
+	job.UpdatedAt = q.now()
+	switch {
+	case err == nil:
+		job.Status = ProofJobDone
+		job.LastError = ""
+		job.Result = result
+		q.log.Info("proof generated", "blockNumber", blockNumber, "attempts", job.Attempts)
+		q.removeCheckpoints(blockNumber)
+	case ctx.Err() != nil:
+		// the queue is stopped, the job is resumed on the next start.
+		return
+	case !job.Deadline.IsZero() && !job.UpdatedAt.Before(job.Deadline):
+		q.abort(job, job.UpdatedAt)
+		return
+	case job.Attempts >= q.cfg.MaxAttempts:
+		job.Status = ProofJobFailed
+		job.LastError = err.Error()
+		q.log.Error("proof generation failed", "blockNumber", blockNumber, "attempts", job.Attempts, "err", err)
+	default:
+		job.Status = ProofJobPending
+		job.LastError = err.Error()
+		job.NextAttempt = job.UpdatedAt.Add(q.backoff(job.Attempts))
+		q.log.Warn("proof generation attempt failed, retrying", "blockNumber", blockNumber,
+			"attempts", job.Attempts, "nextAttempt", job.NextAttempt, "err", err)
+	}
+	if err := q.persist(job); err != nil {
+		q.log.Error("failed to persist proof job", "blockNumber", blockNumber, "err", err)
+	}
+}
+
+// backoff returns the delay before the next attempt, after the given number of failed attempts.
+func (q *ProofQueue) backoff(attempts uint64) time.Duration {
+	d := q.cfg.RetryBackoff
+	for i := uint64(1); i < attempts; i++ {
+		d *= 2
+		if q.cfg.MaxRetryBackoff != 0 && d >= q.cfg.MaxRetryBackoff {
+			return q.cfg.MaxRetryBackoff
+		}
+	}
+	if q.cfg.MaxRetryBackoff != 0 && d > q.cfg.MaxRetryBackoff {
+		return q.cfg.MaxRetryBackoff
+	}
+	return d
+}
+
+func (q *ProofQueue) wake() {
+	select {
+	case q.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// sortedJobs returns the jobs in ascending order of block number. The caller must hold the lock.
+func (q *ProofQueue) sortedJobs() []*ProofJob {
+	jobs := make([]*ProofJob, 0, len(q.jobs))
+	for _, job := range q.jobs {
+		jobs = append(jobs, job)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].BlockNumber < jobs[j].BlockNumber })
+	return jobs
+}
+
+// Proof returns the proof of the given block if it was generated. Otherwise, it enqueues a job
+// to generate it and returns ErrProofPending. A failed job is returned as an error once,
+// and is scheduled again on the next call.
+func (q *ProofQueue) Proof(blockNumber uint64) (*ProofAndPair, error) {
+	return q.ProofBefore(blockNumber, time.Time{})
+}
+
+// ProofBefore is like Proof, but the proof must be generated before the given deadline, if not zero.
+// If it cannot be, the job is aborted and ErrProofDeadline is returned, so that the caller can escalate.
+func (q *ProofQueue) ProofBefore(blockNumber uint64, deadline time.Time) (*ProofAndPair, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.jobs[blockNumber]
+	if ok {
+		if !deadline.IsZero() && !job.Deadline.Equal(deadline) && job.Status != ProofJobDone {
+			job.Deadline = deadline
+			if err := q.persist(job); err != nil {
+				q.log.Error("failed to persist proof job", "blockNumber", blockNumber, "err", err)
+			}
+		}
+		switch job.Status {
+		case ProofJobDone:
+			return job.Result, nil
+		case ProofJobAborted:
+			return nil, fmt.Errorf("%w: deadline %s", ErrProofDeadline, job.Deadline)
+		case ProofJobFailed:
+			err := fmt.Errorf("proof generation failed after %d attempts: %s", job.Attempts, job.LastError)
+			job.Status = ProofJobPending
+			job.Attempts = 0
+			job.NextAttempt = q.now()
+			job.UpdatedAt = job.NextAttempt
+			if perr := q.persist(job); perr != nil {
+				q.log.Error("failed to persist proof job", "blockNumber", blockNumber, "err", perr)
+			}
+			q.wake()
+			return nil, err
+		default:
+			return nil, ErrProofPending
+		}
+	}
+
+	now := q.now()
+	job = &ProofJob{
+		BlockNumber: blockNumber,
+		Status:      ProofJobPending,
+		NextAttempt: now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Deadline:    deadline,
+	}
+	if err := q.persist(job); err != nil {
+		return nil, err
+	}
+	q.jobs[blockNumber] = job
+	q.log.Info("enqueued proof job", "blockNumber", blockNumber, "deadline", deadline)
+	q.wake()
+	return nil, ErrProofPending
+}
+
+// Remove deletes the job of the given block, once its proof is not needed anymore.
+func (q *ProofQueue) Remove(blockNumber uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.jobs[blockNumber]; !ok {
+		return nil
+	}
+	delete(q.jobs, blockNumber)
+	if q.cfg.Dir == "" {
+		return nil
+	}
+	q.removeCheckpoints(blockNumber)
+	if err := os.Remove(q.jobPath(blockNumber)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove proof job: %w", err)
+	}
+	return nil
+}
+
+// Job returns the status of the job of the given block without its result, or nil if there is none.
+func (q *ProofQueue) Job(blockNumber uint64) *ProofJob {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.jobs[blockNumber]
+	if !ok {
+		return nil
+	}
+	status := *job
+	status.Result = nil
+	return &status
+}
+
+// Jobs returns the status of all the jobs without their results, in ascending order of block number.
+func (q *ProofQueue) Jobs() []*ProofJob {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	jobs := q.sortedJobs()
+	statuses := make([]*ProofJob, len(jobs))
+	for i, job := range jobs {
+		status := *job
+		status.Result = nil
+		statuses[i] = &status
+	}
+	return statuses
+}
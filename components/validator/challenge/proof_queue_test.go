@@ -0,0 +1,209 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+// flakyProver fails the given number of times before returning a proof.
+type flakyProver struct {
+	MockProver
+	failures int32
+	calls    atomic.Int32
+}
+
+func (p *flakyProver) FetchProofAndPair(blockNumber uint64) (*ProofAndPair, error) {
+	if p.calls.Add(1) <= p.failures {
+		return nil, errors.New("prover error")
+	}
+	return &ProofAndPair{
+		Proof: []*big.Int{new(big.Int).SetUint64(blockNumber)},
+		Pair:  []*big.Int{big.NewInt(1)},
+	}, nil
+}
+
+// resumableProver fails the given number of times, leaving a checkpoint behind, before returning a proof.
+type resumableProver struct {
+	flakyProver
+	resumed atomic.Int32
+}
+
+func (p *resumableProver) ResumeProofAndPair(_ context.Context, blockNumber uint64, checkpointDir string) (*ProofAndPair, error) {
+	if _, err := os.Stat(checkpointDir + "/progress"); err == nil {
+		p.resumed.Add(1)
+	}
+	if err := os.MkdirAll(checkpointDir, 0o755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(checkpointDir+"/progress", nil, 0o644); err != nil {
+		return nil, err
+	}
+	return p.FetchProofAndPair(blockNumber)
+}
+
+func TestProofQueue_RetryAndPersist(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	cfg := ProofQueueConfig{
+		Dir:          t.TempDir(),
+		MaxAttempts:  3,
+		RetryBackoff: 10 * time.Millisecond,
+	}
+	prover := &flakyProver{failures: 1}
+
+	q, err := NewProofQueue(cfg, prover, logger)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	q.Start(ctx, 1)
+
+	_, err = q.Proof(10)
+	require.ErrorIs(t, err, ErrProofPending)
+
+	require.Eventually(t, func() bool {
+		job := q.Job(10)
+		return job != nil && job.Status == ProofJobDone
+	}, 5*time.Second, 10*time.Millisecond)
+
+	job := q.Job(10)
+	require.Equal(t, uint64(2), job.Attempts)
+	require.Nil(t, job.Result, "status must not contain the proof")
+
+	cancel()
+	q.Wait()
+
+	// the generated proof survives a restart
+	q, err = NewProofQueue(cfg, prover, logger)
+	require.NoError(t, err)
+	result, err := q.Proof(10)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10), result.Proof[0])
+
+	require.NoError(t, q.Remove(10))
+	require.Nil(t, q.Job(10))
+	q, err = NewProofQueue(cfg, prover, logger)
+	require.NoError(t, err)
+	require.Empty(t, q.Jobs())
+}
+
+func TestProofQueue_FailedJob(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	cfg := ProofQueueConfig{
+		MaxAttempts:  2,
+		RetryBackoff: 10 * time.Millisecond,
+	}
+	prover := &flakyProver{failures: 2}
+
+	q, err := NewProofQueue(cfg, prover, logger)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	q.Start(ctx, 0)
+
+	_, err = q.Proof(7)
+	require.ErrorIs(t, err, ErrProofPending)
+	require.Eventually(t, func() bool {
+		job := q.Job(7)
+		return job != nil && job.Status == ProofJobFailed
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the failure is reported once, then the job is scheduled again
+	_, err = q.Proof(7)
+	require.ErrorContains(t, err, "prover error")
+	require.Eventually(t, func() bool {
+		_, err := q.Proof(7)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestProofQueue_ResumeRunningJob(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	cfg := ProofQueueConfig{Dir: t.TempDir(), MaxAttempts: 1}
+
+	q, err := NewProofQueue(cfg, &flakyProver{}, logger)
+	require.NoError(t, err)
+	q.mu.Lock()
+	require.NoError(t, q.persist(&ProofJob{BlockNumber: 5, Status: ProofJobRunning, Attempts: 1}))
+	q.mu.Unlock()
+
+	q, err = NewProofQueue(cfg, &flakyProver{}, logger)
+	require.NoError(t, err)
+	require.Equal(t, ProofJobPending, q.Job(5).Status)
+}
+
+func TestProofQueue_Backoff(t *testing.T) {
+	q := &ProofQueue{cfg: ProofQueueConfig{RetryBackoff: time.Second, MaxRetryBackoff: 5 * time.Second}}
+	require.Equal(t, time.Second, q.backoff(1))
+	require.Equal(t, 2*time.Second, q.backoff(2))
+	require.Equal(t, 4*time.Second, q.backoff(3))
+	require.Equal(t, 5*time.Second, q.backoff(4))
+	require.Equal(t, 5*time.Second, q.backoff(40))
+}
+
+func TestProofQueue_ResumeFromCheckpoint(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	cfg := ProofQueueConfig{
+		Dir:          t.TempDir(),
+		MaxAttempts:  3,
+		RetryBackoff: 10 * time.Millisecond,
+	}
+	prover := &resumableProver{flakyProver: flakyProver{failures: 1}}
+
+	q, err := NewProofQueue(cfg, prover, logger)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	q.Start(ctx, 1)
+
+	_, err = q.Proof(10)
+	require.ErrorIs(t, err, ErrProofPending)
+	require.Eventually(t, func() bool {
+		_, err := q.Proof(10)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Equal(t, int32(1), prover.resumed.Load(), "the retried attempt should resume from the checkpoint")
+	_, err = os.Stat(q.checkpointDir(10))
+	require.ErrorIs(t, err, os.ErrNotExist, "checkpoints should be removed once the proof is generated")
+}
+
+func TestProofQueue_Deadline(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	cfg := ProofQueueConfig{
+		MaxAttempts:         1,
+		ExpectedProvingTime: time.Hour,
+	}
+
+	q, err := NewProofQueue(cfg, &flakyProver{}, logger)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	q.Start(ctx, 1)
+
+	_, err = q.ProofBefore(3, time.Now().Add(time.Minute))
+	require.ErrorIs(t, err, ErrProofPending)
+	require.Eventually(t, func() bool {
+		job := q.Job(3)
+		return job != nil && job.Status == ProofJobAborted
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = q.ProofBefore(3, time.Now().Add(time.Minute))
+	require.ErrorIs(t, err, ErrProofDeadline)
+
+	// a job whose deadline can be met is generated
+	_, err = q.ProofBefore(4, time.Now().Add(2*time.Hour))
+	require.ErrorIs(t, err, ErrProofPending)
+	require.Eventually(t, func() bool {
+		_, err := q.ProofBefore(4, time.Now().Add(2*time.Hour))
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,117 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	ProverBackendRPC   = "rpc"
+	ProverBackendLocal = "local"
+	ProverBackendMock  = "mock"
+)
+
+// ProofTypeVerifyCircuit is the aggregated proof of the verify circuit, which is what Colosseum verifies.
+const ProofTypeVerifyCircuit = "verify_circuit"
+
+// ProverBackend generates the zkEVM proofs used to prove faults in Colosseum.
+type ProverBackend interface {
+	// Name returns the name of the backend implementation.
+	Name() string
+	// Capabilities returns the features supported by the prover.
+	Capabilities(ctx context.Context) (Capabilities, error)
+	// HealthCheck returns an error if the prover cannot serve proof requests.
+	HealthCheck(ctx context.Context) error
+	// FetchProofAndPair generates the proof and the final pair for the given block.
+	FetchProofAndPair(blockNumber uint64) (*ProofAndPair, error)
+	// Close releases the resources held by the backend.
+	Close() error
+}
+
+// ResumableProver is a prover backend saving the progress of a proof generation in a checkpoint directory,
+// so that a crashed or interrupted generation resumes from the checkpoint instead of starting over.
+type ResumableProver interface {
+	ProverBackend
+	// ResumeProofAndPair generates the proof and the final pair for the given block until ctx is done,
+	// resuming from the checkpoint in checkpointDir if any.
+	ResumeProofAndPair(ctx context.Context, blockNumber uint64, checkpointDir string) (*ProofAndPair, error)
+}
+
+// Capabilities describes what a prover backend supports.
+type Capabilities struct {
+	// ProofTypes is the list of proof types the prover can generate.
+	ProofTypes []string `json:"proofTypes"`
+	// MaxConcurrency is the maximum number of proofs the prover can generate at once.
+	// 0 means there is no limit.
+	MaxConcurrency uint64 `json:"maxConcurrency"`
+	// Checkpoints is true if the prover can resume a proof generation from a checkpoint.
+	Checkpoints bool `json:"checkpoints"`
+}
+
+// DefaultCapabilities are the capabilities of a prover that does not advertise them.
+var DefaultCapabilities = Capabilities{
+	ProofTypes:     []string{ProofTypeVerifyCircuit},
+	MaxConcurrency: 1,
+}
+
+// Supports returns whether the prover can generate the given type of proof.
+func (c Capabilities) Supports(proofType string) bool {
+	for _, t := range c.ProofTypes {
+		if t == proofType {
+			return true
+		}
+	}
+	return false
+}
+
+// Negotiate checks that the backend is healthy and can generate the proofs required by the challenger,
+// and returns its capabilities.
+func Negotiate(ctx context.Context, backend ProverBackend) (Capabilities, error) {
+	if err := backend.HealthCheck(ctx); err != nil {
+		return Capabilities{}, fmt.Errorf("%s prover is not healthy: %w", backend.Name(), err)
+	}
+	caps, err := backend.Capabilities(ctx)
+	if err != nil {
+		return Capabilities{}, fmt.Errorf("failed to get capabilities of %s prover: %w", backend.Name(), err)
+	}
+	if !caps.Supports(ProofTypeVerifyCircuit) {
+		return Capabilities{}, fmt.Errorf("%s prover does not support %s proofs, supported: %v",
+			backend.Name(), ProofTypeVerifyCircuit, caps.ProofTypes)
+	}
+	return caps, nil
+}
+
+// ProverConfig is the configuration to create a prover backend.
+type ProverConfig struct {
+	// Backend is the type of the backend: rpc, local, mock or pool.
+	Backend string
+	// GrpcUrl is the URL of the prover gRPC server, used by the rpc backend.
+	GrpcUrl string
+	// Endpoints are the prover gRPC servers to dispatch the proofs across, used by the pool backend.
+	Endpoints []ProverEndpoint
+	// BinaryPath is the path to the prover binary, used by the local backend.
+	BinaryPath string
+	// MockDir is the directory containing the proof to return, used by the mock backend.
+	MockDir string
+	// Timeout is the duration to wait for a proof.
+	Timeout time.Duration
+}
+
+// NewProverBackend creates the prover backend of the configured type.
+func NewProverBackend(cfg ProverConfig, logger log.Logger) (ProverBackend, error) {
+	switch cfg.Backend {
+	case "", ProverBackendRPC:
+		return NewFetcher(cfg.GrpcUrl, cfg.Timeout, logger)
+	case ProverBackendLocal:
+		return NewLocalProver(cfg.BinaryPath, cfg.Timeout, logger)
+	case ProverBackendMock:
+		return NewMockProver(cfg.MockDir, logger)
+	case ProverBackendPool:
+		return NewRPCProverPool(cfg.Endpoints, cfg.Timeout, logger)
+	default:
+		return nil, fmt.Errorf("unknown prover backend: %s", cfg.Backend)
+	}
+}
@@ -0,0 +1,217 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const ProverBackendPool = "pool"
+
+// ProverEndpoint is a prover gRPC server of a pool, with the number of proofs it can generate at once.
+type ProverEndpoint struct {
+	Url      string
+	Capacity uint64
+}
+
+// ParseProverEndpoint parses an endpoint given as <url> or <url>=<capacity>. The capacity defaults to 1.
+func ParseProverEndpoint(s string) (ProverEndpoint, error) {
+	endpoint := ProverEndpoint{Url: s, Capacity: 1}
+	if i := strings.LastIndex(s, "="); i >= 0 {
+		capacity, err := strconv.ParseUint(s[i+1:], 10, 64)
+		if err != nil {
+			return ProverEndpoint{}, fmt.Errorf("invalid capacity of prover endpoint %s: %w", s, err)
+		}
+		endpoint.Url, endpoint.Capacity = s[:i], capacity
+	}
+	if endpoint.Url == "" {
+		return ProverEndpoint{}, fmt.Errorf("no url in prover endpoint %s", s)
+	}
+	if endpoint.Capacity == 0 {
+		return ProverEndpoint{}, fmt.Errorf("capacity of prover endpoint %s must not be 0", s)
+	}
+	return endpoint, nil
+}
+
+// PoolMember is a prover backend of a pool.
+type PoolMember struct {
+	Name     string
+	Backend  ProverBackend
+	Capacity uint64
+}
+
+type poolMember struct {
+	PoolMember
+	inFlight uint64
+	healthy  bool
+}
+
+// loadBelow returns true if the member is less loaded than the other, relative to their capacities.
+func (m *poolMember) loadBelow(other *poolMember) bool {
+	return m.inFlight*other.Capacity < other.inFlight*m.Capacity
+}
+
+func (m *poolMember) preferredTo(other *poolMember) bool {
+	if m.healthy != other.healthy {
+		return m.healthy
+	}
+	if free, otherFree := m.inFlight < m.Capacity, other.inFlight < other.Capacity; free != otherFree {
+		return free
+	}
+	return m.loadBelow(other)
+}
+
+// ProverPool dispatches the proof requests across several prover backends. Each request goes to the
+// healthy backend with the lowest load relative to its capacity, and fails over to the other backends
+// if it fails.
+type ProverPool struct {
+	mu      sync.Mutex
+	members []*poolMember
+	logger  log.Logger
+}
+
+func NewProverPool(members []PoolMember, logger log.Logger) (*ProverPool, error) {
+	if len(members) == 0 {
+		return nil, errors.New("no prover endpoints specified")
+	}
+	p := &ProverPool{logger: logger}
+	for _, m := range members {
+		if m.Capacity == 0 {
+			return nil, fmt.Errorf("capacity of prover %s must not be 0", m.Name)
+		}
+		p.members = append(p.members, &poolMember{PoolMember: m, healthy: true})
+	}
+	return p, nil
+}
+
+// NewRPCProverPool creates a pool of the kroma-prover gRPC servers at the given endpoints.
+func NewRPCProverPool(endpoints []ProverEndpoint, timeout time.Duration, logger log.Logger) (*ProverPool, error) {
+	members := make([]PoolMember, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		fetcher, err := NewFetcher(endpoint.Url, timeout, logger.New("prover", endpoint.Url))
+		if err != nil {
+			for _, m := range members {
+				_ = m.Backend.Close()
+			}
+			return nil, fmt.Errorf("failed to create prover %s: %w", endpoint.Url, err)
+		}
+		members = append(members, PoolMember{Name: endpoint.Url, Backend: fetcher, Capacity: endpoint.Capacity})
+	}
+	return NewProverPool(members, logger)
+}
+
+func (p *ProverPool) Name() string {
+	return ProverBackendPool
+}
+
+// Capabilities returns the capabilities shared by all the backends. The maximum concurrency is the sum
+// of the capacities of the backends.
+func (p *ProverPool) Capabilities(ctx context.Context) (Capabilities, error) {
+	caps := Capabilities{ProofTypes: []string{ProofTypeVerifyCircuit}}
+	for _, m := range p.members {
+		memberCaps, err := m.Backend.Capabilities(ctx)
+		if err != nil {
+			return Capabilities{}, fmt.Errorf("failed to get capabilities of prover %s: %w", m.Name, err)
+		}
+		if !memberCaps.Supports(ProofTypeVerifyCircuit) {
+			return Capabilities{}, fmt.Errorf("prover %s does not support %s proofs", m.Name, ProofTypeVerifyCircuit)
+		}
+		caps.MaxConcurrency += m.Capacity
+	}
+	return caps, nil
+}
+
+// HealthCheck checks the health of every backend, so that the unhealthy ones are only used when the
+// others fail too, and fails if none of them is healthy.
+func (p *ProverPool) HealthCheck(ctx context.Context) error {
+	var errs []string
+	for _, m := range p.members {
+		err := m.Backend.HealthCheck(ctx)
+		p.setHealthy(m, err == nil)
+		if err != nil {
+			p.logger.Warn("prover is not healthy", "prover", m.Name, "err", err)
+			errs = append(errs, fmt.Sprintf("%s: %v", m.Name, err))
+		}
+	}
+	if len(errs) == len(p.members) {
+		return fmt.Errorf("no healthy prover: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// FetchProofAndPair requests the proof to the least loaded backend, failing over to the next one on errors
+// until every backend has been tried.
+func (p *ProverPool) FetchProofAndPair(blockNumber uint64) (*ProofAndPair, error) {
+	tried := make(map[*poolMember]bool, len(p.members))
+	var lastErr error
+	for {
+		m := p.acquire(tried)
+		if m == nil {
+			return nil, fmt.Errorf("all provers failed to generate the proof of block %d: %w", blockNumber, lastErr)
+		}
+		tried[m] = true
+
+		p.logger.Info("dispatching proof request", "blockNumber", blockNumber, "prover", m.Name)
+		proofAndPair, err := m.Backend.FetchProofAndPair(blockNumber)
+		p.release(m, err == nil)
+		if err == nil {
+			return proofAndPair, nil
+		}
+		p.logger.Warn("prover failed to generate proof, failing over", "blockNumber", blockNumber,
+			"prover", m.Name, "err", err)
+		lastErr = err
+	}
+}
+
+// acquire reserves a slot of the backend to request a proof to. The healthy backends with free capacity
+// are preferred, then the least loaded ones. Backends already tried are skipped.
+func (p *ProverPool) acquire(tried map[*poolMember]bool) *poolMember {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var best *poolMember
+	for _, m := range p.members {
+		if tried[m] {
+			continue
+		}
+		if best == nil || m.preferredTo(best) {
+			best = m
+		}
+	}
+	if best != nil {
+		best.inFlight++
+	}
+	return best
+}
+
+func (p *ProverPool) release(m *poolMember, healthy bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	m.inFlight--
+	m.healthy = healthy
+}
+
+func (p *ProverPool) setHealthy(m *poolMember, healthy bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	m.healthy = healthy
+}
+
+func (p *ProverPool) Close() error {
+	var errs []string
+	for _, m := range p.members {
+		if err := m.Backend.Close(); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", m.Name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to close provers: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+type poolTestProver struct {
+	testProver
+	fetchErr error
+	fetched  []uint64
+}
+
+func (p *poolTestProver) FetchProofAndPair(blockNumber uint64) (*ProofAndPair, error) {
+	p.fetched = append(p.fetched, blockNumber)
+	if p.fetchErr != nil {
+		return nil, p.fetchErr
+	}
+	return &ProofAndPair{Proof: []*big.Int{new(big.Int).SetUint64(blockNumber)}}, nil
+}
+
+func TestParseProverEndpoint(t *testing.T) {
+	endpoint, err := ParseProverEndpoint("localhost:3000")
+	require.NoError(t, err)
+	require.Equal(t, ProverEndpoint{Url: "localhost:3000", Capacity: 1}, endpoint)
+
+	endpoint, err = ParseProverEndpoint("localhost:3000=4")
+	require.NoError(t, err)
+	require.Equal(t, ProverEndpoint{Url: "localhost:3000", Capacity: 4}, endpoint)
+
+	_, err = ParseProverEndpoint("localhost:3000=0")
+	require.Error(t, err)
+	_, err = ParseProverEndpoint("localhost:3000=x")
+	require.Error(t, err)
+	_, err = ParseProverEndpoint("=2")
+	require.Error(t, err)
+}
+
+func TestProverPool_Scheduling(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	small := &poolTestProver{testProver: testProver{caps: DefaultCapabilities}}
+	large := &poolTestProver{testProver: testProver{caps: DefaultCapabilities}}
+	pool, err := NewProverPool([]PoolMember{
+		{Name: "small", Backend: small, Capacity: 1},
+		{Name: "large", Backend: large, Capacity: 3},
+	}, logger)
+	require.NoError(t, err)
+
+	caps, err := Negotiate(context.Background(), pool)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), caps.MaxConcurrency)
+
+	// Fill the pool by hand to check that the requests go to the least loaded member.
+	tried := make(map[*poolMember]bool)
+	var order []string
+	for i := 0; i < 4; i++ {
+		order = append(order, pool.acquire(tried).Name)
+	}
+	require.Equal(t, []string{"small", "large", "large", "large"}, order)
+	// Over capacity, the requests still go to the least loaded member relative to its capacity.
+	require.Equal(t, "small", pool.acquire(tried).Name)
+	require.Equal(t, "large", pool.acquire(tried).Name)
+}
+
+func TestProverPool_Failover(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+	failing := &poolTestProver{testProver: testProver{caps: DefaultCapabilities}, fetchErr: errors.New("out of memory")}
+	working := &poolTestProver{testProver: testProver{caps: DefaultCapabilities}}
+	pool, err := NewProverPool([]PoolMember{
+		{Name: "failing", Backend: failing, Capacity: 1},
+		{Name: "working", Backend: working, Capacity: 1},
+	}, logger)
+	require.NoError(t, err)
+
+	proof, err := pool.FetchProofAndPair(10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), proof.Proof[0].Uint64())
+	require.Equal(t, []uint64{10}, failing.fetched)
+	require.Equal(t, []uint64{10}, working.fetched)
+
+	// The failed member is now unhealthy, so the next request goes to the working one first.
+	_, err = pool.FetchProofAndPair(11)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10}, failing.fetched)
+
+	working.fetchErr = errors.New("down")
+	_, err = pool.FetchProofAndPair(12)
+	require.ErrorIs(t, err, failing.fetchErr)
+
+	failing.healthErr = errors.New("unreachable")
+	working.healthErr = errors.New("unreachable")
+	require.ErrorContains(t, pool.HealthCheck(context.Background()), "no healthy prover")
+}
@@ -0,0 +1,57 @@
+package challenge
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+type testProver struct {
+	MockProver
+	caps      Capabilities
+	healthErr error
+}
+
+func (p *testProver) Capabilities(_ context.Context) (Capabilities, error) {
+	return p.caps, nil
+}
+
+func (p *testProver) HealthCheck(_ context.Context) error {
+	return p.healthErr
+}
+
+func TestNegotiate(t *testing.T) {
+	ctx := context.Background()
+
+	caps, err := Negotiate(ctx, &testProver{caps: DefaultCapabilities})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), caps.MaxConcurrency)
+
+	_, err = Negotiate(ctx, &testProver{caps: Capabilities{ProofTypes: []string{"chunk"}}})
+	require.ErrorContains(t, err, "does not support")
+
+	healthErr := errors.New("down")
+	_, err = Negotiate(ctx, &testProver{caps: DefaultCapabilities, healthErr: healthErr})
+	require.ErrorIs(t, err, healthErr)
+}
+
+func TestNewProverBackend(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlCrit)
+
+	backend, err := NewProverBackend(ProverConfig{Backend: ProverBackendMock, MockDir: t.TempDir()}, logger)
+	require.NoError(t, err)
+	require.Equal(t, ProverBackendMock, backend.Name())
+	_, err = Negotiate(context.Background(), backend)
+	require.NoError(t, err)
+
+	_, err = NewProverBackend(ProverConfig{Backend: ProverBackendLocal}, logger)
+	require.Error(t, err)
+
+	_, err = NewProverBackend(ProverConfig{Backend: "unknown"}, logger)
+	require.ErrorContains(t, err, "unknown prover backend")
+}
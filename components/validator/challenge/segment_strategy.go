@@ -0,0 +1,89 @@
+package challenge
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	SegmentStrategyFirst = "first"
+	SegmentStrategyLast  = "last"
+)
+
+var ErrNoFaultPosition = errors.New("no fault position in segments")
+
+// SegmentStrategy selects the section of the segments to bisect into or to prove fault of.
+// The lengths of segments are fixed by the Colosseum per turn, but whenever the segments disagree
+// with the local outputs in several places, any section whose first segment agrees and whose last
+// segment disagrees can be selected.
+type SegmentStrategy interface {
+	// Name returns the name of the strategy.
+	Name() string
+	// SelectFaultPosition returns the position of the last valid segment, given the output roots
+	// computed locally at the block numbers of the segments.
+	SelectFaultPosition(segments *Segments, local []Hash) (uint64, error)
+}
+
+// NewSegmentStrategy creates the segment strategy of the given name.
+func NewSegmentStrategy(name string) (SegmentStrategy, error) {
+	switch name {
+	case "", SegmentStrategyFirst:
+		return firstFaultStrategy{}, nil
+	case SegmentStrategyLast:
+		return lastFaultStrategy{}, nil
+	default:
+		return nil, fmt.Errorf("unknown segment strategy: %s", name)
+	}
+}
+
+// firstFaultStrategy selects the earliest section where the disagreement begins.
+type firstFaultStrategy struct{}
+
+func (firstFaultStrategy) Name() string {
+	return SegmentStrategyFirst
+}
+
+func (firstFaultStrategy) SelectFaultPosition(segments *Segments, local []Hash) (uint64, error) {
+	positions, err := FaultPositions(segments, local)
+	if err != nil {
+		return 0, err
+	}
+	return positions[0], nil
+}
+
+// lastFaultStrategy selects the latest section where the disagreement begins, which is where
+// the segments disagree with the local outputs for good.
+type lastFaultStrategy struct{}
+
+func (lastFaultStrategy) Name() string {
+	return SegmentStrategyLast
+}
+
+func (lastFaultStrategy) SelectFaultPosition(segments *Segments, local []Hash) (uint64, error) {
+	positions, err := FaultPositions(segments, local)
+	if err != nil {
+		return 0, err
+	}
+	return positions[len(positions)-1], nil
+}
+
+// FaultPositions returns all the positions of valid segments followed by an invalid one.
+func FaultPositions(segments *Segments, local []Hash) ([]uint64, error) {
+	if len(local) != len(segments.Hashes) {
+		return nil, fmt.Errorf("mismatched number of outputs: expected %d, got %d", len(segments.Hashes), len(local))
+	}
+	if len(local) == 0 || segments.Hashes[0] != local[0] {
+		return nil, fmt.Errorf("%w: the first segment must be valid", ErrNoFaultPosition)
+	}
+
+	var positions []uint64
+	for i := 0; i < len(local)-1; i++ {
+		if segments.Hashes[i] == local[i] && segments.Hashes[i+1] != local[i+1] {
+			positions = append(positions, uint64(i))
+		}
+	}
+	if len(positions) == 0 {
+		return nil, ErrNoFaultPosition
+	}
+	return positions, nil
+}
@@ -0,0 +1,45 @@
+package challenge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSegmentStrategy_SelectFaultPosition(t *testing.T) {
+	valid, invalid := Hash{1}, Hash{2}
+	segments := NewSegments(0, 40, []Hash{valid, invalid, valid, invalid, invalid})
+	local := []Hash{valid, valid, valid, valid, valid}
+
+	positions, err := FaultPositions(segments, local)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 2}, positions)
+
+	first, err := NewSegmentStrategy(SegmentStrategyFirst)
+	require.NoError(t, err)
+	position, err := first.SelectFaultPosition(segments, local)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), position)
+
+	last, err := NewSegmentStrategy(SegmentStrategyLast)
+	require.NoError(t, err)
+	position, err = last.SelectFaultPosition(segments, local)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), position)
+}
+
+func TestFaultPositions_NoFault(t *testing.T) {
+	valid, invalid := Hash{1}, Hash{2}
+	local := []Hash{valid, valid, valid}
+
+	_, err := FaultPositions(NewSegments(0, 20, []Hash{valid, valid, valid}), local)
+	require.ErrorIs(t, err, ErrNoFaultPosition)
+
+	_, err = FaultPositions(NewSegments(0, 20, []Hash{invalid, invalid, invalid}), local)
+	require.ErrorIs(t, err, ErrNoFaultPosition)
+}
+
+func TestNewSegmentStrategy_Unknown(t *testing.T) {
+	_, err := NewSegmentStrategy("unknown")
+	require.Error(t, err)
+}
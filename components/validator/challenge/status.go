@@ -10,3 +10,22 @@ const (
 	StatusProven
 	StatusApproved
 )
+
+var statusNames = [...]string{
+	StatusNone:              "none",
+	StatusChallengerTurn:    "challenger_turn",
+	StatusAsserterTurn:      "asserter_turn",
+	StatusChallengerTimeout: "challenger_timeout",
+	StatusAsserterTimeout:   "asserter_timeout",
+	StatusReadyToProve:      "ready_to_prove",
+	StatusProven:            "proven",
+	StatusApproved:          "approved",
+}
+
+// StatusName returns the name of the challenge status, or "unknown" if it is not a known status.
+func StatusName(status uint8) string {
+	if int(status) < len(statusNames) {
+		return statusNames[status]
+	}
+	return "unknown"
+}
@@ -0,0 +1,165 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+const (
+	// ChallengePriorityOldest challenges the invalid output with the lowest index first.
+	ChallengePriorityOldest = "oldest"
+	// ChallengePriorityFinalization challenges the invalid output closest to its finalization first.
+	ChallengePriorityFinalization = "finalization"
+	// ChallengePriorityBond challenges the invalid output with the largest bond at stake first.
+	ChallengePriorityBond = "bond"
+)
+
+// challengeCandidate is an invalid output waiting to be challenged.
+type challengeCandidate struct {
+	outputIndex *big.Int
+	// finalizesAt is the L1 timestamp at which the output is finalized, and cannot be challenged anymore.
+	finalizesAt uint64
+	// bond is the amount bonded by the submitter of the output, nil if it is not needed by the policy.
+	bond *big.Int
+}
+
+// challengeLess returns whether a should be challenged before b.
+type challengeLess func(a, b *challengeCandidate) bool
+
+func newChallengeLess(policy string) (challengeLess, error) {
+	oldest := func(a, b *challengeCandidate) bool {
+		return a.outputIndex.Cmp(b.outputIndex) < 0
+	}
+	finalization := func(a, b *challengeCandidate) bool {
+		if a.finalizesAt != b.finalizesAt {
+			return a.finalizesAt < b.finalizesAt
+		}
+		return oldest(a, b)
+	}
+
+	switch policy {
+	case "", ChallengePriorityOldest:
+		return oldest, nil
+	case ChallengePriorityFinalization:
+		return finalization, nil
+	case ChallengePriorityBond:
+		return func(a, b *challengeCandidate) bool {
+			if cmp := a.bond.Cmp(b.bond); cmp != 0 {
+				return cmp > 0
+			}
+			return finalization(a, b)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown challenge priority: %s", policy)
+	}
+}
+
+// challengeScheduler limits the number of challenges the challenger has in progress at once, since each of
+// them locks a bond and proving capacity. When the limit is reached, the invalid outputs wait for a slot,
+// which is given to them in the order of the priority policy.
+type challengeScheduler struct {
+	mu   sync.Mutex
+	less challengeLess
+	// maxActive is the maximum number of challenges in progress. 0 means there is no limit.
+	maxActive int
+	// active are the output indexes of the challenges holding a slot.
+	active map[uint64]struct{}
+	// pending are the candidates waiting for a slot.
+	pending map[uint64]*challengeCandidate
+	// changed is closed and replaced whenever a slot is released or the pending candidates change.
+	changed chan struct{}
+}
+
+func newChallengeScheduler(less challengeLess, maxActive int) *challengeScheduler {
+	return &challengeScheduler{
+		less:      less,
+		maxActive: maxActive,
+		active:    make(map[uint64]struct{}),
+		pending:   make(map[uint64]*challengeCandidate),
+		changed:   make(chan struct{}),
+	}
+}
+
+// acquire blocks until the candidate gets a slot, i.e. a slot is free and no pending candidate has
+// a higher priority. It returns whether it had to wait, or an error if ctx is done while waiting.
+// It returns immediately if the output holds a slot already.
+func (s *challengeScheduler) acquire(ctx context.Context, candidate *challengeCandidate) (bool, error) {
+	key := candidate.outputIndex.Uint64()
+	waited := false
+	for {
+		s.mu.Lock()
+		if _, ok := s.active[key]; ok {
+			s.mu.Unlock()
+			return waited, nil
+		}
+		if s.grantable(candidate) {
+			if _, ok := s.pending[key]; ok {
+				delete(s.pending, key)
+				s.notify()
+			}
+			s.active[key] = struct{}{}
+			s.mu.Unlock()
+			return waited, nil
+		}
+		s.pending[key] = candidate
+		changed := s.changed
+		s.mu.Unlock()
+
+		waited = true
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			s.mu.Lock()
+			delete(s.pending, key)
+			s.notify()
+			s.mu.Unlock()
+			return waited, ctx.Err()
+		}
+	}
+}
+
+// grantable returns whether the candidate can take a slot. It must be called with the lock held.
+func (s *challengeScheduler) grantable(candidate *challengeCandidate) bool {
+	if s.maxActive == 0 {
+		return true
+	}
+	if len(s.active) >= s.maxActive {
+		return false
+	}
+	for _, other := range s.pending {
+		if other.outputIndex.Cmp(candidate.outputIndex) != 0 && s.less(other, candidate) {
+			return false
+		}
+	}
+	return true
+}
+
+// hold takes a slot for a challenge already in progress, regardless of the limit.
+func (s *challengeScheduler) hold(outputIndex *big.Int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.active[outputIndex.Uint64()] = struct{}{}
+}
+
+// release frees the slot of the given output, if it holds one.
+func (s *challengeScheduler) release(outputIndex *big.Int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := outputIndex.Uint64()
+	if _, ok := s.active[key]; !ok {
+		return
+	}
+	delete(s.active, key)
+	s.notify()
+}
+
+// notify wakes up the pending candidates to check whether they can take a slot.
+// It must be called with the lock held.
+func (s *challengeScheduler) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallengeLess(t *testing.T) {
+	old := &challengeCandidate{outputIndex: big.NewInt(1), finalizesAt: 200, bond: big.NewInt(10)}
+	urgent := &challengeCandidate{outputIndex: big.NewInt(2), finalizesAt: 100, bond: big.NewInt(20)}
+	rich := &challengeCandidate{outputIndex: big.NewInt(3), finalizesAt: 300, bond: big.NewInt(30)}
+
+	less, err := newChallengeLess(ChallengePriorityOldest)
+	require.NoError(t, err)
+	require.True(t, less(old, urgent))
+	require.True(t, less(urgent, rich))
+
+	less, err = newChallengeLess(ChallengePriorityFinalization)
+	require.NoError(t, err)
+	require.True(t, less(urgent, old))
+	require.True(t, less(old, rich))
+
+	less, err = newChallengeLess(ChallengePriorityBond)
+	require.NoError(t, err)
+	require.True(t, less(rich, urgent))
+	require.True(t, less(urgent, old))
+
+	_, err = newChallengeLess("newest")
+	require.ErrorContains(t, err, "unknown challenge priority")
+}
+
+func TestChallengeScheduler(t *testing.T) {
+	less, err := newChallengeLess(ChallengePriorityBond)
+	require.NoError(t, err)
+	s := newChallengeScheduler(less, 1)
+	ctx := context.Background()
+
+	first := &challengeCandidate{outputIndex: big.NewInt(1), bond: big.NewInt(10)}
+	waited, err := s.acquire(ctx, first)
+	require.NoError(t, err)
+	require.False(t, waited)
+
+	// the slot is held already.
+	waited, err = s.acquire(ctx, first)
+	require.NoError(t, err)
+	require.False(t, waited)
+
+	granted := make(chan uint64, 2)
+	for _, c := range []*challengeCandidate{
+		{outputIndex: big.NewInt(2), bond: big.NewInt(10)},
+		{outputIndex: big.NewInt(3), bond: big.NewInt(50)},
+	} {
+		c := c
+		go func() {
+			waited, err := s.acquire(ctx, c)
+			require.NoError(t, err)
+			require.True(t, waited)
+			granted <- c.outputIndex.Uint64()
+		}()
+	}
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.pending) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the largest bond gets the slot first.
+	s.release(big.NewInt(1))
+	require.Equal(t, uint64(3), <-granted)
+	select {
+	case <-granted:
+		t.Fatal("no slot must be free")
+	case <-time.After(50 * time.Millisecond):
+	}
+	s.release(big.NewInt(3))
+	require.Equal(t, uint64(2), <-granted)
+
+	// a cancelled candidate stops waiting.
+	cCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = s.acquire(cCtx, &challengeCandidate{outputIndex: big.NewInt(4), bond: big.NewInt(0)})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Empty(t, s.pending)
+}
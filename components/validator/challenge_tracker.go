@@ -0,0 +1,97 @@
+package validator
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+)
+
+// activeChallenge is the state of a challenge that the challenger is progressing.
+type activeChallenge struct {
+	outputIndex *big.Int
+	status      uint8
+	cancel      context.CancelFunc
+	// proofDeadlineMissed is set once it is escalated that the proof cannot be generated in time.
+	// It is only accessed by the handler of the challenge.
+	proofDeadlineMissed bool
+	// publicInputUsed is set once it is escalated that the public input of the fault has already been verified,
+	// so that the fault is not proven again. It is only accessed by the handler of the challenge.
+	publicInputUsed bool
+	// verificationFailures is the number of consecutive failures to verify the proof locally, due to errors of
+	// the L1 RPC or reverts of the zk verifier. It is only accessed by the handler of the challenge.
+	verificationFailures int
+}
+
+// challengeTracker keeps track of the challenges in progress, so that each challenge is
+// handled by exactly one state machine, independently of the others.
+type challengeTracker struct {
+	mu         sync.Mutex
+	challenges map[uint64]*activeChallenge
+}
+
+func newChallengeTracker() *challengeTracker {
+	return &challengeTracker{
+		challenges: make(map[uint64]*activeChallenge),
+	}
+}
+
+// add registers the challenge of the given output index.
+// It returns nil if the challenge is already being handled.
+func (t *challengeTracker) add(outputIndex *big.Int, cancel context.CancelFunc) *activeChallenge {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := outputIndex.Uint64()
+	if _, ok := t.challenges[key]; ok {
+		return nil
+	}
+	ch := &activeChallenge{
+		outputIndex: new(big.Int).Set(outputIndex),
+		cancel:      cancel,
+	}
+	t.challenges[key] = ch
+	return ch
+}
+
+// remove unregisters the challenge of the given output index.
+func (t *challengeTracker) remove(outputIndex *big.Int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if ch, ok := t.challenges[outputIndex.Uint64()]; ok {
+		ch.cancel()
+		delete(t.challenges, outputIndex.Uint64())
+	}
+}
+
+// setStatus updates the last observed status of the challenge and reports whether it changed.
+func (t *challengeTracker) setStatus(ch *activeChallenge, status uint8) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	changed := ch.status != status
+	ch.status = status
+	return changed
+}
+
+// len returns the number of challenges in progress.
+func (t *challengeTracker) len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return len(t.challenges)
+}
+
+// outputIndexes returns the output indexes of the challenges in progress in ascending order.
+func (t *challengeTracker) outputIndexes() []uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	indexes := make([]uint64, 0, len(t.challenges))
+	for index := range t.challenges {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	return indexes
+}
@@ -0,0 +1,36 @@
+package validator
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chal "github.com/kroma-network/kroma/components/validator/challenge"
+)
+
+func TestChallengeTracker(t *testing.T) {
+	tracker := newChallengeTracker()
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ch1 := tracker.add(big.NewInt(3), cancel1)
+	require.NotNil(t, ch1)
+	require.Nil(t, tracker.add(big.NewInt(3), func() {}), "challenge must be handled only once")
+
+	_, cancel2 := context.WithCancel(context.Background())
+	ch2 := tracker.add(big.NewInt(1), cancel2)
+	require.NotNil(t, ch2)
+	require.Equal(t, 2, tracker.len())
+	require.Equal(t, []uint64{1, 3}, tracker.outputIndexes())
+
+	require.True(t, tracker.setStatus(ch1, chal.StatusChallengerTurn))
+	require.False(t, tracker.setStatus(ch1, chal.StatusChallengerTurn))
+	require.True(t, tracker.setStatus(ch1, chal.StatusAsserterTurn))
+	require.Equal(t, chal.StatusNone, ch2.status, "status must be tracked per challenge")
+
+	tracker.remove(big.NewInt(3))
+	require.ErrorIs(t, ctx1.Err(), context.Canceled)
+	require.Equal(t, []uint64{1}, tracker.outputIndexes())
+	require.NotNil(t, tracker.add(big.NewInt(3), func() {}), "challenge can be handled again after removal")
+}
@@ -6,13 +6,16 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -22,14 +25,30 @@ import (
 	"github.com/kroma-network/kroma/bindings/bindings"
 	"github.com/kroma-network/kroma/components/node/eth"
 	chal "github.com/kroma-network/kroma/components/validator/challenge"
+	"github.com/kroma-network/kroma/components/validator/indexer"
 	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/components/validator/publicinput"
 	"github.com/kroma-network/kroma/utils"
+	"github.com/kroma-network/kroma/utils/service/txmgr"
 )
 
-type ProofFetcher interface {
-	FetchProofAndPair(blockRef uint64) (*chal.ProofAndPair, error)
-	Close() error
-}
+// maxProofVerificationAttempts is the number of consecutive failures to verify a proof locally after which
+// the proof is submitted without local verification, so that it is left to the Colosseum to verify it
+// before the proving timeout.
+const maxProofVerificationAttempts = 3
+
+var (
+	errChallengerPaused = errors.New("challenger is paused")
+	errInvalidProof     = errors.New("invalid proof")
+	// errPublicInputVerified is returned if the public input of the fault has already been verified by the
+	// Colosseum, which rejects it from then on. Proving the fault again cannot succeed.
+	errPublicInputVerified = errors.New("public input has already been verified")
+	// errProofVerification is returned if the proof cannot be verified locally, due to an error of the L1 RPC
+	// or a revert of the zk verifier.
+	errProofVerification = errors.New("failed to verify proof locally")
+
+	errCouncilEscalationDisabled = errors.New("council escalation is disabled")
+)
 
 type Challenger struct {
 	log      log.Logger
@@ -40,16 +59,23 @@ type Challenger struct {
 	callOpts *bind.CallOpts
 
 	l1Client *ethclient.Client
+	outputs  *OutputService
+	strategy chal.SegmentStrategy
 
 	l2ooContract      *bindings.L2OutputOracle
 	l2ooABI           *abi.ABI
 	colosseumContract *bindings.Colosseum
 	colosseumABI      *abi.ABI
+	valpoolContract   *bindings.ValidatorPoolCaller
+	zkVerifier        *bindings.ZKVerifierCaller
 
 	submissionInterval        *big.Int
 	finalizationPeriodSeconds *big.Int
 	l2BlockTime               *big.Int
 	checkpoint                *big.Int
+	// dummyHash and maxTxs are used to pad the public input of a proof, as in the Colosseum contract.
+	dummyHash common.Hash
+	maxTxs    uint64
 
 	l2OutputSub  ethereum.Subscription
 	challengeSub ethereum.Subscription
@@ -57,10 +83,29 @@ type Challenger struct {
 	l2OutputSubmittedEventChan chan *bindings.L2OutputOracleOutputSubmitted
 	challengeCreatedEventChan  chan *bindings.ColosseumChallengeCreated
 
+	challenges *challengeTracker
+	// scheduler orders the creation of challenges when the number of active challenges is limited.
+	scheduler *challengeScheduler
+
+	// paused holds back challenge txs on request of the admin API.
+	paused atomic.Bool
+
+	// proofQueue runs the proof generation requests in the background, nil until the challenger is started.
+	proofQueue *chal.ProofQueue
+
+	// escalator escalates the challenges the challenger cannot resolve to the Security Council,
+	// nil if the council escalation is disabled.
+	escalator *councilEscalator
+
 	wg sync.WaitGroup
 }
 
 func NewChallenger(ctx context.Context, cfg Config, l log.Logger, m metrics.Metricer) (*Challenger, error) {
+	strategy := cfg.SegmentStrategy
+	if strategy == nil {
+		strategy, _ = chal.NewSegmentStrategy(chal.SegmentStrategyFirst)
+	}
+
 	colosseumContract, err := bindings.NewColosseum(cfg.ColosseumAddr, cfg.L1Client)
 	if err != nil {
 		return nil, err
@@ -81,6 +126,16 @@ func NewChallenger(ctx context.Context, cfg Config, l log.Logger, m metrics.Metr
 		return nil, err
 	}
 
+	valpoolContract, err := bindings.NewValidatorPoolCaller(cfg.ValidatorPoolAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	less, err := newChallengeLess(cfg.ChallengePriority)
+	if err != nil {
+		return nil, err
+	}
+
 	callOpts := utils.NewSimpleCallOpts(ctx)
 	submissionInterval, err := l2ooContract.SUBMISSIONINTERVAL(callOpts)
 	if err != nil {
@@ -94,6 +149,30 @@ func NewChallenger(ctx context.Context, cfg Config, l log.Logger, m metrics.Metr
 	if err != nil {
 		return nil, fmt.Errorf("failed to get l2 block time: %w", err)
 	}
+	zkVerifierAddr, err := colosseumContract.ZKVERIFIER(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get zk verifier address: %w", err)
+	}
+	zkVerifier, err := bindings.NewZKVerifierCaller(zkVerifierAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+	dummyHash, err := colosseumContract.DUMMYHASH(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dummy hash: %w", err)
+	}
+	maxTxs, err := colosseumContract.MAXTXS(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get max txs: %w", err)
+	}
+
+	var escalator *councilEscalator
+	if cfg.CouncilEscalationEnabled {
+		escalator, err = newCouncilEscalator(cfg, l, m)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &Challenger{
 		log:  l,
@@ -101,15 +180,25 @@ func NewChallenger(ctx context.Context, cfg Config, l log.Logger, m metrics.Metr
 		metr: m,
 
 		l1Client: cfg.L1Client,
+		outputs:  newOutputServiceFromConfig(cfg, l, m),
+		strategy: strategy,
 
 		l2ooContract:      l2ooContract,
 		l2ooABI:           l2ooABI,
 		colosseumContract: colosseumContract,
 		colosseumABI:      colosseumABI,
+		valpoolContract:   valpoolContract,
+		zkVerifier:        zkVerifier,
 
 		submissionInterval:        submissionInterval,
 		finalizationPeriodSeconds: finalizationPeriodSeconds,
 		l2BlockTime:               l2BlockTime,
+		dummyHash:                 dummyHash,
+		maxTxs:                    maxTxs.Uint64(),
+
+		challenges: newChallengeTracker(),
+		scheduler:  newChallengeScheduler(less, int(cfg.MaxActiveChallenges)),
+		escalator:  escalator,
 	}, nil
 }
 
@@ -158,6 +247,37 @@ func (c *Challenger) Start(ctx context.Context) error {
 	}
 	c.metr.RecordChallengeCheckpoint(c.checkpoint)
 
+	if c.cfg.ChallengerEnabled {
+		caps, err := chal.Negotiate(c.ctx, c.cfg.ProverBackend)
+		if err != nil {
+			return fmt.Errorf("failed to negotiate with prover: %w", err)
+		}
+		c.log.Info("using prover", "backend", c.cfg.ProverBackend.Name(), "proofTypes", caps.ProofTypes,
+			"maxConcurrency", caps.MaxConcurrency, "checkpoints", caps.Checkpoints)
+		c.metr.RecordProverHealthy(true)
+
+		c.proofQueue, err = chal.NewProofQueue(chal.ProofQueueConfig{
+			Dir:                 c.cfg.ProofQueueDir,
+			MaxAttempts:         c.cfg.ProofMaxAttempts,
+			RetryBackoff:        c.cfg.ProofRetryBackoff,
+			MaxRetryBackoff:     c.cfg.ProofMaxRetryBackoff,
+			ExpectedProvingTime: c.cfg.ProofExpectedTime,
+		}, c.cfg.ProverBackend, c.log)
+		if err != nil {
+			return fmt.Errorf("failed to create proof queue: %w", err)
+		}
+		c.proofQueue.Start(c.ctx, caps.MaxConcurrency)
+
+		if c.cfg.ProverHealthCheckInterval > 0 {
+			c.wg.Add(1)
+			go c.proverHealthCheckLoop(c.ctx)
+		}
+	}
+
+	if err := c.recoverChallenges(c.ctx, nextOutputIndex); err != nil {
+		return fmt.Errorf("failed to recover challenges: %w", err)
+	}
+
 	if err := c.scanPrevOutputs(c.ctx); err != nil {
 		return fmt.Errorf("failed to scan previous outputs: %w", err)
 	}
@@ -188,6 +308,12 @@ func (c *Challenger) Stop() error {
 
 	c.cancel()
 	c.wg.Wait()
+	if c.proofQueue != nil {
+		c.proofQueue.Wait()
+	}
+	if c.escalator != nil {
+		c.escalator.Wait()
+	}
 
 	close(c.l2OutputSubmittedEventChan)
 	close(c.challengeCreatedEventChan)
@@ -195,6 +321,130 @@ func (c *Challenger) Stop() error {
 	return nil
 }
 
+// recoverChallenges reconstructs the challenges in progress that the validator is party to from the
+// Colosseum state, and resumes handling them from their current turn. Unlike scanning events, this does
+// not depend on how long ago the challenges were created.
+func (c *Challenger) recoverChallenges(ctx context.Context, nextOutputIndex *big.Int) error {
+	// outputs can be challenged only until they are finalized.
+	fromIndex, err := c.firstUnfinalizedOutputIndex(nextOutputIndex.Uint64())
+	if err != nil {
+		return err
+	}
+	// genesis output cannot be challenged.
+	if fromIndex == 0 {
+		fromIndex = 1
+	}
+
+	candidates, err := c.recoveryCandidates(ctx, fromIndex, nextOutputIndex.Uint64())
+	if err != nil {
+		return err
+	}
+
+	from := c.cfg.TxManager.From()
+	for _, i := range candidates {
+		outputIndex := new(big.Int).SetUint64(i)
+
+		status, err := c.GetChallengeStatus(outputIndex)
+		if err != nil {
+			return fmt.Errorf("failed to get challenge status of output %d: %w", i, err)
+		}
+		// proven challenges are still handled to be escalated if they are not approved in time.
+		if isInactivated(status) && !(status == chal.StatusProven && c.escalator != nil) {
+			continue
+		}
+
+		challenge, err := c.GetChallenge(outputIndex)
+		if err != nil {
+			return fmt.Errorf("failed to get challenge of output %d: %w", i, err)
+		}
+		if !c.isRelatedChallenge(challenge.Asserter, challenge.Challenger) {
+			continue
+		}
+
+		c.log.Info("recovered challenge in progress",
+			"outputIndex", outputIndex,
+			"turn", challenge.Turn,
+			"challengeStatus", status,
+			"isAsserter", challenge.Asserter == from,
+			"isChallenger", challenge.Challenger == from,
+		)
+		if challenge.Challenger == from {
+			c.scheduler.hold(outputIndex)
+		}
+		c.startChallenge(ctx, outputIndex)
+	}
+
+	return nil
+}
+
+// recoveryCandidates returns the indexes of the outputs in [fromIndex, nextOutputIndex) that may have
+// a challenge in progress the validator is party to. If the indexer is synced, they are the indexed
+// challenges in progress together with those created in the blocks not indexed yet. Otherwise, all
+// the outputs are candidates.
+func (c *Challenger) recoveryCandidates(ctx context.Context, fromIndex, nextOutputIndex uint64) ([]uint64, error) {
+	var candidates []uint64
+	if c.cfg.Indexer == nil || !c.cfg.Indexer.Status().Synced {
+		for i := fromIndex; i < nextOutputIndex; i++ {
+			candidates = append(candidates, i)
+		}
+		return candidates, nil
+	}
+
+	from := c.cfg.TxManager.From()
+	status := c.cfg.Indexer.Status()
+	indexed, err := c.cfg.Indexer.Challenges(indexer.ChallengeFilter{Address: &from, Status: indexer.ChallengeInProgress})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query indexed challenges: %w", err)
+	}
+	seen := make(map[uint64]bool)
+	add := func(i uint64) {
+		if i >= fromIndex && i < nextOutputIndex && !seen[i] {
+			seen[i] = true
+			candidates = append(candidates, i)
+		}
+	}
+	for _, ch := range indexed {
+		add(ch.OutputIndex)
+	}
+
+	iter, err := c.colosseumContract.FilterChallengeCreated(&bind.FilterOpts{Start: status.NextBlock, Context: ctx}, nil, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch challenges created after the indexed blocks: %w", err)
+	}
+	defer iter.Close()
+	for iter.Next() {
+		if c.isRelatedChallenge(iter.Event.Asserter, iter.Event.Challenger) {
+			add(iter.Event.OutputIndex.Uint64())
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("failed to fetch challenges created after the indexed blocks: %w", err)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+	c.log.Info("recovering challenges from the indexer", "candidates", len(candidates), "indexedUntil", status.NextBlock)
+	return candidates, nil
+}
+
+// firstUnfinalizedOutputIndex returns the index of the first output that is not finalized yet,
+// or nextOutputIndex if all the outputs are finalized.
+func (c *Challenger) firstUnfinalizedOutputIndex(nextOutputIndex uint64) (uint64, error) {
+	var err error
+	// outputs are finalized in the order of submission.
+	index := sort.Search(int(nextOutputIndex), func(i int) bool {
+		if err != nil {
+			return true
+		}
+		var finalized bool
+		finalized, err = c.isOutputFinalized(big.NewInt(int64(i)))
+		return !finalized
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get if output is finalized: %w", err)
+	}
+	return uint64(index), nil
+}
+
 // scanPrevOutputs scans all the previous outputs since the checkpoint within the finalization window.
 // If there are invalid outputs, create challenge.
 // If there are challenges in progress, keep handling them.
@@ -246,8 +496,7 @@ func (c *Challenger) scanPrevOutputs(ctx context.Context) error {
 		case c.cfg.ColosseumAddr:
 			ev := NewChallengeCreatedEvent(vLog)
 			if ev.OutputIndex.Sign() == 1 && c.isRelatedChallenge(ev.Asserter, ev.Challenger) {
-				c.wg.Add(1)
-				go c.handleChallenge(ctx, ev.OutputIndex)
+				c.startChallenge(ctx, ev.OutputIndex)
 			}
 		default:
 			c.log.Warn("unknown event log", "logs", vLog)
@@ -289,20 +538,76 @@ func (c *Challenger) subscribeChallengeCreated(ctx context.Context) {
 		case ev := <-c.challengeCreatedEventChan:
 			// when challenge created, handle it
 			if ev.OutputIndex.Sign() == 1 && c.isRelatedChallenge(ev.Asserter, ev.Challenger) {
-				c.wg.Add(1)
-				go c.handleChallenge(ctx, ev.OutputIndex)
+				c.startChallenge(ctx, ev.OutputIndex)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// proverHealthCheckLoop periodically checks the health of the prover backend.
+func (c *Challenger) proverHealthCheckLoop(ctx context.Context) {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.cfg.ProverHealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			cCtx, cCancel := context.WithTimeout(ctx, c.cfg.NetworkTimeout)
+			err := c.cfg.ProverBackend.HealthCheck(cCtx)
+			cCancel()
+			if err != nil {
+				c.log.Warn("prover is not healthy", "backend", c.cfg.ProverBackend.Name(), "err", err)
 			}
+			c.metr.RecordProverHealthy(err == nil)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// fetchProofAndPair returns the proof of the given block. Once the challenger is started, the proof is
+// generated in the background by the proof queue, and chal.ErrProofPending is returned until it is ready.
+// If the deadline is not zero and the proof cannot be generated before it, chal.ErrProofDeadline is returned.
+func (c *Challenger) fetchProofAndPair(blockNumber uint64, deadline time.Time) (*chal.ProofAndPair, error) {
+	if c.proofQueue == nil {
+		return c.cfg.ProverBackend.FetchProofAndPair(blockNumber)
+	}
+	return c.proofQueue.ProofBefore(blockNumber, deadline)
+}
+
+// ProofJobs returns the status of the proof generation jobs.
+func (c *Challenger) ProofJobs() []*chal.ProofJob {
+	if c.proofQueue == nil {
+		return nil
+	}
+	return c.proofQueue.Jobs()
+}
+
+// ProofJob returns the status of the proof generation job of the given block, or nil if there is none.
+func (c *Challenger) ProofJob(blockNumber uint64) *chal.ProofJob {
+	if c.proofQueue == nil {
+		return nil
+	}
+	return c.proofQueue.Job(blockNumber)
+}
+
 // handleOutput handles output when output submitted.
 func (c *Challenger) handleOutput(ctx context.Context, outputIndex *big.Int) {
 	c.log.Info("handling output", "outputIndex", outputIndex)
 	defer c.wg.Done()
 
+	// the slot taken to create the challenge is released by its handler once the challenge is created.
+	created := false
+	defer func() {
+		if !created {
+			c.scheduler.release(outputIndex)
+		}
+	}()
+
 	ticker := time.NewTicker(time.Minute)
 	defer ticker.Stop()
 
@@ -347,6 +652,21 @@ func (c *Challenger) handleOutput(ctx context.Context, outputIndex *big.Int) {
 				return
 			}
 
+			candidate, err := c.challengeCandidate(ctx, outputIndex)
+			if err != nil {
+				c.log.Error("unable to get the challenge priority of output", "err", err, "outputIndex", outputIndex)
+				continue
+			}
+			waited, err := c.scheduler.acquire(ctx, candidate)
+			if err != nil {
+				return
+			}
+			// the output may have been finalized or challenged while waiting, so check it again.
+			if waited {
+				c.log.Info("invalid output is ready to be challenged", "outputIndex", outputIndex)
+				continue
+			}
+
 			// if there is no challenge on invalid output, create a new challenge
 			tx, err := c.CreateChallenge(ctx, outputRange)
 			if err != nil {
@@ -354,20 +674,77 @@ func (c *Challenger) handleOutput(ctx context.Context, outputIndex *big.Int) {
 				continue
 			}
 
-			if err := c.submitChallengeTx(ctx, tx); err != nil {
+			if err := c.submitChallengeTx(ctx, tx, time.Time{}); err != nil {
 				c.log.Error("failed to submit create challenge tx", "err", err, "outputIndex", outputIndex)
 				continue
 			}
 
+			if c.cfg.ChallengerDryRun {
+				if err := c.simulateChallenge(ctx, outputRange); err != nil {
+					c.log.Error("dry run: failed to simulate challenge", "err", err, "outputIndex", outputIndex)
+				}
+				return
+			}
+
 			c.log.Info("submit create challenge tx", "outputIndex", outputIndex)
+			created = true
+			c.startChallenge(ctx, outputIndex)
 			return
 		}
 	}
 }
 
+// challengeCandidate returns the invalid output to challenge, with what the priority policy needs.
+func (c *Challenger) challengeCandidate(ctx context.Context, outputIndex *big.Int) (*challengeCandidate, error) {
+	cCtx, cCancel := context.WithTimeout(ctx, c.cfg.NetworkTimeout)
+	defer cCancel()
+	opts := utils.NewSimpleCallOpts(cCtx)
+
+	output, err := c.l2ooContract.GetL2Output(opts, outputIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get output: %w", err)
+	}
+	candidate := &challengeCandidate{
+		outputIndex: new(big.Int).Set(outputIndex),
+		finalizesAt: new(big.Int).Add(output.Timestamp, c.finalizationPeriodSeconds).Uint64(),
+	}
+	if c.cfg.ChallengePriority == ChallengePriorityBond {
+		bond, err := c.valpoolContract.GetBond(opts, outputIndex)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bond of output: %w", err)
+		}
+		candidate.bond = bond.Amount
+	}
+	return candidate, nil
+}
+
+// startChallenge starts handling the challenge on the given output index, unless it is handled already.
+// Each challenge is progressed by its own state machine with an independent timer and tx flow,
+// so that several invalid outputs can be contested at once.
+func (c *Challenger) startChallenge(ctx context.Context, outputIndex *big.Int) {
+	cCtx, cCancel := context.WithCancel(ctx)
+	ch := c.challenges.add(outputIndex, cCancel)
+	if ch == nil {
+		cCancel()
+		c.log.Debug("challenge is already being handled", "outputIndex", outputIndex)
+		return
+	}
+	c.metr.RecordActiveChallenges(c.challenges.len())
+	c.log.Info("start handling challenge", "outputIndex", outputIndex, "activeChallenges", c.challenges.outputIndexes())
+
+	c.wg.Add(1)
+	go c.handleChallenge(cCtx, ch)
+}
+
 // handleChallenge handles challenge according to its status and role.
-func (c *Challenger) handleChallenge(ctx context.Context, outputIndex *big.Int) {
-	defer c.wg.Done()
+func (c *Challenger) handleChallenge(ctx context.Context, ch *activeChallenge) {
+	outputIndex := ch.outputIndex
+	defer func() {
+		c.challenges.remove(outputIndex)
+		c.scheduler.release(outputIndex)
+		c.metr.RecordActiveChallenges(c.challenges.len())
+		c.wg.Done()
+	}()
 
 	ticker := time.NewTicker(c.cfg.ChallengerPollInterval)
 	defer ticker.Stop()
@@ -404,22 +781,29 @@ func (c *Challenger) handleChallenge(ctx context.Context, outputIndex *big.Int)
 				continue
 			}
 
+			if c.challenges.setStatus(ch, status) {
+				c.log.Info("challenge status changed", "outputIndex", outputIndex, "challengeStatus", status)
+			}
+
+			// if the proven challenge is not approved in time, escalate it to the security council
+			if status == chal.StatusProven && isChallenger && c.escalator != nil {
+				if err := c.checkProvenChallenge(ctx, outputIndex); err != nil {
+					c.log.Error("challenger: failed to check proven challenge", "err", err, "outputIndex", outputIndex)
+				}
+				continue
+			}
+
 			// if the challenge is inactivated, terminate handling
 			if isInactivated(status) {
-				c.log.Error("challenge is not in progress", "challengeStatus", status)
+				c.log.Error("challenge is not in progress", "outputIndex", outputIndex, "challengeStatus", status)
 				return
 			}
 
 			// if asserter
 			if isAsserter && c.cfg.OutputSubmitterEnabled {
 				if status == chal.StatusAsserterTurn {
-					tx, err := c.Bisect(ctx, outputIndex)
-					if err != nil {
-						c.log.Error("asserter: failed to create bisect tx", "err", err, "outputIndex", outputIndex)
-						continue
-					}
-					if err := c.submitChallengeTx(ctx, tx); err != nil {
-						c.log.Error("asserter: failed to submit bisect tx", "err", err, "outputIndex", outputIndex)
+					if err := c.defend(ctx, outputIndex, challenge); err != nil {
+						c.log.Error("asserter: failed to defend output", "err", err, "outputIndex", outputIndex)
 						continue
 					}
 				}
@@ -434,29 +818,189 @@ func (c *Challenger) handleChallenge(ctx context.Context, outputIndex *big.Int)
 						c.log.Error("challenger: failed to create bisect tx", "err", err, "outputIndex", outputIndex)
 						continue
 					}
-					if err := c.submitChallengeTx(ctx, tx); err != nil {
+					if err := c.submitChallengeTx(ctx, tx, challengeDeadline(challenge)); err != nil {
 						c.log.Error("challenger: failed to submit bisect tx", "err", err, "outputIndex", outputIndex)
 						continue
 					}
 				case chal.StatusAsserterTimeout, chal.StatusReadyToProve:
+					if ch.publicInputUsed {
+						continue
+					}
 					skipSelectPosition := status == chal.StatusAsserterTimeout
-					tx, err := c.ProveFault(ctx, outputIndex, skipSelectPosition)
+					skipVerification := ch.verificationFailures >= maxProofVerificationAttempts
+					tx, blockNumber, err := c.proveFault(ctx, outputIndex, skipSelectPosition, skipVerification)
+					if errors.Is(err, chal.ErrProofPending) {
+						c.log.Info("challenger: waiting for proof", "outputIndex", outputIndex, "blockNumber", blockNumber)
+						continue
+					}
+					if errors.Is(err, chal.ErrProofDeadline) {
+						if !ch.proofDeadlineMissed {
+							ch.proofDeadlineMissed = true
+							c.log.Error("challenger: proof cannot be generated before the proving timeout, the challenge will be lost unless proven otherwise",
+								"err", err, "outputIndex", outputIndex, "blockNumber", blockNumber)
+							c.metr.RecordProofDeadlineMissed()
+							if c.escalator != nil {
+								if err := c.escalator.escalate(ctx, EscalationProofDeadline, outputIndex, err.Error()); err != nil {
+									c.log.Error("challenger: failed to escalate missed proof deadline", "err", err, "outputIndex", outputIndex)
+								}
+							}
+						}
+						continue
+					}
+					if errors.Is(err, errPublicInputVerified) {
+						ch.publicInputUsed = true
+						c.log.Error("challenger: public input of the fault has already been verified, the fault cannot be proven",
+							"err", err, "outputIndex", outputIndex, "blockNumber", blockNumber)
+						if c.escalator != nil {
+							if err := c.escalator.escalate(ctx, EscalationUsedPublicInput, outputIndex, err.Error()); err != nil {
+								c.log.Error("challenger: failed to escalate used public input", "err", err, "outputIndex", outputIndex)
+							}
+						}
+						continue
+					}
+					if errors.Is(err, errProofVerification) {
+						ch.verificationFailures++
+						c.log.Warn("challenger: failed to verify proof locally, retrying",
+							"err", err, "outputIndex", outputIndex, "blockNumber", blockNumber,
+							"attempts", ch.verificationFailures, "maxAttempts", maxProofVerificationAttempts)
+						continue
+					}
+					if errors.Is(err, errInvalidProof) {
+						ch.verificationFailures = 0
+						c.log.Error("challenger: proof failed local verification, regenerating it",
+							"err", err, "outputIndex", outputIndex, "blockNumber", blockNumber)
+						if c.proofQueue != nil {
+							if err := c.proofQueue.Remove(blockNumber); err != nil {
+								c.log.Warn("challenger: failed to remove proof job", "err", err, "blockNumber", blockNumber)
+							}
+						}
+						continue
+					}
 					if err != nil {
 						c.log.Error("challenger: failed to create prove fault tx", "err", err, "outputIndex", outputIndex)
 						continue
 					}
-					if err := c.submitChallengeTx(ctx, tx); err != nil {
+					// the proving timeout is stored once ready to prove, but not after the asserter timed out.
+					var deadline time.Time
+					if status == chal.StatusReadyToProve {
+						deadline = challengeDeadline(challenge)
+					}
+					if err := c.submitChallengeTx(ctx, tx, deadline); err != nil {
 						c.log.Error("challenger: failed to submit prove fault tx", "err", err, "outputIndex", outputIndex)
 						continue
 					}
+					if c.proofQueue != nil {
+						if err := c.proofQueue.Remove(blockNumber); err != nil {
+							c.log.Warn("challenger: failed to remove proof job", "err", err, "blockNumber", blockNumber)
+						}
+					}
 				}
 			}
 		}
 	}
 }
 
-func (c *Challenger) submitChallengeTx(ctx context.Context, tx *types.Transaction) error {
-	return c.cfg.TxManager.SendTransaction(ctx, tx).Err
+// checkProvenChallenge escalates the proven challenge on the given output to the Security Council
+// if it is not approved yet while the output is about to be finalized, since the invalid output
+// could not be rolled back anymore.
+func (c *Challenger) checkProvenChallenge(ctx context.Context, outputIndex *big.Int) error {
+	output, err := c.l2ooContract.GetL2Output(c.callOpts, outputIndex)
+	if err != nil {
+		return fmt.Errorf("failed to get output: %w", err)
+	}
+	finalizesAt := time.Unix(new(big.Int).Add(output.Timestamp, c.finalizationPeriodSeconds).Int64(), 0)
+	if time.Until(finalizesAt) > c.cfg.CouncilEscalationMargin {
+		return nil
+	}
+	reason := fmt.Sprintf("challenge is not approved and the output is finalized at %s", finalizesAt.UTC().Format(time.RFC3339))
+	return c.escalator.escalate(ctx, EscalationForcedRollback, outputIndex, reason)
+}
+
+// submitChallengeTx sends the challenge tx directly instead of through the tx buffer,
+// so that a pending tx of one challenge does not hold back the txs of the other challenges.
+// In dry run mode, the tx is only logged. If the deadline is not zero, the fees are escalated as it approaches.
+func (c *Challenger) submitChallengeTx(ctx context.Context, tx *types.Transaction, deadline time.Time) error {
+	return c.sendChallengeTx(ctx, tx, 0, deadline)
+}
+
+// challengeDeadline returns the time by which the party of the current turn of the challenge must act.
+func challengeDeadline(challenge bindings.TypesChallenge) time.Time {
+	return time.Unix(int64(challenge.TimeoutAt), 0)
+}
+
+// sendChallengeTx is submitChallengeTx, bumping the fees of the tx every resubmissionTimeout
+// if it is not 0, or every resubmission timeout of the tx manager otherwise.
+func (c *Challenger) sendChallengeTx(ctx context.Context, tx *types.Transaction, resubmissionTimeout time.Duration, deadline time.Time) error {
+	if c.paused.Load() {
+		return errChallengerPaused
+	}
+
+	if c.cfg.ChallengerDryRun {
+		method := "unknown"
+		if m, err := c.colosseumABI.MethodById(tx.Data()); err == nil {
+			method = m.Name
+		}
+		c.log.Info("dry run: skip sending tx", "method", method, "to", tx.To(), "data", hexutil.Bytes(tx.Data()))
+		return nil
+	}
+
+	_, err := c.cfg.TxManager.Send(ctx, txmgr.TxCandidate{
+		TxData:              tx.Data(),
+		To:                  tx.To(),
+		GasLimit:            0,
+		ResubmissionTimeout: resubmissionTimeout,
+		Deadline:            deadline,
+	})
+	return err
+}
+
+// Pause stops sending challenge txs until Resume is called. Outputs are still validated,
+// and proofs are still generated, so that the challenges can be progressed right after resuming.
+func (c *Challenger) Pause() {
+	if !c.paused.Swap(true) {
+		c.log.Info("pausing challenge txs")
+	}
+}
+
+// Resume resumes sending challenge txs after Pause.
+func (c *Challenger) Resume() {
+	if c.paused.Swap(false) {
+		c.log.Info("resuming challenge txs")
+	}
+}
+
+// Paused returns true if sending challenge txs is paused.
+func (c *Challenger) Paused() bool {
+	return c.paused.Load()
+}
+
+// Escalations returns the escalations of the challenges to the Security Council.
+func (c *Challenger) Escalations() []*Escalation {
+	if c.escalator == nil {
+		return nil
+	}
+	return c.escalator.Escalations()
+}
+
+// ConfirmEscalation submits the pending escalation of the given output to the Security Council.
+func (c *Challenger) ConfirmEscalation(ctx context.Context, outputIndex uint64) error {
+	if c.escalator == nil {
+		return errCouncilEscalationDisabled
+	}
+	return c.escalator.Confirm(ctx, outputIndex)
+}
+
+// RejectEscalation drops the pending escalation of the given output.
+func (c *Challenger) RejectEscalation(outputIndex uint64) error {
+	if c.escalator == nil {
+		return errCouncilEscalationDisabled
+	}
+	return c.escalator.Reject(outputIndex)
+}
+
+// ActiveChallenges returns the output indexes of the challenges being handled.
+func (c *Challenger) ActiveChallenges() []uint64 {
+	return c.challenges.outputIndexes()
 }
 
 func (c *Challenger) isOutputFinalized(outputIndex *big.Int) (bool, error) {
@@ -472,9 +1016,7 @@ func (c *Challenger) GetChallenge(outputIndex *big.Int) (bindings.TypesChallenge
 }
 
 func (c *Challenger) OutputAtBlockSafe(ctx context.Context, blockNumber uint64) (*eth.OutputResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.cfg.NetworkTimeout)
-	defer cancel()
-	return c.cfg.RollupClient.OutputAtBlock(ctx, blockNumber)
+	return c.outputs.OutputAtBlock(ctx, blockNumber)
 }
 
 func (c *Challenger) OutputWithProofAtBlockSafe(ctx context.Context, blockNumber uint64) (*eth.OutputResponse, error) {
@@ -484,46 +1026,7 @@ func (c *Challenger) OutputWithProofAtBlockSafe(ctx context.Context, blockNumber
 }
 
 func (c *Challenger) PublicInputProof(ctx context.Context, blockNumber uint64) (bindings.TypesPublicInputProof, error) {
-	srcOutput, err := c.OutputWithProofAtBlockSafe(ctx, blockNumber)
-	if err != nil {
-		return bindings.TypesPublicInputProof{}, err
-	}
-
-	dstOutput, err := c.OutputWithProofAtBlockSafe(ctx, blockNumber+1)
-	if err != nil {
-		return bindings.TypesPublicInputProof{}, err
-	}
-
-	publicInput, err := srcOutput.ToPublicInput()
-	if err != nil {
-		return bindings.TypesPublicInputProof{}, err
-	}
-
-	rlp, err := srcOutput.ToBlockHeaderRLP()
-	if err != nil {
-		return bindings.TypesPublicInputProof{}, err
-	}
-
-	p := dstOutput.PublicInputProof
-
-	var balance [32]byte
-	copy(balance[:], common.BigToHash(p.L2ToL1MessagePasserBalance).Bytes())
-
-	// TODO(chokobole): Do we need to deep copy of this?
-	merkleProof := make([][]byte, len(p.MerkleProof))
-	for i, b := range p.MerkleProof {
-		merkleProof[i] = b
-	}
-
-	return bindings.TypesPublicInputProof{
-		SrcOutputRootProof:          srcOutput.ToOutputRootProof(),
-		DstOutputRootProof:          dstOutput.ToOutputRootProof(),
-		PublicInput:                 publicInput,
-		Rlps:                        rlp,
-		L2ToL1MessagePasserBalance:  balance,
-		L2ToL1MessagePasserCodeHash: p.L2ToL1MessagePasserCodeHash,
-		MerkleProof:                 merkleProof,
-	}, nil
+	return publicinput.Fetch(ctx, publicinput.OutputSourceFunc(c.OutputWithProofAtBlockSafe), blockNumber)
 }
 
 type Outputs struct {
@@ -601,12 +1104,11 @@ func (c *Challenger) BuildSegments(ctx context.Context, turn uint8, segStart, se
 
 	segments := chal.NewEmptySegments(segStart, segSize, sections.Uint64())
 
-	for i, blockNumber := range segments.BlockNumbers() {
-		output, err := c.OutputAtBlockSafe(ctx, blockNumber)
-		if err != nil {
-			return nil, fmt.Errorf("unable to get output %d: %w", blockNumber, err)
-		}
-
+	outputs, err := c.outputs.OutputsAtBlocks(ctx, segments.BlockNumbers())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get outputs of segments: %w", err)
+	}
+	for i, output := range outputs {
 		segments.SetHashValue(i, output.OutputRoot)
 	}
 
@@ -614,18 +1116,22 @@ func (c *Challenger) BuildSegments(ctx context.Context, turn uint8, segStart, se
 }
 
 func (c *Challenger) selectFaultPosition(ctx context.Context, segments *chal.Segments) (*big.Int, error) {
-	for i, blockNumber := range segments.BlockNumbers() {
-		output, err := c.OutputAtBlockSafe(ctx, blockNumber)
-		if err != nil {
-			return nil, err
-		}
+	outputs, err := c.outputs.OutputsAtBlocks(ctx, segments.BlockNumbers())
+	if err != nil {
+		return nil, err
+	}
 
-		if !bytes.Equal(segments.Hashes[i][:], output.OutputRoot[:]) {
-			return big.NewInt(int64(i) - 1), nil
-		}
+	local := make([]chal.Hash, len(outputs))
+	for i, output := range outputs {
+		local[i] = output.OutputRoot
 	}
 
-	return nil, errors.New("failed to select fault position")
+	position, err := c.strategy.SelectFaultPosition(segments, local)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select fault position: %w", err)
+	}
+
+	return new(big.Int).SetUint64(position), nil
 }
 
 func (c *Challenger) CreateChallenge(ctx context.Context, outputRange *OutputRange) (*types.Transaction, error) {
@@ -676,18 +1182,25 @@ func (c *Challenger) ChallengerTimeout(ctx context.Context, outputIndex *big.Int
 }
 
 // ProveFault creates proveFault transaction for invalid output root
-// TODO: ProveFault will take long time, so that we may have to handle it carefully
 func (c *Challenger) ProveFault(ctx context.Context, outputIndex *big.Int, skipSelectPosition bool) (*types.Transaction, error) {
+	tx, _, err := c.proveFault(ctx, outputIndex, skipSelectPosition, false)
+	return tx, err
+}
+
+// proveFault creates proveFault transaction for invalid output root, and returns the block number of the proof.
+// Since generating the proof takes long, it returns chal.ErrProofPending until the proof is ready.
+// If skipVerification is set, the proof is not verified locally before the tx is created.
+func (c *Challenger) proveFault(ctx context.Context, outputIndex *big.Int, skipSelectPosition bool, skipVerification bool) (*types.Transaction, uint64, error) {
 	c.log.Info("crafting proveFault tx")
 
 	outputs, err := c.outputsAtIndex(ctx, outputIndex)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	challenge, err := c.colosseumContract.GetChallenge(c.callOpts, outputIndex)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// When asserter timeout, skip finding fault position since the same segments have been stored in colosseum.
@@ -697,24 +1210,36 @@ func (c *Challenger) ProveFault(ctx context.Context, outputIndex *big.Int, skipS
 		segments := chal.NewSegments(challenge.SegStart.Uint64(), challenge.SegSize.Uint64(), challenge.Segments)
 		position, err = c.selectFaultPosition(ctx, segments)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		blockNumber = challenge.SegStart.Uint64() + position.Uint64()
 	}
 
-	fetchResult, err := c.cfg.ProofFetcher.FetchProofAndPair(blockNumber + 1)
+	// When ready to prove, the proof must be submitted before the proving timeout of the challenge.
+	var deadline time.Time
+	if !skipSelectPosition {
+		deadline = time.Unix(int64(challenge.TimeoutAt), 0)
+	}
+	fetchResult, err := c.fetchProofAndPair(blockNumber+1, deadline)
 	if err != nil {
-		return nil, fmt.Errorf("%w: blockNumber: %d", err, blockNumber)
+		return nil, blockNumber + 1, fmt.Errorf("%w: blockNumber: %d", err, blockNumber)
 	}
 
 	proof, err := c.PublicInputProof(ctx, blockNumber)
 	if err != nil {
-		return nil, err
+		return nil, blockNumber + 1, err
+	}
+
+	if skipVerification {
+		c.log.Warn("submitting proof without local verification", "outputIndex", outputIndex, "blockNumber", blockNumber+1)
+		c.metr.RecordProofVerification("unverified")
+	} else if err := c.verifyProof(ctx, proof, fetchResult); err != nil {
+		return nil, blockNumber + 1, err
 	}
 
 	txOpts := utils.NewSimpleTxOpts(ctx, c.cfg.TxManager.From(), c.cfg.TxManager.Signer)
-	return c.colosseumContract.ProveFault(
+	tx, err := c.colosseumContract.ProveFault(
 		txOpts,
 		outputIndex,
 		outputs.localOutput.OutputRoot,
@@ -725,6 +1250,118 @@ func (c *Challenger) ProveFault(ctx context.Context, outputIndex *big.Int, skipS
 		// It can be calculated using public input sent to colosseum contract.
 		fetchResult.Pair[:4],
 	)
+	return tx, blockNumber + 1, err
+}
+
+// verifyProof checks the proof locally before it is submitted, instead of wasting the proving timeout and
+// the gas of a reverted tx: the public input is checked the same way the Colosseum contract does, and the zk proof
+// is verified by the zk verifier unless the verification is skipped. errInvalidProof is returned if the zk proof
+// is rejected, so that it can be regenerated, and errPublicInputVerified if the public input cannot be used anymore.
+// errProofVerification is returned if the checks cannot be done, so that the caller can limit the retries.
+func (c *Challenger) verifyProof(ctx context.Context, proof bindings.TypesPublicInputProof, fetchResult *chal.ProofAndPair) error {
+	if err := publicinput.Validate(proof); err != nil {
+		c.metr.RecordProofVerification("invalid_public_input")
+		return fmt.Errorf("invalid public input: %w", err)
+	}
+
+	cCtx, cCancel := context.WithTimeout(ctx, c.cfg.NetworkTimeout)
+	defer cCancel()
+	opts := utils.NewSimpleCallOpts(cCtx)
+
+	publicInputHash := publicinput.Hash(proof.SrcOutputRootProof.StateRoot, proof.PublicInput, c.dummyHash, c.maxTxs)
+	verified, err := c.colosseumContract.VerifiedPublicInputs(opts, publicInputHash)
+	if err != nil {
+		c.metr.RecordProofVerification("failed")
+		return fmt.Errorf("%w: failed to check public input %s: %v", errProofVerification, publicInputHash, err)
+	}
+	if verified {
+		c.metr.RecordProofVerification("used_public_input")
+		return fmt.Errorf("%w: %s", errPublicInputVerified, publicInputHash)
+	}
+
+	if c.cfg.SkipProofVerification {
+		c.metr.RecordProofVerification("skipped")
+		return nil
+	}
+	if len(fetchResult.Pair) < 4 {
+		c.metr.RecordProofVerification("invalid_proof")
+		return fmt.Errorf("%w: pair has %d elements, expected at least 4", errInvalidProof, len(fetchResult.Pair))
+	}
+	valid, err := c.zkVerifier.Verify(opts, fetchResult.Proof, fetchResult.Pair[:4])
+	if err != nil {
+		c.metr.RecordProofVerification("failed")
+		return fmt.Errorf("%w: %v", errProofVerification, err)
+	}
+	if !valid {
+		c.metr.RecordProofVerification("invalid_proof")
+		return fmt.Errorf("%w: rejected by the zk verifier", errInvalidProof)
+	}
+	c.metr.RecordProofVerification("valid")
+	return nil
+}
+
+// simulateChallenge runs the bisection and the proof generation of a challenge on the given output
+// locally, as in dry run mode the challenge is never created. Since the segments of the asserter are
+// unknown, it is assumed that the asserter agrees on every segment but the invalid output, so that
+// the fault is narrowed down to the last block of the output.
+func (c *Challenger) simulateChallenge(ctx context.Context, outputRange *OutputRange) error {
+	start, size := outputRange.StartBlock, outputRange.EndBlock-outputRange.StartBlock
+	for turn := uint8(1); ; turn++ {
+		segments, err := c.BuildSegments(ctx, turn, start, size)
+		if err != nil {
+			return err
+		}
+
+		claimed := chal.NewSegments(start, size, append([]chal.Hash(nil), segments.Hashes...))
+		claimed.Hashes[len(claimed.Hashes)-1][0] ^= 0xff
+		position, err := c.strategy.SelectFaultPosition(claimed, segments.Hashes)
+		if err != nil {
+			return err
+		}
+
+		if claimed.Degree <= 1 {
+			blockNumber := start + position
+			c.log.Info("dry run: ready to prove fault", "outputIndex", outputRange.OutputIndex,
+				"turn", turn, "position", position, "blockNumber", blockNumber+1)
+			return c.simulateProveFault(ctx, outputRange.OutputIndex, blockNumber)
+		}
+
+		start, size = claimed.NextSegmentsRange(position)
+		c.log.Info("dry run: bisect", "outputIndex", outputRange.OutputIndex,
+			"turn", turn, "position", position, "segStart", start, "segSize", size)
+	}
+}
+
+// simulateProveFault generates the proof of the given block locally, waiting for the proof queue if needed.
+func (c *Challenger) simulateProveFault(ctx context.Context, outputIndex *big.Int, blockNumber uint64) error {
+	ticker := time.NewTicker(c.cfg.ChallengerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		fetchResult, err := c.fetchProofAndPair(blockNumber+1, time.Time{})
+		if err == nil {
+			if _, err := c.PublicInputProof(ctx, blockNumber); err != nil {
+				return err
+			}
+			c.log.Info("dry run: skip sending prove fault tx", "outputIndex", outputIndex,
+				"blockNumber", blockNumber+1, "proofLen", len(fetchResult.Proof))
+			if c.proofQueue != nil {
+				if err := c.proofQueue.Remove(blockNumber + 1); err != nil {
+					c.log.Warn("dry run: failed to remove proof job", "err", err, "blockNumber", blockNumber+1)
+				}
+			}
+			return nil
+		}
+		if !errors.Is(err, chal.ErrProofPending) {
+			return err
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
 }
 
 // isInactivated checks if the challenge is inactivated.
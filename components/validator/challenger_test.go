@@ -0,0 +1,229 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/node/testlog"
+	chal "github.com/kroma-network/kroma/components/validator/challenge"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+)
+
+var (
+	testL2OOAddr      = common.HexToAddress("0xa1")
+	testColosseumAddr = common.HexToAddress("0xa2")
+	testValidatorAddr = common.HexToAddress("0xb1")
+	testOtherAddr     = common.HexToAddress("0xb2")
+)
+
+// testChallengeBackend serves the output finalization of the L2OutputOracle and the challenges of the Colosseum.
+type testChallengeBackend struct {
+	bind.ContractBackend
+
+	l2ooABI      *abi.ABI
+	colosseumABI *abi.ABI
+
+	mu sync.Mutex
+	// finalizedUntil is the index of the first output that is not finalized.
+	finalizedUntil uint64
+	challenges     map[uint64]bindings.TypesChallenge
+	statuses       map[uint64]uint8
+	finalizedErr   error
+}
+
+func newTestChallengeBackend(t *testing.T) *testChallengeBackend {
+	l2ooABI, err := bindings.L2OutputOracleMetaData.GetAbi()
+	require.NoError(t, err)
+	colosseumABI, err := bindings.ColosseumMetaData.GetAbi()
+	require.NoError(t, err)
+	return &testChallengeBackend{
+		l2ooABI:      l2ooABI,
+		colosseumABI: colosseumABI,
+		challenges:   make(map[uint64]bindings.TypesChallenge),
+		statuses:     make(map[uint64]uint8),
+	}
+}
+
+func (b *testChallengeBackend) setChallenge(outputIndex uint64, asserter, challenger common.Address, status uint8) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.challenges[outputIndex] = bindings.TypesChallenge{
+		Asserter:   asserter,
+		Challenger: challenger,
+		SegSize:    common.Big0,
+		SegStart:   common.Big0,
+	}
+	b.statuses[outputIndex] = status
+}
+
+func (b *testChallengeBackend) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (b *testChallengeBackend) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	contractABI := b.colosseumABI
+	if *call.To == testL2OOAddr {
+		contractABI = b.l2ooABI
+	}
+	method, err := contractABI.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	outputIndex := args[0].(*big.Int).Uint64()
+
+	switch method.Name {
+	case "isFinalized":
+		if b.finalizedErr != nil {
+			return nil, b.finalizedErr
+		}
+		return method.Outputs.Pack(outputIndex < b.finalizedUntil)
+	case "getStatus":
+		return method.Outputs.Pack(b.statuses[outputIndex])
+	case "getChallenge":
+		challenge, ok := b.challenges[outputIndex]
+		if !ok {
+			challenge = bindings.TypesChallenge{SegSize: common.Big0, SegStart: common.Big0}
+		}
+		return method.Outputs.Pack(challenge)
+	default:
+		return nil, errors.New("unexpected call: " + method.Name)
+	}
+}
+
+func newTestChallenger(t *testing.T, backend *testChallengeBackend) *Challenger {
+	l2oo, err := bindings.NewL2OutputOracle(testL2OOAddr, backend)
+	require.NoError(t, err)
+	colosseum, err := bindings.NewColosseum(testColosseumAddr, backend)
+	require.NoError(t, err)
+	less, err := newChallengeLess(ChallengePriorityBond)
+	require.NoError(t, err)
+
+	txMgr := &txmgr.BufferedTxManager{}
+	txMgr.Config.From = testValidatorAddr
+	return &Challenger{
+		log: testlog.Logger(t, log.LvlError),
+		cfg: Config{
+			TxManager:              txMgr,
+			ChallengerPollInterval: 10 * time.Millisecond,
+		},
+		metr:              metrics.NoopMetrics,
+		callOpts:          &bind.CallOpts{},
+		l2ooContract:      l2oo,
+		colosseumContract: colosseum,
+		colosseumABI:      backend.colosseumABI,
+		challenges:        newChallengeTracker(),
+		scheduler:         newChallengeScheduler(less, 1),
+	}
+}
+
+func TestFirstUnfinalizedOutputIndex(t *testing.T) {
+	backend := newTestChallengeBackend(t)
+	c := newTestChallenger(t, backend)
+
+	backend.finalizedUntil = 4
+	index, err := c.firstUnfinalizedOutputIndex(10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), index)
+
+	backend.finalizedUntil = 0
+	index, err = c.firstUnfinalizedOutputIndex(10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), index)
+
+	backend.finalizedUntil = 10
+	index, err = c.firstUnfinalizedOutputIndex(10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), index, "all the outputs are finalized")
+
+	backend.finalizedErr = errors.New("boom")
+	_, err = c.firstUnfinalizedOutputIndex(10)
+	require.Error(t, err)
+}
+
+func TestRecoverChallenges(t *testing.T) {
+	backend := newTestChallengeBackend(t)
+	backend.finalizedUntil = 4
+	// the output is finalized, so the challenge cannot be in progress anymore.
+	backend.setChallenge(2, testValidatorAddr, testOtherAddr, chal.StatusAsserterTurn)
+	backend.setChallenge(5, testValidatorAddr, testOtherAddr, chal.StatusAsserterTurn)
+	backend.setChallenge(6, testOtherAddr, testValidatorAddr, chal.StatusChallengerTimeout)
+	backend.setChallenge(7, testOtherAddr, testValidatorAddr, chal.StatusChallengerTurn)
+	// the validator is not party to the challenge.
+	backend.setChallenge(8, testOtherAddr, common.HexToAddress("0xb3"), chal.StatusChallengerTurn)
+
+	c := newTestChallenger(t, backend)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		c.wg.Wait()
+	}()
+
+	require.NoError(t, c.recoverChallenges(ctx, big.NewInt(10)))
+	require.Equal(t, []uint64{5, 7}, c.challenges.outputIndexes(), "scanning starts at the first unfinalized output")
+	held := func(outputIndex uint64) bool {
+		c.scheduler.mu.Lock()
+		defer c.scheduler.mu.Unlock()
+		_, ok := c.scheduler.active[outputIndex]
+		return ok
+	}
+	require.True(t, held(7), "challenge created by the validator holds a slot")
+	require.False(t, held(5))
+
+	// recovering again, e.g. on a restart of the challenger loop, does not handle a challenge twice.
+	require.NoError(t, c.recoverChallenges(ctx, big.NewInt(10)))
+	require.Equal(t, []uint64{5, 7}, c.challenges.outputIndexes())
+	require.Equal(t, 2, c.challenges.len())
+}
+
+func TestRecoverChallenges_Genesis(t *testing.T) {
+	backend := newTestChallengeBackend(t)
+	backend.setChallenge(0, testValidatorAddr, testOtherAddr, chal.StatusAsserterTurn)
+	backend.setChallenge(1, testValidatorAddr, testOtherAddr, chal.StatusAsserterTurn)
+
+	c := newTestChallenger(t, backend)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		c.wg.Wait()
+	}()
+
+	require.NoError(t, c.recoverChallenges(ctx, big.NewInt(2)))
+	require.Equal(t, []uint64{1}, c.challenges.outputIndexes(), "genesis output cannot be challenged")
+}
+
+func TestSendChallengeTx_DryRun(t *testing.T) {
+	backend := newTestChallengeBackend(t)
+	c := newTestChallenger(t, backend)
+	c.cfg.ChallengerDryRun = true
+
+	data, err := backend.colosseumABI.Pack("bisect", big.NewInt(5), big.NewInt(1), [][32]byte{{0x1}, {0x2}})
+	require.NoError(t, err)
+	tx := types.NewTx(&types.DynamicFeeTx{To: &testColosseumAddr, Data: data})
+
+	// the tx manager has no backend, so sending the tx would fail.
+	require.NoError(t, c.sendChallengeTx(context.Background(), tx, 0, time.Now().Add(time.Hour)))
+
+	c.Pause()
+	require.ErrorIs(t, c.sendChallengeTx(context.Background(), tx, 0, time.Now().Add(time.Hour)), errChallengerPaused)
+}
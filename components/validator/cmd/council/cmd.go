@@ -0,0 +1,287 @@
+package council
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli"
+
+	"github.com/kroma-network/kroma/components/validator/council"
+	"github.com/kroma-network/kroma/components/validator/flags"
+	"github.com/kroma-network/kroma/utils"
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+	"github.com/kroma-network/kroma/utils/service/txmgr/metrics"
+)
+
+var (
+	transactionIdFlag = cli.Uint64Flag{
+		Name:     "transaction-id",
+		Usage:    "Id of the SecurityCouncil transaction",
+		Required: true,
+	}
+	waitFlag = cli.BoolFlag{
+		Name:  "wait",
+		Usage: "Wait until the transaction has enough confirmations",
+	}
+	pollIntervalFlag = cli.DurationFlag{
+		Name:  "poll-interval",
+		Usage: "Interval to poll the confirmations of the transaction when waiting",
+		Value: 12 * time.Second,
+	}
+)
+
+// Commands returns the subcommands to manage the SecurityCouncil transactions as one of its owners.
+func Commands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "submit-upgrade",
+			Usage: "Submit a transaction upgrading the implementation of a proxy",
+			Flags: []cli.Flag{
+				cli.StringFlag{Name: "proxy", Usage: "Address of the proxy", Required: true},
+				cli.StringFlag{Name: "implementation", Usage: "Address of the new implementation", Required: true},
+				cli.StringFlag{Name: "data", Usage: "Hex encoded calldata to call the new implementation with"},
+				waitFlag, pollIntervalFlag,
+			},
+			Action: SubmitUpgrade,
+		},
+		{
+			Name:  "submit-add-owner",
+			Usage: "Submit a transaction adding an owner",
+			Flags: []cli.Flag{
+				cli.StringFlag{Name: "owner", Usage: "Address of the owner to add", Required: true},
+				waitFlag, pollIntervalFlag,
+			},
+			Action: SubmitAddOwner,
+		},
+		{
+			Name:  "submit-remove-owner",
+			Usage: "Submit a transaction removing an owner",
+			Flags: []cli.Flag{
+				cli.StringFlag{Name: "owner", Usage: "Address of the owner to remove", Required: true},
+				waitFlag, pollIntervalFlag,
+			},
+			Action: SubmitRemoveOwner,
+		},
+		{
+			Name:  "submit-change-requirement",
+			Usage: "Submit a transaction changing the number of confirmations required",
+			Flags: []cli.Flag{
+				cli.Uint64Flag{Name: "required", Usage: "Number of confirmations required", Required: true},
+				waitFlag, pollIntervalFlag,
+			},
+			Action: SubmitChangeRequirement,
+		},
+		{
+			Name:   "confirm",
+			Usage:  "Confirm a transaction, e.g. a validation request of the Colosseum",
+			Flags:  []cli.Flag{transactionIdFlag, waitFlag, pollIntervalFlag},
+			Action: Confirm,
+		},
+		{
+			Name:   "revoke",
+			Usage:  "Revoke the confirmation of a transaction",
+			Flags:  []cli.Flag{transactionIdFlag},
+			Action: Revoke,
+		},
+		{
+			Name:   "execute",
+			Usage:  "Execute a confirmed transaction",
+			Flags:  []cli.Flag{transactionIdFlag},
+			Action: Execute,
+		},
+		{
+			Name:   "status",
+			Usage:  "Show the status of a transaction",
+			Flags:  []cli.Flag{transactionIdFlag},
+			Action: Status,
+		},
+	}
+}
+
+func SubmitUpgrade(ctx *cli.Context) error {
+	proxy, err := utils.ParseAddress(ctx.String("proxy"))
+	if err != nil {
+		return fmt.Errorf("failed to parse proxy address: %w", err)
+	}
+	implementation, err := utils.ParseAddress(ctx.String("implementation"))
+	if err != nil {
+		return fmt.Errorf("failed to parse implementation address: %w", err)
+	}
+
+	return submit(ctx, func(b *council.Builder) (*council.Call, error) {
+		if ctx.String("data") == "" {
+			return b.UpgradeTo(proxy, implementation)
+		}
+		data, err := hexutil.Decode(ctx.String("data"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse calldata: %w", err)
+		}
+		return b.UpgradeToAndCall(proxy, implementation, data)
+	})
+}
+
+func SubmitAddOwner(ctx *cli.Context) error {
+	owner, err := utils.ParseAddress(ctx.String("owner"))
+	if err != nil {
+		return fmt.Errorf("failed to parse owner address: %w", err)
+	}
+
+	return submit(ctx, func(b *council.Builder) (*council.Call, error) {
+		return b.AddOwner(owner)
+	})
+}
+
+func SubmitRemoveOwner(ctx *cli.Context) error {
+	owner, err := utils.ParseAddress(ctx.String("owner"))
+	if err != nil {
+		return fmt.Errorf("failed to parse owner address: %w", err)
+	}
+
+	return submit(ctx, func(b *council.Builder) (*council.Call, error) {
+		return b.RemoveOwner(owner)
+	})
+}
+
+func SubmitChangeRequirement(ctx *cli.Context) error {
+	required := new(big.Int).SetUint64(ctx.Uint64("required"))
+
+	return submit(ctx, func(b *council.Builder) (*council.Call, error) {
+		return b.ChangeRequirement(required)
+	})
+}
+
+func Confirm(ctx *cli.Context) error {
+	client, err := newClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	transactionId := new(big.Int).SetUint64(ctx.Uint64(transactionIdFlag.Name))
+	if err := client.Confirm(context.Background(), transactionId); err != nil {
+		return err
+	}
+
+	return maybeWait(ctx, client, transactionId)
+}
+
+func Revoke(ctx *cli.Context) error {
+	client, err := newClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	return client.Revoke(context.Background(), new(big.Int).SetUint64(ctx.Uint64(transactionIdFlag.Name)))
+}
+
+func Execute(ctx *cli.Context) error {
+	client, err := newClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	return client.Execute(context.Background(), new(big.Int).SetUint64(ctx.Uint64(transactionIdFlag.Name)))
+}
+
+func Status(ctx *cli.Context) error {
+	client, err := newClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	status, err := client.Status(context.Background(), new(big.Int).SetUint64(ctx.Uint64(transactionIdFlag.Name)))
+	if err != nil {
+		return err
+	}
+
+	printStatus(status)
+	return nil
+}
+
+func submit(ctx *cli.Context, build func(b *council.Builder) (*council.Call, error)) error {
+	councilAddr, err := parseCouncilAddress(ctx)
+	if err != nil {
+		return err
+	}
+
+	builder, err := council.NewBuilder(councilAddr)
+	if err != nil {
+		return fmt.Errorf("failed to create transaction builder: %w", err)
+	}
+
+	call, err := build(builder)
+	if err != nil {
+		return err
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	transactionId, err := client.Submit(context.Background(), call)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("submitted transaction %d\n", transactionId)
+
+	return maybeWait(ctx, client, transactionId)
+}
+
+func maybeWait(ctx *cli.Context, client *council.Client, transactionId *big.Int) error {
+	if !ctx.Bool(waitFlag.Name) {
+		return nil
+	}
+
+	status, err := client.WaitConfirmed(context.Background(), transactionId, ctx.Duration(pollIntervalFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	printStatus(status)
+	return nil
+}
+
+func printStatus(status *council.TxStatus) {
+	fmt.Printf("transaction:   %d\n", status.TransactionId)
+	fmt.Printf("destination:   %s\n", status.Call.Destination)
+	fmt.Printf("value:         %s\n", status.Call.Value)
+	fmt.Printf("data:          %s\n", hexutil.Encode(status.Call.Data))
+	fmt.Printf("executed:      %t\n", status.Executed)
+	fmt.Printf("confirmations: %d/%s\n", len(status.Confirmations), status.Required)
+	for _, owner := range status.Confirmations {
+		fmt.Printf("  - %s\n", owner)
+	}
+}
+
+func parseCouncilAddress(ctx *cli.Context) (common.Address, error) {
+	councilAddr, err := utils.ParseAddress(ctx.GlobalString(flags.SecurityCouncilAddressFlag.Name))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to parse SecurityCouncil address: %w", err)
+	}
+	return councilAddr, nil
+}
+
+func newClient(ctx *cli.Context) (*council.Client, error) {
+	councilAddr, err := parseCouncilAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txMgrConfig := txmgr.ReadCLIConfig(ctx)
+	txManager, err := txmgr.NewSimpleTxManager("validator-council", log.New(), &metrics.NoopTxMetrics{}, txMgrConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tx manager: %w", err)
+	}
+
+	l1Client, err := utils.DialEthClientWithTimeout(context.Background(), ctx.GlobalString(flags.L1EthRpcFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial L1: %w", err)
+	}
+
+	return council.NewClient(councilAddr, l1Client, txManager, txMgrConfig.NetworkTimeout, log.New())
+}
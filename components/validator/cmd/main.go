@@ -9,6 +9,7 @@ import (
 
 	"github.com/kroma-network/kroma/components/validator"
 	"github.com/kroma-network/kroma/components/validator/cmd/balance"
+	"github.com/kroma-network/kroma/components/validator/cmd/council"
 	"github.com/kroma-network/kroma/components/validator/flags"
 	klog "github.com/kroma-network/kroma/utils/service/log"
 )
@@ -59,6 +60,11 @@ func main() {
 			Usage:  "Attempt to unbond in ValidatorPool",
 			Action: balance.Unbond,
 		},
+		{
+			Name:        "council",
+			Usage:       "Manage SecurityCouncil transactions as one of its owners",
+			Subcommands: council.Commands(),
+		},
 	}
 
 	err := app.Run(os.Args)
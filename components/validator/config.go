@@ -3,6 +3,9 @@ package validator
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math/big"
+	"os"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -14,13 +17,15 @@ import (
 	"github.com/kroma-network/kroma/components/node/sources"
 	chal "github.com/kroma-network/kroma/components/validator/challenge"
 	"github.com/kroma-network/kroma/components/validator/flags"
+	"github.com/kroma-network/kroma/components/validator/indexer"
 	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/components/validator/rpc"
 	"github.com/kroma-network/kroma/utils"
 	klog "github.com/kroma-network/kroma/utils/service/log"
 	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
 	kpprof "github.com/kroma-network/kroma/utils/service/pprof"
-	krpc "github.com/kroma-network/kroma/utils/service/rpc"
 	"github.com/kroma-network/kroma/utils/service/txmgr"
+	ksigner "github.com/kroma-network/kroma/utils/signer/client"
 )
 
 // Config contains the well typed fields that are used to initialize the output submitter.
@@ -36,14 +41,77 @@ type Config struct {
 	L1Client                     *ethclient.Client
 	RollupClient                 *sources.RollupClient
 	RollupConfig                 *rollup.Config
+	OutputService                *OutputService
+	OutputCacheSize              int
 	AllowNonFinalized            bool
 	OutputSubmitterEnabled       bool
 	OutputSubmitterBondAmount    uint64
 	OutputSubmitterRetryInterval time.Duration
 	OutputSubmitterRoundBuffer   uint64
+	LeaderLockPath               string
+	LeaderLockTTL                time.Duration
+	InstanceID                   string
+	StandbyEnabled               bool
+	StandbyTakeoverDelay         time.Duration
 	ChallengerEnabled            bool
+	ChallengerDryRun             bool
 	GuardianEnabled              bool
-	ProofFetcher                 ProofFetcher
+	WatcherEnabled               bool
+	WatcherPollInterval          time.Duration
+	WatcherWebhookURL            string
+	SegmentStrategy              chal.SegmentStrategy
+	ChallengePriority            string
+	MaxActiveChallenges          uint64
+	DefenseResubmissionTimeout   time.Duration
+	DefenseRetryInterval         time.Duration
+	ProverBackend                chal.ProverBackend
+	ProverHealthCheckInterval    time.Duration
+	ProofQueueDir                string
+	ProofMaxAttempts             uint64
+	ProofRetryBackoff            time.Duration
+	ProofMaxRetryBackoff         time.Duration
+	ProofExpectedTime            time.Duration
+	SkipProofVerification        bool
+	BondTopUpEnabled             bool
+	BondTopUpInterval            time.Duration
+	BondTopUpSubmissions         uint64
+	BondTopUpCap                 *big.Int
+	FundingTxManager             txmgr.TxManager
+	RewardWithdrawalEnabled      bool
+	RewardWithdrawalInterval     time.Duration
+	RewardRecipient              common.Address
+	RewardRetainAmount           *big.Int
+	RewardWithdrawalThreshold    *big.Int
+	RewardMaxGasPrice            *big.Int
+	BalanceMonitorEnabled        bool
+	BalanceMonitorInterval       time.Duration
+	BalanceMonitorMinBalance     *big.Int
+	BalanceMonitorMinDeposit     *big.Int
+	BalanceMonitorWebhookURL     string
+	ExitEnabled                  bool
+	ExitPollInterval             time.Duration
+	IndexerEnabled               bool
+	IndexerDBPath                string
+	IndexerStartBlock            uint64
+	IndexerConfirmations         uint64
+	IndexerPollInterval          time.Duration
+	IndexerBatchSize             uint64
+	Indexer                      *indexer.Indexer
+	PenaltyMonitorEnabled        bool
+	PenaltyMonitorAddresses      []common.Address
+	PenaltyMonitorWebhookURL     string
+	FinalityTrackerEnabled       bool
+	FinalityTrackerPollInterval  time.Duration
+	CouncilEscalationEnabled     bool
+	CouncilEscalationAutoSubmit  bool
+	CouncilEscalationMargin      time.Duration
+	CouncilEscalationWebhookURL  string
+	ProfitEstimatorEnabled       bool
+	ProfitSkipUnprofitable       bool
+	ProfitSubmissionGas          uint64
+	ProfitGasPriceSamples        uint64
+	ProfitL2Client               *ethclient.Client
+	FaultInjection               FaultInjection
 }
 
 // Check ensures that the [Config] is valid.
@@ -51,6 +119,95 @@ func (c *Config) Check() error {
 	if err := c.RollupConfig.Check(); err != nil {
 		return err
 	}
+	if c.ChallengerEnabled && c.ProofMaxAttempts == 0 {
+		return errors.New("proof max attempts must not be 0")
+	}
+	if c.WatcherEnabled && c.WatcherPollInterval == 0 {
+		return errors.New("watcher poll interval must not be 0")
+	}
+	if c.StandbyEnabled && c.LeaderLockPath == "" {
+		return errors.New("standby requires the leader lock path")
+	}
+	if c.LeaderLockPath != "" && c.LeaderLockTTL == 0 {
+		return errors.New("leader lock ttl must not be 0")
+	}
+	if c.ChallengerDryRun {
+		if !c.ChallengerEnabled {
+			return errors.New("challenger dry run requires the challenger to be enabled")
+		}
+		if c.OutputSubmitterEnabled || c.GuardianEnabled || c.BondTopUpEnabled || c.RewardWithdrawalEnabled {
+			return errors.New("challenger dry run cannot be used with the components sending transactions: " +
+				"output submitter, guardian, bond top-up and reward withdrawal")
+		}
+	}
+	if c.BondTopUpEnabled {
+		if c.BondTopUpInterval == 0 {
+			return errors.New("bond top-up interval must not be 0")
+		}
+		if c.BondTopUpSubmissions == 0 {
+			return errors.New("bond top-up submissions must not be 0")
+		}
+		required := new(big.Int).Mul(
+			new(big.Int).SetUint64(c.OutputSubmitterBondAmount),
+			new(big.Int).SetUint64(c.BondTopUpSubmissions),
+		)
+		if c.BondTopUpCap == nil || c.BondTopUpCap.Cmp(required) < 0 {
+			return fmt.Errorf("bond top-up cap must be at least the bond amount of %d submissions (%s)",
+				c.BondTopUpSubmissions, required)
+		}
+	}
+	if c.RewardWithdrawalEnabled {
+		if c.RewardWithdrawalInterval == 0 {
+			return errors.New("reward withdrawal interval must not be 0")
+		}
+		if c.RewardRetainAmount == nil {
+			return errors.New("reward retain amount must be set")
+		}
+		if c.OutputSubmitterEnabled && c.RewardRetainAmount.Cmp(new(big.Int).SetUint64(c.OutputSubmitterBondAmount)) < 0 {
+			return fmt.Errorf("reward retain amount must be at least the bond amount (%d)", c.OutputSubmitterBondAmount)
+		}
+		// Otherwise, the bond manager would deposit what has just been withdrawn.
+		if c.BondTopUpEnabled && c.RewardRetainAmount.Cmp(c.BondTopUpCap) < 0 {
+			return fmt.Errorf("reward retain amount must be at least the bond top-up cap (%s)", c.BondTopUpCap)
+		}
+	}
+	if c.BalanceMonitorEnabled && c.BalanceMonitorInterval == 0 {
+		return errors.New("balance monitor interval must not be 0")
+	}
+	if c.ExitEnabled && c.BondTopUpEnabled {
+		return errExitWithBondTopUp
+	}
+	if c.ExitEnabled && c.ExitPollInterval == 0 {
+		return errors.New("exit poll interval must not be 0")
+	}
+	if c.FinalityTrackerEnabled && c.FinalityTrackerPollInterval == 0 {
+		return errors.New("finality tracker poll interval must not be 0")
+	}
+	if c.CouncilEscalationEnabled {
+		if !c.ChallengerEnabled {
+			return errors.New("council escalation requires the challenger to be enabled")
+		}
+		if c.SecurityCouncilAddr == (common.Address{}) {
+			return errors.New("council escalation requires the security council address")
+		}
+		if c.CouncilEscalationMargin == 0 {
+			return errors.New("council escalation margin must not be 0")
+		}
+	}
+	if c.ProfitEstimatorEnabled {
+		if !c.OutputSubmitterEnabled {
+			return errors.New("profit estimator requires the output submitter to be enabled")
+		}
+		if c.ProfitL2Client == nil {
+			return errors.New("profit estimator requires the L2 client")
+		}
+		if c.ProfitGasPriceSamples == 0 {
+			return errors.New("profit estimator gas price samples must not be 0")
+		}
+	}
+	if c.ProfitSkipUnprofitable && !c.ProfitEstimatorEnabled {
+		return errors.New("skipping unprofitable submissions requires the profit estimator to be enabled")
+	}
 	return nil
 }
 
@@ -79,9 +236,48 @@ type CLIConfig struct {
 	// ChallengerPollInterval is how frequently to poll L2 for new finalized outputs.
 	ChallengerPollInterval time.Duration
 
-	// ProverGrpc is the URL of prover grpc server.
+	// ProverBackend is the type of the prover backend: rpc, local, mock or pool.
+	ProverBackend string
+
+	// ProverGrpc is the URL of prover grpc server, used by the rpc prover backend.
 	ProverGrpc string
 
+	// ProverBinary is the path to the prover binary, used by the local prover backend.
+	ProverBinary string
+
+	// ProverEndpoints are the prover gRPC servers used by the pool prover backend, as <url>[=<capacity>].
+	ProverEndpoints []string
+
+	// ProverMockDir is the directory of the proof returned by the mock prover backend.
+	ProverMockDir string
+
+	// ProverHealthCheckInterval is how frequently to check the health of the prover backend.
+	ProverHealthCheckInterval time.Duration
+
+	// ProofQueueDir is the directory to persist the proof generation jobs in.
+	// If empty, the jobs are lost on restart.
+	ProofQueueDir string
+
+	// ProofMaxAttempts is the number of times to try generating a proof before giving up.
+	ProofMaxAttempts uint64
+
+	// ProofRetryBackoff is the delay before retrying to generate a proof, doubled on every attempt.
+	ProofRetryBackoff time.Duration
+
+	// ProofMaxRetryBackoff is the maximum delay between attempts to generate a proof.
+	ProofMaxRetryBackoff time.Duration
+
+	// ProofExpectedTime is how long generating a proof is expected to take. The proof generation
+	// is aborted and escalated if it cannot be done before the proving timeout.
+	ProofExpectedTime time.Duration
+
+	// SkipProofVerification can be set to true to skip verifying proofs with the zk verifier before
+	// submitting them, e.g. when the proofs are mocked. The public inputs are checked regardless.
+	SkipProofVerification bool
+
+	// OutputCacheSize is the number of outputs of finalized blocks to cache.
+	OutputCacheSize int
+
 	// AllowNonFinalized can be set to true to submit outputs
 	// for L2 blocks derived from non-finalized L1 data.
 	AllowNonFinalized bool
@@ -97,14 +293,195 @@ type CLIConfig struct {
 	// OutputSubmitterRoundBuffer is how many blocks before each round to start trying submission.
 	OutputSubmitterRoundBuffer uint64
 
+	// LeaderLockPath is the path of the leader lock file shared by the instances using the same key.
+	// If empty, the instance submits outputs without coordination.
+	LeaderLockPath string
+
+	// LeaderLockTTL is how long the leader lock is held after each renewal.
+	LeaderLockTTL time.Duration
+
+	// InstanceID identifies the instance in the leader lock. Defaults to the hostname and the pid.
+	InstanceID string
+
+	// StandbyEnabled can be set to true to run the instance as a hot standby, which takes over
+	// the submissions when the leader misses its round.
+	StandbyEnabled bool
+
+	// StandbyTakeoverDelay is how long the standby waits for the leader to submit a ready output
+	// before taking over.
+	StandbyTakeoverDelay time.Duration
+
 	ChallengerEnabled bool
 
+	// ChallengerDryRun can be set to true to run the challenger without sending any transaction,
+	// logging the transactions it would send instead.
+	ChallengerDryRun bool
+
 	GuardianEnabled bool
 
+	// WatcherEnabled can be set to true to validate every submitted output,
+	// alerting on mismatch without submitting outputs.
+	WatcherEnabled bool
+
+	// WatcherPollInterval is how frequently to retry validating an output
+	// until the local node has derived its block.
+	WatcherPollInterval time.Duration
+
+	// WatcherWebhookURL is the URL invalid outputs are posted to. If empty, the webhook is disabled.
+	WatcherWebhookURL string
+
 	FetchingProofTimeout time.Duration
 
+	// SegmentStrategy is the name of the strategy selecting the section to bisect into.
+	SegmentStrategy string
+
+	// ChallengePriority is the policy ordering the invalid outputs to challenge: oldest, finalization or bond.
+	ChallengePriority string
+
+	// MaxActiveChallenges is the maximum number of challenges created by the validator in progress at once.
+	// 0 means there is no limit.
+	MaxActiveChallenges uint64
+
+	// DefenseResubmissionTimeout is the interval to bump the fees of the txs defending an output of
+	// the validator. If 0, the resubmission timeout of the tx manager is used.
+	DefenseResubmissionTimeout time.Duration
+
+	// DefenseRetryInterval is the delay before retrying a failed defense tx. If 0, ChallengerPollInterval is used.
+	DefenseRetryInterval time.Duration
+
+	BondTopUpEnabled bool
+
+	// BondTopUpInterval is how frequently to check the deposit in the ValidatorPool.
+	BondTopUpInterval time.Duration
+
+	// BondTopUpSubmissions is the number of upcoming submissions the deposit should be able to bond.
+	BondTopUpSubmissions uint64
+
+	// BondTopUpCap is the deposit amount (in wei) to refill up to when topping up.
+	BondTopUpCap string
+
+	// BondTopUpFundingPrivateKey is the private key of the account funding the top-ups.
+	// If empty, the validator account deposits from its own balance.
+	BondTopUpFundingPrivateKey string
+
+	RewardWithdrawalEnabled bool
+
+	// RewardWithdrawalInterval is how frequently to check the rewards in the ValidatorPool.
+	RewardWithdrawalInterval time.Duration
+
+	// RewardRecipient is the address to send the withdrawn rewards to.
+	// If empty, the rewards are kept in the validator account.
+	RewardRecipient string
+
+	// RewardRetainAmount is the deposit amount (in wei) to keep in the ValidatorPool for bonding.
+	RewardRetainAmount string
+
+	// RewardWithdrawalThreshold is the minimum amount (in wei) to withdraw at once.
+	RewardWithdrawalThreshold string
+
+	// RewardMaxGasPrice is the gas price (in wei) above which the withdrawal is postponed.
+	// If empty, there is no limit.
+	RewardMaxGasPrice string
+
+	BalanceMonitorEnabled bool
+
+	// BalanceMonitorInterval is how frequently to check the balances of the validator.
+	BalanceMonitorInterval time.Duration
+
+	// BalanceMonitorMinBalance is the L1 balance (in wei) below which to alert. If empty, there is no alert.
+	BalanceMonitorMinBalance string
+
+	// BalanceMonitorMinDeposit is the deposit (in wei) in the ValidatorPool below which to alert.
+	// If empty, there is no alert.
+	BalanceMonitorMinDeposit string
+
+	// BalanceMonitorWebhookURL is the URL balance alerts are posted to. If empty, the webhook is disabled.
+	BalanceMonitorWebhookURL string
+
+	// ExitEnabled can be set to true to exit cleanly: stop submitting outputs, wait out the pending outputs
+	// and challenges, unbond and withdraw the whole balance from the ValidatorPool.
+	ExitEnabled bool
+
+	// ExitPollInterval is how frequently to check the progress of the exit.
+	ExitPollInterval time.Duration
+
+	// IndexerEnabled can be set to true to index the outputs, challenges and bonds from L1 in a local database.
+	IndexerEnabled bool
+
+	// IndexerDBPath is the directory of the indexer database. If empty, the records are kept in memory.
+	IndexerDBPath string
+
+	// IndexerStartBlock is the L1 block to start indexing from when the database is empty.
+	IndexerStartBlock uint64
+
+	// IndexerConfirmations is the number of L1 blocks on top of a block before it is indexed.
+	IndexerConfirmations uint64
+
+	// IndexerPollInterval is how frequently to index the new L1 blocks.
+	IndexerPollInterval time.Duration
+
+	// IndexerBatchSize is the maximum number of L1 blocks to fetch the logs of at once.
+	IndexerBatchSize uint64
+
+	// PenaltyMonitorEnabled can be set to true to report the penalties of the validator detected on chain.
+	PenaltyMonitorEnabled bool
+
+	// PenaltyMonitorAddresses are the addresses of other validators to report the penalties of.
+	PenaltyMonitorAddresses []string
+
+	// PenaltyMonitorWebhookURL is the URL penalties are posted to. If empty, the webhook is disabled.
+	PenaltyMonitorWebhookURL string
+
+	// FinalityTrackerEnabled can be set to true to track the submitted outputs until they are finalized.
+	FinalityTrackerEnabled bool
+
+	// FinalityTrackerPollInterval is how frequently to update the status of the submitted outputs.
+	FinalityTrackerPollInterval time.Duration
+
+	// CouncilEscalationEnabled can be set to true to escalate the challenges the challenger cannot resolve
+	// to the Security Council.
+	CouncilEscalationEnabled bool
+
+	// CouncilEscalationAutoSubmit can be set to true to submit the escalations to the Security Council
+	// without waiting for the confirmation of the operator through the admin API.
+	CouncilEscalationAutoSubmit bool
+
+	// CouncilEscalationMargin is how long before the finalization of a proven output to escalate it
+	// if the challenge is not approved yet.
+	CouncilEscalationMargin time.Duration
+
+	// CouncilEscalationWebhookURL is the URL escalations are posted to. If empty, the webhook is disabled.
+	CouncilEscalationWebhookURL string
+
+	// ProfitEstimatorEnabled can be set to true to estimate the expected rewards and gas costs of the
+	// output submissions.
+	ProfitEstimatorEnabled bool
+
+	// ProfitSkipUnprofitable can be set to true to skip the submissions in the public round whose
+	// expected gas cost exceeds the expected reward.
+	ProfitSkipUnprofitable bool
+
+	// ProfitL2EthRpc is the HTTP provider URL for L2, used to fetch the balance of the ValidatorRewardVault.
+	ProfitL2EthRpc string
+
+	// ProfitSubmissionGas is the gas expected to be used by a submission until one is made.
+	ProfitSubmissionGas uint64
+
+	// ProfitGasPriceSamples is the number of recent gas prices averaged to estimate the gas cost.
+	ProfitGasPriceSamples uint64
+
+	// FaultInjectionOutputBlock is the first block whose output is deliberately corrupted. For testing only.
+	FaultInjectionOutputBlock uint64
+
+	// FaultInjectionSegmentBlock is the block whose output alone is deliberately corrupted. For testing only.
+	FaultInjectionSegmentBlock uint64
+
+	// ChainsConfig is the path of the JSON file listing the additional chains to validate,
+	// besides the chain configured by flags. If empty, only that chain is validated.
+	ChainsConfig string
+
 	TxMgrConfig   txmgr.CLIConfig
-	RPCConfig     krpc.CLIConfig
+	RPCConfig     rpc.CLIConfig
 	LogConfig     klog.CLIConfig
 	MetricsConfig kmetrics.CLIConfig
 	PprofConfig   kpprof.CLIConfig
@@ -140,19 +517,86 @@ func NewCLIConfig(ctx *cli.Context) CLIConfig {
 		ValPoolAddress:         ctx.GlobalString(flags.ValPoolAddressFlag.Name),
 		OutputSubmitterEnabled: ctx.GlobalBool(flags.OutputSubmitterEnabledFlag.Name),
 		ChallengerEnabled:      ctx.GlobalBool(flags.ChallengerEnabledFlag.Name),
+		ChallengerDryRun:       ctx.GlobalBool(flags.ChallengerDryRunFlag.Name),
+		LeaderLockPath:         ctx.GlobalString(flags.LeaderLockPathFlag.Name),
+		LeaderLockTTL:          ctx.GlobalDuration(flags.LeaderLockTTLFlag.Name),
+		InstanceID:             ctx.GlobalString(flags.InstanceIDFlag.Name),
+		StandbyEnabled:         ctx.GlobalBool(flags.StandbyEnabledFlag.Name),
+		StandbyTakeoverDelay:   ctx.GlobalDuration(flags.StandbyTakeoverDelayFlag.Name),
 		ChallengerPollInterval: ctx.GlobalDuration(flags.ChallengerPollIntervalFlag.Name),
 		TxMgrConfig:            txmgr.ReadCLIConfig(ctx),
 
 		// Optional Flags
 		AllowNonFinalized:            ctx.GlobalBool(flags.AllowNonFinalizedFlag.Name),
+		OutputCacheSize:              ctx.GlobalInt(flags.OutputCacheSizeFlag.Name),
 		OutputSubmitterBondAmount:    ctx.GlobalUint64(flags.OutputSubmitterBondAmountFlag.Name),
 		OutputSubmitterRetryInterval: ctx.GlobalDuration(flags.OutputSubmitterRetryIntervalFlag.Name),
 		OutputSubmitterRoundBuffer:   ctx.GlobalUint64(flags.OutputSubmitterRoundBufferFlag.Name),
 		SecurityCouncilAddress:       ctx.GlobalString(flags.SecurityCouncilAddressFlag.Name),
+		ProverBackend:                ctx.GlobalString(flags.ProverBackendFlag.Name),
 		ProverGrpc:                   ctx.GlobalString(flags.ProverGrpcFlag.Name),
+		ProverBinary:                 ctx.GlobalString(flags.ProverBinaryFlag.Name),
+		ProverEndpoints:              ctx.GlobalStringSlice(flags.ProverEndpointsFlag.Name),
+		ProverMockDir:                ctx.GlobalString(flags.ProverMockDirFlag.Name),
+		ProverHealthCheckInterval:    ctx.GlobalDuration(flags.ProverHealthCheckIntervalFlag.Name),
+		ProofQueueDir:                ctx.GlobalString(flags.ProofQueueDirFlag.Name),
+		ProofMaxAttempts:             ctx.GlobalUint64(flags.ProofMaxAttemptsFlag.Name),
+		ProofRetryBackoff:            ctx.GlobalDuration(flags.ProofRetryBackoffFlag.Name),
+		ProofMaxRetryBackoff:         ctx.GlobalDuration(flags.ProofMaxRetryBackoffFlag.Name),
+		ProofExpectedTime:            ctx.GlobalDuration(flags.ProofExpectedTimeFlag.Name),
+		SkipProofVerification:        ctx.GlobalBool(flags.SkipProofVerificationFlag.Name),
 		GuardianEnabled:              ctx.GlobalBool(flags.GuardianEnabledFlag.Name),
+		WatcherEnabled:               ctx.GlobalBool(flags.WatcherEnabledFlag.Name),
+		WatcherPollInterval:          ctx.GlobalDuration(flags.WatcherPollIntervalFlag.Name),
+		WatcherWebhookURL:            ctx.GlobalString(flags.WatcherWebhookURLFlag.Name),
 		FetchingProofTimeout:         ctx.GlobalDuration(flags.FetchingProofTimeoutFlag.Name),
-		RPCConfig:                    krpc.ReadCLIConfig(ctx),
+		SegmentStrategy:              ctx.GlobalString(flags.SegmentStrategyFlag.Name),
+		ChallengePriority:            ctx.GlobalString(flags.ChallengePriorityFlag.Name),
+		MaxActiveChallenges:          ctx.GlobalUint64(flags.MaxActiveChallengesFlag.Name),
+		DefenseResubmissionTimeout:   ctx.GlobalDuration(flags.DefenseResubmissionTimeoutFlag.Name),
+		DefenseRetryInterval:         ctx.GlobalDuration(flags.DefenseRetryIntervalFlag.Name),
+		BondTopUpEnabled:             ctx.GlobalBool(flags.BondTopUpEnabledFlag.Name),
+		BondTopUpInterval:            ctx.GlobalDuration(flags.BondTopUpIntervalFlag.Name),
+		BondTopUpSubmissions:         ctx.GlobalUint64(flags.BondTopUpSubmissionsFlag.Name),
+		BondTopUpCap:                 ctx.GlobalString(flags.BondTopUpCapFlag.Name),
+		BondTopUpFundingPrivateKey:   ctx.GlobalString(flags.BondTopUpFundingPrivateKeyFlag.Name),
+		RewardWithdrawalEnabled:      ctx.GlobalBool(flags.RewardWithdrawalEnabledFlag.Name),
+		RewardWithdrawalInterval:     ctx.GlobalDuration(flags.RewardWithdrawalIntervalFlag.Name),
+		RewardRecipient:              ctx.GlobalString(flags.RewardRecipientFlag.Name),
+		RewardRetainAmount:           ctx.GlobalString(flags.RewardRetainAmountFlag.Name),
+		RewardWithdrawalThreshold:    ctx.GlobalString(flags.RewardWithdrawalThresholdFlag.Name),
+		RewardMaxGasPrice:            ctx.GlobalString(flags.RewardMaxGasPriceFlag.Name),
+		BalanceMonitorEnabled:        ctx.GlobalBool(flags.BalanceMonitorEnabledFlag.Name),
+		BalanceMonitorInterval:       ctx.GlobalDuration(flags.BalanceMonitorIntervalFlag.Name),
+		BalanceMonitorMinBalance:     ctx.GlobalString(flags.BalanceMonitorMinBalanceFlag.Name),
+		BalanceMonitorMinDeposit:     ctx.GlobalString(flags.BalanceMonitorMinDepositFlag.Name),
+		BalanceMonitorWebhookURL:     ctx.GlobalString(flags.BalanceMonitorWebhookURLFlag.Name),
+		ExitEnabled:                  ctx.GlobalBool(flags.ExitEnabledFlag.Name),
+		ExitPollInterval:             ctx.GlobalDuration(flags.ExitPollIntervalFlag.Name),
+		IndexerEnabled:               ctx.GlobalBool(flags.IndexerEnabledFlag.Name),
+		IndexerDBPath:                ctx.GlobalString(flags.IndexerDBPathFlag.Name),
+		IndexerStartBlock:            ctx.GlobalUint64(flags.IndexerStartBlockFlag.Name),
+		IndexerConfirmations:         ctx.GlobalUint64(flags.IndexerConfirmationsFlag.Name),
+		IndexerPollInterval:          ctx.GlobalDuration(flags.IndexerPollIntervalFlag.Name),
+		IndexerBatchSize:             ctx.GlobalUint64(flags.IndexerBatchSizeFlag.Name),
+		PenaltyMonitorEnabled:        ctx.GlobalBool(flags.PenaltyMonitorEnabledFlag.Name),
+		PenaltyMonitorAddresses:      ctx.GlobalStringSlice(flags.PenaltyMonitorAddressesFlag.Name),
+		PenaltyMonitorWebhookURL:     ctx.GlobalString(flags.PenaltyMonitorWebhookURLFlag.Name),
+		FinalityTrackerEnabled:       ctx.GlobalBool(flags.FinalityTrackerEnabledFlag.Name),
+		FinalityTrackerPollInterval:  ctx.GlobalDuration(flags.FinalityTrackerPollIntervalFlag.Name),
+		CouncilEscalationEnabled:     ctx.GlobalBool(flags.CouncilEscalationEnabledFlag.Name),
+		CouncilEscalationAutoSubmit:  ctx.GlobalBool(flags.CouncilEscalationAutoSubmitFlag.Name),
+		CouncilEscalationMargin:      ctx.GlobalDuration(flags.CouncilEscalationMarginFlag.Name),
+		CouncilEscalationWebhookURL:  ctx.GlobalString(flags.CouncilEscalationWebhookURLFlag.Name),
+		ProfitEstimatorEnabled:       ctx.GlobalBool(flags.ProfitEstimatorEnabledFlag.Name),
+		ProfitSkipUnprofitable:       ctx.GlobalBool(flags.ProfitSkipUnprofitableFlag.Name),
+		ProfitL2EthRpc:               ctx.GlobalString(flags.ProfitL2EthRpcFlag.Name),
+		ProfitSubmissionGas:          ctx.GlobalUint64(flags.ProfitSubmissionGasFlag.Name),
+		ProfitGasPriceSamples:        ctx.GlobalUint64(flags.ProfitGasPriceSamplesFlag.Name),
+		FaultInjectionOutputBlock:    ctx.GlobalUint64(flags.FaultInjectionOutputBlockFlag.Name),
+		FaultInjectionSegmentBlock:   ctx.GlobalUint64(flags.FaultInjectionSegmentBlockFlag.Name),
+		ChainsConfig:                 ctx.GlobalString(flags.ChainsConfigFlag.Name),
+		RPCConfig:                    rpc.ReadCLIConfig(ctx),
 		LogConfig:                    klog.ReadCLIConfig(ctx),
 		MetricsConfig:                kmetrics.ReadCLIConfig(ctx),
 		PprofConfig:                  kpprof.ReadCLIConfig(ctx),
@@ -172,7 +616,7 @@ func NewValidatorConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Confi
 	}
 
 	var securityCouncilAddress common.Address
-	if cfg.GuardianEnabled {
+	if cfg.GuardianEnabled || cfg.CouncilEscalationEnabled {
 		securityCouncilAddress, err = utils.ParseAddress(cfg.SecurityCouncilAddress)
 		if err != nil {
 			return nil, err
@@ -189,20 +633,119 @@ func NewValidatorConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Confi
 		return nil, err
 	}
 
-	if !cfg.OutputSubmitterEnabled && !cfg.ChallengerEnabled {
-		return nil, errors.New("output submitter and challenger are disabled. either output submitter or challenger must be enabled")
+	if !cfg.OutputSubmitterEnabled && !cfg.ChallengerEnabled && !cfg.WatcherEnabled {
+		return nil, errors.New("output submitter, challenger and watcher are disabled. either output submitter, challenger or watcher must be enabled")
+	}
+
+	var bondTopUpCap *big.Int
+	var fundingTxManager txmgr.TxManager
+	if cfg.BondTopUpEnabled {
+		var ok bool
+		bondTopUpCap, ok = new(big.Int).SetString(cfg.BondTopUpCap, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid bond top-up cap: %q", cfg.BondTopUpCap)
+		}
+
+		if cfg.BondTopUpFundingPrivateKey != "" {
+			fundingTxMgrConfig := cfg.TxMgrConfig
+			fundingTxMgrConfig.PrivateKey = cfg.BondTopUpFundingPrivateKey
+			fundingTxMgrConfig.Mnemonic = ""
+			fundingTxMgrConfig.HDPath = ""
+			// the funding key is a local key, but the signing policies are kept to restrict it as well.
+			fundingTxMgrConfig.SignerCLIConfig = ksigner.CLIConfig{
+				PolicyPath: cfg.TxMgrConfig.SignerCLIConfig.PolicyPath,
+			}
+			fundingTxManager, err = txmgr.NewSimpleTxManager("validator_funding", l, m, fundingTxMgrConfig)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var rewardRecipient common.Address
+	var rewardRetainAmount, rewardThreshold, rewardMaxGasPrice *big.Int
+	if cfg.RewardWithdrawalEnabled {
+		if cfg.RewardRecipient != "" {
+			rewardRecipient, err = utils.ParseAddress(cfg.RewardRecipient)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if rewardRetainAmount, err = parseWei("reward retain amount", cfg.RewardRetainAmount); err != nil {
+			return nil, err
+		}
+		if rewardThreshold, err = parseWei("reward withdrawal threshold", cfg.RewardWithdrawalThreshold); err != nil {
+			return nil, err
+		}
+		if cfg.RewardMaxGasPrice != "" {
+			if rewardMaxGasPrice, err = parseWei("reward max gas price", cfg.RewardMaxGasPrice); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var balanceMinBalance, balanceMinDeposit *big.Int
+	if cfg.BalanceMonitorEnabled {
+		if cfg.BalanceMonitorMinBalance != "" {
+			if balanceMinBalance, err = parseWei("balance monitor min balance", cfg.BalanceMonitorMinBalance); err != nil {
+				return nil, err
+			}
+		}
+		if cfg.BalanceMonitorMinDeposit != "" {
+			if balanceMinDeposit, err = parseWei("balance monitor min deposit", cfg.BalanceMonitorMinDeposit); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	instanceID := cfg.InstanceID
+	if instanceID == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get hostname for instance id: %w", err)
+		}
+		instanceID = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+
+	segmentStrategy, err := chal.NewSegmentStrategy(cfg.SegmentStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := newChallengeLess(cfg.ChallengePriority); err != nil {
+		return nil, err
 	}
 
-	if cfg.ChallengerEnabled && len(cfg.ProverGrpc) == 0 {
-		return nil, errors.New("ProverGrpc is required when challenger enabled, but given empty")
+	penaltyMonitorAddresses := make([]common.Address, 0, len(cfg.PenaltyMonitorAddresses))
+	for _, addr := range cfg.PenaltyMonitorAddresses {
+		if !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid penalty monitor address: %s", addr)
+		}
+		penaltyMonitorAddresses = append(penaltyMonitorAddresses, common.HexToAddress(addr))
 	}
 
-	var fetcher ProofFetcher
-	if len(cfg.ProverGrpc) > 0 {
-		fetcher, err = chal.NewFetcher(cfg.ProverGrpc, cfg.FetchingProofTimeout, l)
+	proverEndpoints := make([]chal.ProverEndpoint, 0, len(cfg.ProverEndpoints))
+	for _, e := range cfg.ProverEndpoints {
+		endpoint, err := chal.ParseProverEndpoint(e)
 		if err != nil {
 			return nil, err
 		}
+		proverEndpoints = append(proverEndpoints, endpoint)
+	}
+
+	var prover chal.ProverBackend
+	if cfg.ChallengerEnabled || len(cfg.ProverGrpc) > 0 {
+		prover, err = chal.NewProverBackend(chal.ProverConfig{
+			Backend:    cfg.ProverBackend,
+			GrpcUrl:    cfg.ProverGrpc,
+			Endpoints:  proverEndpoints,
+			BinaryPath: cfg.ProverBinary,
+			MockDir:    cfg.ProverMockDir,
+			Timeout:    cfg.FetchingProofTimeout,
+		}, l)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create prover backend: %w", err)
+		}
 	}
 
 	// Connect to L1 and L2 providers. Perform these last since they are the most expensive.
@@ -217,6 +760,14 @@ func NewValidatorConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Confi
 		return nil, err
 	}
 
+	var profitL2Client *ethclient.Client
+	if cfg.ProfitEstimatorEnabled {
+		profitL2Client, err = utils.DialEthClientWithTimeout(ctx, cfg.ProfitL2EthRpc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	rollupConfig, err := rollupClient.RollupConfig(ctx)
 	if err != nil {
 		return nil, err
@@ -233,13 +784,86 @@ func NewValidatorConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Confi
 		L1Client:                     l1Client,
 		RollupClient:                 rollupClient,
 		RollupConfig:                 rollupConfig,
+		OutputCacheSize:              cfg.OutputCacheSize,
 		AllowNonFinalized:            cfg.AllowNonFinalized,
 		OutputSubmitterEnabled:       cfg.OutputSubmitterEnabled,
 		OutputSubmitterBondAmount:    cfg.OutputSubmitterBondAmount,
 		OutputSubmitterRetryInterval: cfg.OutputSubmitterRetryInterval,
 		OutputSubmitterRoundBuffer:   cfg.OutputSubmitterRoundBuffer,
+		LeaderLockPath:               cfg.LeaderLockPath,
+		LeaderLockTTL:                cfg.LeaderLockTTL,
+		InstanceID:                   instanceID,
+		StandbyEnabled:               cfg.StandbyEnabled,
+		StandbyTakeoverDelay:         cfg.StandbyTakeoverDelay,
 		ChallengerEnabled:            cfg.ChallengerEnabled,
+		ChallengerDryRun:             cfg.ChallengerDryRun,
 		GuardianEnabled:              cfg.GuardianEnabled,
-		ProofFetcher:                 fetcher,
+		WatcherEnabled:               cfg.WatcherEnabled,
+		WatcherPollInterval:          cfg.WatcherPollInterval,
+		WatcherWebhookURL:            cfg.WatcherWebhookURL,
+		SegmentStrategy:              segmentStrategy,
+		ChallengePriority:            cfg.ChallengePriority,
+		MaxActiveChallenges:          cfg.MaxActiveChallenges,
+		DefenseResubmissionTimeout:   cfg.DefenseResubmissionTimeout,
+		DefenseRetryInterval:         cfg.DefenseRetryInterval,
+		ProverBackend:                prover,
+		ProverHealthCheckInterval:    cfg.ProverHealthCheckInterval,
+		ProofQueueDir:                cfg.ProofQueueDir,
+		ProofMaxAttempts:             cfg.ProofMaxAttempts,
+		ProofRetryBackoff:            cfg.ProofRetryBackoff,
+		ProofMaxRetryBackoff:         cfg.ProofMaxRetryBackoff,
+		ProofExpectedTime:            cfg.ProofExpectedTime,
+		SkipProofVerification:        cfg.SkipProofVerification,
+		BondTopUpEnabled:             cfg.BondTopUpEnabled,
+		BondTopUpInterval:            cfg.BondTopUpInterval,
+		BondTopUpSubmissions:         cfg.BondTopUpSubmissions,
+		BondTopUpCap:                 bondTopUpCap,
+		FundingTxManager:             fundingTxManager,
+		RewardWithdrawalEnabled:      cfg.RewardWithdrawalEnabled,
+		RewardWithdrawalInterval:     cfg.RewardWithdrawalInterval,
+		RewardRecipient:              rewardRecipient,
+		RewardRetainAmount:           rewardRetainAmount,
+		RewardWithdrawalThreshold:    rewardThreshold,
+		RewardMaxGasPrice:            rewardMaxGasPrice,
+		BalanceMonitorEnabled:        cfg.BalanceMonitorEnabled,
+		BalanceMonitorInterval:       cfg.BalanceMonitorInterval,
+		BalanceMonitorMinBalance:     balanceMinBalance,
+		BalanceMonitorMinDeposit:     balanceMinDeposit,
+		BalanceMonitorWebhookURL:     cfg.BalanceMonitorWebhookURL,
+		ExitEnabled:                  cfg.ExitEnabled,
+		ExitPollInterval:             cfg.ExitPollInterval,
+		IndexerEnabled:               cfg.IndexerEnabled,
+		IndexerDBPath:                cfg.IndexerDBPath,
+		IndexerStartBlock:            cfg.IndexerStartBlock,
+		IndexerConfirmations:         cfg.IndexerConfirmations,
+		IndexerPollInterval:          cfg.IndexerPollInterval,
+		IndexerBatchSize:             cfg.IndexerBatchSize,
+		PenaltyMonitorEnabled:        cfg.PenaltyMonitorEnabled,
+		PenaltyMonitorAddresses:      penaltyMonitorAddresses,
+		PenaltyMonitorWebhookURL:     cfg.PenaltyMonitorWebhookURL,
+		FinalityTrackerEnabled:       cfg.FinalityTrackerEnabled,
+		FinalityTrackerPollInterval:  cfg.FinalityTrackerPollInterval,
+		CouncilEscalationEnabled:     cfg.CouncilEscalationEnabled,
+		CouncilEscalationAutoSubmit:  cfg.CouncilEscalationAutoSubmit,
+		CouncilEscalationMargin:      cfg.CouncilEscalationMargin,
+		CouncilEscalationWebhookURL:  cfg.CouncilEscalationWebhookURL,
+		ProfitEstimatorEnabled:       cfg.ProfitEstimatorEnabled,
+		ProfitSkipUnprofitable:       cfg.ProfitSkipUnprofitable,
+		ProfitSubmissionGas:          cfg.ProfitSubmissionGas,
+		ProfitGasPriceSamples:        cfg.ProfitGasPriceSamples,
+		ProfitL2Client:               profitL2Client,
+		FaultInjection: FaultInjection{
+			OutputBlock:  cfg.FaultInjectionOutputBlock,
+			SegmentBlock: cfg.FaultInjectionSegmentBlock,
+		},
 	}, nil
 }
+
+// parseWei parses the given decimal amount in wei.
+func parseWei(name, value string) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid %s: %q", name, value)
+	}
+	return amount, nil
+}
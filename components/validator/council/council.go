@@ -0,0 +1,272 @@
+package council
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/utils"
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+)
+
+var ErrNoSubmission = errors.New("no submission event in receipt")
+
+// Call is a call to be executed by the SecurityCouncil multisig once it is confirmed by enough owners.
+type Call struct {
+	Destination common.Address
+	Value       *big.Int
+	Data        []byte
+}
+
+// Builder encodes the calls for the common Security Council operations.
+type Builder struct {
+	council      common.Address
+	councilABI   *abi.ABI
+	colosseumABI *abi.ABI
+	proxyABI     *abi.ABI
+}
+
+// NewBuilder creates a new Builder for the SecurityCouncil at the given address.
+func NewBuilder(council common.Address) (*Builder, error) {
+	councilABI, err := bindings.SecurityCouncilMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	colosseumABI, err := bindings.ColosseumMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	proxyABI, err := bindings.ProxyMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Builder{
+		council:      council,
+		councilABI:   councilABI,
+		colosseumABI: colosseumABI,
+		proxyABI:     proxyABI,
+	}, nil
+}
+
+// ApproveChallenge encodes the approval of a proven challenge, replacing the invalid output.
+// It is the call requested by the Colosseum in a validation request.
+func (b *Builder) ApproveChallenge(colosseum common.Address, outputIndex *big.Int) (*Call, error) {
+	return b.call(b.colosseumABI, colosseum, "approveChallenge", outputIndex)
+}
+
+// UpgradeTo encodes the upgrade of the implementation of the given proxy.
+func (b *Builder) UpgradeTo(proxy common.Address, implementation common.Address) (*Call, error) {
+	return b.call(b.proxyABI, proxy, "upgradeTo", implementation)
+}
+
+// UpgradeToAndCall encodes the upgrade of the implementation of the given proxy,
+// calling the new implementation with the given data in the same transaction.
+func (b *Builder) UpgradeToAndCall(proxy common.Address, implementation common.Address, data []byte) (*Call, error) {
+	return b.call(b.proxyABI, proxy, "upgradeToAndCall", implementation, data)
+}
+
+// AddOwner encodes the addition of an owner of the SecurityCouncil.
+func (b *Builder) AddOwner(owner common.Address) (*Call, error) {
+	return b.call(b.councilABI, b.council, "addOwner", owner)
+}
+
+// RemoveOwner encodes the removal of an owner of the SecurityCouncil.
+func (b *Builder) RemoveOwner(owner common.Address) (*Call, error) {
+	return b.call(b.councilABI, b.council, "removeOwner", owner)
+}
+
+// ReplaceOwner encodes the replacement of an owner of the SecurityCouncil.
+func (b *Builder) ReplaceOwner(owner common.Address, newOwner common.Address) (*Call, error) {
+	return b.call(b.councilABI, b.council, "replaceOwner", owner, newOwner)
+}
+
+// ChangeRequirement encodes the change of the number of confirmations required.
+func (b *Builder) ChangeRequirement(required *big.Int) (*Call, error) {
+	return b.call(b.councilABI, b.council, "changeRequirement", required)
+}
+
+func (b *Builder) call(contractABI *abi.ABI, to common.Address, method string, args ...interface{}) (*Call, error) {
+	data, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", method, err)
+	}
+	return &Call{Destination: to, Value: common.Big0, Data: data}, nil
+}
+
+// TxStatus is the status of a SecurityCouncil transaction.
+type TxStatus struct {
+	TransactionId *big.Int
+	Call          Call
+	Executed      bool
+	Confirmations []common.Address
+	Required      *big.Int
+}
+
+// IsConfirmed returns whether the transaction has enough confirmations to be executed.
+func (s *TxStatus) IsConfirmed() bool {
+	return big.NewInt(int64(len(s.Confirmations))).Cmp(s.Required) >= 0
+}
+
+// Client submits, confirms and executes SecurityCouncil transactions as one of the owners.
+type Client struct {
+	addr      common.Address
+	contract  *bindings.SecurityCouncil
+	abi       *abi.ABI
+	txManager txmgr.TxManager
+	timeout   time.Duration
+	log       log.Logger
+}
+
+// NewClient creates a new Client sending transactions with the given tx manager.
+func NewClient(addr common.Address, l1Client *ethclient.Client, txManager txmgr.TxManager, timeout time.Duration, l log.Logger) (*Client, error) {
+	contract, err := bindings.NewSecurityCouncil(addr, l1Client)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := bindings.SecurityCouncilMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		addr:      addr,
+		contract:  contract,
+		abi:       parsed,
+		txManager: txManager,
+		timeout:   timeout,
+		log:       l,
+	}, nil
+}
+
+// Submit submits the call as a new transaction, confirming it by the sender, and returns its id.
+func (c *Client) Submit(ctx context.Context, call *Call) (*big.Int, error) {
+	receipt, err := c.send(ctx, "submitTransaction", call.Destination, call.Value, call.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range receipt.Logs {
+		ev, err := c.contract.ParseSubmission(*l)
+		if err == nil {
+			c.log.Info("submitted security council transaction", "transactionId", ev.TransactionId,
+				"destination", call.Destination, "txHash", receipt.TxHash)
+			return ev.TransactionId, nil
+		}
+	}
+	return nil, ErrNoSubmission
+}
+
+// Confirm confirms the transaction of the given id.
+func (c *Client) Confirm(ctx context.Context, transactionId *big.Int) error {
+	if _, err := c.send(ctx, "confirmTransaction", transactionId); err != nil {
+		return err
+	}
+	c.log.Info("confirmed security council transaction", "transactionId", transactionId)
+	return nil
+}
+
+// Revoke revokes the confirmation of the transaction of the given id.
+func (c *Client) Revoke(ctx context.Context, transactionId *big.Int) error {
+	if _, err := c.send(ctx, "revokeConfirmation", transactionId); err != nil {
+		return err
+	}
+	c.log.Info("revoked confirmation of security council transaction", "transactionId", transactionId)
+	return nil
+}
+
+// Execute executes the transaction of the given id, which must be confirmed already.
+func (c *Client) Execute(ctx context.Context, transactionId *big.Int) error {
+	receipt, err := c.send(ctx, "executeTransaction", transactionId)
+	if err != nil {
+		return err
+	}
+	for _, l := range receipt.Logs {
+		if _, err := c.contract.ParseExecutionFailure(*l); err == nil {
+			return fmt.Errorf("execution of security council transaction %d failed", transactionId)
+		}
+	}
+	c.log.Info("executed security council transaction", "transactionId", transactionId)
+	return nil
+}
+
+// Status returns the status of the transaction of the given id.
+func (c *Client) Status(ctx context.Context, transactionId *big.Int) (*TxStatus, error) {
+	cCtx, cCancel := context.WithTimeout(ctx, c.timeout)
+	defer cCancel()
+	callOpts := utils.NewSimpleCallOpts(cCtx)
+
+	tx, err := c.contract.Transactions(callOpts, transactionId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction %d: %w", transactionId, err)
+	}
+	confirmations, err := c.contract.GetConfirmations(callOpts, transactionId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get confirmations of transaction %d: %w", transactionId, err)
+	}
+	required, err := c.contract.NumConfirmationsRequired(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get number of confirmations required: %w", err)
+	}
+
+	return &TxStatus{
+		TransactionId: transactionId,
+		Call:          Call{Destination: tx.Destination, Value: tx.Value, Data: tx.Data},
+		Executed:      tx.Executed,
+		Confirmations: confirmations,
+		Required:      required,
+	}, nil
+}
+
+// WaitConfirmed polls the status of the transaction of the given id until it has enough confirmations.
+func (c *Client) WaitConfirmed(ctx context.Context, transactionId *big.Int, pollInterval time.Duration) (*TxStatus, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := c.Status(ctx, transactionId)
+		if err != nil {
+			c.log.Warn("failed to get security council transaction status", "err", err, "transactionId", transactionId)
+		} else if status.IsConfirmed() || status.Executed {
+			return status, nil
+		} else {
+			c.log.Info("waiting for confirmations", "transactionId", transactionId,
+				"confirmations", len(status.Confirmations), "required", status.Required)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, method string, args ...interface{}) (*types.Receipt, error) {
+	data, err := c.abi.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s transaction data: %w", method, err)
+	}
+
+	receipt, err := c.txManager.Send(ctx, txmgr.TxCandidate{
+		TxData:   data,
+		To:       &c.addr,
+		GasLimit: 0,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s transaction: %w", method, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("%s transaction reverted: %s", method, receipt.TxHash)
+	}
+	return receipt, nil
+}
@@ -0,0 +1,55 @@
+package council
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+)
+
+func TestBuilder_EncodesCalls(t *testing.T) {
+	councilAddr := common.HexToAddress("0x01")
+	proxy := common.HexToAddress("0x02")
+	implementation := common.HexToAddress("0x03")
+	owner := common.HexToAddress("0x04")
+
+	b, err := NewBuilder(councilAddr)
+	require.NoError(t, err)
+
+	call, err := b.UpgradeTo(proxy, implementation)
+	require.NoError(t, err)
+	require.Equal(t, proxy, call.Destination)
+	proxyABI, err := bindings.ProxyMetaData.GetAbi()
+	require.NoError(t, err)
+	args, err := proxyABI.Methods["upgradeTo"].Inputs.Unpack(call.Data[4:])
+	require.NoError(t, err)
+	require.Equal(t, implementation, args[0])
+
+	call, err = b.AddOwner(owner)
+	require.NoError(t, err)
+	require.Equal(t, councilAddr, call.Destination, "owner management must be called on the council itself")
+	councilABI, err := bindings.SecurityCouncilMetaData.GetAbi()
+	require.NoError(t, err)
+	method, err := councilABI.MethodById(call.Data)
+	require.NoError(t, err)
+	require.Equal(t, "addOwner", method.Name)
+
+	call, err = b.ApproveChallenge(proxy, big.NewInt(7))
+	require.NoError(t, err)
+	colosseumABI, err := bindings.ColosseumMetaData.GetAbi()
+	require.NoError(t, err)
+	args, err = colosseumABI.Methods["approveChallenge"].Inputs.Unpack(call.Data[4:])
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7), args[0])
+}
+
+func TestTxStatus_IsConfirmed(t *testing.T) {
+	status := &TxStatus{Confirmations: []common.Address{{1}}, Required: big.NewInt(2)}
+	require.False(t, status.IsConfirmed())
+
+	status.Confirmations = append(status.Confirmations, common.Address{2})
+	require.True(t, status.IsConfirmed())
+}
@@ -0,0 +1,113 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	chal "github.com/kroma-network/kroma/components/validator/challenge"
+)
+
+const (
+	DefenseResultSent     = "sent"
+	DefenseResultRetried  = "retried"
+	DefenseResultTimedOut = "timed_out"
+)
+
+var errBisectionTimedOut = errors.New("bisection timeout is passed")
+
+// defend bisects the challenge on an output of the validator when it is the asserter's turn. Since the
+// output is deemed invalid if the asserter does not bisect before the timeout, failed attempts are retried
+// until the timeout with a short delay, and the fees of the bisect tx are bumped at the defense resubmission
+// timeout, instead of waiting for the next poll as the challenger does.
+func (c *Challenger) defend(ctx context.Context, outputIndex *big.Int, challenge bindings.TypesChallenge) error {
+	deadline := challengeDeadline(challenge)
+	if !time.Now().Before(deadline) {
+		c.metr.RecordDefenseTx(DefenseResultTimedOut)
+		return fmt.Errorf("%w: turn %d timed out at %s", errBisectionTimedOut, challenge.Turn, deadline)
+	}
+
+	retryInterval := c.cfg.DefenseRetryInterval
+	if retryInterval == 0 {
+		retryInterval = c.cfg.ChallengerPollInterval
+	}
+
+	c.log.Info("asserter: defending output", "outputIndex", outputIndex, "turn", challenge.Turn,
+		"timeoutAt", deadline, "remaining", time.Until(deadline).Round(time.Second))
+
+	dCtx, dCancel := context.WithDeadline(ctx, deadline)
+	defer dCancel()
+
+	retried := false
+	attempts, err := retryDefense(dCtx, retryInterval,
+		func(ctx context.Context) error {
+			// a previous tx may have been included meanwhile, in which case there is nothing left to do.
+			if retried {
+				status, err := c.GetChallengeStatus(outputIndex)
+				if err != nil {
+					return fmt.Errorf("failed to get challenge status: %w", err)
+				}
+				if status != chal.StatusAsserterTurn {
+					return nil
+				}
+			}
+			tx, err := c.Bisect(ctx, outputIndex)
+			if err != nil {
+				return fmt.Errorf("failed to create bisect tx: %w", err)
+			}
+			if err := c.sendChallengeTx(ctx, tx, c.cfg.DefenseResubmissionTimeout, deadline); err != nil {
+				return fmt.Errorf("failed to submit bisect tx: %w", err)
+			}
+			return nil
+		},
+		func(err error, attempt int) bool {
+			if errors.Is(err, errChallengerPaused) {
+				return false
+			}
+			retried = true
+			c.metr.RecordDefenseTx(DefenseResultRetried)
+			c.log.Warn("asserter: failed to defend output, retrying", "err", err, "outputIndex", outputIndex,
+				"attempt", attempt, "remaining", time.Until(deadline).Round(time.Second))
+			return true
+		},
+	)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			c.metr.RecordDefenseTx(DefenseResultTimedOut)
+			return fmt.Errorf("%w after %d attempts: %v", errBisectionTimedOut, attempts, err)
+		}
+		return err
+	}
+
+	c.metr.RecordDefenseTx(DefenseResultSent)
+	c.log.Info("asserter: defended output", "outputIndex", outputIndex, "turn", challenge.Turn,
+		"attempts", attempts, "remaining", time.Until(deadline).Round(time.Second))
+	return nil
+}
+
+// retryDefense calls attempt until it succeeds, waiting interval between the attempts. After a failed
+// attempt, retry is called to decide whether to try again; if not, the error of the attempt is returned.
+// It returns the number of attempts made, and the error of the last attempt if ctx is done.
+func retryDefense(ctx context.Context, interval time.Duration, attempt func(context.Context) error, retry func(err error, attempt int) bool) (int, error) {
+	for i := 1; ; i++ {
+		err := attempt(ctx)
+		if err == nil {
+			return i, nil
+		}
+		if ctx.Err() != nil {
+			return i, ctx.Err()
+		}
+		if !retry(err, i) {
+			return i, err
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return i, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryDefense(t *testing.T) {
+	errAttempt := errors.New("attempt failed")
+	retryAll := func(error, int) bool { return true }
+
+	t.Run("succeeds after retries", func(t *testing.T) {
+		calls := 0
+		attempts, err := retryDefense(context.Background(), time.Millisecond, func(context.Context) error {
+			calls++
+			if calls < 3 {
+				return errAttempt
+			}
+			return nil
+		}, retryAll)
+		require.NoError(t, err)
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("stops when not retried", func(t *testing.T) {
+		attempts, err := retryDefense(context.Background(), time.Millisecond, func(context.Context) error {
+			return errAttempt
+		}, func(_ error, attempt int) bool { return attempt < 2 })
+		require.ErrorIs(t, err, errAttempt)
+		require.Equal(t, 2, attempts)
+	})
+
+	t.Run("stops at the deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		attempts, err := retryDefense(ctx, 10*time.Millisecond, func(context.Context) error {
+			return errAttempt
+		}, retryAll)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Greater(t, attempts, 1)
+	})
+}
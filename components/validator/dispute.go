@@ -0,0 +1,121 @@
+package validator
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	chal "github.com/kroma-network/kroma/components/validator/challenge"
+	"github.com/kroma-network/kroma/components/validator/indexer"
+)
+
+// DisputeSegment is a segment of the current turn of a challenge, i.e. the output root claimed at a block.
+type DisputeSegment struct {
+	BlockNumber uint64      `json:"blockNumber"`
+	OutputRoot  common.Hash `json:"outputRoot"`
+}
+
+// DisputeState is the state of the challenge of an output, as stored in the Colosseum, together with
+// the proof generation jobs of the challenger and the history of the challenge if the indexer is enabled.
+type DisputeState struct {
+	OutputIndex uint64         `json:"outputIndex"`
+	Status      string         `json:"status"`
+	Asserter    common.Address `json:"asserter"`
+	Challenger  common.Address `json:"challenger"`
+	Turn        uint8          `json:"turn"`
+	// TimeoutAt is the L1 timestamp by which the party of the current turn must act.
+	TimeoutAt uint64 `json:"timeoutAt"`
+	// TimedOut is true if TimeoutAt is passed according to the local clock.
+	TimedOut bool `json:"timedOut"`
+	Approved bool `json:"approved"`
+	// ProvenOutputRoot is the output root proven by the challenger, if the fault is proven.
+	ProvenOutputRoot *common.Hash     `json:"provenOutputRoot,omitempty"`
+	SegStart         uint64           `json:"segStart"`
+	SegSize          uint64           `json:"segSize"`
+	Segments         []DisputeSegment `json:"segments"`
+	// ProofJobs are the proof generation jobs of the blocks in the current segments.
+	ProofJobs []*chal.ProofJob `json:"proofJobs"`
+	// Events are the indexed events of the challenge, nil if the indexer is disabled.
+	Events []indexer.ChallengeEvent `json:"events,omitempty"`
+}
+
+// DisputeState returns the state of the challenge of the output, or nil if the output is not challenged.
+func (c *Challenger) DisputeState(outputIndex *big.Int) (*DisputeState, error) {
+	status, err := c.GetChallengeStatus(outputIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenge status: %w", err)
+	}
+	if status == chal.StatusNone {
+		return nil, nil
+	}
+
+	challenge, err := c.GetChallenge(outputIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenge: %w", err)
+	}
+
+	state := newDisputeState(outputIndex.Uint64(), status, challenge, time.Now())
+	state.ProofJobs = disputeProofJobs(c.ProofJobs(), state.SegStart, state.SegSize)
+
+	if c.cfg.Indexer != nil {
+		record, err := c.cfg.Indexer.Challenge(state.OutputIndex)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get indexed challenge: %w", err)
+		}
+		if record != nil {
+			state.Events = record.Events
+		}
+	}
+
+	return state, nil
+}
+
+func newDisputeState(outputIndex uint64, status uint8, challenge bindings.TypesChallenge, now time.Time) *DisputeState {
+	state := &DisputeState{
+		OutputIndex: outputIndex,
+		Status:      chal.StatusName(status),
+		Asserter:    challenge.Asserter,
+		Challenger:  challenge.Challenger,
+		Turn:        challenge.Turn,
+		TimeoutAt:   challenge.TimeoutAt,
+		TimedOut:    !now.Before(time.Unix(int64(challenge.TimeoutAt), 0)),
+		Approved:    challenge.Approved,
+		Segments:    []DisputeSegment{},
+	}
+	if challenge.SegStart != nil {
+		state.SegStart = challenge.SegStart.Uint64()
+	}
+	if challenge.SegSize != nil {
+		state.SegSize = challenge.SegSize.Uint64()
+	}
+	if challenge.OutputRoot != (common.Hash{}) {
+		root := common.Hash(challenge.OutputRoot)
+		state.ProvenOutputRoot = &root
+	}
+	// the segments are cleared once the fault is proven.
+	if len(challenge.Segments) > 1 {
+		segments := chal.NewSegments(state.SegStart, state.SegSize, challenge.Segments)
+		for i, blockNumber := range segments.BlockNumbers() {
+			state.Segments = append(state.Segments, DisputeSegment{
+				BlockNumber: blockNumber,
+				OutputRoot:  segments.Hashes[i],
+			})
+		}
+	}
+	return state
+}
+
+// disputeProofJobs returns the proof jobs of the blocks in the segments. The proof of a fault at a segment
+// is the proof of the block following its start.
+func disputeProofJobs(jobs []*chal.ProofJob, segStart, segSize uint64) []*chal.ProofJob {
+	res := []*chal.ProofJob{}
+	for _, job := range jobs {
+		if job.BlockNumber > segStart && job.BlockNumber <= segStart+segSize {
+			res = append(res, job)
+		}
+	}
+	return res
+}
@@ -0,0 +1,51 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	chal "github.com/kroma-network/kroma/components/validator/challenge"
+)
+
+func TestNewDisputeState(t *testing.T) {
+	now := time.Unix(1000, 0)
+	challenge := bindings.TypesChallenge{
+		Turn:       2,
+		TimeoutAt:  1500,
+		Asserter:   common.HexToAddress("0xa1"),
+		Challenger: common.HexToAddress("0xc1"),
+		Segments:   [][32]byte{{0x01}, {0x02}, {0x03}},
+		SegStart:   big.NewInt(100),
+		SegSize:    big.NewInt(10),
+	}
+
+	state := newDisputeState(7, chal.StatusAsserterTurn, challenge, now)
+	require.Equal(t, "asserter_turn", state.Status)
+	require.False(t, state.TimedOut)
+	require.Nil(t, state.ProvenOutputRoot)
+	require.Equal(t, []DisputeSegment{
+		{BlockNumber: 100, OutputRoot: common.Hash{0x01}},
+		{BlockNumber: 105, OutputRoot: common.Hash{0x02}},
+		{BlockNumber: 110, OutputRoot: common.Hash{0x03}},
+	}, state.Segments)
+
+	// once proven, the segments are cleared and the proven output root is set.
+	challenge.Segments = nil
+	challenge.OutputRoot = [32]byte{0xbb}
+	state = newDisputeState(7, chal.StatusProven, challenge, time.Unix(1500, 0))
+	require.Equal(t, "proven", state.Status)
+	require.True(t, state.TimedOut)
+	require.Equal(t, common.Hash{0xbb}, *state.ProvenOutputRoot)
+	require.Empty(t, state.Segments)
+}
+
+func TestDisputeProofJobs(t *testing.T) {
+	jobs := []*chal.ProofJob{{BlockNumber: 100}, {BlockNumber: 101}, {BlockNumber: 110}, {BlockNumber: 111}}
+	require.Equal(t, []*chal.ProofJob{{BlockNumber: 101}, {BlockNumber: 110}}, disputeProofJobs(jobs, 100, 10))
+	require.Empty(t, disputeProofJobs(nil, 100, 10))
+}
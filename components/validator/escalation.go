@@ -0,0 +1,270 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/validator/council"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+)
+
+const (
+	// EscalationProofDeadline is the escalation of a challenge whose proof cannot be generated before the
+	// proving timeout. No Security Council call can replace an output that is not proven, so it is only
+	// reported to the operator.
+	EscalationProofDeadline = "proof_deadline"
+	// EscalationForcedRollback is the escalation of a proven challenge that is not approved by the Security
+	// Council yet while its output is about to be finalized. The request is to approve the challenge,
+	// rolling the invalid output back.
+	EscalationForcedRollback = "forced_rollback"
+	// EscalationUsedPublicInput is the escalation of a challenge whose fault cannot be proven, since the public
+	// input of the faulty block has already been verified by the Colosseum. It is only reported to the operator.
+	EscalationUsedPublicInput = "used_public_input"
+)
+
+const (
+	// EscalationReported is the status of an escalation without a Security Council call.
+	EscalationReported = "reported"
+	// EscalationPending is the status of an escalation waiting for the confirmation of the operator.
+	EscalationPending = "pending"
+	// EscalationRejected is the status of an escalation rejected by the operator.
+	EscalationRejected = "rejected"
+	// EscalationSubmitting is the status of an escalation being submitted to the Security Council.
+	EscalationSubmitting = "submitting"
+	// EscalationSubmitted is the status of an escalation submitted to the Security Council.
+	EscalationSubmitted = "submitted"
+	// EscalationFailed is the status of an escalation that failed to be submitted. It can be confirmed again.
+	EscalationFailed = "failed"
+)
+
+var (
+	errEscalationNotFound       = errors.New("escalation not found")
+	errEscalationNotPending     = errors.New("escalation is not waiting for confirmation")
+	errEscalationSubmitDisabled = errors.New("submitting escalations to the security council is disabled")
+)
+
+// Escalation is a situation the challenger cannot resolve by itself, with the Security Council transaction
+// prepared to resolve it.
+type Escalation struct {
+	Kind        string `json:"kind"`
+	OutputIndex uint64 `json:"outputIndex"`
+	Reason      string `json:"reason"`
+	Status      string `json:"status"`
+	// Destination and Data are the call to be executed by the Security Council, empty if there is none.
+	Destination *common.Address `json:"destination,omitempty"`
+	Data        hexutil.Bytes   `json:"data,omitempty"`
+	// TransactionId is the id of the Security Council transaction once submitted.
+	TransactionId *hexutil.Big `json:"transactionId,omitempty"`
+	Error         string       `json:"error,omitempty"`
+	CreatedAt     time.Time    `json:"createdAt"`
+}
+
+func (e *Escalation) call() *council.Call {
+	if e.Destination == nil {
+		return nil
+	}
+	return &council.Call{Destination: *e.Destination, Value: common.Big0, Data: e.Data}
+}
+
+// councilSubmitter submits calls as Security Council transactions.
+type councilSubmitter interface {
+	Submit(ctx context.Context, call *council.Call) (*big.Int, error)
+}
+
+// councilEscalator prepares the Security Council transactions resolving the escalations of the challenger.
+// They are submitted right away in auto submit mode, or once confirmed by the operator through the admin API
+// otherwise. Every escalation is posted to the webhook, if any, so that the operator is notified.
+type councilEscalator struct {
+	log  log.Logger
+	metr metrics.Metricer
+
+	builder   *council.Builder
+	colosseum common.Address
+	// submitter is nil if the escalations cannot be submitted, i.e. in dry run mode.
+	submitter  councilSubmitter
+	autoSubmit bool
+	webhookURL string
+	client     *http.Client
+
+	mu          sync.Mutex
+	escalations map[uint64]*Escalation
+	wg          sync.WaitGroup
+}
+
+func newCouncilEscalator(cfg Config, l log.Logger, m metrics.Metricer) (*councilEscalator, error) {
+	builder, err := council.NewBuilder(cfg.SecurityCouncilAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &councilEscalator{
+		log:         l,
+		metr:        m,
+		builder:     builder,
+		colosseum:   cfg.ColosseumAddr,
+		autoSubmit:  cfg.CouncilEscalationAutoSubmit,
+		webhookURL:  cfg.CouncilEscalationWebhookURL,
+		client:      &http.Client{Timeout: webhookTimeout},
+		escalations: make(map[uint64]*Escalation),
+	}
+	if !cfg.ChallengerDryRun {
+		client, err := council.NewClient(cfg.SecurityCouncilAddr, cfg.L1Client, cfg.TxManager, cfg.NetworkTimeout, l)
+		if err != nil {
+			return nil, err
+		}
+		e.submitter = client
+	}
+	return e, nil
+}
+
+// escalate prepares the escalation of the given kind on the output, unless it is escalated already.
+// Only one escalation is kept per output, so that the operator confirms or rejects it by the output index.
+func (e *councilEscalator) escalate(ctx context.Context, kind string, outputIndex *big.Int, reason string) error {
+	escalation := &Escalation{
+		Kind:        kind,
+		OutputIndex: outputIndex.Uint64(),
+		Reason:      reason,
+		Status:      EscalationReported,
+		CreatedAt:   time.Now(),
+	}
+	if kind == EscalationForcedRollback {
+		call, err := e.builder.ApproveChallenge(e.colosseum, outputIndex)
+		if err != nil {
+			return err
+		}
+		escalation.Destination = &call.Destination
+		escalation.Data = call.Data
+		escalation.Status = EscalationPending
+	}
+
+	e.mu.Lock()
+	// a reported escalation is replaced by one with a council call, e.g. when a challenge whose proof
+	// was late is proven after all but is not approved in time.
+	if prev, ok := e.escalations[escalation.OutputIndex]; ok && !(prev.Status == EscalationReported && escalation.Destination != nil) {
+		e.mu.Unlock()
+		return nil
+	}
+	e.escalations[escalation.OutputIndex] = escalation
+	e.mu.Unlock()
+
+	e.log.Warn("escalating challenge to the security council", "kind", kind, "outputIndex", outputIndex,
+		"reason", reason, "status", escalation.Status)
+	e.metr.RecordCouncilEscalation(kind, escalation.Status)
+	e.notify(ctx, escalation)
+
+	if escalation.Status == EscalationPending && e.autoSubmit && e.submitter != nil {
+		e.setStatus(escalation, EscalationSubmitting, "")
+		return e.submit(ctx, escalation)
+	}
+	return nil
+}
+
+// Confirm submits the pending escalation of the given output on behalf of the operator.
+func (e *councilEscalator) Confirm(ctx context.Context, outputIndex uint64) error {
+	if e.submitter == nil {
+		return errEscalationSubmitDisabled
+	}
+	escalation, err := e.transition(outputIndex, EscalationSubmitting)
+	if err != nil {
+		return err
+	}
+	return e.submit(ctx, escalation)
+}
+
+// Reject drops the pending escalation of the given output on behalf of the operator.
+func (e *councilEscalator) Reject(outputIndex uint64) error {
+	escalation, err := e.transition(outputIndex, EscalationRejected)
+	if err != nil {
+		return err
+	}
+	e.log.Info("escalation rejected by the operator", "kind", escalation.Kind, "outputIndex", outputIndex)
+	return nil
+}
+
+// Escalations returns the escalations in the order of their outputs.
+func (e *councilEscalator) Escalations() []*Escalation {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	escalations := make([]*Escalation, 0, len(e.escalations))
+	for _, escalation := range e.escalations {
+		cpy := *escalation
+		escalations = append(escalations, &cpy)
+	}
+	sort.Slice(escalations, func(i, j int) bool { return escalations[i].OutputIndex < escalations[j].OutputIndex })
+	return escalations
+}
+
+// Wait waits for the webhook notifications in flight.
+func (e *councilEscalator) Wait() {
+	e.wg.Wait()
+}
+
+// transition moves the escalation of the given output to the given status, if it is waiting for
+// the confirmation of the operator or failed to be submitted.
+func (e *councilEscalator) transition(outputIndex uint64, status string) (*Escalation, error) {
+	e.mu.Lock()
+	escalation, ok := e.escalations[outputIndex]
+	if !ok {
+		e.mu.Unlock()
+		return nil, fmt.Errorf("%w: output %d", errEscalationNotFound, outputIndex)
+	}
+	if escalation.Status != EscalationPending && escalation.Status != EscalationFailed {
+		e.mu.Unlock()
+		return nil, fmt.Errorf("%w: output %d is %s", errEscalationNotPending, outputIndex, escalation.Status)
+	}
+	escalation.Status = status
+	escalation.Error = ""
+	e.mu.Unlock()
+
+	e.metr.RecordCouncilEscalation(escalation.Kind, status)
+	return escalation, nil
+}
+
+func (e *councilEscalator) submit(ctx context.Context, escalation *Escalation) error {
+	transactionId, err := e.submitter.Submit(ctx, escalation.call())
+	if err != nil {
+		e.setStatus(escalation, EscalationFailed, err.Error())
+		return fmt.Errorf("failed to submit escalation of output %d: %w", escalation.OutputIndex, err)
+	}
+
+	e.mu.Lock()
+	escalation.TransactionId = (*hexutil.Big)(transactionId)
+	e.mu.Unlock()
+	e.setStatus(escalation, EscalationSubmitted, "")
+	e.log.Info("submitted escalation to the security council", "kind", escalation.Kind,
+		"outputIndex", escalation.OutputIndex, "transactionId", transactionId)
+	return nil
+}
+
+func (e *councilEscalator) setStatus(escalation *Escalation, status string, errMsg string) {
+	e.mu.Lock()
+	escalation.Status = status
+	escalation.Error = errMsg
+	e.mu.Unlock()
+	e.metr.RecordCouncilEscalation(escalation.Kind, status)
+}
+
+func (e *councilEscalator) notify(ctx context.Context, escalation *Escalation) {
+	if e.webhookURL == "" {
+		return
+	}
+	cpy := *escalation
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		if err := postWebhook(ctx, e.client, e.webhookURL, &cpy); err != nil {
+			e.log.Warn("failed to notify council escalation webhook", "outputIndex", cpy.OutputIndex, "err", err)
+		}
+	}()
+}
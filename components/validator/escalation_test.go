@@ -0,0 +1,141 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/validator/council"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+)
+
+type mockCouncilSubmitter struct {
+	calls []*council.Call
+	err   error
+}
+
+func (m *mockCouncilSubmitter) Submit(_ context.Context, call *council.Call) (*big.Int, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.calls = append(m.calls, call)
+	return big.NewInt(int64(len(m.calls))), nil
+}
+
+func newTestEscalator(t *testing.T, submitter councilSubmitter, autoSubmit bool) *councilEscalator {
+	builder, err := council.NewBuilder(common.HexToAddress("0xc0"))
+	require.NoError(t, err)
+	return &councilEscalator{
+		log:         log.New(),
+		metr:        metrics.NoopMetrics,
+		builder:     builder,
+		colosseum:   common.HexToAddress("0xc1"),
+		submitter:   submitter,
+		autoSubmit:  autoSubmit,
+		escalations: make(map[uint64]*Escalation),
+	}
+}
+
+func TestCouncilEscalator_ForcedRollback(t *testing.T) {
+	submitter := &mockCouncilSubmitter{}
+	e := newTestEscalator(t, submitter, false)
+
+	require.NoError(t, e.escalate(context.Background(), EscalationForcedRollback, big.NewInt(7), "not approved"))
+	escalations := e.Escalations()
+	require.Len(t, escalations, 1)
+	require.Equal(t, EscalationPending, escalations[0].Status)
+	require.Equal(t, common.HexToAddress("0xc1"), *escalations[0].Destination)
+
+	colosseumABI, err := bindings.ColosseumMetaData.GetAbi()
+	require.NoError(t, err)
+	args, err := colosseumABI.Methods["approveChallenge"].Inputs.Unpack(escalations[0].Data[4:])
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7), args[0])
+
+	// escalating the same output again is ignored.
+	require.NoError(t, e.escalate(context.Background(), EscalationForcedRollback, big.NewInt(7), "not approved"))
+	require.Len(t, e.Escalations(), 1)
+	require.Empty(t, submitter.calls, "must wait for the confirmation of the operator")
+
+	require.NoError(t, e.Confirm(context.Background(), 7))
+	require.Len(t, submitter.calls, 1)
+	escalations = e.Escalations()
+	require.Equal(t, EscalationSubmitted, escalations[0].Status)
+	require.Equal(t, int64(1), escalations[0].TransactionId.ToInt().Int64())
+
+	require.ErrorIs(t, e.Confirm(context.Background(), 7), errEscalationNotPending)
+	require.ErrorIs(t, e.Reject(8), errEscalationNotFound)
+}
+
+func TestCouncilEscalator_AutoSubmit(t *testing.T) {
+	submitter := &mockCouncilSubmitter{err: errors.New("reverted")}
+	e := newTestEscalator(t, submitter, true)
+
+	require.Error(t, e.escalate(context.Background(), EscalationForcedRollback, big.NewInt(3), "not approved"))
+	escalations := e.Escalations()
+	require.Equal(t, EscalationFailed, escalations[0].Status)
+	require.Equal(t, "reverted", escalations[0].Error)
+
+	// failed escalations can be confirmed again.
+	submitter.err = nil
+	require.NoError(t, e.Confirm(context.Background(), 3))
+	require.Equal(t, EscalationSubmitted, e.Escalations()[0].Status)
+}
+
+func TestCouncilEscalator_ProofDeadline(t *testing.T) {
+	submitter := &mockCouncilSubmitter{}
+	e := newTestEscalator(t, submitter, true)
+
+	require.NoError(t, e.escalate(context.Background(), EscalationProofDeadline, big.NewInt(5), "deadline missed"))
+	escalations := e.Escalations()
+	require.Equal(t, EscalationReported, escalations[0].Status)
+	require.Nil(t, escalations[0].Destination)
+	require.Empty(t, submitter.calls)
+
+	require.ErrorIs(t, e.Confirm(context.Background(), 5), errEscalationNotPending)
+}
+
+func TestCouncilEscalator_ReportThenForcedRollback(t *testing.T) {
+	submitter := &mockCouncilSubmitter{}
+	e := newTestEscalator(t, submitter, true)
+
+	require.NoError(t, e.escalate(context.Background(), EscalationProofDeadline, big.NewInt(4), "deadline missed"))
+	require.Equal(t, EscalationReported, e.Escalations()[0].Status)
+
+	require.NoError(t, e.escalate(context.Background(), EscalationForcedRollback, big.NewInt(4), "not approved"))
+	escalations := e.Escalations()
+	require.Len(t, escalations, 1)
+	require.Equal(t, EscalationForcedRollback, escalations[0].Kind)
+	require.Equal(t, EscalationSubmitted, escalations[0].Status)
+	require.Len(t, submitter.calls, 1)
+
+	// the submitted rollback is not replaced by a later report.
+	require.NoError(t, e.escalate(context.Background(), EscalationProofDeadline, big.NewInt(4), "deadline missed"))
+	require.Equal(t, EscalationSubmitted, e.Escalations()[0].Status)
+}
+
+func TestCouncilEscalator_ReportThenPendingRollback(t *testing.T) {
+	e := newTestEscalator(t, &mockCouncilSubmitter{}, false)
+
+	require.NoError(t, e.escalate(context.Background(), EscalationProofDeadline, big.NewInt(4), "deadline missed"))
+	require.NoError(t, e.escalate(context.Background(), EscalationForcedRollback, big.NewInt(4), "not approved"))
+	escalations := e.Escalations()
+	require.Len(t, escalations, 1)
+	require.Equal(t, EscalationPending, escalations[0].Status)
+	require.NotNil(t, escalations[0].Destination)
+}
+
+func TestCouncilEscalator_Reject(t *testing.T) {
+	e := newTestEscalator(t, nil, false)
+
+	require.NoError(t, e.escalate(context.Background(), EscalationForcedRollback, big.NewInt(2), "not approved"))
+	require.ErrorIs(t, e.Confirm(context.Background(), 2), errEscalationSubmitDisabled)
+	require.NoError(t, e.Reject(2))
+	require.Equal(t, EscalationRejected, e.Escalations()[0].Status)
+}
@@ -0,0 +1,280 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/utils"
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+)
+
+const (
+	// ExitPhaseDraining waits for the challenges in progress to end and the bonds of the outputs
+	// submitted by the validator to expire.
+	ExitPhaseDraining = "draining"
+	// ExitPhaseUnbonding unbonds the expired bonds, up to MAX_UNBOND bonds per tx.
+	ExitPhaseUnbonding = "unbonding"
+	// ExitPhaseWithdrawing withdraws the whole balance of the validator from the ValidatorPool.
+	ExitPhaseWithdrawing = "withdrawing"
+	// ExitPhaseDone means that the validator has no bond nor balance left in the ValidatorPool.
+	ExitPhaseDone = "done"
+)
+
+var errExitWithBondTopUp = errors.New("validator cannot exit while the bond top-up is enabled")
+
+// ownBond is the latest bond of an output submitted by the validator that is not unbonded yet.
+type ownBond struct {
+	outputIndex *big.Int
+	bond        bindings.TypesBond
+}
+
+// exitPhase returns the phase of the exit given the state of the validator at the L1 time now.
+func exitPhase(activeChallenges int, bond *ownBond, now uint64, balance *big.Int) string {
+	switch {
+	case activeChallenges > 0:
+		return ExitPhaseDraining
+	case bond != nil && now < bond.bond.ExpiresAt.Uint64():
+		return ExitPhaseDraining
+	case bond != nil:
+		return ExitPhaseUnbonding
+	case balance.Sign() > 0:
+		return ExitPhaseWithdrawing
+	default:
+		return ExitPhaseDone
+	}
+}
+
+// Exiter performs a clean exit of the validator: it stops entering new submission rounds, waits out
+// the pending outputs and challenges, unbonds the bonds of the outputs and withdraws the whole balance
+// from the ValidatorPool.
+type Exiter struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	cfg        Config
+	log        log.Logger
+	metr       metrics.Metricer
+	l2os       *L2OutputSubmitter
+	challenger *Challenger
+
+	l2ooContract    *bindings.L2OutputOracleCaller
+	valpoolContract *bindings.ValidatorPoolCaller
+	valpoolABI      *abi.ABI
+
+	mu      sync.Mutex
+	started bool
+	phase   string
+
+	wg sync.WaitGroup
+}
+
+// NewExiter creates a new Exiter.
+func NewExiter(cfg Config, l log.Logger, m metrics.Metricer, l2os *L2OutputSubmitter, challenger *Challenger) (*Exiter, error) {
+	l2ooContract, err := bindings.NewL2OutputOracleCaller(cfg.L2OutputOracleAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	valpoolContract, err := bindings.NewValidatorPoolCaller(cfg.ValidatorPoolAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := bindings.ValidatorPoolMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Exiter{
+		cfg:             cfg,
+		log:             l,
+		metr:            m,
+		l2os:            l2os,
+		challenger:      challenger,
+		l2ooContract:    l2ooContract,
+		valpoolContract: valpoolContract,
+		valpoolABI:      parsed,
+	}, nil
+}
+
+// Start starts the exit. It is a no-op if the exit is started already.
+func (e *Exiter) Start(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.started {
+		return nil
+	}
+	e.started = true
+	e.phase = ExitPhaseDraining
+
+	e.ctx, e.cancel = context.WithCancel(ctx)
+	e.log.Info("starting validator exit")
+
+	e.wg.Add(1)
+	go e.loop()
+
+	return nil
+}
+
+func (e *Exiter) Stop() error {
+	e.mu.Lock()
+	started := e.started
+	e.mu.Unlock()
+	if !started {
+		return nil
+	}
+
+	e.log.Info("stopping validator exit")
+	e.cancel()
+	e.wg.Wait()
+
+	return nil
+}
+
+// Phase returns the current phase of the exit, or an empty string if the exit is not started.
+func (e *Exiter) Phase() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.phase
+}
+
+func (e *Exiter) setPhase(phase string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.phase != phase {
+		e.log.Info("validator exit progressed", "from", e.phase, "to", phase)
+	}
+	e.phase = phase
+}
+
+func (e *Exiter) loop() {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(e.cfg.ExitPollInterval)
+	defer ticker.Stop()
+
+	for ; ; <-ticker.C {
+		select {
+		case <-e.ctx.Done():
+			return
+		default:
+			done, err := e.step(e.ctx)
+			if err != nil {
+				e.log.Error("failed to progress validator exit", "phase", e.Phase(), "err", err)
+				continue
+			}
+			if done {
+				e.log.Info("validator exit completed, it has no bond nor balance left in the ValidatorPool")
+				return
+			}
+		}
+	}
+}
+
+// step determines the phase of the exit and takes its action. It returns true once the exit is done.
+func (e *Exiter) step(ctx context.Context) (bool, error) {
+	// keep the submitter paused even if it is resumed through the admin API.
+	e.l2os.Pause()
+
+	cCtx, cCancel := context.WithTimeout(ctx, e.cfg.NetworkTimeout)
+	defer cCancel()
+	opts := utils.NewSimpleCallOpts(cCtx)
+	from := e.cfg.TxManager.From()
+
+	bond, err := e.latestOwnBond(opts)
+	if err != nil {
+		return false, err
+	}
+	header, err := e.cfg.L1Client.HeaderByNumber(cCtx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch L1 head: %w", err)
+	}
+	balance, err := e.valpoolContract.BalanceOf(opts, from)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch validator deposit amount: %w", err)
+	}
+
+	activeChallenges := e.challenger.ActiveChallenges()
+	phase := exitPhase(len(activeChallenges), bond, header.Time, balance)
+	e.setPhase(phase)
+
+	switch phase {
+	case ExitPhaseDraining:
+		if len(activeChallenges) > 0 {
+			e.log.Info("waiting for challenges to end", "activeChallenges", activeChallenges)
+		} else {
+			e.log.Info("waiting for the bond of the last submitted output to expire", "outputIndex", bond.outputIndex,
+				"expiresAt", bond.bond.ExpiresAt, "remaining", time.Duration(bond.bond.ExpiresAt.Uint64()-header.Time)*time.Second)
+		}
+	case ExitPhaseUnbonding:
+		if err := e.send(ctx, "unbond"); err != nil {
+			return false, fmt.Errorf("failed to unbond: %w", err)
+		}
+		e.log.Info("unbonded expired bonds", "lastOwnOutputIndex", bond.outputIndex)
+	case ExitPhaseWithdrawing:
+		if err := e.send(ctx, "withdraw", balance); err != nil {
+			return false, fmt.Errorf("failed to withdraw: %w", err)
+		}
+		e.log.Info("withdrew balance from ValidatorPool", "amount", balance)
+		e.metr.RecordDepositAmount(new(big.Int))
+	case ExitPhaseDone:
+		return true, nil
+	}
+	return false, nil
+}
+
+// latestOwnBond returns the bond of the latest output submitted by the validator that is not unbonded yet,
+// or nil if there is none. Since bonds are unbonded in order, only the outputs after the last unbonded
+// one are scanned.
+func (e *Exiter) latestOwnBond(opts *bind.CallOpts) (*ownBond, error) {
+	nextOutputIndex, err := e.l2ooContract.NextOutputIndex(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch next output index: %w", err)
+	}
+	from := e.cfg.TxManager.From()
+	for i := new(big.Int).Sub(nextOutputIndex, common.Big1); i.Sign() >= 0; i.Sub(i, common.Big1) {
+		bond, err := e.valpoolContract.GetBond(opts, i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch bond of output %d: %w", i, err)
+		}
+		if bond.Amount == nil || bond.Amount.Sign() == 0 {
+			return nil, nil
+		}
+		submitter, err := e.l2ooContract.GetSubmitter(opts, i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch submitter of output %d: %w", i, err)
+		}
+		if submitter == from {
+			return &ownBond{outputIndex: new(big.Int).Set(i), bond: bond}, nil
+		}
+	}
+	return nil, nil
+}
+
+// send sends a tx calling the given method of the ValidatorPool.
+func (e *Exiter) send(ctx context.Context, method string, args ...any) error {
+	data, err := e.valpoolABI.Pack(method, args...)
+	if err != nil {
+		return fmt.Errorf("failed to create %s transaction data: %w", method, err)
+	}
+	txResponse := e.cfg.TxManager.SendTxCandidate(ctx, &txmgr.TxCandidate{
+		TxData:   data,
+		To:       &e.cfg.ValidatorPoolAddr,
+		GasLimit: 0,
+	})
+	if txResponse.Err != nil {
+		return txResponse.Err
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+)
+
+func TestExitPhase(t *testing.T) {
+	bond := &ownBond{
+		outputIndex: big.NewInt(3),
+		bond:        bindings.TypesBond{Amount: big.NewInt(100), ExpiresAt: big.NewInt(1000)},
+	}
+	balance := big.NewInt(500)
+
+	tests := []struct {
+		name             string
+		activeChallenges int
+		bond             *ownBond
+		now              uint64
+		balance          *big.Int
+		want             string
+	}{
+		{"challenges in progress", 1, nil, 2000, balance, ExitPhaseDraining},
+		{"challenges in progress after bond expiry", 2, bond, 2000, balance, ExitPhaseDraining},
+		{"bond not expired", 0, bond, 999, balance, ExitPhaseDraining},
+		{"bond expired", 0, bond, 1000, balance, ExitPhaseUnbonding},
+		{"balance left", 0, nil, 2000, balance, ExitPhaseWithdrawing},
+		{"nothing left", 0, nil, 2000, new(big.Int), ExitPhaseDone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, exitPhase(tt.activeChallenges, tt.bond, tt.now, tt.balance))
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/validator/metrics"
+)
+
+// LeaderLock coordinates the validator instances sharing the same key,
+// so that only one of them submits outputs at a time.
+type LeaderLock interface {
+	// ID returns the id of the instance.
+	ID() string
+	// TryAcquire acquires or renews the lock if it is free, expired or already held by the instance.
+	TryAcquire() (bool, error)
+	// Steal acquires the lock even if it is held by another instance, as long as it is still held by the given holder,
+	// or free. It returns false if the lock changed hands in the meantime, e.g. to another standby instance.
+	Steal(holder string) (bool, error)
+	// Holder returns the id of the instance holding the lock, or an empty string if the lock is free.
+	Holder() (string, error)
+}
+
+type leaderLockState struct {
+	Holder    string `json:"holder"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+// leaderLockGuardTimeout is the time to wait for the guard of the leader lock held by another instance.
+const leaderLockGuardTimeout = 2 * time.Second
+
+// leaderLockGuardStaleAfter is the age after which the guard of the leader lock is considered abandoned,
+// e.g. by an instance that crashed while updating the lock.
+const leaderLockGuardStaleAfter = 10 * time.Second
+
+// FileLeaderLock is a LeaderLock backed by a file, which must be on a filesystem shared by the instances.
+// Every update of the lock happens while holding a guard file, which is created exclusively (O_EXCL)
+// so that only one instance at a time can read and update the lock, and the update is read back
+// to confirm the ownership.
+type FileLeaderLock struct {
+	path string
+	id   string
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu sync.Mutex
+}
+
+// NewFileLeaderLock creates a FileLeaderLock held as the given instance id for ttl after each renewal.
+func NewFileLeaderLock(path string, id string, ttl time.Duration) *FileLeaderLock {
+	return &FileLeaderLock{
+		path: path,
+		id:   id,
+		ttl:  ttl,
+		now:  time.Now,
+	}
+}
+
+func (f *FileLeaderLock) ID() string {
+	return f.id
+}
+
+func (f *FileLeaderLock) TryAcquire() (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.update(func(state *leaderLockState) bool {
+		return state == nil || state.Holder == f.id || f.now().Unix() >= state.ExpiresAt
+	})
+}
+
+func (f *FileLeaderLock) Steal(holder string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.update(func(state *leaderLockState) bool {
+		return state == nil || state.Holder == holder || state.Holder == f.id || f.now().Unix() >= state.ExpiresAt
+	})
+}
+
+// update writes the lock as held by the instance if canAcquire returns true for the current state of the lock,
+// and returns whether the instance holds the lock after the update.
+func (f *FileLeaderLock) update(canAcquire func(state *leaderLockState) bool) (bool, error) {
+	release, err := f.guard()
+	if err != nil {
+		return false, err
+	}
+	defer release()
+
+	state, err := f.read()
+	if err != nil {
+		return false, err
+	}
+	if !canAcquire(state) {
+		return false, nil
+	}
+	written, err := f.write()
+	if err != nil {
+		return false, err
+	}
+	// Read the lock back, in case another instance updated it without the guard, e.g. after taking a stale guard.
+	state, err = f.read()
+	if err != nil {
+		return false, err
+	}
+	return state != nil && *state == written, nil
+}
+
+// guard creates the guard file of the lock, waiting for other instances to release it,
+// and returns the function to release it.
+func (f *FileLeaderLock) guard() (func(), error) {
+	path := f.path + ".guard"
+	deadline := time.Now().Add(leaderLockGuardTimeout)
+	for {
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err == nil {
+			_, err = file.WriteString(f.id)
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				_ = os.Remove(path)
+				return nil, fmt.Errorf("failed to write leader lock guard: %w", err)
+			}
+			return func() { _ = os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("failed to create leader lock guard: %w", err)
+		}
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > leaderLockGuardStaleAfter {
+			// The instance holding the guard did not release it, remove it to not block the lock forever.
+			if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return nil, fmt.Errorf("failed to remove stale leader lock guard: %w", err)
+			}
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, errors.New("timed out waiting for leader lock guard")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func (f *FileLeaderLock) Holder() (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	state, err := f.read()
+	if err != nil || state == nil || f.now().Unix() >= state.ExpiresAt {
+		return "", err
+	}
+	return state.Holder, nil
+}
+
+func (f *FileLeaderLock) read() (*leaderLockState, error) {
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read leader lock: %w", err)
+	}
+	var state leaderLockState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode leader lock: %w", err)
+	}
+	return &state, nil
+}
+
+func (f *FileLeaderLock) write() (leaderLockState, error) {
+	state := leaderLockState{Holder: f.id, ExpiresAt: f.now().Add(f.ttl).Unix()}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return state, err
+	}
+	tmp := f.path + "." + f.id + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return state, fmt.Errorf("failed to write leader lock: %w", err)
+	}
+	if err := os.Rename(tmp, f.path); err != nil {
+		return state, fmt.Errorf("failed to replace leader lock: %w", err)
+	}
+	return state, nil
+}
+
+// Failover decides which of the validator instances sharing the same key submits outputs.
+// The primary instance submits whenever it holds the leader lock. A standby instance monitors
+// the submissions instead, and takes the lock over when the output has not been submitted
+// within the takeover delay after it became ready to submit, i.e. the primary missed its round.
+type Failover struct {
+	lock          LeaderLock
+	standby       bool
+	takeoverDelay time.Duration
+	log           log.Logger
+	metr          metrics.Metricer
+	now           func() time.Time
+
+	// pendingBlock is the block of the output waited to be submitted by the leader since pendingSince.
+	pendingBlock uint64
+	pendingSince time.Time
+}
+
+// NewFailover creates a new Failover.
+func NewFailover(lock LeaderLock, standby bool, takeoverDelay time.Duration, l log.Logger, m metrics.Metricer) *Failover {
+	return &Failover{
+		lock:          lock,
+		standby:       standby,
+		takeoverDelay: takeoverDelay,
+		log:           l,
+		metr:          m,
+		now:           time.Now,
+	}
+}
+
+// ShouldSubmit returns whether the instance should submit the output at the given block, which is ready to submit.
+func (f *Failover) ShouldSubmit(blockNumber uint64) (bool, error) {
+	if f.standby {
+		holder, err := f.lock.Holder()
+		if err != nil {
+			return false, err
+		}
+		if holder != f.lock.ID() {
+			return f.maybeTakeOver(blockNumber, holder)
+		}
+	}
+
+	acquired, err := f.lock.TryAcquire()
+	if err != nil {
+		return false, err
+	}
+	f.metr.RecordLeader(acquired)
+	return acquired, nil
+}
+
+// maybeTakeOver takes the leader lock over if the output at the given block has been left
+// unsubmitted for the takeover delay.
+func (f *Failover) maybeTakeOver(blockNumber uint64, holder string) (bool, error) {
+	f.metr.RecordLeader(false)
+	if f.pendingBlock != blockNumber || f.pendingSince.IsZero() {
+		f.pendingBlock = blockNumber
+		f.pendingSince = f.now()
+	}
+	if f.now().Sub(f.pendingSince) < f.takeoverDelay {
+		f.log.Info("standby: waiting for leader to submit output", "leader", holder, "blockNumber", blockNumber)
+		return false, nil
+	}
+
+	f.log.Warn("standby: leader missed its submission, taking over", "leader", holder, "blockNumber", blockNumber,
+		"pendingSince", f.pendingSince)
+	stolen, err := f.lock.Steal(holder)
+	if err != nil {
+		return false, err
+	}
+	if !stolen {
+		f.log.Warn("standby: leader lock was taken by another instance in the meantime")
+		return false, nil
+	}
+	f.metr.RecordLeader(true)
+	return true, nil
+}
+
+// RenewLoop keeps renewing the leader lock while it is held, so that it does not expire between submissions.
+func (f *Failover) RenewLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			holder, err := f.lock.Holder()
+			if err != nil {
+				f.log.Warn("failed to get leader lock holder", "err", err)
+				continue
+			}
+			if holder != f.lock.ID() {
+				continue
+			}
+			acquired, err := f.lock.TryAcquire()
+			if err != nil {
+				f.log.Warn("failed to renew leader lock", "err", err)
+				continue
+			}
+			f.metr.RecordLeader(acquired)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
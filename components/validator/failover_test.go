@@ -0,0 +1,115 @@
+package validator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/testlog"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+)
+
+func TestFileLeaderLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "leader.lock")
+	now := time.Unix(1000, 0)
+	clock := func() time.Time { return now }
+
+	a := NewFileLeaderLock(path, "a", time.Minute)
+	a.now = clock
+	b := NewFileLeaderLock(path, "b", time.Minute)
+	b.now = clock
+
+	holder, err := a.Holder()
+	require.NoError(t, err)
+	require.Empty(t, holder)
+
+	acquired, err := a.TryAcquire()
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	acquired, err = b.TryAcquire()
+	require.NoError(t, err)
+	require.False(t, acquired, "lock held by another instance")
+
+	acquired, err = a.TryAcquire()
+	require.NoError(t, err)
+	require.True(t, acquired, "lock renewed by the holder")
+
+	now = now.Add(time.Minute)
+	acquired, err = b.TryAcquire()
+	require.NoError(t, err)
+	require.True(t, acquired, "expired lock acquired by another instance")
+
+	stolen, err := a.Steal("c")
+	require.NoError(t, err)
+	require.False(t, stolen, "lock is not held by the expected holder anymore")
+
+	stolen, err = a.Steal("b")
+	require.NoError(t, err)
+	require.True(t, stolen)
+	holder, err = b.Holder()
+	require.NoError(t, err)
+	require.Equal(t, "a", holder)
+}
+
+func TestFileLeaderLockGuard(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "leader.lock")
+	a := NewFileLeaderLock(path, "a", time.Minute)
+
+	// another instance is updating the lock
+	require.NoError(t, os.WriteFile(path+".guard", []byte("b"), 0o644))
+	_, err := a.TryAcquire()
+	require.Error(t, err, "lock is not updated while another instance holds the guard")
+
+	// the other instance crashed while holding the guard
+	stale := time.Now().Add(-2 * leaderLockGuardStaleAfter)
+	require.NoError(t, os.Chtimes(path+".guard", stale, stale))
+	acquired, err := a.TryAcquire()
+	require.NoError(t, err)
+	require.True(t, acquired, "stale guard is removed")
+	require.NoFileExists(t, path+".guard", "guard is released after the update")
+}
+
+func TestFailover_StandbyTakesOver(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "leader.lock")
+	now := time.Unix(1000, 0)
+	clock := func() time.Time { return now }
+	l := testlog.Logger(t, log.LvlCrit)
+
+	primaryLock := NewFileLeaderLock(path, "primary", time.Hour)
+	primaryLock.now = clock
+	standbyLock := NewFileLeaderLock(path, "standby", time.Hour)
+	standbyLock.now = clock
+
+	primary := NewFailover(primaryLock, false, time.Minute, l, metrics.NoopMetrics)
+	standby := NewFailover(standbyLock, true, time.Minute, l, metrics.NoopMetrics)
+	standby.now = clock
+
+	ok, err := primary.ShouldSubmit(10)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = standby.ShouldSubmit(20)
+	require.NoError(t, err)
+	require.False(t, ok, "standby waits for the leader")
+
+	// The leader submits the output in time, so the next output resets the delay.
+	now = now.Add(30 * time.Second)
+	ok, err = standby.ShouldSubmit(30)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// The leader misses its round.
+	now = now.Add(time.Minute)
+	ok, err = standby.ShouldSubmit(30)
+	require.NoError(t, err)
+	require.True(t, ok, "standby takes over")
+
+	ok, err = primary.ShouldSubmit(30)
+	require.NoError(t, err)
+	require.False(t, ok, "primary steps down")
+}
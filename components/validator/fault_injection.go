@@ -0,0 +1,91 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup"
+)
+
+// FaultInjection configures the faults deliberately injected into the outputs the validator uses,
+// so that e2e tests and testnets can exercise the full challenge and proof path of the Colosseum.
+// It must never be enabled on production networks.
+type FaultInjection struct {
+	// OutputBlock is the first block whose output is corrupted. The outputs of all the following
+	// blocks are corrupted too, so that the submitted outputs and the segments built by the validator
+	// stay consistent during bisection. 0 disables it.
+	OutputBlock uint64
+
+	// SegmentBlock is the block whose output alone is corrupted, so that the validator builds
+	// a wrong intermediate segment when the block is a segment of a challenge. 0 disables it.
+	SegmentBlock uint64
+}
+
+// Enabled returns true if any fault is injected.
+func (f FaultInjection) Enabled() bool {
+	return f.OutputBlock != 0 || f.SegmentBlock != 0
+}
+
+func (f FaultInjection) corrupts(blockNumber uint64) bool {
+	return (f.OutputBlock != 0 && blockNumber >= f.OutputBlock) || (f.SegmentBlock != 0 && blockNumber == f.SegmentBlock)
+}
+
+// faultInjectingOutputSource corrupts the outputs of the given source according to the fault injection.
+type faultInjectingOutputSource struct {
+	source OutputSource
+	faults FaultInjection
+	log    log.Logger
+}
+
+func newFaultInjectingOutputSource(source OutputSource, faults FaultInjection, l log.Logger) *faultInjectingOutputSource {
+	return &faultInjectingOutputSource{
+		source: source,
+		faults: faults,
+		log:    l,
+	}
+}
+
+func (s *faultInjectingOutputSource) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	output, err := s.source.OutputAtBlock(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	return s.maybeCorrupt(output)
+}
+
+func (s *faultInjectingOutputSource) OutputsAtBlocks(ctx context.Context, blockNums []uint64) ([]*eth.OutputResponse, error) {
+	outputs, err := s.source.OutputsAtBlocks(ctx, blockNums)
+	if err != nil {
+		return nil, err
+	}
+	for i, output := range outputs {
+		if outputs[i], err = s.maybeCorrupt(output); err != nil {
+			return nil, err
+		}
+	}
+	return outputs, nil
+}
+
+// maybeCorrupt replaces the state root of the output with a deterministic wrong one, and recomputes
+// the output root from it so that the output root proof of the corrupted output is still consistent.
+func (s *faultInjectingOutputSource) maybeCorrupt(output *eth.OutputResponse) (*eth.OutputResponse, error) {
+	if output == nil || !s.faults.corrupts(output.BlockRef.Number) {
+		return output, nil
+	}
+
+	corrupted := *output
+	corrupted.StateRoot = crypto.Keccak256Hash(output.StateRoot[:])
+	proof := corrupted.ToOutputRootProof()
+	outputRoot, err := rollup.ComputeL2OutputRoot(&proof)
+	if err != nil {
+		return nil, err
+	}
+	corrupted.OutputRoot = outputRoot
+
+	s.log.Warn("injected fault into output", "blockNumber", output.BlockRef.Number,
+		"outputRoot", output.OutputRoot, "corrupted", corrupted.OutputRoot)
+	return &corrupted, nil
+}
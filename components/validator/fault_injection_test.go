@@ -0,0 +1,34 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+func TestFaultInjectingOutputSource(t *testing.T) {
+	honest := &fakeOutputSource{}
+	source := newFaultInjectingOutputSource(&fakeOutputSource{}, FaultInjection{OutputBlock: 20, SegmentBlock: 5}, testlog.Logger(t, log.LvlCrit))
+
+	outputs, err := source.OutputsAtBlocks(context.Background(), []uint64{4, 5, 6, 19, 20, 21})
+	require.NoError(t, err)
+	expected := []bool{false, true, false, false, true, true}
+	for i, output := range outputs {
+		want, err := honest.OutputAtBlock(context.Background(), output.BlockRef.Number)
+		require.NoError(t, err)
+		require.Equal(t, expected[i], output.OutputRoot != want.OutputRoot, "block %d", output.BlockRef.Number)
+		if !expected[i] {
+			continue
+		}
+
+		proof := output.ToOutputRootProof()
+		outputRoot, err := rollup.ComputeL2OutputRoot(&proof)
+		require.NoError(t, err)
+		require.Equal(t, outputRoot, output.OutputRoot, "corrupted output root should match its proof")
+	}
+}
@@ -0,0 +1,353 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/utils"
+)
+
+const (
+	// OutputPending is the status of an output in its finalization window, without a challenge in progress.
+	OutputPending = "pending"
+	// OutputChallenged is the status of an output with a challenge in progress.
+	OutputChallenged = "challenged"
+	// OutputFinalized is the status of an output whose finalization window is over.
+	OutputFinalized = "finalized"
+	// OutputDeleted is the status of an output replaced after a successful challenge.
+	OutputDeleted = "deleted"
+)
+
+// maxTrackedOutputs is the number of outputs kept by the finality tracker. The oldest outputs that are
+// finalized or deleted are dropped beyond it.
+const maxTrackedOutputs = 1000
+
+var outputStatuses = []string{OutputPending, OutputChallenged, OutputFinalized, OutputDeleted}
+
+// OutputFinality is the finalization progress of a submitted output.
+type OutputFinality struct {
+	OutputIndex   uint64         `json:"outputIndex"`
+	OutputRoot    common.Hash    `json:"outputRoot"`
+	L2BlockNumber uint64         `json:"l2BlockNumber"`
+	Submitter     common.Address `json:"submitter"`
+	// Own is true if the output was submitted by this validator.
+	Own bool `json:"own"`
+	// SubmittedAt is the L1 timestamp of the submission.
+	SubmittedAt uint64 `json:"submittedAt"`
+	// FinalizesAt is the L1 timestamp at which the finalization window is over, unless the output is deleted.
+	FinalizesAt uint64 `json:"finalizesAt"`
+	Status      string `json:"status"`
+	// ReplacedBy is the output root that replaced the submitted one, if the output is deleted.
+	ReplacedBy *common.Hash `json:"replacedBy,omitempty"`
+}
+
+func (o *OutputFinality) done() bool {
+	return o.Status == OutputFinalized || o.Status == OutputDeleted
+}
+
+// outputFinalityStatus returns the status of the output, given its current root and state on chain.
+func outputFinalityStatus(submittedRoot, currentRoot common.Hash, finalized, challenged bool) string {
+	switch {
+	case submittedRoot != currentRoot:
+		return OutputDeleted
+	case finalized:
+		return OutputFinalized
+	case challenged:
+		return OutputChallenged
+	default:
+		return OutputPending
+	}
+}
+
+// FinalityTracker tracks the submitted outputs through their finalization window, including the challenges,
+// and reports their status in metrics and through the RPC, e.g. to monitor the readiness of withdrawals.
+type FinalityTracker struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	cfg  Config
+	log  log.Logger
+	metr metrics.Metricer
+
+	l2ooContract      *bindings.L2OutputOracleCaller
+	colosseumContract *bindings.ColosseumCaller
+	// finalizationPeriod is the FINALIZATION_PERIOD_SECONDS of the L2OutputOracle, loaded on start.
+	finalizationPeriod uint64
+
+	mu      sync.RWMutex
+	outputs map[uint64]*OutputFinality
+	// next is the index of the next output to track, nil until the first update.
+	next *uint64
+
+	wg sync.WaitGroup
+}
+
+// NewFinalityTracker creates a new FinalityTracker.
+func NewFinalityTracker(cfg Config, l log.Logger, m metrics.Metricer) (*FinalityTracker, error) {
+	l2ooContract, err := bindings.NewL2OutputOracleCaller(cfg.L2OutputOracleAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+	colosseumContract, err := bindings.NewColosseumCaller(cfg.ColosseumAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FinalityTracker{
+		cfg:               cfg,
+		log:               l,
+		metr:              m,
+		l2ooContract:      l2ooContract,
+		colosseumContract: colosseumContract,
+		outputs:           make(map[uint64]*OutputFinality),
+	}, nil
+}
+
+func (f *FinalityTracker) Start(ctx context.Context) error {
+	f.ctx, f.cancel = context.WithCancel(ctx)
+
+	cCtx, cCancel := context.WithTimeout(f.ctx, f.cfg.NetworkTimeout)
+	defer cCancel()
+	finalizationPeriod, err := f.l2ooContract.FINALIZATIONPERIODSECONDS(utils.NewSimpleCallOpts(cCtx))
+	if err != nil {
+		return fmt.Errorf("failed to get finalization period: %w", err)
+	}
+	f.finalizationPeriod = finalizationPeriod.Uint64()
+	f.log.Info("starting finality tracker", "finalizationPeriod", f.finalizationPeriod)
+
+	f.wg.Add(1)
+	go f.loop()
+
+	return nil
+}
+
+func (f *FinalityTracker) Stop() error {
+	f.log.Info("stopping finality tracker")
+	f.cancel()
+	f.wg.Wait()
+
+	return nil
+}
+
+func (f *FinalityTracker) loop() {
+	defer f.wg.Done()
+
+	ticker := time.NewTicker(f.cfg.FinalityTrackerPollInterval)
+	defer ticker.Stop()
+
+	for ; ; <-ticker.C {
+		select {
+		case <-f.ctx.Done():
+			return
+		default:
+			if err := f.update(f.ctx); err != nil {
+				f.log.Warn("failed to update output finality", "err", err)
+			}
+		}
+	}
+}
+
+// update tracks the outputs submitted since the last update, and updates the status of the tracked outputs.
+func (f *FinalityTracker) update(ctx context.Context) error {
+	nextOutputIndex, err := f.call(ctx, f.l2ooContract.NextOutputIndex)
+	if err != nil {
+		return fmt.Errorf("failed to get next output index: %w", err)
+	}
+	next := nextOutputIndex.Uint64()
+
+	f.mu.RLock()
+	start := f.next
+	f.mu.RUnlock()
+	if start == nil {
+		first, err := f.firstPendingOutput(ctx, next)
+		if err != nil {
+			return err
+		}
+		start = &first
+	}
+
+	// only this goroutine modifies the records, so they are read without the lock.
+	var updated []*OutputFinality
+	for i := *start; i < next; i++ {
+		output, err := f.getL2Output(ctx, i)
+		if err != nil {
+			return fmt.Errorf("failed to get output %d: %w", i, err)
+		}
+		updated = append(updated, &OutputFinality{
+			OutputIndex:   i,
+			OutputRoot:    output.OutputRoot,
+			L2BlockNumber: output.L2BlockNumber.Uint64(),
+			Submitter:     output.Submitter,
+			Own:           output.Submitter == f.cfg.TxManager.From(),
+			SubmittedAt:   output.Timestamp.Uint64(),
+			FinalizesAt:   output.Timestamp.Uint64() + f.finalizationPeriod,
+			Status:        OutputPending,
+		})
+	}
+	for _, o := range f.outputs {
+		if !o.done() {
+			c := *o
+			updated = append(updated, &c)
+		}
+	}
+
+	for _, o := range updated {
+		if err := f.updateStatus(ctx, o); err != nil {
+			return err
+		}
+	}
+
+	f.mu.Lock()
+	for _, o := range updated {
+		f.outputs[o.OutputIndex] = o
+	}
+	f.next = &next
+	f.prune()
+	counts := f.counts()
+	f.mu.Unlock()
+
+	for _, status := range outputStatuses {
+		f.metr.RecordOutputFinality(status, counts[status])
+	}
+	return nil
+}
+
+// firstPendingOutput returns the index of the oldest output that is not finalized yet, walking back from
+// the latest output, or next if all the outputs are finalized.
+func (f *FinalityTracker) firstPendingOutput(ctx context.Context, next uint64) (uint64, error) {
+	for i := next; i > 0; i-- {
+		finalized, err := f.isFinalized(ctx, i-1)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get if output %d is finalized: %w", i-1, err)
+		}
+		if finalized {
+			return i, nil
+		}
+	}
+	return 0, nil
+}
+
+func (f *FinalityTracker) updateStatus(ctx context.Context, o *OutputFinality) error {
+	output, err := f.getL2Output(ctx, o.OutputIndex)
+	if err != nil {
+		return fmt.Errorf("failed to get output %d: %w", o.OutputIndex, err)
+	}
+	finalized, err := f.isFinalized(ctx, o.OutputIndex)
+	if err != nil {
+		return fmt.Errorf("failed to get if output %d is finalized: %w", o.OutputIndex, err)
+	}
+	challenged, err := f.isChallenged(ctx, o.OutputIndex)
+	if err != nil {
+		return fmt.Errorf("failed to get if output %d is challenged: %w", o.OutputIndex, err)
+	}
+
+	currentRoot := common.Hash(output.OutputRoot)
+	status := outputFinalityStatus(o.OutputRoot, currentRoot, finalized, challenged)
+	if status == o.Status {
+		return nil
+	}
+	o.Status = status
+	if status == OutputDeleted {
+		o.ReplacedBy = &currentRoot
+	}
+
+	logFn := f.log.Info
+	if o.Own && (status == OutputChallenged || status == OutputDeleted) {
+		logFn = f.log.Warn
+	}
+	logFn("output status changed", "outputIndex", o.OutputIndex, "status", status, "own", o.Own,
+		"submitter", o.Submitter, "finalizesAt", o.FinalizesAt)
+	return nil
+}
+
+// call calls the contract with the network timeout.
+func (f *FinalityTracker) call(ctx context.Context, fn func(*bind.CallOpts) (*big.Int, error)) (*big.Int, error) {
+	cCtx, cCancel := context.WithTimeout(ctx, f.cfg.NetworkTimeout)
+	defer cCancel()
+	return fn(utils.NewSimpleCallOpts(cCtx))
+}
+
+func (f *FinalityTracker) getL2Output(ctx context.Context, outputIndex uint64) (bindings.TypesCheckpointOutput, error) {
+	cCtx, cCancel := context.WithTimeout(ctx, f.cfg.NetworkTimeout)
+	defer cCancel()
+	return f.l2ooContract.GetL2Output(utils.NewSimpleCallOpts(cCtx), new(big.Int).SetUint64(outputIndex))
+}
+
+func (f *FinalityTracker) isFinalized(ctx context.Context, outputIndex uint64) (bool, error) {
+	cCtx, cCancel := context.WithTimeout(ctx, f.cfg.NetworkTimeout)
+	defer cCancel()
+	return f.l2ooContract.IsFinalized(utils.NewSimpleCallOpts(cCtx), new(big.Int).SetUint64(outputIndex))
+}
+
+func (f *FinalityTracker) isChallenged(ctx context.Context, outputIndex uint64) (bool, error) {
+	cCtx, cCancel := context.WithTimeout(ctx, f.cfg.NetworkTimeout)
+	defer cCancel()
+	return f.colosseumContract.IsInProgress(utils.NewSimpleCallOpts(cCtx), new(big.Int).SetUint64(outputIndex))
+}
+
+// prune drops the oldest outputs that are done beyond maxTrackedOutputs. It must be called with the lock held.
+func (f *FinalityTracker) prune() {
+	if len(f.outputs) <= maxTrackedOutputs {
+		return
+	}
+	indexes := make([]uint64, 0, len(f.outputs))
+	for i := range f.outputs {
+		indexes = append(indexes, i)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	for _, i := range indexes {
+		if len(f.outputs) <= maxTrackedOutputs {
+			return
+		}
+		if f.outputs[i].done() {
+			delete(f.outputs, i)
+		}
+	}
+}
+
+// counts returns the number of tracked outputs by status. It must be called with the lock held.
+func (f *FinalityTracker) counts() map[string]int {
+	counts := make(map[string]int)
+	for _, o := range f.outputs {
+		counts[o.Status]++
+	}
+	return counts
+}
+
+// Output returns the finality of the output, or nil if it is not tracked.
+func (f *FinalityTracker) Output(outputIndex uint64) *OutputFinality {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	o, ok := f.outputs[outputIndex]
+	if !ok {
+		return nil
+	}
+	c := *o
+	return &c
+}
+
+// Outputs returns the finality of the tracked outputs in the given status, or of all of them if the
+// status is empty, in the order of the outputs.
+func (f *FinalityTracker) Outputs(status string) []*OutputFinality {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	res := []*OutputFinality{}
+	for _, o := range f.outputs {
+		if status == "" || o.Status == status {
+			c := *o
+			res = append(res, &c)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].OutputIndex < res[j].OutputIndex })
+	return res
+}
@@ -0,0 +1,47 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputFinalityStatus(t *testing.T) {
+	root := common.HexToHash("0xaa")
+	other := common.HexToHash("0xbb")
+
+	require.Equal(t, OutputPending, outputFinalityStatus(root, root, false, false))
+	require.Equal(t, OutputChallenged, outputFinalityStatus(root, root, false, true))
+	require.Equal(t, OutputFinalized, outputFinalityStatus(root, root, true, false))
+	require.Equal(t, OutputDeleted, outputFinalityStatus(root, other, false, true))
+	require.Equal(t, OutputDeleted, outputFinalityStatus(root, other, true, false))
+}
+
+func TestFinalityTrackerPrune(t *testing.T) {
+	f := &FinalityTracker{outputs: make(map[uint64]*OutputFinality)}
+	for i := uint64(0); i < maxTrackedOutputs+10; i++ {
+		status := OutputFinalized
+		// an old output that is not finalized yet is kept regardless of its age.
+		if i == 3 || i >= maxTrackedOutputs {
+			status = OutputPending
+		}
+		f.outputs[i] = &OutputFinality{OutputIndex: i, Status: status}
+	}
+
+	f.prune()
+	require.Len(t, f.outputs, maxTrackedOutputs)
+	require.Contains(t, f.outputs, uint64(3))
+	require.NotContains(t, f.outputs, uint64(10))
+	require.Contains(t, f.outputs, uint64(11))
+	require.Equal(t, map[string]int{OutputFinalized: maxTrackedOutputs - 11, OutputPending: 11}, f.counts())
+
+	pending := f.Outputs(OutputPending)
+	require.Len(t, pending, 11)
+	require.Equal(t, uint64(3), pending[0].OutputIndex)
+	require.Equal(t, uint64(maxTrackedOutputs), pending[1].OutputIndex)
+	require.Len(t, f.Outputs(""), maxTrackedOutputs)
+
+	require.Nil(t, f.Output(0))
+	require.Equal(t, OutputPending, f.Output(3).Status)
+}
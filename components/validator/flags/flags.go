@@ -5,6 +5,7 @@ import (
 
 	"github.com/urfave/cli"
 
+	"github.com/kroma-network/kroma/components/validator/rpc"
 	kservice "github.com/kroma-network/kroma/utils/service"
 	klog "github.com/kroma-network/kroma/utils/service/log"
 	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
@@ -74,6 +75,12 @@ var (
 		Usage:  "Allow the validator to submit outputs for L2 blocks derived from non-finalized L1 blocks.",
 		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "ALLOW_NON_FINALIZED"),
 	}
+	OutputCacheSizeFlag = cli.IntFlag{
+		Name:   "output-cache-size",
+		Usage:  "Number of outputs of finalized L2 blocks to cache",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "OUTPUT_CACHE_SIZE"),
+		Value:  1024,
+	}
 	OutputSubmitterBondAmountFlag = cli.Uint64Flag{
 		Name:   "output-submitter.bond-amount",
 		Usage:  "Amount to bond when submitting each output (in wei)",
@@ -92,6 +99,178 @@ var (
 		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "OUTPUT_SUBMITTER_ROUND_BUFFER"),
 		Value:  30,
 	}
+	WatcherEnabledFlag = cli.BoolFlag{
+		Name:   "watcher.enabled",
+		Usage:  "Validate every submitted output and alert on mismatch, without submitting outputs",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "WATCHER_ENABLED"),
+	}
+	WatcherPollIntervalFlag = cli.DurationFlag{
+		Name:   "watcher.poll-interval",
+		Usage:  "Interval to retry validating an output until the local node has derived its block",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "WATCHER_POLL_INTERVAL"),
+		Value:  12 * time.Second,
+	}
+	WatcherWebhookURLFlag = cli.StringFlag{
+		Name:   "watcher.webhook",
+		Usage:  "URL to post invalid outputs to as JSON. If empty, the webhook is disabled",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "WATCHER_WEBHOOK"),
+	}
+	LeaderLockPathFlag = cli.StringFlag{
+		Name:   "leader-lock.path",
+		Usage:  "Path of the leader lock file shared by the validator instances using the same key. If empty, outputs are submitted without coordination",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "LEADER_LOCK_PATH"),
+	}
+	LeaderLockTTLFlag = cli.DurationFlag{
+		Name:   "leader-lock.ttl",
+		Usage:  "How long the leader lock is held after each renewal",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "LEADER_LOCK_TTL"),
+		Value:  time.Minute,
+	}
+	InstanceIDFlag = cli.StringFlag{
+		Name:   "instance-id",
+		Usage:  "Id of the validator instance in the leader lock. Defaults to the hostname and the pid",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "INSTANCE_ID"),
+	}
+	StandbyEnabledFlag = cli.BoolFlag{
+		Name:   "standby.enabled",
+		Usage:  "Run as a hot standby taking over the submissions when the leader misses its round. The same key must be available, e.g. via a remote signer",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "STANDBY_ENABLED"),
+	}
+	StandbyTakeoverDelayFlag = cli.DurationFlag{
+		Name:   "standby.takeover-delay",
+		Usage:  "How long the standby waits for the leader to submit a ready output before taking over",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "STANDBY_TAKEOVER_DELAY"),
+		Value:  time.Minute,
+	}
+	ChallengerDryRunFlag = cli.BoolFlag{
+		Name:   "challenger.dry-run",
+		Usage:  "Run the challenger without sending any transaction, logging the transactions it would send instead",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "CHALLENGER_DRY_RUN"),
+	}
+	SegmentStrategyFlag = cli.StringFlag{
+		Name:   "challenger.segment-strategy",
+		Usage:  "The strategy to select the section to bisect into when several sections are invalid: first or last",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "CHALLENGER_SEGMENT_STRATEGY"),
+		Value:  "first",
+	}
+	ExitEnabledFlag = cli.BoolFlag{
+		Name: "exit.enabled",
+		Usage: "Exit cleanly: stop submitting outputs, wait out the pending outputs and challenges, " +
+			"unbond and withdraw the whole balance from ValidatorPool",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "EXIT_ENABLED"),
+	}
+	ExitPollIntervalFlag = cli.DurationFlag{
+		Name:   "exit.poll-interval",
+		Usage:  "Interval to check the progress of the exit",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "EXIT_POLL_INTERVAL"),
+		Value:  time.Minute,
+	}
+	DefenseResubmissionTimeoutFlag = cli.DurationFlag{
+		Name: "challenger.defense-resubmission-timeout",
+		Usage: "Interval to bump the fees of the txs defending an output of the validator in a challenge, " +
+			"to get them included before the bisection timeout. If 0, the resubmission timeout of the tx manager is used",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "CHALLENGER_DEFENSE_RESUBMISSION_TIMEOUT"),
+		Value:  24 * time.Second,
+	}
+	DefenseRetryIntervalFlag = cli.DurationFlag{
+		Name: "challenger.defense-retry-interval",
+		Usage: "Delay before retrying a failed tx defending an output of the validator in a challenge. " +
+			"If 0, the challenger poll interval is used",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "CHALLENGER_DEFENSE_RETRY_INTERVAL"),
+		Value:  5 * time.Second,
+	}
+	ChallengePriorityFlag = cli.StringFlag{
+		Name: "challenger.priority",
+		Usage: "The order to challenge invalid outputs in when the max active challenges is reached: " +
+			"oldest, finalization (closest to finalization first) or bond (largest bond at stake first)",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "CHALLENGER_PRIORITY"),
+		Value:  "oldest",
+	}
+	MaxActiveChallengesFlag = cli.Uint64Flag{
+		Name:   "challenger.max-active-challenges",
+		Usage:  "Maximum number of challenges created by the validator in progress at once. 0 means there is no limit",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "CHALLENGER_MAX_ACTIVE_CHALLENGES"),
+	}
+	ChainsConfigFlag = cli.StringFlag{
+		Name: "chains-config",
+		Usage: "Path to a JSON file listing additional chains to validate in the same process, each with its " +
+			"rollup rpc, contract addresses and optionally key, rpc port and proof queue dir",
+		EnvVar:    kservice.PrefixEnvVar(envVarPrefix, "CHAINS_CONFIG"),
+		TakesFile: true,
+	}
+	FaultInjectionOutputBlockFlag = cli.Uint64Flag{
+		Name:   "fault-injection.output-block",
+		Usage:  "For testing only. The first block whose output is deliberately corrupted, to exercise the challenge path",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "FAULT_INJECTION_OUTPUT_BLOCK"),
+		Hidden: true,
+	}
+	FaultInjectionSegmentBlockFlag = cli.Uint64Flag{
+		Name:   "fault-injection.segment-block",
+		Usage:  "For testing only. The block whose output alone is deliberately corrupted, to build a wrong intermediate segment",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "FAULT_INJECTION_SEGMENT_BLOCK"),
+		Hidden: true,
+	}
+	ProverBackendFlag = cli.StringFlag{
+		Name:   "prover.backend",
+		Usage:  "The prover backend to generate proofs with: rpc, local, mock or pool",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_BACKEND"),
+		Value:  "rpc",
+	}
+	ProverBinaryFlag = cli.StringFlag{
+		Name:   "prover.binary",
+		Usage:  "Path to the prover binary, used by the local prover backend",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_BINARY"),
+	}
+	ProverEndpointsFlag = cli.StringSliceFlag{
+		Name: "prover.endpoints",
+		Usage: "gRPC URLs of the kroma-provers the pool prover backend dispatches proofs across, as <url> or " +
+			"<url>=<capacity>, where capacity is the number of proofs the prover can generate at once (default 1)",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_ENDPOINTS"),
+	}
+	ProverMockDirFlag = cli.StringFlag{
+		Name:   "prover.mock-dir",
+		Usage:  "Directory of the proof returned by the mock prover backend. Only for testing",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_MOCK_DIR"),
+	}
+	ProverHealthCheckIntervalFlag = cli.DurationFlag{
+		Name:   "prover.health-check-interval",
+		Usage:  "Interval to check the health of the prover backend",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_HEALTH_CHECK_INTERVAL"),
+		Value:  time.Minute,
+	}
+	ProofQueueDirFlag = cli.StringFlag{
+		Name:   "prover.queue-dir",
+		Usage:  "Directory to persist the proof generation jobs in, so that they survive restarts",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_QUEUE_DIR"),
+	}
+	ProofMaxAttemptsFlag = cli.Uint64Flag{
+		Name:   "prover.max-attempts",
+		Usage:  "Number of times to try generating a proof before reporting the failure",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_MAX_ATTEMPTS"),
+		Value:  5,
+	}
+	ProofRetryBackoffFlag = cli.DurationFlag{
+		Name:   "prover.retry-backoff",
+		Usage:  "Delay before retrying to generate a proof, doubled on every attempt",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_RETRY_BACKOFF"),
+		Value:  time.Minute,
+	}
+	ProofMaxRetryBackoffFlag = cli.DurationFlag{
+		Name:   "prover.max-retry-backoff",
+		Usage:  "Maximum delay between attempts to generate a proof",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_MAX_RETRY_BACKOFF"),
+		Value:  30 * time.Minute,
+	}
+	SkipProofVerificationFlag = cli.BoolFlag{
+		Name:   "prover.skip-proof-verification",
+		Usage:  "Skip verifying proofs with the zk verifier before submitting them, e.g. for mock proofs. The public inputs are still checked",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_SKIP_PROOF_VERIFICATION"),
+	}
+	ProofExpectedTimeFlag = cli.DurationFlag{
+		Name:   "prover.expected-proving-time",
+		Usage:  "How long generating a proof is expected to take. Proof jobs that cannot meet the proving timeout are aborted and escalated",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROVER_EXPECTED_PROVING_TIME"),
+	}
 	ProverGrpcFlag = cli.StringFlag{
 		Name:   "prover-grpc-url",
 		Usage:  "gRPC URL for kroma-prover.",
@@ -113,6 +292,198 @@ var (
 		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "FETCHING_PROOF_TIMEOUT"),
 		Value:  time.Hour * 2,
 	}
+	BondTopUpEnabledFlag = cli.BoolFlag{
+		Name:   "bond-topup.enabled",
+		Usage:  "Automatically deposit into ValidatorPool when the deposit is not enough for upcoming submissions",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BOND_TOPUP_ENABLED"),
+	}
+	BondTopUpIntervalFlag = cli.DurationFlag{
+		Name:   "bond-topup.interval",
+		Usage:  "Interval to check the deposit in ValidatorPool",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BOND_TOPUP_INTERVAL"),
+		Value:  time.Minute,
+	}
+	BondTopUpSubmissionsFlag = cli.Uint64Flag{
+		Name:   "bond-topup.submissions",
+		Usage:  "Number of upcoming submissions the deposit should be able to bond before topping up",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BOND_TOPUP_SUBMISSIONS"),
+		Value:  10,
+	}
+	BondTopUpCapFlag = cli.StringFlag{
+		Name:   "bond-topup.cap",
+		Usage:  "Deposit amount to refill up to when topping up (in wei)",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BOND_TOPUP_CAP"),
+	}
+	BondTopUpFundingPrivateKeyFlag = cli.StringFlag{
+		Name:   "bond-topup.funding-private-key",
+		Usage:  "The private key of the account funding the top-ups. If empty, the validator account deposits from its own balance",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BOND_TOPUP_FUNDING_PRIVATE_KEY"),
+	}
+	RewardWithdrawalEnabledFlag = cli.BoolFlag{
+		Name:   "reward-withdrawal.enabled",
+		Usage:  "Automatically withdraw the deposit exceeding the retained amount from ValidatorPool",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "REWARD_WITHDRAWAL_ENABLED"),
+	}
+	RewardWithdrawalIntervalFlag = cli.DurationFlag{
+		Name:   "reward-withdrawal.interval",
+		Usage:  "Interval to check the rewards in ValidatorPool",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "REWARD_WITHDRAWAL_INTERVAL"),
+		Value:  time.Hour,
+	}
+	RewardRecipientFlag = cli.StringFlag{
+		Name:   "reward-withdrawal.recipient",
+		Usage:  "Address to send the withdrawn rewards to. If empty, the rewards are kept in the validator account",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "REWARD_WITHDRAWAL_RECIPIENT"),
+	}
+	RewardRetainAmountFlag = cli.StringFlag{
+		Name:   "reward-withdrawal.retain",
+		Usage:  "Deposit amount to keep in ValidatorPool for bonding (in wei)",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "REWARD_WITHDRAWAL_RETAIN"),
+	}
+	RewardWithdrawalThresholdFlag = cli.StringFlag{
+		Name:   "reward-withdrawal.threshold",
+		Usage:  "Minimum amount to withdraw at once (in wei)",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "REWARD_WITHDRAWAL_THRESHOLD"),
+		Value:  "0",
+	}
+	RewardMaxGasPriceFlag = cli.StringFlag{
+		Name:   "reward-withdrawal.max-gas-price",
+		Usage:  "Gas price above which the withdrawal is postponed (in wei). If empty, there is no limit",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "REWARD_WITHDRAWAL_MAX_GAS_PRICE"),
+	}
+	IndexerEnabledFlag = cli.BoolFlag{
+		Name:   "indexer.enabled",
+		Usage:  "Index the outputs, challenges and bonds from L1 and serve them through the indexer RPC API",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "INDEXER_ENABLED"),
+	}
+	IndexerDBPathFlag = cli.StringFlag{
+		Name:   "indexer.db-path",
+		Usage:  "Directory of the indexer database. If empty, the records are kept in memory and indexed again on restart",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "INDEXER_DB_PATH"),
+	}
+	IndexerStartBlockFlag = cli.Uint64Flag{
+		Name:   "indexer.start-block",
+		Usage:  "L1 block to start indexing from when the database is empty. If 0, the L1 origin of the L2 genesis is used",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "INDEXER_START_BLOCK"),
+	}
+	IndexerConfirmationsFlag = cli.Uint64Flag{
+		Name:   "indexer.confirmations",
+		Usage:  "Number of L1 blocks on top of a block before it is indexed",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "INDEXER_CONFIRMATIONS"),
+		Value:  10,
+	}
+	IndexerPollIntervalFlag = cli.DurationFlag{
+		Name:   "indexer.poll-interval",
+		Usage:  "Interval to index the new L1 blocks",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "INDEXER_POLL_INTERVAL"),
+		Value:  12 * time.Second,
+	}
+	IndexerBatchSizeFlag = cli.Uint64Flag{
+		Name:   "indexer.batch-size",
+		Usage:  "Maximum number of L1 blocks to fetch the logs of at once",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "INDEXER_BATCH_SIZE"),
+		Value:  1000,
+	}
+	PenaltyMonitorEnabledFlag = cli.BoolFlag{
+		Name:   "penalty-monitor.enabled",
+		Usage:  "Report the penalties of the validator and of the watched validators detected on chain",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PENALTY_MONITOR_ENABLED"),
+	}
+	PenaltyMonitorAddressesFlag = cli.StringSliceFlag{
+		Name:   "penalty-monitor.addresses",
+		Usage:  "Addresses of other validators to report the penalties of",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PENALTY_MONITOR_ADDRESSES"),
+	}
+	PenaltyMonitorWebhookURLFlag = cli.StringFlag{
+		Name:   "penalty-monitor.webhook",
+		Usage:  "URL to post penalties to as JSON. If empty, the webhook is disabled",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PENALTY_MONITOR_WEBHOOK"),
+	}
+	FinalityTrackerEnabledFlag = cli.BoolFlag{
+		Name:   "finality-tracker.enabled",
+		Usage:  "Track the submitted outputs through their finalization window, reporting their status in metrics and the RPC",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "FINALITY_TRACKER_ENABLED"),
+	}
+	FinalityTrackerPollIntervalFlag = cli.DurationFlag{
+		Name:   "finality-tracker.poll-interval",
+		Usage:  "Interval to update the status of the submitted outputs",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "FINALITY_TRACKER_POLL_INTERVAL"),
+		Value:  time.Minute,
+	}
+	CouncilEscalationEnabledFlag = cli.BoolFlag{
+		Name:   "council-escalation.enabled",
+		Usage:  "Escalate the challenges the challenger cannot resolve to the Security Council",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "COUNCIL_ESCALATION_ENABLED"),
+	}
+	CouncilEscalationAutoSubmitFlag = cli.BoolFlag{
+		Name:   "council-escalation.auto-submit",
+		Usage:  "Submit the escalations to the Security Council without waiting for the confirmation of the operator through the admin API",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "COUNCIL_ESCALATION_AUTO_SUBMIT"),
+	}
+	CouncilEscalationMarginFlag = cli.DurationFlag{
+		Name:   "council-escalation.margin",
+		Usage:  "How long before the finalization of a proven output to escalate it if the challenge is not approved yet",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "COUNCIL_ESCALATION_MARGIN"),
+		Value:  24 * time.Hour,
+	}
+	CouncilEscalationWebhookURLFlag = cli.StringFlag{
+		Name:   "council-escalation.webhook",
+		Usage:  "URL to post escalations to as JSON. If empty, the webhook is disabled",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "COUNCIL_ESCALATION_WEBHOOK"),
+	}
+	ProfitEstimatorEnabledFlag = cli.BoolFlag{
+		Name:   "profit-estimator.enabled",
+		Usage:  "Estimate the expected rewards and gas costs of the output submissions",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROFIT_ESTIMATOR_ENABLED"),
+	}
+	ProfitSkipUnprofitableFlag = cli.BoolFlag{
+		Name:   "profit-estimator.skip-unprofitable",
+		Usage:  "Skip the submissions in the public round whose expected gas cost exceeds the expected reward",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROFIT_ESTIMATOR_SKIP_UNPROFITABLE"),
+	}
+	ProfitL2EthRpcFlag = cli.StringFlag{
+		Name:   "profit-estimator.l2-eth-rpc",
+		Usage:  "HTTP provider URL for L2, used to fetch the balance of the ValidatorRewardVault",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROFIT_ESTIMATOR_L2_ETH_RPC"),
+	}
+	ProfitSubmissionGasFlag = cli.Uint64Flag{
+		Name:   "profit-estimator.submission-gas",
+		Usage:  "Gas expected to be used by an output submission until one is made",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROFIT_ESTIMATOR_SUBMISSION_GAS"),
+		Value:  300_000,
+	}
+	ProfitGasPriceSamplesFlag = cli.Uint64Flag{
+		Name:   "profit-estimator.gas-price-samples",
+		Usage:  "Number of recent gas prices averaged to estimate the gas cost of a submission",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "PROFIT_ESTIMATOR_GAS_PRICE_SAMPLES"),
+		Value:  10,
+	}
+	BalanceMonitorEnabledFlag = cli.BoolFlag{
+		Name:   "balance-monitor.enabled",
+		Usage:  "Monitor the L1 balance and the deposit in ValidatorPool of the validator, alerting below the thresholds",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BALANCE_MONITOR_ENABLED"),
+	}
+	BalanceMonitorIntervalFlag = cli.DurationFlag{
+		Name:   "balance-monitor.interval",
+		Usage:  "Interval to check the balances of the validator",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BALANCE_MONITOR_INTERVAL"),
+		Value:  time.Minute,
+	}
+	BalanceMonitorMinBalanceFlag = cli.StringFlag{
+		Name:   "balance-monitor.min-balance",
+		Usage:  "L1 balance below which to alert (in wei). If empty, the L1 balance is only recorded",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BALANCE_MONITOR_MIN_BALANCE"),
+	}
+	BalanceMonitorMinDepositFlag = cli.StringFlag{
+		Name:   "balance-monitor.min-deposit",
+		Usage:  "Deposit in ValidatorPool below which to alert (in wei). If empty, the deposit is only recorded",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BALANCE_MONITOR_MIN_DEPOSIT"),
+	}
+	BalanceMonitorWebhookURLFlag = cli.StringFlag{
+		Name:   "balance-monitor.webhook",
+		Usage:  "URL to post balance alerts to as JSON. If empty, the webhook is disabled",
+		EnvVar: kservice.PrefixEnvVar(envVarPrefix, "BALANCE_MONITOR_WEBHOOK"),
+	}
 )
 
 var requiredFlags = []cli.Flag{
@@ -128,18 +499,86 @@ var requiredFlags = []cli.Flag{
 
 var optionalFlags = []cli.Flag{
 	AllowNonFinalizedFlag,
+	OutputCacheSizeFlag,
 	OutputSubmitterBondAmountFlag,
 	OutputSubmitterRetryIntervalFlag,
 	OutputSubmitterRoundBufferFlag,
+	WatcherEnabledFlag,
+	WatcherPollIntervalFlag,
+	WatcherWebhookURLFlag,
+	LeaderLockPathFlag,
+	LeaderLockTTLFlag,
+	InstanceIDFlag,
+	StandbyEnabledFlag,
+	StandbyTakeoverDelayFlag,
+	ChallengerDryRunFlag,
+	SegmentStrategyFlag,
+	DefenseResubmissionTimeoutFlag,
+	DefenseRetryIntervalFlag,
+	ProverBackendFlag,
 	ProverGrpcFlag,
+	ProverBinaryFlag,
+	ProverEndpointsFlag,
+	ProverMockDirFlag,
+	ProverHealthCheckIntervalFlag,
+	ProofQueueDirFlag,
+	ProofMaxAttemptsFlag,
+	ProofRetryBackoffFlag,
+	ProofMaxRetryBackoffFlag,
+	ProofExpectedTimeFlag,
+	SkipProofVerificationFlag,
 	SecurityCouncilAddressFlag,
 	GuardianEnabledFlag,
 	FetchingProofTimeoutFlag,
+	BondTopUpEnabledFlag,
+	BondTopUpIntervalFlag,
+	BondTopUpSubmissionsFlag,
+	BondTopUpCapFlag,
+	BondTopUpFundingPrivateKeyFlag,
+	RewardWithdrawalEnabledFlag,
+	RewardWithdrawalIntervalFlag,
+	RewardRecipientFlag,
+	RewardRetainAmountFlag,
+	RewardWithdrawalThresholdFlag,
+	RewardMaxGasPriceFlag,
+	ChallengePriorityFlag,
+	MaxActiveChallengesFlag,
+	ExitEnabledFlag,
+	ExitPollIntervalFlag,
+	IndexerEnabledFlag,
+	IndexerDBPathFlag,
+	IndexerStartBlockFlag,
+	IndexerConfirmationsFlag,
+	IndexerPollIntervalFlag,
+	IndexerBatchSizeFlag,
+	PenaltyMonitorEnabledFlag,
+	PenaltyMonitorAddressesFlag,
+	PenaltyMonitorWebhookURLFlag,
+	FinalityTrackerEnabledFlag,
+	FinalityTrackerPollIntervalFlag,
+	CouncilEscalationEnabledFlag,
+	CouncilEscalationAutoSubmitFlag,
+	CouncilEscalationMarginFlag,
+	CouncilEscalationWebhookURLFlag,
+	ProfitEstimatorEnabledFlag,
+	ProfitSkipUnprofitableFlag,
+	ProfitL2EthRpcFlag,
+	ProfitSubmissionGasFlag,
+	ProfitGasPriceSamplesFlag,
+	BalanceMonitorEnabledFlag,
+	BalanceMonitorIntervalFlag,
+	BalanceMonitorMinBalanceFlag,
+	BalanceMonitorMinDepositFlag,
+	BalanceMonitorWebhookURLFlag,
+	ChainsConfigFlag,
+	FaultInjectionOutputBlockFlag,
+	FaultInjectionSegmentBlockFlag,
 }
 
 func init() {
 	requiredFlags = append(requiredFlags, krpc.CLIFlags(envVarPrefix)...)
 
+	optionalFlags = append(optionalFlags, rpc.CLIFlags(envVarPrefix)...)
 	optionalFlags = append(optionalFlags, klog.CLIFlags(envVarPrefix)...)
 	optionalFlags = append(optionalFlags, kmetrics.CLIFlags(envVarPrefix)...)
 	optionalFlags = append(optionalFlags, kpprof.CLIFlags(envVarPrefix)...)
@@ -0,0 +1,57 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+type indexerAPI struct {
+	i *Indexer
+}
+
+func NewIndexerAPI(i *Indexer) *indexerAPI {
+	return &indexerAPI{
+		i: i,
+	}
+}
+
+// GetIndexerAPI returns the API of the indexer to register at the RPC server.
+func GetIndexerAPI(api *indexerAPI) rpc.API {
+	return rpc.API{
+		Namespace: "indexer",
+		Service:   api,
+	}
+}
+
+// Status returns the progress of the indexer.
+func (a *indexerAPI) Status(_ context.Context) (Status, error) {
+	return a.i.Status(), nil
+}
+
+// Output returns the output of the given index, or null if it is not indexed.
+func (a *indexerAPI) Output(_ context.Context, outputIndex hexutil.Uint64) (*OutputRecord, error) {
+	return a.i.Output(uint64(outputIndex))
+}
+
+// Challenge returns the challenge of the output of the given index, or null if it was never challenged.
+func (a *indexerAPI) Challenge(_ context.Context, outputIndex hexutil.Uint64) (*ChallengeRecord, error) {
+	return a.i.Challenge(uint64(outputIndex))
+}
+
+// Challenges returns the challenges selected by the filter, in the order of the outputs.
+func (a *indexerAPI) Challenges(_ context.Context, filter ChallengeFilter) ([]*ChallengeRecord, error) {
+	return a.i.Challenges(filter)
+}
+
+// Bond returns the bond of the output of the given index, or null if it is not indexed.
+func (a *indexerAPI) Bond(_ context.Context, outputIndex hexutil.Uint64) (*BondRecord, error) {
+	return a.i.Bond(uint64(outputIndex))
+}
+
+// Bonds returns the bonds of the outputs submitted by the given validator, in the order of the outputs.
+func (a *indexerAPI) Bonds(_ context.Context, submitter common.Address) ([]*BondRecord, error) {
+	return a.i.Bonds(submitter)
+}
@@ -0,0 +1,507 @@
+// Package indexer follows L1 and stores the lifecycle of the outputs submitted to the L2OutputOracle,
+// of their challenges in the Colosseum and of their bonds in the ValidatorPool in a local database.
+// It serves them through a query API for dashboards, and lets the challenger recover the challenges
+// it is party to without scanning the contract state.
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+)
+
+type Config struct {
+	L2OutputOracleAddr common.Address
+	ColosseumAddr      common.Address
+	ValidatorPoolAddr  common.Address
+	// StartBlock is the L1 block to start indexing from when the database is empty,
+	// typically the block the contracts were deployed at.
+	StartBlock uint64
+	// Confirmations is the number of blocks on top of a block before it is indexed, to avoid indexing
+	// logs removed by a reorg.
+	Confirmations uint64
+	// PollInterval is how frequently to index the new L1 blocks.
+	PollInterval time.Duration
+	// BatchSize is the maximum number of L1 blocks to fetch the logs of at once.
+	BatchSize uint64
+}
+
+func (c Config) Check() error {
+	if c.PollInterval == 0 {
+		return errors.New("indexer poll interval must not be 0")
+	}
+	if c.BatchSize == 0 {
+		return errors.New("indexer batch size must not be 0")
+	}
+	return nil
+}
+
+// L1Client is the subset of the L1 client methods used by the indexer.
+type L1Client interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+type logHandler func(u *update, l types.Log) error
+
+// Indexer indexes the events of the L2OutputOracle, the Colosseum and the ValidatorPool.
+type Indexer struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	cfg    Config
+	log    log.Logger
+	client L1Client
+	db     ethdb.KeyValueStore
+	store  *store
+
+	l2oo      *bindings.L2OutputOracleFilterer
+	colosseum *bindings.ColosseumFilterer
+	valpool   *bindings.ValidatorPoolFilterer
+	handlers  map[common.Address]map[common.Hash]logHandler
+	topics    []common.Hash
+
+	mu     sync.RWMutex
+	status Status
+
+	wg sync.WaitGroup
+}
+
+// NewIndexer creates an indexer storing the records in the given database.
+func NewIndexer(cfg Config, l log.Logger, client L1Client, db ethdb.KeyValueStore) (*Indexer, error) {
+	if err := cfg.Check(); err != nil {
+		return nil, err
+	}
+
+	l2oo, err := bindings.NewL2OutputOracleFilterer(cfg.L2OutputOracleAddr, nil)
+	if err != nil {
+		return nil, err
+	}
+	colosseum, err := bindings.NewColosseumFilterer(cfg.ColosseumAddr, nil)
+	if err != nil {
+		return nil, err
+	}
+	valpool, err := bindings.NewValidatorPoolFilterer(cfg.ValidatorPoolAddr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &Indexer{
+		cfg:       cfg,
+		log:       l,
+		client:    client,
+		db:        db,
+		store:     &store{db: db},
+		l2oo:      l2oo,
+		colosseum: colosseum,
+		valpool:   valpool,
+		handlers:  make(map[common.Address]map[common.Hash]logHandler),
+	}
+	if err := i.registerHandlers(); err != nil {
+		return nil, err
+	}
+
+	next, ok, err := i.store.nextBlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read indexer progress: %w", err)
+	}
+	if !ok {
+		next = cfg.StartBlock
+	}
+	i.status.NextBlock = next
+
+	return i, nil
+}
+
+func (i *Indexer) registerHandlers() error {
+	register := func(addr common.Address, metaData *bind.MetaData, handlers map[string]logHandler) error {
+		parsed, err := metaData.GetAbi()
+		if err != nil {
+			return err
+		}
+		byTopic := make(map[common.Hash]logHandler, len(handlers))
+		for name, h := range handlers {
+			ev, ok := parsed.Events[name]
+			if !ok {
+				return fmt.Errorf("event %s not found in the contract abi", name)
+			}
+			byTopic[ev.ID] = h
+			i.topics = append(i.topics, ev.ID)
+		}
+		i.handlers[addr] = byTopic
+		return nil
+	}
+
+	if err := register(i.cfg.L2OutputOracleAddr, bindings.L2OutputOracleMetaData, map[string]logHandler{
+		EventOutputSubmitted: i.onOutputSubmitted,
+		EventOutputReplaced:  i.onOutputReplaced,
+	}); err != nil {
+		return err
+	}
+	if err := register(i.cfg.ColosseumAddr, bindings.ColosseumMetaData, map[string]logHandler{
+		EventChallengeCreated: i.onChallengeCreated,
+		EventBisected:         i.onBisected,
+		EventProven:           i.onProven,
+		EventApproved:         i.onApproved,
+		EventDeleted:          i.onDeleted,
+	}); err != nil {
+		return err
+	}
+	return register(i.cfg.ValidatorPoolAddr, bindings.ValidatorPoolMetaData, map[string]logHandler{
+		EventBonded:        i.onBonded,
+		EventBondIncreased: i.onBondIncreased,
+		EventUnbonded:      i.onUnbonded,
+	})
+}
+
+// Start indexes the new L1 blocks in the background. The blocks behind are indexed before it returns,
+// so that the components started after it read up to date records.
+func (i *Indexer) Start(ctx context.Context) error {
+	i.ctx, i.cancel = context.WithCancel(ctx)
+	i.log.Info("starting indexer", "nextBlock", i.Status().NextBlock)
+
+	if err := i.sync(i.ctx); err != nil {
+		i.log.Warn("failed to catch up with L1, indexing in the background", "err", err)
+	}
+
+	i.wg.Add(1)
+	go i.loop()
+
+	return nil
+}
+
+// Stop stops indexing and closes the database.
+func (i *Indexer) Stop() error {
+	i.log.Info("stopping indexer")
+	i.cancel()
+	i.wg.Wait()
+
+	return i.db.Close()
+}
+
+func (i *Indexer) loop() {
+	defer i.wg.Done()
+
+	ticker := time.NewTicker(i.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := i.sync(i.ctx); err != nil {
+				i.log.Warn("failed to index L1 blocks", "err", err)
+			}
+		case <-i.ctx.Done():
+			return
+		}
+	}
+}
+
+// sync indexes the blocks with enough confirmations, BatchSize blocks at a time.
+func (i *Indexer) sync(ctx context.Context) error {
+	head, err := i.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 head: %w", err)
+	}
+	headNum := head.Number.Uint64()
+
+	i.mu.Lock()
+	i.status.HeadBlock = headNum
+	i.status.Synced = false
+	next := i.status.NextBlock
+	i.mu.Unlock()
+
+	var target uint64
+	if headNum >= i.cfg.Confirmations {
+		target = headNum - i.cfg.Confirmations
+	}
+	for next <= target {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		to := next + i.cfg.BatchSize - 1
+		if to > target {
+			to = target
+		}
+		if err := i.index(ctx, next, to); err != nil {
+			return fmt.Errorf("failed to index blocks %d-%d: %w", next, to, err)
+		}
+		next = to + 1
+
+		i.mu.Lock()
+		i.status.NextBlock = next
+		i.mu.Unlock()
+	}
+
+	i.mu.Lock()
+	i.status.Synced = true
+	i.mu.Unlock()
+	return nil
+}
+
+// index applies the logs of the blocks from to to, and commits them together with the progress.
+func (i *Indexer) index(ctx context.Context, from, to uint64) error {
+	logs, err := i.client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{i.cfg.L2OutputOracleAddr, i.cfg.ColosseumAddr, i.cfg.ValidatorPoolAddr},
+		Topics:    [][]common.Hash{i.topics},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch logs: %w", err)
+	}
+	// the logs are applied in the order they were emitted.
+	sort.SliceStable(logs, func(a, b int) bool {
+		if logs[a].BlockNumber != logs[b].BlockNumber {
+			return logs[a].BlockNumber < logs[b].BlockNumber
+		}
+		return logs[a].Index < logs[b].Index
+	})
+
+	u := newUpdate(i.store)
+	for _, l := range logs {
+		if l.Removed || len(l.Topics) == 0 {
+			continue
+		}
+		h, ok := i.handlers[l.Address][l.Topics[0]]
+		if !ok {
+			continue
+		}
+		if err := h(u, l); err != nil {
+			return fmt.Errorf("failed to apply log %d of tx %s: %w", l.Index, l.TxHash, err)
+		}
+	}
+	if err := u.commit(to + 1); err != nil {
+		return fmt.Errorf("failed to write records: %w", err)
+	}
+	if len(logs) > 0 {
+		i.log.Debug("indexed L1 blocks", "from", from, "to", to, "logs", len(logs))
+	}
+	return nil
+}
+
+func l1Ref(l types.Log) L1Ref {
+	return L1Ref{BlockNumber: l.BlockNumber, TxHash: l.TxHash}
+}
+
+func (i *Indexer) onOutputSubmitted(u *update, l types.Log) error {
+	ev, err := i.l2oo.ParseOutputSubmitted(l)
+	if err != nil {
+		return err
+	}
+	r, err := u.output(ev.L2OutputIndex.Uint64())
+	if err != nil {
+		return err
+	}
+	r.OutputRoot = ev.OutputRoot
+	r.L2BlockNumber = ev.L2BlockNumber.Uint64()
+	r.L1Timestamp = ev.L1Timestamp.Uint64()
+	r.Submitted = l1Ref(l)
+	return nil
+}
+
+func (i *Indexer) onOutputReplaced(u *update, l types.Log) error {
+	ev, err := i.l2oo.ParseOutputReplaced(l)
+	if err != nil {
+		return err
+	}
+	r, err := u.output(ev.OutputIndex.Uint64())
+	if err != nil {
+		return err
+	}
+	root := common.Hash(ev.NewOutputRoot)
+	r.ReplacedBy = &root
+	return nil
+}
+
+// challengeEvent appends the event to the challenge of the output, after applying fn to it.
+func (i *Indexer) challengeEvent(u *update, l types.Log, outputIndex *big.Int, name string, timestamp *big.Int, fn func(r *ChallengeRecord)) error {
+	r, err := u.challenge(outputIndex.Uint64())
+	if err != nil {
+		return err
+	}
+	fn(r)
+	ev := ChallengeEvent{Name: name, Turn: r.Turn, L1: l1Ref(l)}
+	if timestamp != nil {
+		ev.Timestamp = timestamp.Uint64()
+	}
+	r.Events = append(r.Events, ev)
+	return nil
+}
+
+func (i *Indexer) onChallengeCreated(u *update, l types.Log) error {
+	ev, err := i.colosseum.ParseChallengeCreated(l)
+	if err != nil {
+		return err
+	}
+	return i.challengeEvent(u, l, ev.OutputIndex, EventChallengeCreated, ev.Timestamp, func(r *ChallengeRecord) {
+		// an output can be challenged again once its previous challenge is deleted.
+		r.Asserter = ev.Asserter
+		r.Challenger = ev.Challenger
+		r.Status = ChallengeInProgress
+		r.Turn = 1
+		r.NewOutputRoot = nil
+	})
+}
+
+func (i *Indexer) onBisected(u *update, l types.Log) error {
+	ev, err := i.colosseum.ParseBisected(l)
+	if err != nil {
+		return err
+	}
+	return i.challengeEvent(u, l, ev.OutputIndex, EventBisected, ev.Timestamp, func(r *ChallengeRecord) {
+		r.Turn = ev.Turn
+	})
+}
+
+func (i *Indexer) onProven(u *update, l types.Log) error {
+	ev, err := i.colosseum.ParseProven(l)
+	if err != nil {
+		return err
+	}
+	return i.challengeEvent(u, l, ev.OutputIndex, EventProven, nil, func(r *ChallengeRecord) {
+		root := common.Hash(ev.NewOutputRoot)
+		r.Status = ChallengeProven
+		r.NewOutputRoot = &root
+	})
+}
+
+func (i *Indexer) onApproved(u *update, l types.Log) error {
+	ev, err := i.colosseum.ParseApproved(l)
+	if err != nil {
+		return err
+	}
+	return i.challengeEvent(u, l, ev.OutputIndex, EventApproved, ev.Timestamp, func(r *ChallengeRecord) {
+		r.Status = ChallengeApproved
+	})
+}
+
+func (i *Indexer) onDeleted(u *update, l types.Log) error {
+	ev, err := i.colosseum.ParseDeleted(l)
+	if err != nil {
+		return err
+	}
+	return i.challengeEvent(u, l, ev.OutputIndex, EventDeleted, ev.Timestamp, func(r *ChallengeRecord) {
+		r.Status = ChallengeDeleted
+	})
+}
+
+func (i *Indexer) onBonded(u *update, l types.Log) error {
+	ev, err := i.valpool.ParseBonded(l)
+	if err != nil {
+		return err
+	}
+	outputIndex := ev.OutputIndex.Uint64()
+	r, err := u.bond(outputIndex)
+	if err != nil {
+		return err
+	}
+	r.Submitter = ev.Submitter
+	r.Amount = (*hexutil.Big)(new(big.Int).Set(ev.Amount))
+	r.ExpiresAt = ev.ExpiresAt.Uint64()
+	r.Bonded = l1Ref(l)
+
+	output, err := u.output(outputIndex)
+	if err != nil {
+		return err
+	}
+	output.Submitter = ev.Submitter
+	return nil
+}
+
+func (i *Indexer) onBondIncreased(u *update, l types.Log) error {
+	ev, err := i.valpool.ParseBondIncreased(l)
+	if err != nil {
+		return err
+	}
+	r, err := u.bond(ev.OutputIndex.Uint64())
+	if err != nil {
+		return err
+	}
+	amount := new(big.Int).Set(ev.Amount)
+	if r.Amount == nil {
+		r.Amount = (*hexutil.Big)(new(big.Int))
+	}
+	r.Amount = (*hexutil.Big)(new(big.Int).Add(r.Amount.ToInt(), amount))
+	r.Increases = append(r.Increases, BondIncrease{
+		Challenger: ev.Challenger,
+		Amount:     (*hexutil.Big)(amount),
+		L1:         l1Ref(l),
+	})
+	return nil
+}
+
+func (i *Indexer) onUnbonded(u *update, l types.Log) error {
+	ev, err := i.valpool.ParseUnbonded(l)
+	if err != nil {
+		return err
+	}
+	r, err := u.bond(ev.OutputIndex.Uint64())
+	if err != nil {
+		return err
+	}
+	r.Unbonded = &Unbond{
+		Recipient: ev.Recipient,
+		Amount:    (*hexutil.Big)(new(big.Int).Set(ev.Amount)),
+		L1:        l1Ref(l),
+	}
+	return nil
+}
+
+// Status returns the progress of the indexer.
+func (i *Indexer) Status() Status {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.status
+}
+
+// Output returns the output of the given index, or nil if it is not indexed.
+func (i *Indexer) Output(outputIndex uint64) (*OutputRecord, error) {
+	return i.store.output(outputIndex)
+}
+
+// Challenge returns the challenge of the output of the given index, or nil if it was never challenged.
+func (i *Indexer) Challenge(outputIndex uint64) (*ChallengeRecord, error) {
+	return i.store.challenge(outputIndex)
+}
+
+// Challenges returns the challenges selected by the filter, in the order of the outputs.
+func (i *Indexer) Challenges(filter ChallengeFilter) ([]*ChallengeRecord, error) {
+	var res []*ChallengeRecord
+	err := iterate(i.store, challengePrefix, func(r *ChallengeRecord) {
+		if filter.match(r) {
+			res = append(res, r)
+		}
+	})
+	return res, err
+}
+
+// Bond returns the bond of the output of the given index, or nil if it is not indexed.
+func (i *Indexer) Bond(outputIndex uint64) (*BondRecord, error) {
+	return i.store.bond(outputIndex)
+}
+
+// Bonds returns the bonds of the outputs submitted by the given validator, in the order of the outputs.
+func (i *Indexer) Bonds(submitter common.Address) ([]*BondRecord, error) {
+	var res []*BondRecord
+	err := iterate(i.store, bondPrefix, func(r *BondRecord) {
+		if r.Submitter == submitter {
+			res = append(res, r)
+		}
+	})
+	return res, err
+}
@@ -0,0 +1,201 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+var (
+	l2ooAddr      = common.HexToAddress("0x01")
+	colosseumAddr = common.HexToAddress("0x02")
+	valpoolAddr   = common.HexToAddress("0x03")
+	asserter      = common.HexToAddress("0xa1")
+	challenger    = common.HexToAddress("0xc1")
+)
+
+type mockL1Client struct {
+	head    uint64
+	logs    []types.Log
+	queries []ethereum.FilterQuery
+}
+
+func (m *mockL1Client) HeaderByNumber(_ context.Context, _ *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(m.head)}, nil
+}
+
+func (m *mockL1Client) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	m.queries = append(m.queries, q)
+	var res []types.Log
+	for _, l := range m.logs {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			res = append(res, l)
+		}
+	}
+	return res, nil
+}
+
+// newLog encodes the event of the contract, with the indexed arguments first as in the contracts.
+func newLog(t *testing.T, metaData *bind.MetaData, addr common.Address, name string, blockNumber uint64, indexed []common.Hash, args ...any) types.Log {
+	parsed, err := metaData.GetAbi()
+	require.NoError(t, err)
+	ev := parsed.Events[name]
+	data, err := abi.Arguments(ev.Inputs.NonIndexed()).Pack(args...)
+	require.NoError(t, err)
+	return types.Log{
+		Address:     addr,
+		Topics:      append([]common.Hash{ev.ID}, indexed...),
+		Data:        data,
+		BlockNumber: blockNumber,
+		TxHash:      common.BigToHash(new(big.Int).SetUint64(blockNumber)),
+	}
+}
+
+func uintTopic(v uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(v))
+}
+
+func addrTopic(a common.Address) common.Hash {
+	return common.BytesToHash(a.Bytes())
+}
+
+func newTestIndexer(t *testing.T, client *mockL1Client) *Indexer {
+	idx, err := NewIndexer(Config{
+		L2OutputOracleAddr: l2ooAddr,
+		ColosseumAddr:      colosseumAddr,
+		ValidatorPoolAddr:  valpoolAddr,
+		StartBlock:         100,
+		Confirmations:      2,
+		PollInterval:       time.Second,
+		BatchSize:          5,
+	}, testlog.Logger(t, log.LvlCrit), client, memorydb.New())
+	require.NoError(t, err)
+	return idx
+}
+
+func TestIndexer_Lifecycle(t *testing.T) {
+	root := common.HexToHash("0xaa")
+	newRoot := common.HexToHash("0xbb")
+	client := &mockL1Client{
+		head: 120,
+		logs: []types.Log{
+			newLog(t, bindings.ValidatorPoolMetaData, valpoolAddr, EventBonded, 101,
+				[]common.Hash{addrTopic(asserter), uintTopic(1)}, big.NewInt(10), big.NewInt(5000)),
+			newLog(t, bindings.L2OutputOracleMetaData, l2ooAddr, EventOutputSubmitted, 101,
+				[]common.Hash{root, uintTopic(1), uintTopic(1800)}, big.NewInt(1000)),
+			newLog(t, bindings.ColosseumMetaData, colosseumAddr, EventChallengeCreated, 103,
+				[]common.Hash{uintTopic(1), addrTopic(asserter), addrTopic(challenger)}, big.NewInt(1100)),
+			newLog(t, bindings.ValidatorPoolMetaData, valpoolAddr, EventBondIncreased, 103,
+				[]common.Hash{addrTopic(challenger), uintTopic(1)}, big.NewInt(10)),
+			newLog(t, bindings.ColosseumMetaData, colosseumAddr, EventBisected, 107,
+				[]common.Hash{uintTopic(1)}, uint8(2), big.NewInt(1200)),
+			newLog(t, bindings.ColosseumMetaData, colosseumAddr, EventProven, 112,
+				[]common.Hash{uintTopic(1)}, newRoot),
+			newLog(t, bindings.ColosseumMetaData, colosseumAddr, EventApproved, 113,
+				[]common.Hash{uintTopic(1)}, big.NewInt(1300)),
+			newLog(t, bindings.L2OutputOracleMetaData, l2ooAddr, EventOutputReplaced, 113,
+				[]common.Hash{uintTopic(1)}, newRoot),
+			newLog(t, bindings.ValidatorPoolMetaData, valpoolAddr, EventUnbonded, 113,
+				[]common.Hash{uintTopic(1), addrTopic(challenger)}, big.NewInt(20)),
+			// not confirmed yet
+			newLog(t, bindings.ColosseumMetaData, colosseumAddr, EventChallengeCreated, 119,
+				[]common.Hash{uintTopic(2), addrTopic(asserter), addrTopic(challenger)}, big.NewInt(1400)),
+		},
+	}
+	idx := newTestIndexer(t, client)
+
+	require.NoError(t, idx.sync(context.Background()))
+	require.Equal(t, Status{NextBlock: 119, HeadBlock: 120, Synced: true}, idx.Status())
+	// 100-104, 105-109, 110-114, 115-118
+	require.Len(t, client.queries, 4)
+
+	output, err := idx.Output(1)
+	require.NoError(t, err)
+	require.Equal(t, root, output.OutputRoot)
+	require.Equal(t, uint64(1800), output.L2BlockNumber)
+	require.Equal(t, uint64(1000), output.L1Timestamp)
+	require.Equal(t, asserter, output.Submitter)
+	require.Equal(t, uint64(101), output.Submitted.BlockNumber)
+	require.Equal(t, newRoot, *output.ReplacedBy)
+
+	ch, err := idx.Challenge(1)
+	require.NoError(t, err)
+	require.Equal(t, asserter, ch.Asserter)
+	require.Equal(t, challenger, ch.Challenger)
+	require.Equal(t, ChallengeApproved, ch.Status)
+	require.Equal(t, uint8(2), ch.Turn)
+	require.Equal(t, newRoot, *ch.NewOutputRoot)
+	require.Len(t, ch.Events, 4)
+	require.Equal(t, EventBisected, ch.Events[1].Name)
+	require.Equal(t, uint64(1200), ch.Events[1].Timestamp)
+
+	bond, err := idx.Bond(1)
+	require.NoError(t, err)
+	require.Equal(t, asserter, bond.Submitter)
+	require.Equal(t, int64(20), bond.Amount.ToInt().Int64())
+	require.Equal(t, uint64(5000), bond.ExpiresAt)
+	require.Len(t, bond.Increases, 1)
+	require.Equal(t, challenger, bond.Unbonded.Recipient)
+
+	ch, err = idx.Challenge(2)
+	require.NoError(t, err)
+	require.Nil(t, ch)
+
+	// the next sync resumes from the indexed blocks.
+	client.head = 125
+	require.NoError(t, idx.sync(context.Background()))
+	require.Equal(t, uint64(124), idx.Status().NextBlock)
+	require.Equal(t, uint64(119), client.queries[4].FromBlock.Uint64())
+
+	inProgress, err := idx.Challenges(ChallengeFilter{Address: &challenger, Status: ChallengeInProgress})
+	require.NoError(t, err)
+	require.Len(t, inProgress, 1)
+	require.Equal(t, uint64(2), inProgress[0].OutputIndex)
+
+	all, err := idx.Challenges(ChallengeFilter{})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	other := common.HexToAddress("0xff")
+	none, err := idx.Challenges(ChallengeFilter{Address: &other})
+	require.NoError(t, err)
+	require.Empty(t, none)
+
+	bonds, err := idx.Bonds(asserter)
+	require.NoError(t, err)
+	require.Len(t, bonds, 1)
+}
+
+func TestIndexer_ResumesFromDB(t *testing.T) {
+	client := &mockL1Client{head: 110}
+	db := memorydb.New()
+	cfg := Config{
+		L2OutputOracleAddr: l2ooAddr,
+		ColosseumAddr:      colosseumAddr,
+		ValidatorPoolAddr:  valpoolAddr,
+		StartBlock:         100,
+		PollInterval:       time.Second,
+		BatchSize:          100,
+	}
+	idx, err := NewIndexer(cfg, testlog.Logger(t, log.LvlCrit), client, db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), idx.Status().NextBlock)
+	require.NoError(t, idx.sync(context.Background()))
+
+	idx, err = NewIndexer(cfg, testlog.Logger(t, log.LvlCrit), client, db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(111), idx.Status().NextBlock)
+}
@@ -0,0 +1,131 @@
+package indexer
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type ChallengeStatus string
+
+const (
+	// ChallengeInProgress is the status of a challenge that has not ended by an event yet. Note that a challenge
+	// can also end by a timeout, which does not emit an event until a party acts on it.
+	ChallengeInProgress ChallengeStatus = "in_progress"
+	// ChallengeProven is the status of a challenge whose fault is proven, waiting for the Security Council approval.
+	ChallengeProven ChallengeStatus = "proven"
+	// ChallengeApproved is the status of a challenge approved by the Security Council, which replaced the output.
+	ChallengeApproved ChallengeStatus = "approved"
+	// ChallengeDeleted is the status of a challenge deleted since the challenger timed out.
+	ChallengeDeleted ChallengeStatus = "deleted"
+)
+
+// Event names, as declared by the contracts.
+const (
+	EventOutputSubmitted  = "OutputSubmitted"
+	EventOutputReplaced   = "OutputReplaced"
+	EventChallengeCreated = "ChallengeCreated"
+	EventBisected         = "Bisected"
+	EventProven           = "Proven"
+	EventApproved         = "Approved"
+	EventDeleted          = "Deleted"
+	EventBonded           = "Bonded"
+	EventBondIncreased    = "BondIncreased"
+	EventUnbonded         = "Unbonded"
+)
+
+// L1Ref is the L1 tx an event was emitted in.
+type L1Ref struct {
+	BlockNumber uint64      `json:"blockNumber"`
+	TxHash      common.Hash `json:"txHash"`
+}
+
+// OutputRecord is an output submitted to the L2OutputOracle.
+type OutputRecord struct {
+	OutputIndex   uint64         `json:"outputIndex"`
+	OutputRoot    common.Hash    `json:"outputRoot"`
+	L2BlockNumber uint64         `json:"l2BlockNumber"`
+	L1Timestamp   uint64         `json:"l1Timestamp"`
+	Submitter     common.Address `json:"submitter"`
+	Submitted     L1Ref          `json:"submitted"`
+	// ReplacedBy is the output root that replaced the submitted one after a successful challenge, if any.
+	ReplacedBy *common.Hash `json:"replacedBy,omitempty"`
+}
+
+// ChallengeEvent is an event of the lifecycle of a challenge.
+type ChallengeEvent struct {
+	Name string `json:"name"`
+	// Turn is the turn of the challenge after the event.
+	Turn uint8 `json:"turn"`
+	// Timestamp is the L1 timestamp of the event, 0 if the event does not carry it.
+	Timestamp uint64 `json:"timestamp,omitempty"`
+	L1        L1Ref  `json:"l1"`
+}
+
+// ChallengeRecord is the lifecycle of the challenge of an output in the Colosseum.
+type ChallengeRecord struct {
+	OutputIndex uint64          `json:"outputIndex"`
+	Asserter    common.Address  `json:"asserter"`
+	Challenger  common.Address  `json:"challenger"`
+	Status      ChallengeStatus `json:"status"`
+	Turn        uint8           `json:"turn"`
+	// NewOutputRoot is the output root proven by the challenger, if any.
+	NewOutputRoot *common.Hash `json:"newOutputRoot,omitempty"`
+	// Events are the events of the challenge, including those of the previous challenges of the output
+	// if it was challenged again after a deletion.
+	Events []ChallengeEvent `json:"events"`
+}
+
+// BondIncrease is an increase of a bond by a challenger.
+type BondIncrease struct {
+	Challenger common.Address `json:"challenger"`
+	Amount     *hexutil.Big   `json:"amount"`
+	L1         L1Ref          `json:"l1"`
+}
+
+// BondRecord is the bond of an output in the ValidatorPool.
+type BondRecord struct {
+	OutputIndex uint64         `json:"outputIndex"`
+	Submitter   common.Address `json:"submitter"`
+	// Amount is the amount bonded, including the increases.
+	Amount    *hexutil.Big   `json:"amount"`
+	ExpiresAt uint64         `json:"expiresAt"`
+	Bonded    L1Ref          `json:"bonded"`
+	Increases []BondIncrease `json:"increases,omitempty"`
+	// Unbonded is set once the bond is paid out to its recipient.
+	Unbonded *Unbond `json:"unbonded,omitempty"`
+}
+
+// Unbond is the payout of a bond.
+type Unbond struct {
+	Recipient common.Address `json:"recipient"`
+	Amount    *hexutil.Big   `json:"amount"`
+	L1        L1Ref          `json:"l1"`
+}
+
+// ChallengeFilter selects challenges. The zero value selects all the challenges.
+type ChallengeFilter struct {
+	// Address selects the challenges the address is party to, as asserter or challenger.
+	Address *common.Address `json:"address,omitempty"`
+	// Status selects the challenges in the given status.
+	Status ChallengeStatus `json:"status,omitempty"`
+}
+
+func (f ChallengeFilter) match(c *ChallengeRecord) bool {
+	if f.Address != nil && c.Asserter != *f.Address && c.Challenger != *f.Address {
+		return false
+	}
+	if f.Status != "" && c.Status != f.Status {
+		return false
+	}
+	return true
+}
+
+// Status is the progress of the indexer.
+type Status struct {
+	// NextBlock is the next L1 block to index. All the blocks before it are indexed.
+	NextBlock uint64 `json:"nextBlock"`
+	// HeadBlock is the L1 head block at the last sync.
+	HeadBlock uint64 `json:"headBlock"`
+	// Synced is true if all the blocks with enough confirmations were indexed at the last sync.
+	Synced bool `json:"synced"`
+}
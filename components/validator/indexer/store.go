@@ -0,0 +1,211 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+)
+
+const (
+	dbCache   = 16
+	dbHandles = 16
+)
+
+var (
+	nextBlockKey    = []byte("next-block")
+	outputPrefix    = []byte("o")
+	challengePrefix = []byte("c")
+	bondPrefix      = []byte("b")
+)
+
+// OpenDB opens the database at the given path, or an in-memory database if the path is empty.
+func OpenDB(path string) (ethdb.KeyValueStore, error) {
+	if path == "" {
+		return memorydb.New(), nil
+	}
+	db, err := leveldb.New(path, dbCache, dbHandles, "", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open indexer database: %w", err)
+	}
+	return db, nil
+}
+
+func recordKey(prefix []byte, outputIndex uint64) []byte {
+	key := make([]byte, len(prefix)+8)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], outputIndex)
+	return key
+}
+
+// store reads and writes the records as JSON. The output indexes are big endian encoded in the keys,
+// so that iterating over a prefix returns the records in the order of the outputs.
+type store struct {
+	db ethdb.KeyValueStore
+}
+
+// get decodes the value of the key into v, and returns false if there is none.
+func (s *store) get(key []byte, v any) (bool, error) {
+	if ok, err := s.db.Has(key); err != nil || !ok {
+		return false, err
+	}
+	data, err := s.db.Get(key)
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, fmt.Errorf("failed to decode record %x: %w", key, err)
+	}
+	return true, nil
+}
+
+func (s *store) nextBlock() (uint64, bool, error) {
+	if ok, err := s.db.Has(nextBlockKey); err != nil || !ok {
+		return 0, false, err
+	}
+	data, err := s.db.Get(nextBlockKey)
+	if err != nil {
+		return 0, false, err
+	}
+	return binary.BigEndian.Uint64(data), true, nil
+}
+
+func (s *store) output(outputIndex uint64) (*OutputRecord, error) {
+	var r OutputRecord
+	if ok, err := s.get(recordKey(outputPrefix, outputIndex), &r); err != nil || !ok {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (s *store) challenge(outputIndex uint64) (*ChallengeRecord, error) {
+	var r ChallengeRecord
+	if ok, err := s.get(recordKey(challengePrefix, outputIndex), &r); err != nil || !ok {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (s *store) bond(outputIndex uint64) (*BondRecord, error) {
+	var r BondRecord
+	if ok, err := s.get(recordKey(bondPrefix, outputIndex), &r); err != nil || !ok {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// iterate decodes the records under the prefix in order, calling fn with each of them.
+func iterate[T any](s *store, prefix []byte, fn func(*T)) error {
+	it := s.db.NewIterator(prefix, nil)
+	defer it.Release()
+	for it.Next() {
+		var r T
+		if err := json.Unmarshal(it.Value(), &r); err != nil {
+			return fmt.Errorf("failed to decode record %x: %w", it.Key(), err)
+		}
+		fn(&r)
+	}
+	return it.Error()
+}
+
+// update accumulates the records changed by the logs of a block range, so that they are written
+// atomically together with the next block to index.
+type update struct {
+	s          *store
+	outputs    map[uint64]*OutputRecord
+	challenges map[uint64]*ChallengeRecord
+	bonds      map[uint64]*BondRecord
+}
+
+func newUpdate(s *store) *update {
+	return &update{
+		s:          s,
+		outputs:    make(map[uint64]*OutputRecord),
+		challenges: make(map[uint64]*ChallengeRecord),
+		bonds:      make(map[uint64]*BondRecord),
+	}
+}
+
+// output returns the record of the output to modify, creating it if there is none.
+func (u *update) output(outputIndex uint64) (*OutputRecord, error) {
+	if r, ok := u.outputs[outputIndex]; ok {
+		return r, nil
+	}
+	r, err := u.s.output(outputIndex)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		r = &OutputRecord{OutputIndex: outputIndex}
+	}
+	u.outputs[outputIndex] = r
+	return r, nil
+}
+
+// challenge returns the record of the challenge to modify, creating it if there is none.
+func (u *update) challenge(outputIndex uint64) (*ChallengeRecord, error) {
+	if r, ok := u.challenges[outputIndex]; ok {
+		return r, nil
+	}
+	r, err := u.s.challenge(outputIndex)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		r = &ChallengeRecord{OutputIndex: outputIndex}
+	}
+	u.challenges[outputIndex] = r
+	return r, nil
+}
+
+// bond returns the record of the bond to modify, creating it if there is none.
+func (u *update) bond(outputIndex uint64) (*BondRecord, error) {
+	if r, ok := u.bonds[outputIndex]; ok {
+		return r, nil
+	}
+	r, err := u.s.bond(outputIndex)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		r = &BondRecord{OutputIndex: outputIndex}
+	}
+	u.bonds[outputIndex] = r
+	return r, nil
+}
+
+// commit writes the changed records and the next block to index atomically.
+func (u *update) commit(nextBlock uint64) error {
+	batch := u.s.db.NewBatch()
+	put := func(key []byte, v any) error {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		return batch.Put(key, data)
+	}
+	for i, r := range u.outputs {
+		if err := put(recordKey(outputPrefix, i), r); err != nil {
+			return err
+		}
+	}
+	for i, r := range u.challenges {
+		if err := put(recordKey(challengePrefix, i), r); err != nil {
+			return err
+		}
+	}
+	for i, r := range u.bonds {
+		if err := put(recordKey(bondPrefix, i), r); err != nil {
+			return err
+		}
+	}
+	var next [8]byte
+	binary.BigEndian.PutUint64(next[:], nextBlock)
+	if err := batch.Put(nextBlockKey, next[:]); err != nil {
+		return err
+	}
+	return batch.Write()
+}
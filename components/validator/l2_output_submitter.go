@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"math/big"
 	_ "net/http/pprof"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -17,16 +20,13 @@ import (
 
 	"github.com/kroma-network/kroma/bindings/bindings"
 	"github.com/kroma-network/kroma/components/node/eth"
-	"github.com/kroma-network/kroma/components/node/rollup"
 	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/components/validator/rpc"
 	"github.com/kroma-network/kroma/utils"
 	"github.com/kroma-network/kroma/utils/service/txmgr"
 )
 
-const (
-	roundNums      = 2
-	publicRoundHex = "0xffffffffffffffffffffffffffffffffffffffff"
-)
+const publicRoundHex = "0xffffffffffffffffffffffffffffffffffffffff"
 
 var PublicRoundAddress = common.HexToAddress(publicRoundHex)
 
@@ -42,9 +42,28 @@ type L2OutputSubmitter struct {
 	l2ooContract    *bindings.L2OutputOracleCaller
 	l2ooABI         *abi.ABI
 	valpoolContract *bindings.ValidatorPoolCaller
+	outputs         *OutputService
+	failover        *Failover
+	// profit estimates the profitability of the submissions, nil if the profit estimator is disabled.
+	profit *ProfitEstimator
+
+	roundDuration      uint64
+	l2BlockTime        *big.Int
+	submissionInterval *big.Int
+
+	// roundOutcomeBlock and roundOutcome are the last round selection outcome recorded,
+	// so that each outcome is recorded once per output.
+	roundOutcomeBlock uint64
+	roundOutcome      string
+	// selectedBlock is the block of the output the validator was selected for priority round to submit.
+	selectedBlock uint64
+
+	// lastSubmission is the last output submitted by the validator, tracked until an output is
+	// submitted on top of it, so that it can be resubmitted if it is reorged out of L1.
+	lastSubmission *submittedOutput
 
-	singleRoundInterval *big.Int
-	l2BlockTime         *big.Int
+	// paused holds back submissions on request of the admin API.
+	paused atomic.Bool
 
 	submitChan chan struct{}
 
@@ -77,22 +96,47 @@ func NewL2OutputSubmitter(ctx context.Context, cfg Config, l log.Logger, m metri
 	cCancel()
 
 	cCtx, cCancel = context.WithTimeout(ctx, cfg.NetworkTimeout)
-	defer cCancel()
 	submissionInterval, err := l2ooContract.SUBMISSIONINTERVAL(utils.NewSimpleCallOpts(cCtx))
 	if err != nil {
+		cCancel()
 		return nil, fmt.Errorf("failed to get submission interval: %w", err)
 	}
-	singleRoundInterval := new(big.Int).Div(submissionInterval, new(big.Int).SetUint64(roundNums))
+	cCancel()
+
+	cCtx, cCancel = context.WithTimeout(ctx, cfg.NetworkTimeout)
+	defer cCancel()
+	roundDuration, err := valpoolContract.ROUNDDURATION(utils.NewSimpleCallOpts(cCtx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get round duration: %w", err)
+	}
+
+	var failover *Failover
+	if cfg.LeaderLockPath != "" {
+		lock := NewFileLeaderLock(cfg.LeaderLockPath, cfg.InstanceID, cfg.LeaderLockTTL)
+		failover = NewFailover(lock, cfg.StandbyEnabled, cfg.StandbyTakeoverDelay, l, m)
+	}
+
+	var profit *ProfitEstimator
+	if cfg.ProfitEstimatorEnabled {
+		profit, err = NewProfitEstimator(ctx, cfg, l, m)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create profit estimator: %w", err)
+		}
+	}
 
 	return &L2OutputSubmitter{
-		cfg:                 cfg,
-		log:                 l,
-		metr:                m,
-		l2ooContract:        l2ooContract,
-		l2ooABI:             parsed,
-		valpoolContract:     valpoolContract,
-		singleRoundInterval: singleRoundInterval,
-		l2BlockTime:         l2BlockTime,
+		cfg:                cfg,
+		log:                l,
+		metr:               m,
+		l2ooContract:       l2ooContract,
+		l2ooABI:            parsed,
+		valpoolContract:    valpoolContract,
+		outputs:            newOutputServiceFromConfig(cfg, l, m),
+		failover:           failover,
+		profit:             profit,
+		roundDuration:      roundDuration.Uint64(),
+		l2BlockTime:        l2BlockTime,
+		submissionInterval: submissionInterval,
 	}, nil
 }
 
@@ -104,6 +148,14 @@ func (l *L2OutputSubmitter) Start(ctx context.Context) error {
 	l.wg.Add(1)
 	go l.loop()
 
+	if l.failover != nil {
+		l.wg.Add(1)
+		go func() {
+			defer l.wg.Done()
+			l.failover.RenewLoop(l.ctx, l.cfg.LeaderLockTTL/3)
+		}()
+	}
+
 	return nil
 }
 
@@ -151,16 +203,41 @@ func (l *L2OutputSubmitter) repeatSubmitL2Output(ctx context.Context) {
 // If it needs to wait, it will calculate how long the validator should wait and
 // try again after the delay.
 func (l *L2OutputSubmitter) trySubmitL2Output(ctx context.Context) (time.Duration, error) {
+	if l.paused.Load() {
+		l.log.Debug("output submission is paused")
+		return l.cfg.OutputSubmitterRetryInterval, nil
+	}
+
 	nextBlockNumber, err := l.FetchNextBlockNumber(ctx)
 	if err != nil {
 		return l.cfg.OutputSubmitterRetryInterval, err
 	}
 
+	l.checkLastSubmission(nextBlockNumber)
+
+	// The output the validator was selected for has been submitted by another validator in the public round.
+	if l.selectedBlock != 0 && nextBlockNumber.Uint64() > l.selectedBlock {
+		l.log.Warn("missed priority round", "blockNumber", l.selectedBlock)
+		l.metr.RecordRoundOutcome(metrics.RoundOutcomeMissed)
+		l.selectedBlock = 0
+	}
+
 	calculatedWaitTime := l.CalculateWaitTime(ctx, nextBlockNumber)
 	if calculatedWaitTime > 0 {
 		return calculatedWaitTime, nil
 	}
 
+	// Only the leader among the instances sharing the key submits the output.
+	if l.failover != nil {
+		shouldSubmit, err := l.failover.ShouldSubmit(nextBlockNumber.Uint64())
+		if err != nil {
+			return l.cfg.OutputSubmitterRetryInterval, fmt.Errorf("failed to check leader lock: %w", err)
+		}
+		if !shouldSubmit {
+			return l.cfg.OutputSubmitterRetryInterval, nil
+		}
+	}
+
 	if err = l.doSubmitL2Output(ctx, nextBlockNumber); err != nil {
 		return l.cfg.OutputSubmitterRetryInterval, err
 	}
@@ -181,13 +258,29 @@ func (l *L2OutputSubmitter) doSubmitL2Output(ctx context.Context, nextBlockNumbe
 		return fmt.Errorf("failed to create submit l2 output transaction data: %w", err)
 	}
 
-	if txResponse := l.submitL2OutputTx(data); txResponse.Err != nil {
+	txResponse := l.submitL2OutputTx(data)
+	if txResponse.Receipt != nil {
+		l.metr.RecordSubmissionGasUsed(txResponse.Receipt.GasUsed)
+	}
+	if txResponse.Err != nil {
+		l.recordFailedSubmission(ctx, data, txResponse)
 		return txResponse.Err
 	}
 
 	// Successfully submitted
 	l.log.Info("L2output successfully submitted", "blockNumber", output.BlockRef.Number)
+	if l.profit != nil {
+		l.profit.RecordGasUsed(txResponse.Receipt.GasUsed)
+	}
 	l.metr.RecordL2OutputSubmitted(output.BlockRef)
+	l.selectedBlock = 0
+	l.lastSubmission = &submittedOutput{
+		blockNumber:   output.BlockRef.Number,
+		txHash:        txResponse.Receipt.TxHash,
+		l1BlockNumber: txResponse.Receipt.BlockNumber.Uint64(),
+		l1BlockHash:   txResponse.Receipt.BlockHash,
+	}
+	l.recordSubmissionLatency(ctx, nextBlockNumber)
 	// go to try next submission immediately
 	return nil
 }
@@ -230,22 +323,110 @@ func (l *L2OutputSubmitter) CalculateWaitTime(ctx context.Context, nextBlockNumb
 	}
 
 	// Check if it's a public round, or selected for priority validator
-	roundInfo, err := l.fetchCurrentRound(ctx)
+	schedule, err := l.fetchRoundSchedule(ctx, nextBlockNumber)
 	if err != nil {
 		return defaultWaitTime
 	}
+	var outcome string
+	switch {
+	case schedule.isPublicRound():
+		l.log.Info("current round is public round")
+		outcome = metrics.RoundOutcomePublic
+	case schedule.nextValidator == l.cfg.TxManager.From():
+		l.log.Info("current round is priority round, and selected for priority validator")
+		outcome = metrics.RoundOutcomeSelected
+		l.selectedBlock = nextBlockNumber.Uint64()
+	default:
+		l.log.Info("current round is priority round, and not selected for priority validator")
+		outcome = metrics.RoundOutcomeNotSelected
+	}
+	l.recordRoundOutcome(nextBlockNumber.Uint64(), outcome)
+
+	waitTime, ok := schedule.waitTime(l.cfg.TxManager.From())
+	if !ok {
+		// the round will not be opened to the public, so just check again later.
+		l.log.Info("not selected for priority validator and no public round is scheduled")
+		return defaultWaitTime
+	}
+	if waitTime > 0 {
+		l.log.Info("not selected for priority validator, wait for public round", "nextValidator", schedule.nextValidator,
+			"publicRoundStart", schedule.publicRoundStart, "l1Time", schedule.l1Time, "waitDuration", waitTime)
+		return waitTime
+	}
 
-	if !roundInfo.canJoinRound() {
-		// wait for L2 blocks proceeding until public round when not selected for priority validator
-		roundIntervalToWait := new(big.Int).Sub(l.singleRoundInterval, roundBuffer)
-		nextBlockNumberToWait = new(big.Int).Add(nextBlockNumber, roundIntervalToWait)
-		return l.getLeftTimeForL2Blocks(currentBlockNumber, nextBlockNumberToWait)
+	if l.profit != nil {
+		action := ProfitActionPublicRound
+		if outcome == metrics.RoundOutcomeSelected {
+			action = ProfitActionPriorityRound
+		}
+		roundStart := schedule.publicRoundStart - l.roundDuration - 1
+		submit, err := l.profit.Decide(ctx, action, nextBlockNumber.Uint64(), roundStart, schedule.l1Time)
+		if err != nil {
+			// the estimation is best effort, so the submission goes on without it.
+			l.log.Warn("failed to estimate profitability of output submission", "err", err)
+		} else if !submit {
+			return defaultWaitTime
+		}
 	}
 
 	// no need to wait
 	return 0
 }
 
+// ProfitEstimate returns the last estimated profitability of a submission, or nil if there is none.
+func (l *L2OutputSubmitter) ProfitEstimate() *ProfitEstimate {
+	if l.profit == nil {
+		return nil
+	}
+	return l.profit.LastEstimate()
+}
+
+// Pause stops submitting outputs until Resume is called.
+func (l *L2OutputSubmitter) Pause() {
+	if !l.paused.Swap(true) {
+		l.log.Info("pausing output submission")
+	}
+}
+
+// Resume resumes output submission after Pause.
+func (l *L2OutputSubmitter) Resume() {
+	if l.paused.Swap(false) {
+		l.log.Info("resuming output submission")
+	}
+}
+
+// Paused returns true if output submission is paused.
+func (l *L2OutputSubmitter) Paused() bool {
+	return l.paused.Load()
+}
+
+// RoundStatus returns the submission round of the next output.
+func (l *L2OutputSubmitter) RoundStatus(ctx context.Context) (*rpc.RoundStatus, error) {
+	nextBlockNumber, err := l.FetchNextBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := l.fetchRoundSchedule(ctx, nextBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.RoundStatus{
+		NextBlockNumber:  nextBlockNumber.Uint64(),
+		NextValidator:    schedule.nextValidator,
+		Selected:         schedule.nextValidator == l.cfg.TxManager.From(),
+		PublicRound:      schedule.isPublicRound(),
+		PublicRoundStart: schedule.publicRoundStart,
+		L1Time:           schedule.l1Time,
+	}, nil
+}
+
+// DepositAmount returns the balance of the validator in the ValidatorPool.
+func (l *L2OutputSubmitter) DepositAmount(ctx context.Context) (*big.Int, error) {
+	cCtx, cCancel := context.WithTimeout(ctx, l.cfg.NetworkTimeout)
+	defer cCancel()
+	return l.valpoolContract.BalanceOf(utils.NewSimpleCallOpts(cCtx), l.cfg.TxManager.From())
+}
+
 func (l *L2OutputSubmitter) checkDeposit(ctx context.Context) (bool, error) {
 	cCtx, cCancel := context.WithTimeout(ctx, l.cfg.NetworkTimeout)
 	defer cCancel()
@@ -261,6 +442,9 @@ func (l *L2OutputSubmitter) checkDeposit(ctx context.Context) (bool, error) {
 	}
 	l.log.Info("validator deposit amount", "deposit", balance)
 	l.metr.RecordDepositAmount(balance)
+	if l.cfg.OutputSubmitterBondAmount > 0 {
+		l.metr.RecordBondCoverage(new(big.Int).Div(balance, new(big.Int).SetUint64(l.cfg.OutputSubmitterBondAmount)).Uint64())
+	}
 
 	return true, nil
 }
@@ -325,76 +509,188 @@ func (l *L2OutputSubmitter) getLeftTimeForL2Blocks(currentBlockNumber *big.Int,
 	return waitDuration
 }
 
-type roundInfo struct {
-	isPublicRound       bool
-	isPriorityValidator bool
+// roundSchedule models the submission rounds of the ValidatorPool contract for a single output.
+// The priority round starts when the L2 block after the output block is produced, and lasts
+// for ROUND_DURATION seconds. After that anyone can submit the output in the public round.
+type roundSchedule struct {
+	// nextValidator is the validator selected by the ValidatorPool at l1Time.
+	nextValidator common.Address
+	// publicRoundStart is the first L1 timestamp at which the public round is opened.
+	publicRoundStart uint64
+	// l1Time is the timestamp of the L1 block the schedule was fetched at.
+	l1Time uint64
 }
 
-func (r *roundInfo) canJoinRound() bool {
-	joinPriority := !r.isPublicRound && r.isPriorityValidator
-	joinPublic := r.isPublicRound
-	return joinPriority || joinPublic
+func newRoundSchedule(nextValidator common.Address, roundStart uint64, roundDuration uint64, l1Time uint64) roundSchedule {
+	return roundSchedule{
+		nextValidator: nextValidator,
+		// The ValidatorPool opens the public round only when the elapsed time exceeds ROUND_DURATION.
+		publicRoundStart: roundStart + roundDuration + 1,
+		l1Time:           l1Time,
+	}
 }
 
-// fetchCurrentRound fetches next validator address from ValidatorPool contract.
-// It returns if current round is public round, and if selected for priority validator if it's a priority round.
-func (l *L2OutputSubmitter) fetchCurrentRound(ctx context.Context) (roundInfo, error) {
+func (r roundSchedule) isPublicRound() bool {
+	return bytes.Equal(r.nextValidator[:], PublicRoundAddress[:])
+}
+
+// waitTime returns how long the given validator should wait before submitting the output.
+// It returns false if the validator cannot submit the output in any upcoming round,
+// which is the case when there is no priority validator and the trusted validator is selected instead.
+func (r roundSchedule) waitTime(validator common.Address) (time.Duration, bool) {
+	if r.isPublicRound() || r.nextValidator == validator {
+		return 0, true
+	}
+	if r.l1Time >= r.publicRoundStart {
+		return 0, false
+	}
+	return time.Duration(r.publicRoundStart-r.l1Time) * time.Second, true
+}
+
+// fetchRoundSchedule fetches next validator address from ValidatorPool contract together with
+// the L1 timestamp it was evaluated at, and computes when the public round of the given output begins.
+func (l *L2OutputSubmitter) fetchRoundSchedule(ctx context.Context, nextBlockNumber *big.Int) (roundSchedule, error) {
 	cCtx, cCancel := context.WithTimeout(ctx, l.cfg.NetworkTimeout)
 	defer cCancel()
-	nextValidator, err := l.valpoolContract.NextValidator(utils.NewSimpleCallOpts(cCtx))
+	header, err := l.cfg.L1Client.HeaderByNumber(cCtx, nil)
+	if err != nil {
+		l.log.Error("validator unable to get latest L1 header", "err", err)
+		return roundSchedule{}, err
+	}
+
+	// Pin the calls to the fetched header, so that the round is evaluated at its timestamp.
+	callOpts := utils.NewSimpleCallOpts(cCtx)
+	callOpts.BlockNumber = header.Number
+	nextValidator, err := l.valpoolContract.NextValidator(callOpts)
 	if err != nil {
 		l.log.Error("validator unable to get next validator address", "err", err)
-		return roundInfo{
-			isPublicRound:       false,
-			isPriorityValidator: false,
-		}, err
+		return roundSchedule{}, err
 	}
 
 	l.metr.RecordNextValidator(nextValidator)
 
-	if bytes.Equal(nextValidator[:], PublicRoundAddress[:]) {
-		l.log.Info("current round is public round")
-		return roundInfo{
-			isPublicRound:       true,
-			isPriorityValidator: false,
-		}, nil
+	roundStart, err := l.l2ooContract.ComputeL2Timestamp(callOpts, new(big.Int).Add(nextBlockNumber, common.Big1))
+	if err != nil {
+		l.log.Error("validator unable to compute round start", "err", err)
+		return roundSchedule{}, err
 	}
 
-	if nextValidator == l.cfg.TxManager.From() {
-		l.log.Info("current round is priority round, and selected for priority validator")
-		return roundInfo{
-			isPublicRound:       false,
-			isPriorityValidator: true,
-		}, nil
-	}
+	schedule := newRoundSchedule(nextValidator, roundStart.Uint64(), l.roundDuration, header.Time)
 
-	l.log.Info("current round is priority round, and not selected for priority validator")
-	return roundInfo{
-		isPublicRound:       false,
-		isPriorityValidator: false,
-	}, nil
+	return schedule, nil
 }
 
 // FetchOutput gets the output information to the corresponding block number.
 // It returns the output info if the output can be made, otherwise error.
 func (l *L2OutputSubmitter) FetchOutput(ctx context.Context, blockNumber *big.Int) (*eth.OutputResponse, error) {
+	// The sync status of the output is submitted along with it, so it must not come from the cache.
+	output, err := l.outputs.FreshOutputAtBlock(ctx, blockNumber.Uint64())
+	if err != nil {
+		l.log.Error("failed to fetch output", "blockNumber", blockNumber, "err", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// submittedOutput is an output submitted by the validator, and the L1 block its tx was included in.
+type submittedOutput struct {
+	blockNumber   uint64
+	txHash        common.Hash
+	l1BlockNumber uint64
+	l1BlockHash   common.Hash
+}
+
+// checkLastSubmission checks that the last output submitted by the validator is still in the L2OutputOracle.
+// If the next block number to submit went back to the block of the output, its tx has been reorged out of L1,
+// so the nonce is fetched again and the round of the output is raced again, instead of missing the interval.
+func (l *L2OutputSubmitter) checkLastSubmission(nextBlockNumber *big.Int) {
+	sub := l.lastSubmission
+	if sub == nil {
+		return
+	}
+
+	next := nextBlockNumber.Uint64()
+	if next > sub.blockNumber+l.submissionInterval.Uint64() {
+		// Another output has been submitted on top of it.
+		l.lastSubmission = nil
+		return
+	}
+	if next > sub.blockNumber {
+		return
+	}
+
+	l.log.Warn("submitted output was reorged out of L1, resubmitting", "blockNumber", sub.blockNumber,
+		"txHash", sub.txHash, "l1BlockNumber", sub.l1BlockNumber, "l1BlockHash", sub.l1BlockHash)
+	l.metr.RecordSubmissionReorged()
+	l.cfg.TxManager.ResetNonce()
+	l.lastSubmission = nil
+	l.selectedBlock = 0
+}
+
+// recordRoundOutcome records the round selection outcome of the output at the given block,
+// once per output and outcome.
+func (l *L2OutputSubmitter) recordRoundOutcome(blockNumber uint64, outcome string) {
+	if l.roundOutcomeBlock == blockNumber && l.roundOutcome == outcome {
+		return
+	}
+	l.roundOutcomeBlock, l.roundOutcome = blockNumber, outcome
+	l.metr.RecordRoundOutcome(outcome)
+}
+
+// recordSubmissionLatency records how long after the output at the given block could be submitted
+// it has been submitted, relative to the submission interval.
+func (l *L2OutputSubmitter) recordSubmissionLatency(ctx context.Context, nextBlockNumber *big.Int) {
 	cCtx, cCancel := context.WithTimeout(ctx, l.cfg.NetworkTimeout)
 	defer cCancel()
-	output, err := l.cfg.RollupClient.OutputAtBlock(cCtx, blockNumber.Uint64())
+	roundStart, err := l.l2ooContract.ComputeL2Timestamp(utils.NewSimpleCallOpts(cCtx), new(big.Int).Add(nextBlockNumber, common.Big1))
 	if err != nil {
-		l.log.Error("failed to fetch output at block number %d: %w", blockNumber, err)
-		return nil, err
+		l.log.Warn("failed to compute round start for submission latency", "err", err)
+		return
 	}
-	if output.Version != rollup.L2OutputRootVersion(l.cfg.RollupConfig, l.cfg.RollupConfig.ComputeTimestamp(blockNumber.Uint64())) {
-		l.log.Error("l2 output version is not matched: %s", output.Version)
-		return nil, errors.New("mismatched l2 output version")
+
+	latency := time.Since(time.Unix(int64(roundStart.Uint64()), 0))
+	interval := time.Duration(new(big.Int).Mul(l.submissionInterval, l.l2BlockTime).Uint64()) * time.Second
+	l.metr.RecordSubmissionLatency(latency, interval)
+}
+
+// recordFailedSubmission records the reason of the failed submission if it has been reverted.
+// If the transaction has been included, the reason is obtained by replaying it at its block.
+func (l *L2OutputSubmitter) recordFailedSubmission(ctx context.Context, data []byte, txResponse *txmgr.TxResponse) {
+	err := txResponse.Err
+	if errors.Is(err, txmgr.ErrTxReceiptNotSucceed) && txResponse.Receipt != nil {
+		cCtx, cCancel := context.WithTimeout(ctx, l.cfg.NetworkTimeout)
+		defer cCancel()
+		_, err = l.cfg.L1Client.CallContract(cCtx, ethereum.CallMsg{
+			From: l.cfg.TxManager.From(),
+			To:   &l.cfg.L2OutputOracleAddr,
+			Data: data,
+		}, txResponse.Receipt.BlockNumber)
+		if err == nil {
+			l.metr.RecordSubmissionReverted("unknown")
+			return
+		}
 	}
-	if output.BlockRef.Number != blockNumber.Uint64() { // sanity check, e.g. in case of bad RPC caching
-		l.log.Error("invalid block number", "next", blockNumber, "output", output.BlockRef.Number)
-		return nil, errors.New("invalid block number")
+
+	if reason, ok := revertReason(err); ok {
+		l.log.Error("L2output submission reverted", "reason", reason)
+		l.metr.RecordSubmissionReverted(reason)
 	}
+}
 
-	return output, nil
+// revertReason extracts the revert reason from the error of a reverted call or gas estimation.
+func revertReason(err error) (string, bool) {
+	const prefix = "execution reverted"
+	msg := err.Error()
+	i := strings.Index(msg, prefix)
+	if i < 0 {
+		return "", false
+	}
+	reason := strings.TrimPrefix(msg[i+len(prefix):], ": ")
+	if reason == "" {
+		reason = prefix
+	}
+	return reason, true
 }
 
 // SubmitL2OutputTxData creates the transaction data for the submitL2OutputTx function.
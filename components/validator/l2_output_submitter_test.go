@@ -0,0 +1,85 @@
+package validator
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/testlog"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+)
+
+func TestRoundSchedule_WaitTime(t *testing.T) {
+	self := common.HexToAddress("0x01")
+	other := common.HexToAddress("0x02")
+
+	tests := []struct {
+		name          string
+		nextValidator common.Address
+		l1Time        uint64
+		wait          time.Duration
+		ok            bool
+	}{
+		{"selected", self, 1000, 0, true},
+		{"public", PublicRoundAddress, 1000, 0, true},
+		{"not selected in priority round", other, 1000, 61 * time.Second, true},
+		{"not selected at end of priority round", other, 1060, time.Second, true},
+		{"no public round", other, 1061, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := newRoundSchedule(tt.nextValidator, 1000, 60, tt.l1Time)
+			wait, ok := schedule.waitTime(self)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.wait, wait)
+		})
+	}
+}
+
+func TestRevertReason(t *testing.T) {
+	tests := []struct {
+		err    error
+		reason string
+		ok     bool
+	}{
+		{errors.New("execution reverted: L2OutputOracle: block number must be equal to next expected block number"), "L2OutputOracle: block number must be equal to next expected block number", true},
+		{errors.New("failed to estimate gas: execution reverted"), "execution reverted", true},
+		{errors.New("context deadline exceeded"), "", false},
+	}
+	for _, tt := range tests {
+		reason, ok := revertReason(tt.err)
+		require.Equal(t, tt.ok, ok)
+		require.Equal(t, tt.reason, reason)
+	}
+}
+
+func TestCheckLastSubmission(t *testing.T) {
+	newSubmitter := func() *L2OutputSubmitter {
+		return &L2OutputSubmitter{
+			cfg:                Config{TxManager: &txmgr.BufferedTxManager{}},
+			log:                testlog.Logger(t, log.LvlCrit),
+			metr:               metrics.NoopMetrics,
+			submissionInterval: big.NewInt(10),
+			lastSubmission:     &submittedOutput{blockNumber: 20},
+			selectedBlock:      20,
+		}
+	}
+
+	l := newSubmitter()
+	l.checkLastSubmission(big.NewInt(30))
+	require.NotNil(t, l.lastSubmission, "submission should be tracked until an output is submitted on top of it")
+
+	l.checkLastSubmission(big.NewInt(40))
+	require.Nil(t, l.lastSubmission)
+
+	l = newSubmitter()
+	l.checkLastSubmission(big.NewInt(20))
+	require.Nil(t, l.lastSubmission, "reorged submission should be forgotten to be resubmitted")
+	require.Zero(t, l.selectedBlock)
+}
@@ -3,6 +3,8 @@ package metrics
 import (
 	"context"
 	"math/big"
+	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -10,6 +12,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/kroma-network/kroma/components/node/eth"
+	nodemetrics "github.com/kroma-network/kroma/components/node/metrics"
+	"github.com/kroma-network/kroma/components/node/sources/caching"
 	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
 	txmetrics "github.com/kroma-network/kroma/utils/service/txmgr/metrics"
 )
@@ -19,6 +23,14 @@ const (
 	L2OutputSubmitted = "submitted"
 )
 
+// Round selection outcomes of the output submissions.
+const (
+	RoundOutcomeSelected    = "selected"
+	RoundOutcomeNotSelected = "not_selected"
+	RoundOutcomePublic      = "public"
+	RoundOutcomeMissed      = "missed"
+)
+
 type Metricer interface {
 	RecordInfo(version string)
 	RecordUp()
@@ -29,10 +41,35 @@ type Metricer interface {
 	// Record Tx metrics
 	txmetrics.TxMetricer
 
+	// Record output cache metrics
+	caching.Metrics
+
 	RecordL2OutputSubmitted(l2ref eth.L2BlockRef)
 	RecordDepositAmount(amount *big.Int)
 	RecordNextValidator(address common.Address)
 	RecordChallengeCheckpoint(outputIndex *big.Int)
+	RecordBondTopUp(amount *big.Int)
+	RecordRewardWithdrawal(amount *big.Int)
+	RecordActiveChallenges(count int)
+	RecordProverHealthy(healthy bool)
+	RecordLeader(leader bool)
+	RecordOutputWatched(outputIndex *big.Int, valid bool)
+	RecordSubmissionLatency(latency time.Duration, interval time.Duration)
+	RecordSubmissionGasUsed(gasUsed uint64)
+	RecordSubmissionReverted(reason string)
+	RecordRoundOutcome(outcome string)
+	RecordBondCoverage(submissions uint64)
+	RecordSubmissionReorged()
+	RecordProofDeadlineMissed()
+	RecordL1Balance(balance *big.Int)
+	RecordBalanceAlert(kind string)
+	RecordDefenseTx(result string)
+	RecordPenalty(reason string, own bool)
+	RecordOutputFinality(status string, count int)
+	RecordProofVerification(result string)
+	RecordCouncilEscalation(kind string, status string)
+	RecordProfitEstimate(reward *big.Int, gasCost *big.Int)
+	RecordProfitDecision(action string, decision string)
 }
 
 type Metrics struct {
@@ -43,22 +80,54 @@ type Metrics struct {
 	kmetrics.RefMetrics
 	txmetrics.TxMetrics
 
+	OutputCache *nodemetrics.CacheMetrics
+
 	Info                prometheus.GaugeVec
 	Up                  prometheus.Gauge
 	DepositAmount       prometheus.Gauge
 	NextValidator       prometheus.GaugeVec
 	ChallengeCheckpoint prometheus.Gauge
+	BondTopUpAmount     prometheus.Counter
+	RewardWithdrawn     prometheus.Counter
+	ActiveChallenges    prometheus.Gauge
+	ProverHealthy       prometheus.Gauge
+	Leader              prometheus.Gauge
+	WatchedOutputs      prometheus.CounterVec
+	WatchedOutputIndex  prometheus.Gauge
+	SubmissionLatency   prometheus.Gauge
+	SubmissionDelay     prometheus.Gauge
+	SubmissionGasUsed   prometheus.Gauge
+	SubmissionsReverted prometheus.CounterVec
+	RoundOutcomes       prometheus.CounterVec
+	BondCoverage        prometheus.Gauge
+	SubmissionsReorged  prometheus.Counter
+	ProofDeadlineMissed prometheus.Counter
+	L1Balance           prometheus.Gauge
+	BalanceAlerts       prometheus.CounterVec
+	DefenseTxs          prometheus.CounterVec
+	Penalties           prometheus.CounterVec
+	OutputFinality      prometheus.GaugeVec
+	ProofVerifications  prometheus.CounterVec
+	CouncilEscalations  prometheus.CounterVec
+	ExpectedReward      prometheus.Gauge
+	ExpectedGasCost     prometheus.Gauge
+	ProfitDecisions     prometheus.CounterVec
 }
 
 var _ Metricer = (*Metrics)(nil)
 
 func NewMetrics(procName string) *Metrics {
+	return NewMetricsWithRegistry(procName, kmetrics.NewRegistry())
+}
+
+// NewMetricsWithRegistry creates the metrics of the given process in the given registry,
+// so that the metrics of several chains validated by the same process are served together.
+func NewMetricsWithRegistry(procName string, registry *prometheus.Registry) *Metrics {
 	if procName == "" {
 		procName = "default"
 	}
 	ns := Namespace + "_" + procName
 
-	registry := kmetrics.NewRegistry()
 	factory := kmetrics.With(registry)
 
 	return &Metrics{
@@ -69,6 +138,8 @@ func NewMetrics(procName string) *Metrics {
 		RefMetrics: kmetrics.MakeRefMetrics(ns, factory),
 		TxMetrics:  txmetrics.MakeTxMetrics(ns, factory),
 
+		OutputCache: nodemetrics.NewCacheMetrics(factory, ns, "output_cache", "Output cache"),
+
 		Info: *factory.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: ns,
 			Name:      "info",
@@ -98,6 +169,154 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "challenge_checkpoint",
 			Help:      "The output index that the challenge function last checked",
 		}),
+		BondTopUpAmount: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "bond_topup_amount",
+			Help:      "The total amount automatically deposited into the ValidatorPool contract",
+		}),
+		RewardWithdrawn: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "reward_withdrawn_amount",
+			Help:      "The total amount automatically withdrawn from the ValidatorPool contract",
+		}),
+		ActiveChallenges: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "active_challenges",
+			Help:      "The number of challenges the challenger is progressing",
+		}),
+		ProverHealthy: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "prover_healthy",
+			Help:      "1 if the prover backend is healthy",
+		}),
+		Leader: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "leader",
+			Help:      "1 if the instance holds the leader lock to submit outputs",
+		}),
+		WatchedOutputs: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "watched_outputs",
+			Help:      "The number of submitted outputs validated by the watcher",
+		}, []string{
+			"result",
+		}),
+		WatchedOutputIndex: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "watched_output_index",
+			Help:      "The output index that the watcher last validated",
+		}),
+		SubmissionLatency: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "submission_latency_seconds",
+			Help:      "Seconds between when the last output could be submitted and when it was submitted",
+		}),
+		SubmissionDelay: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "submission_latency_ratio",
+			Help:      "The latency of the last submission relative to the submission interval",
+		}),
+		SubmissionGasUsed: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "submission_gas_used",
+			Help:      "The gas used by the last output submission",
+		}),
+		SubmissionsReverted: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "submissions_reverted",
+			Help:      "The number of reverted output submissions by revert reason",
+		}, []string{
+			"reason",
+		}),
+		RoundOutcomes: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "round_outcomes",
+			Help:      "The number of outputs by round selection outcome: selected, not_selected, public or missed",
+		}, []string{
+			"outcome",
+		}),
+		BondCoverage: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "bond_coverage",
+			Help:      "The number of submissions the deposit in the ValidatorPool contract can bond",
+		}),
+		SubmissionsReorged: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "submissions_reorged",
+			Help:      "The number of output submissions reorged out of L1 and resubmitted",
+		}),
+		ProofDeadlineMissed: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "proof_deadline_missed",
+			Help:      "The number of challenges whose proof cannot be generated before the proving timeout",
+		}),
+		L1Balance: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "l1_balance",
+			Help:      "The L1 balance (in ether) of the validator, checked by the balance monitor",
+		}),
+		BalanceAlerts: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "balance_alerts",
+			Help:      "The number of times a balance of the validator fell below its threshold, by kind",
+		}, []string{
+			"kind",
+		}),
+		DefenseTxs: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "defense_txs",
+			Help:      "The number of attempts to defend an output of the validator in a challenge, by result",
+		}, []string{
+			"result",
+		}),
+		Penalties: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "penalties",
+			Help:      "The number of penalties of this validator or of the watched validators detected on chain, by reason",
+		}, []string{
+			"reason",
+			"own",
+		}),
+		OutputFinality: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "output_finality",
+			Help:      "The number of submitted outputs tracked until their finalization, by status",
+		}, []string{
+			"status",
+		}),
+		ProofVerifications: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "proof_verifications",
+			Help:      "The number of local verifications of proofs before submitting them, by result",
+		}, []string{
+			"result",
+		}),
+		CouncilEscalations: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "council_escalations",
+			Help:      "The number of escalations of challenges to the Security Council, by kind and status",
+		}, []string{
+			"kind",
+			"status",
+		}),
+		ExpectedReward: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "expected_reward",
+			Help:      "The reward expected for the last estimated output submission, in ETH",
+		}),
+		ExpectedGasCost: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "expected_gas_cost",
+			Help:      "The gas cost expected for the last estimated output submission, in ETH",
+		}),
+		ProfitDecisions: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "profit_decisions",
+			Help:      "The number of profitability decisions on output submissions, by action and decision",
+		}, []string{
+			"action",
+			"decision",
+		}),
 	}
 }
 
@@ -142,3 +361,140 @@ func (m *Metrics) RecordNextValidator(address common.Address) {
 func (m *Metrics) RecordChallengeCheckpoint(outputIndex *big.Int) {
 	m.ChallengeCheckpoint.Set(float64(outputIndex.Uint64()))
 }
+
+// RecordBondTopUp increases the total amount automatically deposited into the ValidatorPool contract.
+func (m *Metrics) RecordBondTopUp(amount *big.Int) {
+	m.BondTopUpAmount.Add(kmetrics.WeiToEther(amount))
+}
+
+// RecordRewardWithdrawal increases the total amount automatically withdrawn from the ValidatorPool contract.
+func (m *Metrics) RecordRewardWithdrawal(amount *big.Int) {
+	m.RewardWithdrawn.Add(kmetrics.WeiToEther(amount))
+}
+
+// RecordActiveChallenges sets the number of challenges the challenger is progressing.
+func (m *Metrics) RecordActiveChallenges(count int) {
+	m.ActiveChallenges.Set(float64(count))
+}
+
+// RecordProverHealthy sets whether the prover backend is healthy.
+func (m *Metrics) RecordProverHealthy(healthy bool) {
+	if healthy {
+		m.ProverHealthy.Set(1)
+	} else {
+		m.ProverHealthy.Set(0)
+	}
+}
+
+// RecordLeader sets whether the instance holds the leader lock.
+func (m *Metrics) RecordLeader(leader bool) {
+	if leader {
+		m.Leader.Set(1)
+	} else {
+		m.Leader.Set(0)
+	}
+}
+
+// RecordOutputWatched records the result of the validation of a submitted output by the watcher.
+func (m *Metrics) RecordOutputWatched(outputIndex *big.Int, valid bool) {
+	result := "valid"
+	if !valid {
+		result = "invalid"
+	}
+	m.WatchedOutputs.WithLabelValues(result).Inc()
+	m.WatchedOutputIndex.Set(float64(outputIndex.Uint64()))
+}
+
+// RecordSubmissionLatency sets the latency of the last submission, in seconds and relative to the submission interval.
+func (m *Metrics) RecordSubmissionLatency(latency time.Duration, interval time.Duration) {
+	m.SubmissionLatency.Set(latency.Seconds())
+	if interval > 0 {
+		m.SubmissionDelay.Set(latency.Seconds() / interval.Seconds())
+	}
+}
+
+// RecordSubmissionGasUsed sets the gas used by the last output submission.
+func (m *Metrics) RecordSubmissionGasUsed(gasUsed uint64) {
+	m.SubmissionGasUsed.Set(float64(gasUsed))
+}
+
+// RecordSubmissionReverted increases the number of reverted output submissions with the given reason.
+func (m *Metrics) RecordSubmissionReverted(reason string) {
+	m.SubmissionsReverted.WithLabelValues(reason).Inc()
+}
+
+// RecordRoundOutcome increases the number of outputs with the given round selection outcome.
+func (m *Metrics) RecordRoundOutcome(outcome string) {
+	m.RoundOutcomes.WithLabelValues(outcome).Inc()
+}
+
+// RecordBondCoverage sets the number of submissions the deposit in the ValidatorPool contract can bond.
+func (m *Metrics) RecordBondCoverage(submissions uint64) {
+	m.BondCoverage.Set(float64(submissions))
+}
+
+// RecordSubmissionReorged increases the number of output submissions reorged out of L1.
+func (m *Metrics) RecordSubmissionReorged() {
+	m.SubmissionsReorged.Inc()
+}
+
+// RecordProofDeadlineMissed increases the number of challenges whose proof cannot be generated in time.
+func (m *Metrics) RecordProofDeadlineMissed() {
+	m.ProofDeadlineMissed.Inc()
+}
+
+// RecordL1Balance sets the L1 balance of the validator.
+func (m *Metrics) RecordL1Balance(balance *big.Int) {
+	m.L1Balance.Set(kmetrics.WeiToEther(balance))
+}
+
+// RecordBalanceAlert increases the number of times the given kind of balance fell below its threshold.
+func (m *Metrics) RecordBalanceAlert(kind string) {
+	m.BalanceAlerts.WithLabelValues(kind).Inc()
+}
+
+// RecordDefenseTx increases the number of attempts to defend an output with the given result.
+func (m *Metrics) RecordDefenseTx(result string) {
+	m.DefenseTxs.WithLabelValues(result).Inc()
+}
+
+// RecordPenalty increases the number of penalties with the given reason, of this validator if own is true.
+func (m *Metrics) RecordPenalty(reason string, own bool) {
+	m.Penalties.WithLabelValues(reason, strconv.FormatBool(own)).Inc()
+}
+
+// RecordOutputFinality sets the number of tracked outputs in the given finalization status.
+func (m *Metrics) RecordOutputFinality(status string, count int) {
+	m.OutputFinality.WithLabelValues(status).Set(float64(count))
+}
+
+// RecordProofVerification increases the number of local verifications of proofs with the given result.
+func (m *Metrics) RecordProofVerification(result string) {
+	m.ProofVerifications.WithLabelValues(result).Inc()
+}
+
+// RecordCouncilEscalation increases the number of escalations of the given kind reaching the given status.
+func (m *Metrics) RecordCouncilEscalation(kind string, status string) {
+	m.CouncilEscalations.WithLabelValues(kind, status).Inc()
+}
+
+// RecordProfitEstimate sets the expected reward and gas cost of the last estimated output submission.
+func (m *Metrics) RecordProfitEstimate(reward *big.Int, gasCost *big.Int) {
+	m.ExpectedReward.Set(kmetrics.WeiToEther(reward))
+	m.ExpectedGasCost.Set(kmetrics.WeiToEther(gasCost))
+}
+
+// RecordProfitDecision increases the number of profitability decisions on the given action.
+func (m *Metrics) RecordProfitDecision(action string, decision string) {
+	m.ProfitDecisions.WithLabelValues(action, decision).Inc()
+}
+
+// CacheAdd meters the addition of an output to the output cache.
+func (m *Metrics) CacheAdd(label string, cacheSize int, evicted bool) {
+	m.OutputCache.CacheAdd(label, cacheSize, evicted)
+}
+
+// CacheGet meters a lookup of an output in the output cache.
+func (m *Metrics) CacheGet(label string, hit bool) {
+	m.OutputCache.CacheGet(label, hit)
+}
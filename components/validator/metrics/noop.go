@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -20,7 +21,32 @@ var NoopMetrics Metricer = new(noopMetrics)
 func (*noopMetrics) RecordInfo(version string) {}
 func (*noopMetrics) RecordUp()                 {}
 
-func (*noopMetrics) RecordL2OutputSubmitted(l2ref eth.L2BlockRef)   {}
-func (*noopMetrics) RecordDepositAmount(amount *big.Int)            {}
-func (*noopMetrics) RecordNextValidator(address common.Address)     {}
-func (*noopMetrics) RecordChallengeCheckpoint(outputIndex *big.Int) {}
+func (*noopMetrics) RecordL2OutputSubmitted(l2ref eth.L2BlockRef)                          {}
+func (*noopMetrics) RecordDepositAmount(amount *big.Int)                                   {}
+func (*noopMetrics) RecordNextValidator(address common.Address)                            {}
+func (*noopMetrics) RecordChallengeCheckpoint(outputIndex *big.Int)                        {}
+func (*noopMetrics) RecordL1Balance(balance *big.Int)                                      {}
+func (*noopMetrics) RecordBalanceAlert(kind string)                                        {}
+func (*noopMetrics) RecordDefenseTx(result string)                                         {}
+func (*noopMetrics) RecordPenalty(reason string, own bool)                                 {}
+func (*noopMetrics) RecordOutputFinality(status string, count int)                         {}
+func (*noopMetrics) RecordProofVerification(result string)                                 {}
+func (*noopMetrics) RecordCouncilEscalation(kind string, status string)                    {}
+func (*noopMetrics) RecordProfitEstimate(reward *big.Int, gasCost *big.Int)                {}
+func (*noopMetrics) RecordProfitDecision(action string, decision string)                   {}
+func (*noopMetrics) RecordProofDeadlineMissed()                                            {}
+func (*noopMetrics) RecordSubmissionReorged()                                              {}
+func (*noopMetrics) RecordSubmissionLatency(latency time.Duration, interval time.Duration) {}
+func (*noopMetrics) RecordSubmissionGasUsed(gasUsed uint64)                                {}
+func (*noopMetrics) RecordSubmissionReverted(reason string)                                {}
+func (*noopMetrics) RecordRoundOutcome(outcome string)                                     {}
+func (*noopMetrics) RecordBondCoverage(submissions uint64)                                 {}
+func (*noopMetrics) RecordOutputWatched(outputIndex *big.Int, valid bool)                  {}
+func (*noopMetrics) RecordLeader(leader bool)                                              {}
+func (*noopMetrics) RecordRewardWithdrawal(amount *big.Int)                                {}
+func (*noopMetrics) RecordBondTopUp(amount *big.Int)                                       {}
+func (*noopMetrics) RecordActiveChallenges(count int)                                      {}
+func (*noopMetrics) RecordProverHealthy(healthy bool)                                      {}
+
+func (*noopMetrics) CacheAdd(label string, cacheSize int, evicted bool) {}
+func (*noopMetrics) CacheGet(label string, hit bool)                    {}
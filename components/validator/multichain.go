@@ -0,0 +1,125 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// chainNamePattern restricts the chain names to the characters allowed in metrics namespaces.
+var chainNamePattern = regexp.MustCompile("^[a-z][a-z0-9_]*$")
+
+// ChainConfig configures an additional chain validated by the same process. The fields override
+// the chain specific flags, while the others (L1, prover, tx manager settings, ...) are shared.
+type ChainConfig struct {
+	// Name identifies the chain in logs, and is the namespace of its metrics.
+	Name string `json:"name"`
+
+	RollupRpc              string `json:"rollupRpc"`
+	L2OOAddress            string `json:"l2ooAddress"`
+	ColosseumAddress       string `json:"colosseumAddress"`
+	ValPoolAddress         string `json:"valPoolAddress"`
+	SecurityCouncilAddress string `json:"securityCouncilAddress,omitempty"`
+
+	// PrivateKey or Mnemonic and HDPath are the key of the validator on the chain.
+	// If not set, the key of the default chain is used.
+	PrivateKey string `json:"privateKey,omitempty"`
+	Mnemonic   string `json:"mnemonic,omitempty"`
+	HDPath     string `json:"hdPath,omitempty"`
+
+	// RPCPort is the port of the RPC server of the chain.
+	// If 0, it is the port of the default chain plus the index of the chain.
+	RPCPort int `json:"rpcPort,omitempty"`
+
+	// ProofQueueDir is the directory to persist the proof generation jobs of the chain in.
+	// If empty, the chain name under the directory of the default chain is used.
+	ProofQueueDir string `json:"proofQueueDir,omitempty"`
+
+	// LeaderLockPath is the path of the leader lock file of the chain. If empty, the leader lock
+	// path of the default chain suffixed with the chain name is used.
+	LeaderLockPath string `json:"leaderLockPath,omitempty"`
+}
+
+// Check ensures that the [ChainConfig] is valid.
+func (c *ChainConfig) Check() error {
+	if !chainNamePattern.MatchString(c.Name) {
+		return fmt.Errorf("invalid chain name %q, must match %s", c.Name, chainNamePattern)
+	}
+	if c.Name == "default" {
+		return errors.New("chain name default is reserved for the chain configured by flags")
+	}
+	if c.RollupRpc == "" || c.L2OOAddress == "" || c.ColosseumAddress == "" || c.ValPoolAddress == "" {
+		return fmt.Errorf("chain %s: rollup rpc and contract addresses must be set", c.Name)
+	}
+	if c.PrivateKey != "" && c.Mnemonic != "" {
+		return fmt.Errorf("chain %s: private key and mnemonic cannot be set together", c.Name)
+	}
+	return nil
+}
+
+// LoadChainConfigs reads the additional chains from the given JSON file.
+func LoadChainConfigs(path string) ([]ChainConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chains config: %w", err)
+	}
+	var chains []ChainConfig
+	if err := json.Unmarshal(data, &chains); err != nil {
+		return nil, fmt.Errorf("failed to parse chains config: %w", err)
+	}
+
+	names := make(map[string]struct{}, len(chains))
+	for i := range chains {
+		if err := chains[i].Check(); err != nil {
+			return nil, err
+		}
+		if _, ok := names[chains[i].Name]; ok {
+			return nil, fmt.Errorf("duplicate chain name %s", chains[i].Name)
+		}
+		names[chains[i].Name] = struct{}{}
+	}
+	return chains, nil
+}
+
+// apply returns the CLI config of the chain, overriding the chain specific fields of the given
+// config of the default chain. index is the position of the chain, starting from 1.
+func (c *ChainConfig) apply(base CLIConfig, index int) CLIConfig {
+	cfg := base
+	cfg.ChainsConfig = ""
+	cfg.RollupRpc = c.RollupRpc
+	cfg.L2OOAddress = c.L2OOAddress
+	cfg.ColosseumAddress = c.ColosseumAddress
+	cfg.ValPoolAddress = c.ValPoolAddress
+	if c.SecurityCouncilAddress != "" {
+		cfg.SecurityCouncilAddress = c.SecurityCouncilAddress
+	}
+
+	if c.PrivateKey != "" {
+		cfg.TxMgrConfig.PrivateKey = c.PrivateKey
+		cfg.TxMgrConfig.Mnemonic = ""
+		cfg.TxMgrConfig.HDPath = ""
+	} else if c.Mnemonic != "" {
+		cfg.TxMgrConfig.Mnemonic = c.Mnemonic
+		cfg.TxMgrConfig.HDPath = c.HDPath
+		cfg.TxMgrConfig.PrivateKey = ""
+	}
+
+	cfg.RPCConfig.ListenPort = c.RPCPort
+	if cfg.RPCConfig.ListenPort == 0 {
+		cfg.RPCConfig.ListenPort = base.RPCConfig.ListenPort + index
+	}
+
+	cfg.ProofQueueDir = c.ProofQueueDir
+	if cfg.ProofQueueDir == "" && base.ProofQueueDir != "" {
+		cfg.ProofQueueDir = filepath.Join(base.ProofQueueDir, c.Name)
+	}
+	cfg.LeaderLockPath = c.LeaderLockPath
+	if cfg.LeaderLockPath == "" && base.LeaderLockPath != "" {
+		cfg.LeaderLockPath = base.LeaderLockPath + "." + c.Name
+	}
+
+	return cfg
+}
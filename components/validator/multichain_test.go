@@ -0,0 +1,74 @@
+package validator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/validator/rpc"
+	krpc "github.com/kroma-network/kroma/utils/service/rpc"
+)
+
+func TestLoadChainConfigs(t *testing.T) {
+	write := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "chains.json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	chains, err := LoadChainConfigs(write(t, `[
+		{"name": "kroma_a", "rollupRpc": "http://a", "l2ooAddress": "0x01", "colosseumAddress": "0x02", "valPoolAddress": "0x03"},
+		{"name": "kroma_b", "rollupRpc": "http://b", "l2ooAddress": "0x11", "colosseumAddress": "0x12", "valPoolAddress": "0x13", "privateKey": "0xabcd"}
+	]`))
+	require.NoError(t, err)
+	require.Len(t, chains, 2)
+	require.Equal(t, "kroma_b", chains[1].Name)
+	require.Equal(t, "0xabcd", chains[1].PrivateKey)
+
+	_, err = LoadChainConfigs(write(t, `[
+		{"name": "kroma_a", "rollupRpc": "http://a", "l2ooAddress": "0x01", "colosseumAddress": "0x02", "valPoolAddress": "0x03"},
+		{"name": "kroma_a", "rollupRpc": "http://b", "l2ooAddress": "0x11", "colosseumAddress": "0x12", "valPoolAddress": "0x13"}
+	]`))
+	require.ErrorContains(t, err, "duplicate chain name")
+
+	_, err = LoadChainConfigs(write(t, `[{"name": "Kroma-A", "rollupRpc": "http://a", "l2ooAddress": "0x01", "colosseumAddress": "0x02", "valPoolAddress": "0x03"}]`))
+	require.ErrorContains(t, err, "invalid chain name")
+
+	_, err = LoadChainConfigs(write(t, `[{"name": "kroma_a", "rollupRpc": "http://a"}]`))
+	require.ErrorContains(t, err, "contract addresses must be set")
+}
+
+func TestChainConfig_Apply(t *testing.T) {
+	base := CLIConfig{
+		RollupRpc:      "http://default",
+		L2OOAddress:    "0x01",
+		ProofQueueDir:  "/data/proofs",
+		LeaderLockPath: "/data/leader.lock",
+		ChainsConfig:   "/data/chains.json",
+		RPCConfig:      rpc.CLIConfig{CLIConfig: krpc.CLIConfig{ListenPort: 8545}},
+	}
+	base.TxMgrConfig.Mnemonic = "test test test"
+	base.TxMgrConfig.HDPath = "m/44'/60'/0'/0/0"
+
+	chain := ChainConfig{
+		Name:        "kroma_a",
+		RollupRpc:   "http://a",
+		L2OOAddress: "0x11",
+		PrivateKey:  "0xabcd",
+	}
+	cfg := chain.apply(base, 2)
+	require.Equal(t, "http://a", cfg.RollupRpc)
+	require.Equal(t, "0x11", cfg.L2OOAddress)
+	require.Equal(t, "0xabcd", cfg.TxMgrConfig.PrivateKey)
+	require.Empty(t, cfg.TxMgrConfig.Mnemonic)
+	require.Equal(t, 8547, cfg.RPCConfig.ListenPort)
+	require.Equal(t, "/data/proofs/kroma_a", cfg.ProofQueueDir)
+	require.Equal(t, "/data/leader.lock.kroma_a", cfg.LeaderLockPath)
+	require.Empty(t, cfg.ChainsConfig)
+
+	// The default chain is left untouched.
+	require.Equal(t, "http://default", base.RollupRpc)
+	require.Equal(t, 8545, base.RPCConfig.ListenPort)
+}
@@ -0,0 +1,141 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/sources/caching"
+)
+
+const defaultOutputCacheSize = 1024
+
+// OutputSource computes the outputs of L2 blocks, i.e. the rollup node.
+type OutputSource interface {
+	OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error)
+	OutputsAtBlocks(ctx context.Context, blockNums []uint64) ([]*eth.OutputResponse, error)
+}
+
+// OutputService provides the outputs (version, state root, withdrawal storage root and block hash)
+// of L2 blocks. The outputs of finalized blocks are kept in a LRU cache, since the challenger
+// requests the same outputs repeatedly during bisection.
+type OutputService struct {
+	source       OutputSource
+	rollupConfig *rollup.Config
+	timeout      time.Duration
+	cache        *caching.LRUCache
+	log          log.Logger
+}
+
+// NewOutputService creates an OutputService caching up to cacheSize outputs.
+// If cacheSize is 0, the default cache size is used.
+func NewOutputService(source OutputSource, rollupConfig *rollup.Config, cacheSize int, timeout time.Duration, m caching.Metrics, l log.Logger) *OutputService {
+	if cacheSize <= 0 {
+		cacheSize = defaultOutputCacheSize
+	}
+	return &OutputService{
+		source:       source,
+		rollupConfig: rollupConfig,
+		timeout:      timeout,
+		cache:        caching.NewLRUCache(m, "outputs", cacheSize),
+		log:          l,
+	}
+}
+
+// newOutputServiceFromConfig returns the output service of the config, or creates one if not set.
+func newOutputServiceFromConfig(cfg Config, l log.Logger, m caching.Metrics) *OutputService {
+	if cfg.OutputService != nil {
+		return cfg.OutputService
+	}
+	var source OutputSource = cfg.RollupClient
+	if cfg.FaultInjection.Enabled() {
+		source = newFaultInjectingOutputSource(source, cfg.FaultInjection, l)
+	}
+	return NewOutputService(source, cfg.RollupConfig, cfg.OutputCacheSize, cfg.NetworkTimeout, m, l)
+}
+
+// OutputAtBlock returns the output at the given block, from the cache if possible.
+func (s *OutputService) OutputAtBlock(ctx context.Context, blockNumber uint64) (*eth.OutputResponse, error) {
+	if output, ok := s.cache.Get(blockNumber); ok {
+		return output.(*eth.OutputResponse), nil
+	}
+	return s.FreshOutputAtBlock(ctx, blockNumber)
+}
+
+// FreshOutputAtBlock fetches the output at the given block from the source, bypassing the cache.
+// It should be used when the sync status of the output matters, e.g. when submitting it.
+func (s *OutputService) FreshOutputAtBlock(ctx context.Context, blockNumber uint64) (*eth.OutputResponse, error) {
+	cCtx, cCancel := context.WithTimeout(ctx, s.timeout)
+	defer cCancel()
+	output, err := s.source.OutputAtBlock(cCtx, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch output at block %d: %w", blockNumber, err)
+	}
+	if err := s.check(output, blockNumber); err != nil {
+		return nil, err
+	}
+	s.maybeCache(output)
+	return output, nil
+}
+
+// OutputsAtBlocks returns the outputs at the given blocks, fetching the ones not cached in a single batch.
+func (s *OutputService) OutputsAtBlocks(ctx context.Context, blockNumbers []uint64) ([]*eth.OutputResponse, error) {
+	outputs := make([]*eth.OutputResponse, len(blockNumbers))
+	var missing []uint64
+	var missingIdx []int
+	for i, blockNumber := range blockNumbers {
+		if output, ok := s.cache.Get(blockNumber); ok {
+			outputs[i] = output.(*eth.OutputResponse)
+			continue
+		}
+		missing = append(missing, blockNumber)
+		missingIdx = append(missingIdx, i)
+	}
+	if len(missing) == 0 {
+		return outputs, nil
+	}
+
+	cCtx, cCancel := context.WithTimeout(ctx, s.timeout)
+	defer cCancel()
+	fetched, err := s.source.OutputsAtBlocks(cCtx, missing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %d outputs: %w", len(missing), err)
+	}
+	for i, output := range fetched {
+		if err := s.check(output, missing[i]); err != nil {
+			return nil, err
+		}
+		s.maybeCache(output)
+		outputs[missingIdx[i]] = output
+	}
+	return outputs, nil
+}
+
+// check sanity checks the output fetched for the given block, e.g. in case of bad RPC caching.
+func (s *OutputService) check(output *eth.OutputResponse, blockNumber uint64) error {
+	if output == nil {
+		return fmt.Errorf("no output at block %d", blockNumber)
+	}
+	if output.Version != rollup.L2OutputRootVersion(s.rollupConfig, s.rollupConfig.ComputeTimestamp(blockNumber)) {
+		s.log.Error("l2 output version is not matched", "blockNumber", blockNumber, "version", output.Version)
+		return errors.New("mismatched l2 output version")
+	}
+	if output.BlockRef.Number != blockNumber {
+		s.log.Error("invalid block number", "next", blockNumber, "output", output.BlockRef.Number)
+		return errors.New("invalid block number")
+	}
+	return nil
+}
+
+// maybeCache caches the output if its block is finalized, so that it cannot be reorged out.
+func (s *OutputService) maybeCache(output *eth.OutputResponse) {
+	if output.Status == nil || output.BlockRef.Number > output.Status.FinalizedL2.Number {
+		return
+	}
+	s.cache.Add(output.BlockRef.Number, output)
+}
@@ -0,0 +1,98 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+type fakeOutputSource struct {
+	finalized uint64
+	calls     int
+}
+
+func (s *fakeOutputSource) OutputAtBlock(_ context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	s.calls++
+	return s.output(blockNum), nil
+}
+
+func (s *fakeOutputSource) OutputsAtBlocks(_ context.Context, blockNums []uint64) ([]*eth.OutputResponse, error) {
+	s.calls++
+	outputs := make([]*eth.OutputResponse, len(blockNums))
+	for i, blockNum := range blockNums {
+		outputs[i] = s.output(blockNum)
+	}
+	return outputs, nil
+}
+
+func (s *fakeOutputSource) output(blockNum uint64) *eth.OutputResponse {
+	return &eth.OutputResponse{
+		Version:  rollup.V0,
+		BlockRef: eth.L2BlockRef{Number: blockNum},
+		Status: &eth.SyncStatus{
+			SafeL2:      eth.L2BlockRef{Number: s.finalized},
+			FinalizedL2: eth.L2BlockRef{Number: s.finalized},
+		},
+	}
+}
+
+func TestOutputService_CachesFinalizedOutputs(t *testing.T) {
+	source := &fakeOutputSource{finalized: 10}
+	s := NewOutputService(source, &rollup.Config{}, 16, time.Second, nil, testlog.Logger(t, log.LvlCrit))
+
+	_, err := s.OutputAtBlock(context.Background(), 10)
+	require.NoError(t, err)
+	_, err = s.OutputAtBlock(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, source.calls, "finalized output should be cached")
+
+	_, err = s.OutputAtBlock(context.Background(), 11)
+	require.NoError(t, err)
+	_, err = s.OutputAtBlock(context.Background(), 11)
+	require.NoError(t, err)
+	require.Equal(t, 3, source.calls, "unfinalized output should not be cached")
+
+	_, err = s.FreshOutputAtBlock(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, 4, source.calls, "fresh output should bypass the cache")
+}
+
+func TestOutputService_OutputsAtBlocks(t *testing.T) {
+	source := &fakeOutputSource{finalized: 100}
+	s := NewOutputService(source, &rollup.Config{}, 16, time.Second, nil, testlog.Logger(t, log.LvlCrit))
+
+	_, err := s.OutputAtBlock(context.Background(), 2)
+	require.NoError(t, err)
+
+	outputs, err := s.OutputsAtBlocks(context.Background(), []uint64{1, 2, 3})
+	require.NoError(t, err)
+	require.Equal(t, 2, source.calls)
+	for i, output := range outputs {
+		require.Equal(t, uint64(i+1), output.BlockRef.Number)
+	}
+
+	_, err = s.OutputsAtBlocks(context.Background(), []uint64{1, 2, 3})
+	require.NoError(t, err)
+	require.Equal(t, 2, source.calls, "all outputs should be served from the cache")
+}
+
+func TestOutputService_RejectsMismatchedBlock(t *testing.T) {
+	s := NewOutputService(&mismatchedOutputSource{}, &rollup.Config{}, 16, time.Second, nil, testlog.Logger(t, log.LvlCrit))
+	_, err := s.OutputAtBlock(context.Background(), 1)
+	require.Error(t, err)
+}
+
+type mismatchedOutputSource struct {
+	fakeOutputSource
+}
+
+func (s *mismatchedOutputSource) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	return s.fakeOutputSource.OutputAtBlock(ctx, blockNum+1)
+}
@@ -0,0 +1,309 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+)
+
+const (
+	// PenaltyReasonOutputReplaced is the penalty of the asserter whose output was proven faulty and replaced
+	// by the Security Council. Its bond, doubled by the challenger, goes to the challenger.
+	PenaltyReasonOutputReplaced = "output_replaced"
+	// PenaltyReasonChallengerTimeout is the penalty of the challenger who did not progress its challenge
+	// before the timeout. The challenge is deleted and the amount it added to the bond goes to the asserter.
+	PenaltyReasonChallengerTimeout = "challenger_timeout"
+)
+
+// PenaltyEvent is emitted when a watched validator loses a bond on chain.
+type PenaltyEvent struct {
+	Reason string `json:"reason"`
+	// Validator is the penalized validator.
+	Validator common.Address `json:"validator"`
+	// Own is true if the penalized validator is this validator.
+	Own         bool     `json:"own"`
+	OutputIndex *big.Int `json:"outputIndex"`
+	// Counterparty is the other party of the challenge, who wins the amount lost.
+	Counterparty common.Address `json:"counterparty"`
+	// Amount is the amount lost by the validator, nil if it cannot be derived from the logs.
+	Amount *big.Int `json:"amount"`
+	// NewOutputRoot is the output root replacing the faulty one, if the output was replaced.
+	NewOutputRoot *common.Hash `json:"newOutputRoot,omitempty"`
+	L1BlockNumber uint64       `json:"l1BlockNumber"`
+	L1TxHash      string       `json:"l1TxHash"`
+}
+
+// outputReplacedPenalty returns the penalty of the asserter of the challenge approved by the Security Council.
+// bonded is the amount bonded by the asserter, nil if unknown.
+func outputReplacedPenalty(created *bindings.ColosseumChallengeCreated, bonded *big.Int, approved *bindings.ColosseumApproved, newOutputRoot *common.Hash) PenaltyEvent {
+	return PenaltyEvent{
+		Reason:        PenaltyReasonOutputReplaced,
+		Validator:     created.Asserter,
+		OutputIndex:   approved.OutputIndex,
+		Counterparty:  created.Challenger,
+		Amount:        bonded,
+		NewOutputRoot: newOutputRoot,
+		L1BlockNumber: approved.Raw.BlockNumber,
+		L1TxHash:      approved.Raw.TxHash.Hex(),
+	}
+}
+
+// challengerTimeoutPenalty returns the penalty of the challenger of the challenge deleted since it timed out.
+// increased is the amount added to the bond by the challenger, nil if unknown.
+func challengerTimeoutPenalty(created *bindings.ColosseumChallengeCreated, increased *big.Int, deleted *bindings.ColosseumDeleted) PenaltyEvent {
+	return PenaltyEvent{
+		Reason:        PenaltyReasonChallengerTimeout,
+		Validator:     created.Challenger,
+		OutputIndex:   deleted.OutputIndex,
+		Counterparty:  created.Asserter,
+		Amount:        increased,
+		L1BlockNumber: deleted.Raw.BlockNumber,
+		L1TxHash:      deleted.Raw.TxHash.Hex(),
+	}
+}
+
+// PenaltyMonitor detects the penalties of this validator and of the watched validators from the Colosseum
+// and ValidatorPool logs, and reports them in logs, metrics, to the subscribers and optionally to a webhook.
+type PenaltyMonitor struct {
+	log    log.Logger
+	cfg    Config
+	metr   metrics.Metricer
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	colosseumContract *bindings.Colosseum
+	valpoolContract   *bindings.ValidatorPoolFilterer
+	l2ooContract      *bindings.L2OutputOracleFilterer
+
+	approvedSub  ethereum.Subscription
+	approvedChan chan *bindings.ColosseumApproved
+	deletedSub   ethereum.Subscription
+	deletedChan  chan *bindings.ColosseumDeleted
+
+	watched map[common.Address]bool
+	feed    event.Feed
+	client  *http.Client
+}
+
+// NewPenaltyMonitor creates a new PenaltyMonitor.
+func NewPenaltyMonitor(cfg Config, l log.Logger, m metrics.Metricer) (*PenaltyMonitor, error) {
+	colosseumContract, err := bindings.NewColosseum(cfg.ColosseumAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+	valpoolContract, err := bindings.NewValidatorPoolFilterer(cfg.ValidatorPoolAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+	l2ooContract, err := bindings.NewL2OutputOracleFilterer(cfg.L2OutputOracleAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	watched := map[common.Address]bool{cfg.TxManager.From(): true}
+	for _, addr := range cfg.PenaltyMonitorAddresses {
+		watched[addr] = true
+	}
+
+	return &PenaltyMonitor{
+		log:               l,
+		cfg:               cfg,
+		metr:              m,
+		colosseumContract: colosseumContract,
+		valpoolContract:   valpoolContract,
+		l2ooContract:      l2ooContract,
+		approvedChan:      make(chan *bindings.ColosseumApproved),
+		deletedChan:       make(chan *bindings.ColosseumDeleted),
+		watched:           watched,
+		client:            &http.Client{Timeout: webhookTimeout},
+	}, nil
+}
+
+func (p *PenaltyMonitor) Start(ctx context.Context) error {
+	p.ctx, p.cancel = context.WithCancel(ctx)
+	p.log.Info("starting penalty monitor", "watched", len(p.watched))
+
+	watchOpts := &bind.WatchOpts{Context: p.ctx}
+	p.approvedSub = event.ResubscribeErr(time.Second*10, func(ctx context.Context, err error) (event.Subscription, error) {
+		if err != nil {
+			p.log.Warn("resubscribing after failed Approved event", "err", err)
+		}
+		return p.colosseumContract.WatchApproved(watchOpts, p.approvedChan, nil)
+	})
+	p.deletedSub = event.ResubscribeErr(time.Second*10, func(ctx context.Context, err error) (event.Subscription, error) {
+		if err != nil {
+			p.log.Warn("resubscribing after failed Deleted event", "err", err)
+		}
+		return p.colosseumContract.WatchDeleted(watchOpts, p.deletedChan, nil)
+	})
+
+	p.wg.Add(1)
+	go p.loop(p.ctx)
+
+	return nil
+}
+
+func (p *PenaltyMonitor) Stop() error {
+	p.log.Info("stopping penalty monitor")
+
+	if p.approvedSub != nil {
+		p.approvedSub.Unsubscribe()
+	}
+	if p.deletedSub != nil {
+		p.deletedSub.Unsubscribe()
+	}
+
+	p.cancel()
+	p.wg.Wait()
+	close(p.approvedChan)
+	close(p.deletedChan)
+
+	return nil
+}
+
+// SubscribePenalties subscribes to the penalties of the watched validators.
+// The channel must be drained, since the monitor waits for the subscribers to receive each penalty.
+func (p *PenaltyMonitor) SubscribePenalties(ch chan<- PenaltyEvent) event.Subscription {
+	return p.feed.Subscribe(ch)
+}
+
+func (p *PenaltyMonitor) loop(ctx context.Context) {
+	defer p.wg.Done()
+	for {
+		select {
+		case ev := <-p.approvedChan:
+			if err := p.onApproved(ctx, ev); err != nil {
+				p.log.Error("failed to check penalty of approved challenge", "err", err, "outputIndex", ev.OutputIndex)
+			}
+		case ev := <-p.deletedChan:
+			if err := p.onDeleted(ctx, ev); err != nil {
+				p.log.Error("failed to check penalty of deleted challenge", "err", err, "outputIndex", ev.OutputIndex)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// challengeCreated returns the last ChallengeCreated event of the output up to the given L1 block,
+// since the challenge is deleted from the Colosseum storage when it ends.
+func (p *PenaltyMonitor) challengeCreated(ctx context.Context, outputIndex *big.Int, blockNumber uint64) (*bindings.ColosseumChallengeCreated, error) {
+	iter, err := p.colosseumContract.FilterChallengeCreated(&bind.FilterOpts{End: &blockNumber, Context: ctx}, []*big.Int{outputIndex}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	var created *bindings.ColosseumChallengeCreated
+	for iter.Next() {
+		created = iter.Event
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	if created == nil {
+		return nil, fmt.Errorf("no ChallengeCreated event of output %d", outputIndex)
+	}
+	return created, nil
+}
+
+func (p *PenaltyMonitor) onApproved(ctx context.Context, ev *bindings.ColosseumApproved) error {
+	created, err := p.challengeCreated(ctx, ev.OutputIndex, ev.Raw.BlockNumber)
+	if err != nil {
+		return err
+	}
+	if !p.watched[created.Asserter] {
+		return nil
+	}
+
+	var bonded *big.Int
+	blockNumber := ev.Raw.BlockNumber
+	bondIter, err := p.valpoolContract.FilterBonded(&bind.FilterOpts{End: &blockNumber, Context: ctx}, nil, []*big.Int{ev.OutputIndex})
+	if err != nil {
+		p.log.Warn("failed to fetch bond of replaced output", "err", err, "outputIndex", ev.OutputIndex)
+	} else {
+		for bondIter.Next() {
+			bonded = bondIter.Event.Amount
+		}
+		bondIter.Close()
+	}
+
+	var newOutputRoot *common.Hash
+	replacedIter, err := p.l2ooContract.FilterOutputReplaced(&bind.FilterOpts{Start: blockNumber, End: &blockNumber, Context: ctx}, []*big.Int{ev.OutputIndex})
+	if err != nil {
+		p.log.Warn("failed to fetch replaced output root", "err", err, "outputIndex", ev.OutputIndex)
+	} else {
+		for replacedIter.Next() {
+			root := common.Hash(replacedIter.Event.NewOutputRoot)
+			newOutputRoot = &root
+		}
+		replacedIter.Close()
+	}
+
+	p.emit(outputReplacedPenalty(created, bonded, ev, newOutputRoot))
+	return nil
+}
+
+func (p *PenaltyMonitor) onDeleted(ctx context.Context, ev *bindings.ColosseumDeleted) error {
+	created, err := p.challengeCreated(ctx, ev.OutputIndex, ev.Raw.BlockNumber)
+	if err != nil {
+		return err
+	}
+	if !p.watched[created.Challenger] {
+		return nil
+	}
+
+	var increased *big.Int
+	start, end := created.Raw.BlockNumber, ev.Raw.BlockNumber
+	iter, err := p.valpoolContract.FilterBondIncreased(&bind.FilterOpts{Start: start, End: &end, Context: ctx},
+		[]common.Address{created.Challenger}, []*big.Int{ev.OutputIndex})
+	if err != nil {
+		p.log.Warn("failed to fetch bond increase of deleted challenge", "err", err, "outputIndex", ev.OutputIndex)
+	} else {
+		for iter.Next() {
+			increased = iter.Event.Amount
+		}
+		iter.Close()
+	}
+
+	p.emit(challengerTimeoutPenalty(created, increased, ev))
+	return nil
+}
+
+// emit reports the penalty of a watched validator. The webhook is notified in the background.
+func (p *PenaltyMonitor) emit(penalty PenaltyEvent) {
+	penalty.Own = penalty.Validator == p.cfg.TxManager.From()
+	p.metr.RecordPenalty(penalty.Reason, penalty.Own)
+
+	l := p.log.Warn
+	if penalty.Own {
+		l = p.log.Error
+	}
+	l("validator penalized", "reason", penalty.Reason, "validator", penalty.Validator, "own", penalty.Own,
+		"outputIndex", penalty.OutputIndex, "counterparty", penalty.Counterparty, "amount", penalty.Amount,
+		"l1BlockNumber", penalty.L1BlockNumber, "l1TxHash", penalty.L1TxHash)
+
+	p.feed.Send(penalty)
+
+	if p.cfg.PenaltyMonitorWebhookURL != "" {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			if err := postWebhook(p.ctx, p.client, p.cfg.PenaltyMonitorWebhookURL, penalty); err != nil {
+				p.log.Warn("failed to notify penalty monitor webhook", "outputIndex", penalty.OutputIndex, "err", err)
+			}
+		}()
+	}
+}
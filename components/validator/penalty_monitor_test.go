@@ -0,0 +1,59 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+)
+
+func TestPenalties(t *testing.T) {
+	asserter := common.HexToAddress("0xa1")
+	challenger := common.HexToAddress("0xc1")
+	created := &bindings.ColosseumChallengeCreated{
+		OutputIndex: big.NewInt(7),
+		Asserter:    asserter,
+		Challenger:  challenger,
+		Raw:         types.Log{BlockNumber: 100},
+	}
+	txHash := common.HexToHash("0x1234")
+
+	t.Run("output replaced", func(t *testing.T) {
+		newRoot := common.HexToHash("0xbb")
+		approved := &bindings.ColosseumApproved{
+			OutputIndex: big.NewInt(7),
+			Raw:         types.Log{BlockNumber: 200, TxHash: txHash},
+		}
+		penalty := outputReplacedPenalty(created, big.NewInt(10), approved, &newRoot)
+		require.Equal(t, PenaltyEvent{
+			Reason:        PenaltyReasonOutputReplaced,
+			Validator:     asserter,
+			OutputIndex:   big.NewInt(7),
+			Counterparty:  challenger,
+			Amount:        big.NewInt(10),
+			NewOutputRoot: &newRoot,
+			L1BlockNumber: 200,
+			L1TxHash:      txHash.Hex(),
+		}, penalty)
+	})
+
+	t.Run("challenger timeout", func(t *testing.T) {
+		deleted := &bindings.ColosseumDeleted{
+			OutputIndex: big.NewInt(7),
+			Raw:         types.Log{BlockNumber: 300, TxHash: txHash},
+		}
+		penalty := challengerTimeoutPenalty(created, nil, deleted)
+		require.Equal(t, PenaltyEvent{
+			Reason:        PenaltyReasonChallengerTimeout,
+			Validator:     challenger,
+			OutputIndex:   big.NewInt(7),
+			Counterparty:  asserter,
+			L1BlockNumber: 300,
+			L1TxHash:      txHash.Hex(),
+		}, penalty)
+	})
+}
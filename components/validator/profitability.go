@@ -0,0 +1,275 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/bindings/predeploys"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/utils"
+)
+
+const (
+	// ProfitActionPublicRound is the submission of an output in the public round, which the validator
+	// is not obliged to make, unlike in the priority round it is selected for.
+	ProfitActionPublicRound = "public_round_submission"
+	// ProfitActionPriorityRound is the submission of an output in the priority round the validator is
+	// selected for. It is mandatory, so it is only estimated.
+	ProfitActionPriorityRound = "priority_round_submission"
+)
+
+const (
+	ProfitDecisionSubmit = "submit"
+	ProfitDecisionSkip   = "skip"
+)
+
+// ProfitEstimate is the expected reward and gas cost of submitting an output.
+type ProfitEstimate struct {
+	Action      string `json:"action"`
+	BlockNumber uint64 `json:"blockNumber"`
+	// Reward is the reward expected to be paid by the ValidatorRewardVault once the output is finalized,
+	// penalized by the delay of the submission.
+	Reward *hexutil.Big `json:"reward"`
+	// GasCost is the expected gas used by the submission, priced at the average of the recent gas prices.
+	GasCost  *hexutil.Big `json:"gasCost"`
+	GasPrice *hexutil.Big `json:"gasPrice"`
+	// PenaltyNum and PenaltyDenom are the penalty of the reward for the submission delay.
+	PenaltyNum   uint64    `json:"penaltyNum"`
+	PenaltyDenom uint64    `json:"penaltyDenom"`
+	Profitable   bool      `json:"profitable"`
+	Decision     string    `json:"decision"`
+	Time         time.Time `json:"time"`
+}
+
+// Profit returns the expected reward minus the gas cost.
+func (e *ProfitEstimate) Profit() *big.Int {
+	return new(big.Int).Sub(e.Reward.ToInt(), e.GasCost.ToInt())
+}
+
+// rewardParams are the parameters of the ValidatorPool and the ValidatorRewardVault determining the reward.
+type rewardParams struct {
+	roundDuration    uint64
+	nonPenaltyPeriod uint64
+	penaltyPeriod    uint64
+	rewardDivider    *big.Int
+}
+
+// penalty returns the penalty numerator of the reward of an output submitted at the given time, as computed
+// by the ValidatorPool when the output is finalized. The denominator is the penalty period.
+func (p rewardParams) penalty(roundStart uint64, submittedAt uint64) uint64 {
+	if submittedAt <= roundStart {
+		return 0
+	}
+	elapsed := submittedAt - roundStart
+	if elapsed > p.roundDuration {
+		elapsed -= p.roundDuration
+	}
+	if elapsed < p.nonPenaltyPeriod {
+		return 0
+	}
+	if elapsed-p.nonPenaltyPeriod > p.penaltyPeriod {
+		return p.penaltyPeriod
+	}
+	return elapsed - p.nonPenaltyPeriod
+}
+
+// reward returns the reward paid from the vault with the given unreserved balance, with the given penalty,
+// as computed by the ValidatorRewardVault.
+func (p rewardParams) reward(unreserved *big.Int, penaltyNum uint64) *big.Int {
+	if unreserved.Sign() <= 0 || p.rewardDivider.Sign() == 0 || p.penaltyPeriod == 0 {
+		return new(big.Int)
+	}
+	full := new(big.Int).Div(unreserved, p.rewardDivider)
+	full.Mul(full, new(big.Int).SetUint64(p.penaltyPeriod-penaltyNum))
+	return full.Div(full, new(big.Int).SetUint64(p.penaltyPeriod))
+}
+
+// gasPriceWindow keeps the recent gas prices to smooth the estimation of the gas cost.
+type gasPriceWindow struct {
+	size   int
+	prices []*big.Int
+}
+
+func (w *gasPriceWindow) add(price *big.Int) {
+	w.prices = append(w.prices, price)
+	if len(w.prices) > w.size {
+		w.prices = w.prices[len(w.prices)-w.size:]
+	}
+}
+
+// average returns the average of the recent gas prices, or nil if there is none.
+func (w *gasPriceWindow) average() *big.Int {
+	if len(w.prices) == 0 {
+		return nil
+	}
+	sum := new(big.Int)
+	for _, p := range w.prices {
+		sum.Add(sum, p)
+	}
+	return sum.Div(sum, big.NewInt(int64(len(w.prices))))
+}
+
+// ProfitEstimator estimates the expected rewards and gas costs of the upcoming output submissions, from
+// the reward parameters of the ValidatorPool and the ValidatorRewardVault and the recent L1 gas prices.
+// Optionally, the submissions the validator is not obliged to make are skipped if they are unprofitable.
+type ProfitEstimator struct {
+	log  log.Logger
+	metr metrics.Metricer
+	cfg  Config
+
+	l2Client *ethclient.Client
+	vault    *bindings.ValidatorRewardVaultCaller
+	params   rewardParams
+
+	mu       sync.Mutex
+	gasUsed  uint64
+	window   gasPriceWindow
+	estimate *ProfitEstimate
+}
+
+// NewProfitEstimator creates a new ProfitEstimator, fetching the reward parameters.
+func NewProfitEstimator(ctx context.Context, cfg Config, l log.Logger, m metrics.Metricer) (*ProfitEstimator, error) {
+	valpoolContract, err := bindings.NewValidatorPoolCaller(cfg.ValidatorPoolAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+	vault, err := bindings.NewValidatorRewardVaultCaller(predeploys.ValidatorRewardVaultAddr, cfg.ProfitL2Client)
+	if err != nil {
+		return nil, err
+	}
+
+	cCtx, cCancel := context.WithTimeout(ctx, cfg.NetworkTimeout)
+	defer cCancel()
+	callOpts := utils.NewSimpleCallOpts(cCtx)
+	roundDuration, err := valpoolContract.ROUNDDURATION(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get round duration: %w", err)
+	}
+	nonPenaltyPeriod, err := valpoolContract.NONPENALTYPERIOD(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get non-penalty period: %w", err)
+	}
+	penaltyPeriod, err := valpoolContract.PENALTYPERIOD(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get penalty period: %w", err)
+	}
+	rewardDivider, err := vault.REWARDDIVIDER(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reward divider: %w", err)
+	}
+
+	return &ProfitEstimator{
+		log:      l,
+		metr:     m,
+		cfg:      cfg,
+		l2Client: cfg.ProfitL2Client,
+		vault:    vault,
+		params: rewardParams{
+			roundDuration:    roundDuration.Uint64(),
+			nonPenaltyPeriod: nonPenaltyPeriod.Uint64(),
+			penaltyPeriod:    penaltyPeriod.Uint64(),
+			rewardDivider:    rewardDivider,
+		},
+		gasUsed: cfg.ProfitSubmissionGas,
+		window:  gasPriceWindow{size: int(cfg.ProfitGasPriceSamples)},
+	}, nil
+}
+
+// RecordGasUsed updates the gas expected to be used by a submission with the gas used by the last one.
+func (p *ProfitEstimator) RecordGasUsed(gasUsed uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.gasUsed = gasUsed
+}
+
+// LastEstimate returns the last estimate, or nil if no submission has been estimated yet.
+func (p *ProfitEstimator) LastEstimate() *ProfitEstimate {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.estimate == nil {
+		return nil
+	}
+	cpy := *p.estimate
+	return &cpy
+}
+
+// Decide estimates the submission of the output at the given block in a round started at roundStart,
+// if submitted at the given L1 time, and returns whether it should be submitted. The submission is skipped
+// only if it is not mandatory, unprofitable, and skipping is enabled.
+func (p *ProfitEstimator) Decide(ctx context.Context, action string, blockNumber uint64, roundStart uint64, l1Time uint64) (bool, error) {
+	estimate, err := p.estimateSubmission(ctx, action, blockNumber, roundStart, l1Time)
+	if err != nil {
+		return true, err
+	}
+
+	estimate.Decision = ProfitDecisionSubmit
+	if !estimate.Profitable && action != ProfitActionPriorityRound && p.cfg.ProfitSkipUnprofitable {
+		estimate.Decision = ProfitDecisionSkip
+	}
+
+	p.mu.Lock()
+	p.estimate = estimate
+	p.mu.Unlock()
+
+	logFn := p.log.Info
+	if estimate.Decision == ProfitDecisionSkip {
+		logFn = p.log.Warn
+	}
+	logFn("estimated profitability of output submission", "action", action, "blockNumber", blockNumber,
+		"reward", estimate.Reward, "gasCost", estimate.GasCost, "gasPrice", estimate.GasPrice,
+		"penalty", estimate.PenaltyNum, "profitable", estimate.Profitable, "decision", estimate.Decision)
+	p.metr.RecordProfitEstimate(estimate.Reward.ToInt(), estimate.GasCost.ToInt())
+	p.metr.RecordProfitDecision(action, estimate.Decision)
+
+	return estimate.Decision == ProfitDecisionSubmit, nil
+}
+
+func (p *ProfitEstimator) estimateSubmission(ctx context.Context, action string, blockNumber uint64, roundStart uint64, l1Time uint64) (*ProfitEstimate, error) {
+	cCtx, cCancel := context.WithTimeout(ctx, p.cfg.NetworkTimeout)
+	defer cCancel()
+
+	gasPrice, err := p.cfg.L1Client.SuggestGasPrice(cCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch gas price: %w", err)
+	}
+
+	callOpts := utils.NewSimpleCallOpts(cCtx)
+	vaultBalance, err := p.l2Client.BalanceAt(cCtx, predeploys.ValidatorRewardVaultAddr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch reward vault balance: %w", err)
+	}
+	reserved, err := p.vault.TotalReserved(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch reserved rewards: %w", err)
+	}
+
+	p.mu.Lock()
+	p.window.add(gasPrice)
+	avgGasPrice := p.window.average()
+	gasUsed := p.gasUsed
+	p.mu.Unlock()
+
+	penaltyNum := p.params.penalty(roundStart, l1Time)
+	reward := p.params.reward(new(big.Int).Sub(vaultBalance, reserved), penaltyNum)
+	gasCost := new(big.Int).Mul(avgGasPrice, new(big.Int).SetUint64(gasUsed))
+
+	return &ProfitEstimate{
+		Action:       action,
+		BlockNumber:  blockNumber,
+		Reward:       (*hexutil.Big)(reward),
+		GasCost:      (*hexutil.Big)(gasCost),
+		GasPrice:     (*hexutil.Big)(avgGasPrice),
+		PenaltyNum:   penaltyNum,
+		PenaltyDenom: p.params.penaltyPeriod,
+		Profitable:   reward.Cmp(gasCost) >= 0,
+		Time:         time.Now(),
+	}, nil
+}
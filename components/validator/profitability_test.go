@@ -0,0 +1,70 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardParams_Penalty(t *testing.T) {
+	params := rewardParams{
+		roundDuration:    60,
+		nonPenaltyPeriod: 40,
+		penaltyPeriod:    20,
+		rewardDivider:    big.NewInt(10),
+	}
+	const roundStart = 1000
+
+	tests := []struct {
+		name        string
+		submittedAt uint64
+		want        uint64
+	}{
+		{"before round start", 900, 0},
+		{"in non-penalty period", roundStart + 30, 0},
+		{"at penalty start", roundStart + 40, 0},
+		{"in penalty period", roundStart + 50, 10},
+		{"at round end", roundStart + 60, 20},
+		// in the public round, the elapsed time is counted from its start.
+		{"early public round", roundStart + 70, 0},
+		{"late public round", roundStart + 115, 15},
+		{"after public round", roundStart + 200, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, params.penalty(roundStart, tt.submittedAt))
+		})
+	}
+}
+
+func TestRewardParams_Reward(t *testing.T) {
+	params := rewardParams{penaltyPeriod: 20, rewardDivider: big.NewInt(10)}
+
+	require.Equal(t, big.NewInt(100), params.reward(big.NewInt(1000), 0))
+	require.Equal(t, big.NewInt(50), params.reward(big.NewInt(1000), 10))
+	require.Zero(t, params.reward(big.NewInt(1000), 20).Sign())
+	require.Zero(t, params.reward(big.NewInt(-1), 0).Sign(), "reserved rewards may exceed the balance")
+}
+
+func TestGasPriceWindow(t *testing.T) {
+	w := gasPriceWindow{size: 3}
+	require.Nil(t, w.average())
+
+	w.add(big.NewInt(10))
+	require.Equal(t, big.NewInt(10), w.average())
+
+	w.add(big.NewInt(20))
+	w.add(big.NewInt(30))
+	require.Equal(t, big.NewInt(20), w.average())
+
+	// the oldest price is dropped.
+	w.add(big.NewInt(60))
+	require.Equal(t, big.NewInt(36), w.average())
+}
+
+func TestProfitEstimate_Profit(t *testing.T) {
+	estimate := &ProfitEstimate{Reward: (*hexutil.Big)(big.NewInt(100)), GasCost: (*hexutil.Big)(big.NewInt(130))}
+	require.Equal(t, big.NewInt(-30), estimate.Profit())
+}
@@ -0,0 +1,250 @@
+// Package publicinput builds the public inputs of the zkEVM proofs verified by the Colosseum contract:
+// the header fields and transaction hashes of the proven block, and the witness of the state of the
+// L2ToL1MessagePasser after it. Prover implementations can use it to construct the exact inputs the
+// challenger submits with a proof, without depending on the challenger.
+package publicinput
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+var (
+	// ErrBlockIsEmpty is returned when the output does not include the block following it.
+	ErrBlockIsEmpty = errors.New("block is empty")
+	// ErrStateRootMismatch is returned when the state root of the public input is not the one of the dst output.
+	ErrStateRootMismatch = errors.New("state root of the public input does not match the dst output")
+	// ErrBlockHashMismatch is returned when the public input does not hash to the next block hash of the src output.
+	ErrBlockHashMismatch = errors.New("block hash of the public input does not match the src output")
+)
+
+// OutputSource returns the outputs with the proofs of the blocks following them,
+// e.g. the rollup client of a kroma-node.
+type OutputSource interface {
+	OutputWithProofAtBlock(ctx context.Context, blockNumber uint64) (*eth.OutputResponse, error)
+}
+
+// OutputSourceFunc is a function used as an OutputSource.
+type OutputSourceFunc func(ctx context.Context, blockNumber uint64) (*eth.OutputResponse, error)
+
+func (f OutputSourceFunc) OutputWithProofAtBlock(ctx context.Context, blockNumber uint64) (*eth.OutputResponse, error) {
+	return f(ctx, blockNumber)
+}
+
+// Fetch fetches the outputs at the given block and at the next block, and builds the public input proof
+// of the transition between them.
+func Fetch(ctx context.Context, source OutputSource, blockNumber uint64) (bindings.TypesPublicInputProof, error) {
+	srcOutput, err := source.OutputWithProofAtBlock(ctx, blockNumber)
+	if err != nil {
+		return bindings.TypesPublicInputProof{}, fmt.Errorf("failed to fetch output at block %d: %w", blockNumber, err)
+	}
+
+	dstOutput, err := source.OutputWithProofAtBlock(ctx, blockNumber+1)
+	if err != nil {
+		return bindings.TypesPublicInputProof{}, fmt.Errorf("failed to fetch output at block %d: %w", blockNumber+1, err)
+	}
+
+	return Build(srcOutput, dstOutput)
+}
+
+// Build builds the public input proof of the transition from the src output to the dst output,
+// i.e. of the block following the src output.
+func Build(srcOutput, dstOutput *eth.OutputResponse) (bindings.TypesPublicInputProof, error) {
+	if dstOutput.PublicInputProof == nil {
+		return bindings.TypesPublicInputProof{}, ErrBlockIsEmpty
+	}
+
+	publicInput, err := PublicInput(srcOutput)
+	if err != nil {
+		return bindings.TypesPublicInputProof{}, err
+	}
+
+	rlps, err := BlockHeaderRLP(srcOutput)
+	if err != nil {
+		return bindings.TypesPublicInputProof{}, err
+	}
+
+	p := dstOutput.PublicInputProof
+
+	var balance [32]byte
+	copy(balance[:], common.BigToHash(p.L2ToL1MessagePasserBalance).Bytes())
+
+	merkleProof := make([][]byte, len(p.MerkleProof))
+	for i, b := range p.MerkleProof {
+		merkleProof[i] = common.CopyBytes(b)
+	}
+
+	return bindings.TypesPublicInputProof{
+		SrcOutputRootProof:          srcOutput.ToOutputRootProof(),
+		DstOutputRootProof:          dstOutput.ToOutputRootProof(),
+		PublicInput:                 publicInput,
+		Rlps:                        rlps,
+		L2ToL1MessagePasserBalance:  balance,
+		L2ToL1MessagePasserCodeHash: p.L2ToL1MessagePasserCodeHash,
+		MerkleProof:                 merkleProof,
+	}, nil
+}
+
+// PublicInput returns the public input of the block following the given output.
+func PublicInput(output *eth.OutputResponse) (bindings.TypesPublicInput, error) {
+	p := output.PublicInputProof
+	if p == nil || p.NextBlock == nil {
+		return bindings.TypesPublicInput{}, ErrBlockIsEmpty
+	}
+	var withdrawalsRoot common.Hash
+	if p.NextBlock.WithdrawalsHash != nil {
+		withdrawalsRoot = *p.NextBlock.WithdrawalsHash
+	}
+	txHashes := make([][32]byte, len(p.NextTransactions))
+	for i, tx := range p.NextTransactions {
+		txHashes[i] = tx.Hash()
+	}
+	return bindings.TypesPublicInput{
+		BlockHash:        output.NextBlockRef.Hash,
+		ParentHash:       output.BlockRef.Hash,
+		Timestamp:        p.NextBlock.Time,
+		Number:           p.NextBlock.Number.Uint64(),
+		GasLimit:         p.NextBlock.GasLimit,
+		BaseFee:          p.NextBlock.BaseFee,
+		TransactionsRoot: p.NextBlock.TxHash,
+		StateRoot:        p.NextBlock.Root,
+		WithdrawalsRoot:  withdrawalsRoot,
+		TxHashes:         txHashes,
+	}, nil
+}
+
+// BlockHeaderRLP returns the RLP encoded header fields of the block following the given output,
+// that are not part of its public input but needed to recompute its hash.
+func BlockHeaderRLP(output *eth.OutputResponse) (bindings.TypesBlockHeaderRLP, error) {
+	p := output.PublicInputProof
+	if p == nil || p.NextBlock == nil {
+		return bindings.TypesBlockHeaderRLP{}, ErrBlockIsEmpty
+	}
+	uncleHash, err := rlp.EncodeToBytes(types.EmptyUncleHash)
+	if err != nil {
+		return bindings.TypesBlockHeaderRLP{}, err
+	}
+	coinbase, err := rlp.EncodeToBytes(p.NextBlock.Coinbase)
+	if err != nil {
+		return bindings.TypesBlockHeaderRLP{}, err
+	}
+	receiptsRoot, err := rlp.EncodeToBytes(p.NextBlock.ReceiptHash)
+	if err != nil {
+		return bindings.TypesBlockHeaderRLP{}, err
+	}
+	logsBloom, err := rlp.EncodeToBytes(p.NextBlock.Bloom)
+	if err != nil {
+		return bindings.TypesBlockHeaderRLP{}, err
+	}
+	difficulty, err := rlp.EncodeToBytes(p.NextBlock.Difficulty)
+	if err != nil {
+		return bindings.TypesBlockHeaderRLP{}, err
+	}
+	gasUsed, err := rlp.EncodeToBytes(p.NextBlock.GasUsed)
+	if err != nil {
+		return bindings.TypesBlockHeaderRLP{}, err
+	}
+	extraData, err := rlp.EncodeToBytes(p.NextBlock.Extra)
+	if err != nil {
+		return bindings.TypesBlockHeaderRLP{}, err
+	}
+	mixHash, err := rlp.EncodeToBytes(p.NextBlock.MixDigest)
+	if err != nil {
+		return bindings.TypesBlockHeaderRLP{}, err
+	}
+	nonce, err := rlp.EncodeToBytes(types.BlockNonce{})
+	if err != nil {
+		return bindings.TypesBlockHeaderRLP{}, err
+	}
+
+	return bindings.TypesBlockHeaderRLP{
+		UncleHash:    uncleHash,
+		Coinbase:     coinbase,
+		ReceiptsRoot: receiptsRoot,
+		LogsBloom:    logsBloom,
+		Difficulty:   difficulty,
+		GasUsed:      gasUsed,
+		ExtraData:    extraData,
+		MixHash:      mixHash,
+		Nonce:        nonce,
+	}, nil
+}
+
+// HashBlockHeader returns the hash of the block header composed of the public input and the RLP encoded
+// header fields, as computed by the Colosseum contract (before shanghai).
+func HashBlockHeader(pi bindings.TypesPublicInput, rlps bindings.TypesBlockHeaderRLP) (common.Hash, error) {
+	var raw []rlp.RawValue
+	for _, v := range []any{pi.ParentHash, rlps.UncleHash, rlps.Coinbase, pi.StateRoot, pi.TransactionsRoot,
+		rlps.ReceiptsRoot, rlps.LogsBloom, rlps.Difficulty, pi.Number, pi.GasLimit, rlps.GasUsed, pi.Timestamp,
+		rlps.ExtraData, rlps.MixHash, rlps.Nonce, pi.BaseFee} {
+		if encoded, ok := v.([]byte); ok {
+			raw = append(raw, encoded)
+			continue
+		}
+		encoded, err := rlp.EncodeToBytes(v)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		raw = append(raw, encoded)
+	}
+	encoded, err := rlp.EncodeToBytes(raw)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// Validate checks the public input of the proof against its output root proofs the same way
+// the Colosseum contract does, so that an invalid proof is caught before it is submitted.
+func Validate(proof bindings.TypesPublicInputProof) error {
+	if proof.PublicInput.StateRoot != proof.DstOutputRootProof.StateRoot {
+		return ErrStateRootMismatch
+	}
+	blockHash, err := HashBlockHeader(proof.PublicInput, proof.Rlps)
+	if err != nil {
+		return err
+	}
+	if blockHash != proof.SrcOutputRootProof.NextBlockHash {
+		return fmt.Errorf("%w: %s, expected %s", ErrBlockHashMismatch, blockHash,
+			common.Hash(proof.SrcOutputRootProof.NextBlockHash))
+	}
+	return nil
+}
+
+// Hash returns the hash of the public input verified by the zkEVM proof, padded with the dummy hash up to
+// maxTxs transactions, as computed by the Colosseum contract.
+func Hash(prevStateRoot common.Hash, pi bindings.TypesPublicInput, dummyHash common.Hash, maxTxs uint64) common.Hash {
+	var buf []byte
+	buf = append(buf, prevStateRoot[:]...)
+	buf = append(buf, pi.StateRoot[:]...)
+	buf = append(buf, pi.WithdrawalsRoot[:]...)
+	buf = append(buf, pi.BlockHash[:]...)
+	buf = append(buf, pi.ParentHash[:]...)
+	buf = binary.BigEndian.AppendUint64(buf, pi.Number)
+	buf = binary.BigEndian.AppendUint64(buf, pi.Timestamp)
+	baseFee := pi.BaseFee
+	if baseFee == nil {
+		baseFee = new(big.Int)
+	}
+	buf = append(buf, common.BigToHash(baseFee).Bytes()...)
+	buf = binary.BigEndian.AppendUint64(buf, pi.GasLimit)
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(pi.TxHashes)))
+	for _, h := range pi.TxHashes {
+		buf = append(buf, h[:]...)
+	}
+	for i := uint64(len(pi.TxHashes)); i < maxTxs; i++ {
+		buf = append(buf, dummyHash[:]...)
+	}
+	return crypto.Keccak256Hash(buf)
+}
@@ -0,0 +1,296 @@
+package publicinput
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/node/eth"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// goldenProof is the human-readable form of the public input proof stored in the golden files.
+type goldenProof struct {
+	SrcOutputRootProof          goldenOutputRootProof `json:"srcOutputRootProof"`
+	DstOutputRootProof          goldenOutputRootProof `json:"dstOutputRootProof"`
+	PublicInput                 goldenPublicInput     `json:"publicInput"`
+	Rlps                        goldenBlockHeaderRLP  `json:"rlps"`
+	L2ToL1MessagePasserBalance  common.Hash           `json:"l2ToL1MessagePasserBalance"`
+	L2ToL1MessagePasserCodeHash common.Hash           `json:"l2ToL1MessagePasserCodeHash"`
+	MerkleProof                 []hexutil.Bytes       `json:"merkleProof"`
+}
+
+type goldenOutputRootProof struct {
+	Version                  common.Hash `json:"version"`
+	StateRoot                common.Hash `json:"stateRoot"`
+	MessagePasserStorageRoot common.Hash `json:"messagePasserStorageRoot"`
+	BlockHash                common.Hash `json:"blockHash"`
+	NextBlockHash            common.Hash `json:"nextBlockHash"`
+}
+
+type goldenPublicInput struct {
+	BlockHash        common.Hash   `json:"blockHash"`
+	ParentHash       common.Hash   `json:"parentHash"`
+	Timestamp        uint64        `json:"timestamp"`
+	Number           uint64        `json:"number"`
+	GasLimit         uint64        `json:"gasLimit"`
+	BaseFee          *big.Int      `json:"baseFee"`
+	TransactionsRoot common.Hash   `json:"transactionsRoot"`
+	StateRoot        common.Hash   `json:"stateRoot"`
+	WithdrawalsRoot  common.Hash   `json:"withdrawalsRoot"`
+	TxHashes         []common.Hash `json:"txHashes"`
+}
+
+type goldenBlockHeaderRLP struct {
+	UncleHash    hexutil.Bytes `json:"uncleHash"`
+	Coinbase     hexutil.Bytes `json:"coinbase"`
+	ReceiptsRoot hexutil.Bytes `json:"receiptsRoot"`
+	LogsBloom    hexutil.Bytes `json:"logsBloom"`
+	Difficulty   hexutil.Bytes `json:"difficulty"`
+	GasUsed      hexutil.Bytes `json:"gasUsed"`
+	ExtraData    hexutil.Bytes `json:"extraData"`
+	MixHash      hexutil.Bytes `json:"mixHash"`
+	Nonce        hexutil.Bytes `json:"nonce"`
+}
+
+func toGoldenOutputRootProof(p bindings.TypesOutputRootProof) goldenOutputRootProof {
+	return goldenOutputRootProof{
+		Version:                  p.Version,
+		StateRoot:                p.StateRoot,
+		MessagePasserStorageRoot: p.MessagePasserStorageRoot,
+		BlockHash:                p.BlockHash,
+		NextBlockHash:            p.NextBlockHash,
+	}
+}
+
+func toGolden(p bindings.TypesPublicInputProof) goldenProof {
+	txHashes := make([]common.Hash, len(p.PublicInput.TxHashes))
+	for i, h := range p.PublicInput.TxHashes {
+		txHashes[i] = h
+	}
+	merkleProof := make([]hexutil.Bytes, len(p.MerkleProof))
+	for i, b := range p.MerkleProof {
+		merkleProof[i] = b
+	}
+	return goldenProof{
+		SrcOutputRootProof: toGoldenOutputRootProof(p.SrcOutputRootProof),
+		DstOutputRootProof: toGoldenOutputRootProof(p.DstOutputRootProof),
+		PublicInput: goldenPublicInput{
+			BlockHash:        p.PublicInput.BlockHash,
+			ParentHash:       p.PublicInput.ParentHash,
+			Timestamp:        p.PublicInput.Timestamp,
+			Number:           p.PublicInput.Number,
+			GasLimit:         p.PublicInput.GasLimit,
+			BaseFee:          p.PublicInput.BaseFee,
+			TransactionsRoot: p.PublicInput.TransactionsRoot,
+			StateRoot:        p.PublicInput.StateRoot,
+			WithdrawalsRoot:  p.PublicInput.WithdrawalsRoot,
+			TxHashes:         txHashes,
+		},
+		Rlps: goldenBlockHeaderRLP{
+			UncleHash:    p.Rlps.UncleHash,
+			Coinbase:     p.Rlps.Coinbase,
+			ReceiptsRoot: p.Rlps.ReceiptsRoot,
+			LogsBloom:    p.Rlps.LogsBloom,
+			Difficulty:   p.Rlps.Difficulty,
+			GasUsed:      p.Rlps.GasUsed,
+			ExtraData:    p.Rlps.ExtraData,
+			MixHash:      p.Rlps.MixHash,
+			Nonce:        p.Rlps.Nonce,
+		},
+		L2ToL1MessagePasserBalance:  p.L2ToL1MessagePasserBalance,
+		L2ToL1MessagePasserCodeHash: p.L2ToL1MessagePasserCodeHash,
+		MerkleProof:                 merkleProof,
+	}
+}
+
+type transition struct {
+	Src *eth.OutputResponse `json:"src"`
+	Dst *eth.OutputResponse `json:"dst"`
+}
+
+func readTransition(t *testing.T, path string) transition {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var tr transition
+	require.NoError(t, json.Unmarshal(data, &tr))
+	return tr
+}
+
+func TestBuild_Golden(t *testing.T) {
+	tr := readTransition(t, "testdata/transition.json")
+	proof, err := Build(tr.Src, tr.Dst)
+	require.NoError(t, err)
+	got := toGolden(proof)
+
+	goldenPath := "testdata/transition.golden.json"
+	if *update {
+		data, err := json.MarshalIndent(got, "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(goldenPath, append(data, '\n'), 0o644))
+	}
+
+	data, err := os.ReadFile(goldenPath)
+	require.NoError(t, err)
+	var want goldenProof
+	require.NoError(t, json.Unmarshal(data, &want))
+	require.Equal(t, want, got)
+}
+
+func TestFetch(t *testing.T) {
+	tr := readTransition(t, "testdata/transition.json")
+	source := OutputSourceFunc(func(_ context.Context, blockNumber uint64) (*eth.OutputResponse, error) {
+		switch blockNumber {
+		case tr.Src.BlockRef.Number:
+			return tr.Src, nil
+		case tr.Dst.BlockRef.Number:
+			return tr.Dst, nil
+		default:
+			return nil, errors.New("not found")
+		}
+	})
+
+	proof, err := Fetch(context.Background(), source, tr.Src.BlockRef.Number)
+	require.NoError(t, err)
+	want, err := Build(tr.Src, tr.Dst)
+	require.NoError(t, err)
+	require.Equal(t, want, proof)
+
+	_, err = Fetch(context.Background(), source, tr.Dst.BlockRef.Number)
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestBuild_EmptyBlock(t *testing.T) {
+	tr := readTransition(t, "testdata/transition.json")
+	src := *tr.Src
+	src.PublicInputProof = nil
+	_, err := Build(&src, tr.Dst)
+	require.ErrorIs(t, err, ErrBlockIsEmpty)
+
+	dst := *tr.Dst
+	dst.PublicInputProof = nil
+	_, err = Build(tr.Src, &dst)
+	require.ErrorIs(t, err, ErrBlockIsEmpty)
+}
+
+// validTransition returns the golden transition adjusted to be consistent,
+// i.e. the src output commits to the hash of the proven block.
+func validTransition(t *testing.T) (*eth.OutputResponse, *eth.OutputResponse, *types.Header) {
+	tr := readTransition(t, "testdata/transition.json")
+	src, dst := *tr.Src, *tr.Dst
+	p := *src.PublicInputProof
+	header := types.CopyHeader(p.NextBlock)
+	header.ParentHash = src.BlockRef.Hash
+	header.UncleHash = types.EmptyUncleHash
+	header.Nonce = types.BlockNonce{}
+	header.WithdrawalsHash = nil
+	p.NextBlock = header
+	src.PublicInputProof = &p
+	src.NextBlockRef.Hash = header.Hash()
+	dst.StateRoot = header.Root
+	return &src, &dst, header
+}
+
+func TestHashBlockHeader(t *testing.T) {
+	src, _, header := validTransition(t)
+	publicInput, err := PublicInput(src)
+	require.NoError(t, err)
+	rlps, err := BlockHeaderRLP(src)
+	require.NoError(t, err)
+
+	hash, err := HashBlockHeader(publicInput, rlps)
+	require.NoError(t, err)
+	require.Equal(t, header.Hash(), hash)
+}
+
+func TestValidate(t *testing.T) {
+	src, dst, _ := validTransition(t)
+	proof, err := Build(src, dst)
+	require.NoError(t, err)
+	require.NoError(t, Validate(proof))
+
+	invalid := proof
+	invalid.PublicInput.StateRoot[0] ^= 0xff
+	require.ErrorIs(t, Validate(invalid), ErrStateRootMismatch)
+
+	invalid = proof
+	invalid.PublicInput.Timestamp++
+	require.ErrorIs(t, Validate(invalid), ErrBlockHashMismatch)
+
+	invalid = proof
+	invalid.SrcOutputRootProof.NextBlockHash[0] ^= 0xff
+	require.ErrorIs(t, Validate(invalid), ErrBlockHashMismatch)
+}
+
+func TestHash(t *testing.T) {
+	tr := readTransition(t, "testdata/transition.json")
+	publicInput, err := PublicInput(tr.Src)
+	require.NoError(t, err)
+	require.NotEmpty(t, publicInput.TxHashes)
+
+	prevStateRoot := tr.Src.StateRoot
+	dummyHash := common.HexToHash("0x01")
+	maxTxs := uint64(len(publicInput.TxHashes)) + 2
+	hash := Hash(prevStateRoot, publicInput, dummyHash, maxTxs)
+	require.Equal(t, hash, Hash(prevStateRoot, publicInput, dummyHash, maxTxs))
+
+	// the hash commits to the padding of the transactions.
+	require.NotEqual(t, hash, Hash(prevStateRoot, publicInput, dummyHash, maxTxs+1))
+	require.NotEqual(t, hash, Hash(prevStateRoot, publicInput, common.HexToHash("0x02"), maxTxs))
+
+	// a transaction with the dummy hash is distinguished from the padding by the number of transactions.
+	padded := publicInput
+	padded.TxHashes = append(append([][32]byte(nil), publicInput.TxHashes...), dummyHash)
+	require.NotEqual(t, hash, Hash(prevStateRoot, padded, dummyHash, maxTxs))
+
+	require.NotEqual(t, hash, Hash(common.Hash{}, publicInput, dummyHash, maxTxs))
+}
+
+// TestHash_Golden checks the hash against vectors of Hashing.hashPublicInput, i.e. the keccak256 of
+// abi.encodePacked(prevStateRoot, stateRoot, withdrawalsRoot, blockHash, parentHash, uint64 number,
+// uint64 timestamp, uint256 baseFee, uint64 gasLimit, uint16 len(txHashes), txHashes, dummyHashes).
+func TestHash_Golden(t *testing.T) {
+	repeat := func(b byte) [32]byte {
+		var h [32]byte
+		for i := range h {
+			h[i] = b
+		}
+		return h
+	}
+	publicInput := bindings.TypesPublicInput{
+		BlockHash:       repeat(0x44),
+		ParentHash:      repeat(0x55),
+		Timestamp:       1700000000,
+		Number:          100,
+		GasLimit:        30000000,
+		BaseFee:         big.NewInt(1000000000),
+		StateRoot:       repeat(0x22),
+		WithdrawalsRoot: repeat(0x33),
+		TxHashes:        [][32]byte{repeat(0x66), repeat(0x77)},
+	}
+	prevStateRoot := common.Hash(repeat(0x11))
+	dummyHash := common.Hash(repeat(0x88))
+
+	require.Equal(t, common.HexToHash("0x5ee54f513e199ae6cbc9db88f213cc0c718674e0ce730e5a32f9c650b5d5c5d4"),
+		Hash(prevStateRoot, publicInput, dummyHash, 4))
+
+	// the transactions root is not part of the hash.
+	publicInput.TransactionsRoot = repeat(0x99)
+	require.Equal(t, common.HexToHash("0x5ee54f513e199ae6cbc9db88f213cc0c718674e0ce730e5a32f9c650b5d5c5d4"),
+		Hash(prevStateRoot, publicInput, dummyHash, 4))
+
+	publicInput.TxHashes = nil
+	require.Equal(t, common.HexToHash("0x41b4a7b2d072c0ac66eea34535d607a270d0e36332de513d446540c60b4c962d"),
+		Hash(prevStateRoot, publicInput, dummyHash, 4))
+}
@@ -0,0 +1,177 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/utils"
+	"github.com/kroma-network/kroma/utils/service/txmgr"
+)
+
+// RewardWithdrawer is responsible for periodically withdrawing the validator's balance in the
+// ValidatorPool that exceeds the amount retained for bonding, i.e. the returned bonds and the bonds
+// won through challenges, and for sending it to the configured recipient.
+type RewardWithdrawer struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	cfg  Config
+	log  log.Logger
+	metr metrics.Metricer
+
+	valpoolContract *bindings.ValidatorPoolCaller
+	valpoolABI      *abi.ABI
+
+	wg sync.WaitGroup
+}
+
+// NewRewardWithdrawer creates a new RewardWithdrawer.
+func NewRewardWithdrawer(cfg Config, l log.Logger, m metrics.Metricer) (*RewardWithdrawer, error) {
+	valpoolContract, err := bindings.NewValidatorPoolCaller(cfg.ValidatorPoolAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := bindings.ValidatorPoolMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return &RewardWithdrawer{
+		cfg:             cfg,
+		log:             l,
+		metr:            m,
+		valpoolContract: valpoolContract,
+		valpoolABI:      parsed,
+	}, nil
+}
+
+func (r *RewardWithdrawer) Start(ctx context.Context) error {
+	r.ctx, r.cancel = context.WithCancel(ctx)
+	r.log.Info("starting reward withdrawer", "recipient", r.recipient())
+
+	r.wg.Add(1)
+	go r.loop()
+
+	return nil
+}
+
+func (r *RewardWithdrawer) Stop() error {
+	r.log.Info("stopping reward withdrawer")
+	r.cancel()
+	r.wg.Wait()
+
+	return nil
+}
+
+func (r *RewardWithdrawer) loop() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.cfg.RewardWithdrawalInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.tryWithdraw(r.ctx); err != nil {
+				r.log.Error("failed to withdraw rewards", "err", err)
+			}
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
+
+// recipient returns the address to send the withdrawn rewards to.
+func (r *RewardWithdrawer) recipient() common.Address {
+	if r.cfg.RewardRecipient == (common.Address{}) {
+		return r.cfg.TxManager.From()
+	}
+	return r.cfg.RewardRecipient
+}
+
+// tryWithdraw withdraws the rewards from the ValidatorPool if they reach the threshold
+// and the gas price is below the limit, and sends them to the recipient.
+func (r *RewardWithdrawer) tryWithdraw(ctx context.Context) error {
+	cCtx, cCancel := context.WithTimeout(ctx, r.cfg.NetworkTimeout)
+	defer cCancel()
+	from := r.cfg.TxManager.From()
+	balance, err := r.valpoolContract.BalanceOf(utils.NewSimpleCallOpts(cCtx), from)
+	if err != nil {
+		return fmt.Errorf("failed to fetch validator deposit amount: %w", err)
+	}
+
+	amount := withdrawalAmount(balance, r.cfg.RewardRetainAmount, r.cfg.RewardWithdrawalThreshold)
+	if amount == nil {
+		return nil
+	}
+
+	if r.cfg.RewardMaxGasPrice != nil && r.cfg.RewardMaxGasPrice.Sign() > 0 {
+		gasPrice, err := r.cfg.L1Client.SuggestGasPrice(cCtx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch gas price: %w", err)
+		}
+		if gasPrice.Cmp(r.cfg.RewardMaxGasPrice) > 0 {
+			r.log.Info("gas price is above the limit, postponing reward withdrawal",
+				"gasPrice", gasPrice, "max", r.cfg.RewardMaxGasPrice, "amount", amount)
+			return nil
+		}
+	}
+
+	data, err := r.valpoolABI.Pack("withdraw", amount)
+	if err != nil {
+		return fmt.Errorf("failed to create withdraw transaction data: %w", err)
+	}
+
+	txResponse := r.cfg.TxManager.SendTxCandidate(ctx, &txmgr.TxCandidate{
+		TxData:   data,
+		To:       &r.cfg.ValidatorPoolAddr,
+		GasLimit: 0,
+	})
+	if txResponse.Err != nil {
+		return fmt.Errorf("failed to withdraw from ValidatorPool: %w", txResponse.Err)
+	}
+	r.log.Info("rewards successfully withdrawn", "amount", amount)
+	r.metr.RecordDepositAmount(new(big.Int).Sub(balance, amount))
+
+	if recipient := r.recipient(); recipient != from {
+		txResponse = r.cfg.TxManager.SendTxCandidate(ctx, &txmgr.TxCandidate{
+			To:       &recipient,
+			GasLimit: 0,
+			Value:    amount,
+		})
+		if txResponse.Err != nil {
+			return fmt.Errorf("failed to send rewards to recipient: %w", txResponse.Err)
+		}
+		r.log.Info("rewards sent to recipient", "recipient", recipient, "amount", amount)
+	}
+
+	r.metr.RecordRewardWithdrawal(amount)
+
+	return nil
+}
+
+// withdrawalAmount returns the balance exceeding the retained amount,
+// or nil if it does not reach the threshold.
+func withdrawalAmount(balance, retain, threshold *big.Int) *big.Int {
+	if retain == nil {
+		retain = common.Big0
+	}
+	amount := new(big.Int).Sub(balance, retain)
+	if amount.Sign() <= 0 {
+		return nil
+	}
+	if threshold != nil && amount.Cmp(threshold) < 0 {
+		return nil
+	}
+	return amount
+}
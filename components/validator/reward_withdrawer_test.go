@@ -0,0 +1,30 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawalAmount(t *testing.T) {
+	tests := []struct {
+		name      string
+		balance   int64
+		retain    int64
+		threshold int64
+		want      *big.Int
+	}{
+		{"below retained amount", 90, 100, 0, nil},
+		{"equal to retained amount", 100, 100, 0, nil},
+		{"below threshold", 105, 100, 10, nil},
+		{"at threshold", 110, 100, 10, big.NewInt(10)},
+		{"above threshold", 150, 100, 10, big.NewInt(50)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := withdrawalAmount(big.NewInt(tt.balance), big.NewInt(tt.retain), big.NewInt(tt.threshold))
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ValidatorStatus is the detailed status of the validator.
+type ValidatorStatus struct {
+	SubmitterEnabled  bool `json:"submitterEnabled"`
+	SubmitterPaused   bool `json:"submitterPaused"`
+	ChallengerEnabled bool `json:"challengerEnabled"`
+	ChallengerPaused  bool `json:"challengerPaused"`
+	// Round is the round of the next output to submit, nil if the output submitter is disabled.
+	Round *RoundStatus `json:"round"`
+	// ActiveChallenges are the output indexes of the challenges being handled.
+	ActiveChallenges []uint64 `json:"activeChallenges"`
+	// PendingProofs is the number of proof generation jobs that are not done or failed yet.
+	PendingProofs int `json:"pendingProofs"`
+	// Deposit is the balance of the validator in the ValidatorPool, in wei.
+	Deposit *hexutil.Big `json:"deposit"`
+	// ExitPhase is the phase of the exit of the validator, empty if the exit is not started.
+	ExitPhase string `json:"exitPhase,omitempty"`
+}
+
+// RoundStatus is the submission round of the next output.
+type RoundStatus struct {
+	NextBlockNumber uint64         `json:"nextBlockNumber"`
+	NextValidator   common.Address `json:"nextValidator"`
+	// Selected is true if the validator is selected for the priority round.
+	Selected    bool `json:"selected"`
+	PublicRound bool `json:"publicRound"`
+	// PublicRoundStart is the first L1 timestamp at which the public round is opened.
+	PublicRoundStart uint64 `json:"publicRoundStart"`
+	// L1Time is the timestamp of the L1 block the round was evaluated at.
+	L1Time uint64 `json:"l1Time"`
+}
+
+type validatorClient interface {
+	PauseSubmitter()
+	ResumeSubmitter()
+	PauseChallenger()
+	ResumeChallenger()
+	StartExit() error
+	ConfirmEscalation(ctx context.Context, outputIndex uint64) error
+	RejectEscalation(outputIndex uint64) error
+	Status(ctx context.Context) (*ValidatorStatus, error)
+}
+
+type adminAPI struct {
+	v validatorClient
+}
+
+func NewAdminAPI(v validatorClient) *adminAPI {
+	return &adminAPI{
+		v: v,
+	}
+}
+
+// GetAdminAPI returns the admin API of the validator to register at the RPC server.
+func GetAdminAPI(api *adminAPI) rpc.API {
+	return rpc.API{
+		Namespace:     "admin",
+		Service:       api,
+		Authenticated: true,
+	}
+}
+
+// PauseSubmitter stops submitting outputs until ResumeSubmitter is called.
+func (a *adminAPI) PauseSubmitter(_ context.Context) error {
+	a.v.PauseSubmitter()
+	return nil
+}
+
+// ResumeSubmitter resumes submitting outputs after PauseSubmitter.
+func (a *adminAPI) ResumeSubmitter(_ context.Context) error {
+	a.v.ResumeSubmitter()
+	return nil
+}
+
+// PauseChallenger stops sending challenge txs, i.e. creating challenges, bisecting and proving faults,
+// until ResumeChallenger is called. Outputs are still validated and proofs are still generated.
+func (a *adminAPI) PauseChallenger(_ context.Context) error {
+	a.v.PauseChallenger()
+	return nil
+}
+
+// ResumeChallenger resumes sending challenge txs after PauseChallenger.
+func (a *adminAPI) ResumeChallenger(_ context.Context) error {
+	a.v.ResumeChallenger()
+	return nil
+}
+
+// StartExit starts a clean exit of the validator: it stops submitting outputs, waits out the pending
+// outputs and challenges, and unbonds and withdraws its whole balance. Its progress is reported by
+// ValidatorStatus.
+func (a *adminAPI) StartExit(_ context.Context) error {
+	return a.v.StartExit()
+}
+
+// ConfirmEscalation submits the escalation of the given output waiting for the confirmation of the operator
+// to the Security Council. The escalations are reported by validator_councilEscalations.
+func (a *adminAPI) ConfirmEscalation(ctx context.Context, outputIndex hexutil.Uint64) error {
+	return a.v.ConfirmEscalation(ctx, uint64(outputIndex))
+}
+
+// RejectEscalation drops the escalation of the given output waiting for the confirmation of the operator.
+func (a *adminAPI) RejectEscalation(_ context.Context, outputIndex hexutil.Uint64) error {
+	return a.v.RejectEscalation(uint64(outputIndex))
+}
+
+func (a *adminAPI) ValidatorStatus(ctx context.Context) (*ValidatorStatus, error) {
+	return a.v.Status(ctx)
+}
@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	kservice "github.com/kroma-network/kroma/utils/service"
+	krpc "github.com/kroma-network/kroma/utils/service/rpc"
+)
+
+const (
+	EnableAdminFlagName    = "rpc.enable-admin"
+	AdminJWTSecretFlagName = "rpc.admin-jwt-secret"
+)
+
+func CLIFlags(envPrefix string) []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:   EnableAdminFlagName,
+			Usage:  "Enable the admin API (experimental)",
+			EnvVar: kservice.PrefixEnvVar(envPrefix, "RPC_ENABLE_ADMIN"),
+		},
+		cli.StringFlag{
+			Name: AdminJWTSecretFlagName,
+			Usage: "Path to JWT secret key to authenticate the admin API requests with, required by the admin API. " +
+				"Keys are 32 bytes, hex encoded in a file.",
+			EnvVar:    kservice.PrefixEnvVar(envPrefix, "RPC_ADMIN_JWT_SECRET"),
+			TakesFile: true,
+		},
+	}
+}
+
+type CLIConfig struct {
+	krpc.CLIConfig
+	EnableAdmin    bool
+	AdminJWTSecret string
+}
+
+func ReadCLIConfig(ctx *cli.Context) CLIConfig {
+	return CLIConfig{
+		CLIConfig:      krpc.ReadCLIConfig(ctx),
+		EnableAdmin:    ctx.GlobalBool(EnableAdminFlagName),
+		AdminJWTSecret: ctx.GlobalString(AdminJWTSecretFlagName),
+	}
+}
+
+func (c CLIConfig) Check() error {
+	if err := c.CLIConfig.Check(); err != nil {
+		return err
+	}
+	if c.EnableAdmin && strings.TrimSpace(c.AdminJWTSecret) == "" {
+		return errors.New("the admin API requires a JWT secret")
+	}
+	return nil
+}
+
+func (c *CLIConfig) ToServiceCLIConfig() krpc.CLIConfig {
+	return krpc.CLIConfig{
+		ListenAddr: c.ListenAddr,
+		ListenPort: c.ListenPort,
+	}
+}
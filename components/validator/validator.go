@@ -2,15 +2,22 @@ package validator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/urfave/cli"
 
+	chal "github.com/kroma-network/kroma/components/validator/challenge"
+	"github.com/kroma-network/kroma/components/validator/indexer"
 	"github.com/kroma-network/kroma/components/validator/metrics"
+	"github.com/kroma-network/kroma/components/validator/rpc"
 	"github.com/kroma-network/kroma/utils"
 	"github.com/kroma-network/kroma/utils/monitoring"
 	klog "github.com/kroma-network/kroma/utils/service/log"
+	kmetrics "github.com/kroma-network/kroma/utils/service/metrics"
 	krpc "github.com/kroma-network/kroma/utils/service/rpc"
 )
 
@@ -23,49 +30,124 @@ func Main(version string, cliCtx *cli.Context) error {
 	}
 
 	l := klog.NewLogger(cliCfg.LogConfig)
-	m := metrics.NewMetrics("default")
-	l.Info("initializing Validator")
 
-	validatorCfg, err := NewValidatorConfig(cliCfg, l, m)
-	if err != nil {
-		l.Error("Unable to create validator config", "err", err)
-		return err
+	var chains []ChainConfig
+	if cliCfg.ChainsConfig != "" {
+		var err error
+		if chains, err = LoadChainConfigs(cliCfg.ChainsConfig); err != nil {
+			return err
+		}
 	}
+	l.Info("initializing Validator", "additionalChains", len(chains))
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	monitoring.MaybeStartPprof(ctx, cliCfg.PprofConfig, l)
-	monitoring.MaybeStartMetrics(ctx, cliCfg.MetricsConfig, l, m, validatorCfg.L1Client, validatorCfg.TxManager.From())
-	server, err := monitoring.StartRPC(cliCfg.RPCConfig, version, krpc.WithLogger(l))
+
+	// The metrics of all the chains are served together, in their own namespaces.
+	registry := kmetrics.NewRegistry()
+	services := make([]*chainService, 0, len(chains)+1)
+	defer func() {
+		for _, s := range services {
+			if err := s.server.Stop(); err != nil {
+				s.log.Error("Error shutting down http server: %w", err)
+			}
+		}
+	}()
+
+	s, err := newChainService(ctx, version, cliCfg, l, metrics.NewMetricsWithRegistry("default", registry), true)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		if err = server.Stop(); err != nil {
-			l.Error("Error shutting down http server: %w", err)
+	services = append(services, s)
+	for i, chain := range chains {
+		chainLog := l.New("chain", chain.Name)
+		m := metrics.NewMetricsWithRegistry(chain.Name, registry)
+		s, err := newChainService(ctx, version, chain.apply(cliCfg, i+1), chainLog, m, false)
+		if err != nil {
+			return fmt.Errorf("failed to initialize validator of chain %s: %w", chain.Name, err)
 		}
-	}()
+		services = append(services, s)
+	}
 
-	m.RecordInfo(version)
-	m.RecordUp()
+	for i, s := range services {
+		if err := s.validator.Start(); err != nil {
+			s.log.Error("failed to start validator", "err", err)
+			stopValidators(services[:i])
+			return err
+		}
+	}
+	<-utils.WaitInterrupt()
+	return stopValidators(services)
+}
+
+// chainService is the validator of a chain, together with its RPC server.
+type chainService struct {
+	validator *Validator
+	server    *krpc.Server
+	log       log.Logger
+}
+
+// newChainService creates the validator of the chain configured by the given CLI config, and starts its
+// RPC server. If serveMetrics is true, the metrics server serving the registry of m is started too.
+func newChainService(ctx context.Context, version string, cliCfg CLIConfig, l log.Logger, m *metrics.Metrics, serveMetrics bool) (*chainService, error) {
+	validatorCfg, err := NewValidatorConfig(cliCfg, l, m)
+	if err != nil {
+		l.Error("Unable to create validator config", "err", err)
+		return nil, err
+	}
+
+	if serveMetrics {
+		monitoring.MaybeStartMetrics(ctx, cliCfg.MetricsConfig, l, m, validatorCfg.L1Client, validatorCfg.TxManager.From())
+	} else if cliCfg.MetricsConfig.Enabled {
+		m.StartBalanceMetrics(ctx, l, validatorCfg.L1Client, validatorCfg.TxManager.From())
+	}
 
 	validator, err := NewValidator(ctx, *validatorCfg, l, m)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := validator.Start(); err != nil {
-		l.Error("failed to start validator", "err", err)
-		return err
+	apis := []gethrpc.API{GetValidatorAPI(NewValidatorAPI(validator))}
+	if validator.indexer != nil {
+		apis = append(apis, indexer.GetIndexerAPI(indexer.NewIndexerAPI(validator.indexer)))
 	}
-	<-utils.WaitInterrupt()
-	if err := validator.Stop(); err != nil {
-		l.Error("failed to stop validator", "err", err)
-		return err
+	rpcOpts := []krpc.ServerOption{krpc.WithLogger(l)}
+	if cliCfg.RPCConfig.EnableAdmin {
+		secret, err := krpc.ReadJWTSecret(cliCfg.RPCConfig.AdminJWTSecret)
+		if err != nil {
+			return nil, err
+		}
+		apis = append(apis, rpc.GetAdminAPI(rpc.NewAdminAPI(validator)))
+		rpcOpts = append(rpcOpts, krpc.WithAdminJWTSecret(secret))
+	}
+	rpcOpts = append(rpcOpts, krpc.WithAPIs(apis))
+	server, err := monitoring.StartRPC(cliCfg.RPCConfig.ToServiceCLIConfig(), version, rpcOpts...)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	m.RecordInfo(version)
+	m.RecordUp()
+
+	return &chainService{
+		validator: validator,
+		server:    server,
+		log:       l,
+	}, nil
+}
+
+// stopValidators stops the validators of the given chains, returning the last error if any.
+func stopValidators(services []*chainService) error {
+	var stopErr error
+	for _, s := range services {
+		if err := s.validator.Stop(); err != nil {
+			s.log.Error("failed to stop validator", "err", err)
+			stopErr = err
+		}
+	}
+	return stopErr
 }
 
 type Validator struct {
@@ -78,6 +160,14 @@ type Validator struct {
 	l2os       *L2OutputSubmitter
 	challenger *Challenger
 	guardian   *Guardian
+	bondMgr    *BondManager
+	rewardWd   *RewardWithdrawer
+	watcher    *Watcher
+	balanceMon *BalanceMonitor
+	exiter     *Exiter
+	indexer    *indexer.Indexer
+	penaltyMon *PenaltyMonitor
+	finality   *FinalityTracker
 }
 
 func NewValidator(ctx context.Context, cfg Config, l log.Logger, m metrics.Metricer) (*Validator, error) {
@@ -86,6 +176,22 @@ func NewValidator(ctx context.Context, cfg Config, l log.Logger, m metrics.Metri
 		return nil, err
 	}
 
+	if cfg.FaultInjection.Enabled() {
+		l.Warn("fault injection is enabled, the validator will use corrupted outputs. Never enable it on production networks",
+			"outputBlock", cfg.FaultInjection.OutputBlock, "segmentBlock", cfg.FaultInjection.SegmentBlock)
+	}
+
+	// Share the output cache between the output submitter and the challenger.
+	cfg.OutputService = newOutputServiceFromConfig(cfg, l, m)
+
+	if cfg.IndexerEnabled {
+		idx, err := newIndexerFromConfig(cfg, l)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Indexer = idx
+	}
+
 	l2OutputSubmitter, err := NewL2OutputSubmitter(ctx, cfg, l, m)
 	if err != nil {
 		return nil, err
@@ -101,6 +207,41 @@ func NewValidator(ctx context.Context, cfg Config, l log.Logger, m metrics.Metri
 		return nil, err
 	}
 
+	bondManager, err := NewBondManager(cfg, l, m)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardWithdrawer, err := NewRewardWithdrawer(cfg, l, m)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher, err := NewWatcher(cfg, l, m)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceMonitor, err := NewBalanceMonitor(cfg, l, m)
+	if err != nil {
+		return nil, err
+	}
+
+	exiter, err := NewExiter(cfg, l, m, l2OutputSubmitter, challenger)
+	if err != nil {
+		return nil, err
+	}
+
+	penaltyMonitor, err := NewPenaltyMonitor(cfg, l, m)
+	if err != nil {
+		return nil, err
+	}
+
+	finalityTracker, err := NewFinalityTracker(cfg, l, m)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Validator{
 		cfg:        cfg,
 		l:          l,
@@ -108,17 +249,62 @@ func NewValidator(ctx context.Context, cfg Config, l log.Logger, m metrics.Metri
 		l2os:       l2OutputSubmitter,
 		challenger: challenger,
 		guardian:   guardian,
+		bondMgr:    bondManager,
+		rewardWd:   rewardWithdrawer,
+		watcher:    watcher,
+		balanceMon: balanceMonitor,
+		exiter:     exiter,
+		indexer:    cfg.Indexer,
+		penaltyMon: penaltyMonitor,
+		finality:   finalityTracker,
 	}, nil
 }
 
+func newIndexerFromConfig(cfg Config, l log.Logger) (*indexer.Indexer, error) {
+	db, err := indexer.OpenDB(cfg.IndexerDBPath)
+	if err != nil {
+		return nil, err
+	}
+	// the contracts are deployed before the L2 genesis, so no event is emitted before its L1 origin.
+	startBlock := cfg.IndexerStartBlock
+	if startBlock == 0 {
+		startBlock = cfg.RollupConfig.Genesis.L1.Number
+	}
+	idx, err := indexer.NewIndexer(indexer.Config{
+		L2OutputOracleAddr: cfg.L2OutputOracleAddr,
+		ColosseumAddr:      cfg.ColosseumAddr,
+		ValidatorPoolAddr:  cfg.ValidatorPoolAddr,
+		StartBlock:         startBlock,
+		Confirmations:      cfg.IndexerConfirmations,
+		PollInterval:       cfg.IndexerPollInterval,
+		BatchSize:          cfg.IndexerBatchSize,
+	}, l.New("service", "indexer"), cfg.L1Client, db)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return idx, nil
+}
+
 func (v *Validator) Start() error {
 	v.ctx, v.cancel = context.WithCancel(context.Background())
 	v.l.Info("starting Validator")
 
+	if _, err := bootstrap(v.ctx, v.cfg, v.l); err != nil {
+		return fmt.Errorf("cannot bootstrap: %w", err)
+	}
+
 	if err := v.cfg.TxManager.Start(v.ctx); err != nil {
 		return fmt.Errorf("cannot start TxManager: %w", err)
 	}
 
+	// The indexer is started first, since the challenger recovers the challenges from it.
+	if v.indexer != nil {
+		if err := v.indexer.Start(v.ctx); err != nil {
+			return fmt.Errorf("cannot start indexer: %w", err)
+		}
+	}
+
 	if v.cfg.OutputSubmitterEnabled {
 		if err := v.l2os.Start(v.ctx); err != nil {
 			return fmt.Errorf("cannot start l2 output submitter: %w", err)
@@ -135,6 +321,48 @@ func (v *Validator) Start() error {
 		}
 	}
 
+	if v.cfg.BondTopUpEnabled {
+		if err := v.bondMgr.Start(v.ctx); err != nil {
+			return fmt.Errorf("cannot start bond manager: %w", err)
+		}
+	}
+
+	if v.cfg.RewardWithdrawalEnabled {
+		if err := v.rewardWd.Start(v.ctx); err != nil {
+			return fmt.Errorf("cannot start reward withdrawer: %w", err)
+		}
+	}
+
+	if v.cfg.WatcherEnabled {
+		if err := v.watcher.Start(v.ctx); err != nil {
+			return fmt.Errorf("cannot start watcher: %w", err)
+		}
+	}
+
+	if v.cfg.BalanceMonitorEnabled {
+		if err := v.balanceMon.Start(v.ctx); err != nil {
+			return fmt.Errorf("cannot start balance monitor: %w", err)
+		}
+	}
+
+	if v.cfg.PenaltyMonitorEnabled {
+		if err := v.penaltyMon.Start(v.ctx); err != nil {
+			return fmt.Errorf("cannot start penalty monitor: %w", err)
+		}
+	}
+
+	if v.cfg.FinalityTrackerEnabled {
+		if err := v.finality.Start(v.ctx); err != nil {
+			return fmt.Errorf("cannot start finality tracker: %w", err)
+		}
+	}
+
+	if v.cfg.ExitEnabled {
+		if err := v.exiter.Start(v.ctx); err != nil {
+			return fmt.Errorf("cannot start exiter: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -144,8 +372,8 @@ func (v *Validator) Stop() error {
 		return fmt.Errorf("failed to stop TxManager: %w", err)
 	}
 
-	if v.cfg.ProofFetcher != nil {
-		if err := v.cfg.ProofFetcher.Close(); err != nil {
+	if v.cfg.ProverBackend != nil {
+		if err := v.cfg.ProverBackend.Close(); err != nil {
 			return fmt.Errorf("cannot close gRPC connection: %w", err)
 		}
 	}
@@ -166,7 +394,130 @@ func (v *Validator) Stop() error {
 		}
 	}
 
+	if v.cfg.BondTopUpEnabled {
+		if err := v.bondMgr.Stop(); err != nil {
+			return fmt.Errorf("failed to stop bond manager: %w", err)
+		}
+	}
+
+	if v.cfg.RewardWithdrawalEnabled {
+		if err := v.rewardWd.Stop(); err != nil {
+			return fmt.Errorf("failed to stop reward withdrawer: %w", err)
+		}
+	}
+
+	if v.cfg.WatcherEnabled {
+		if err := v.watcher.Stop(); err != nil {
+			return fmt.Errorf("failed to stop watcher: %w", err)
+		}
+	}
+
+	if v.cfg.BalanceMonitorEnabled {
+		if err := v.balanceMon.Stop(); err != nil {
+			return fmt.Errorf("failed to stop balance monitor: %w", err)
+		}
+	}
+
+	if v.cfg.PenaltyMonitorEnabled {
+		if err := v.penaltyMon.Stop(); err != nil {
+			return fmt.Errorf("failed to stop penalty monitor: %w", err)
+		}
+	}
+
+	if v.cfg.FinalityTrackerEnabled {
+		if err := v.finality.Stop(); err != nil {
+			return fmt.Errorf("failed to stop finality tracker: %w", err)
+		}
+	}
+
+	// The exit may have been started through the admin API, so it is stopped regardless of the config.
+	if err := v.exiter.Stop(); err != nil {
+		return fmt.Errorf("failed to stop exiter: %w", err)
+	}
+
+	if v.indexer != nil {
+		if err := v.indexer.Stop(); err != nil {
+			return fmt.Errorf("failed to stop indexer: %w", err)
+		}
+	}
+
 	v.cancel()
 
 	return nil
 }
+
+// PauseSubmitter stops submitting outputs until ResumeSubmitter is called.
+func (v *Validator) PauseSubmitter() {
+	v.l2os.Pause()
+}
+
+// ResumeSubmitter resumes submitting outputs after PauseSubmitter.
+func (v *Validator) ResumeSubmitter() {
+	v.l2os.Resume()
+}
+
+// PauseChallenger stops sending challenge txs until ResumeChallenger is called.
+func (v *Validator) PauseChallenger() {
+	v.challenger.Pause()
+}
+
+// ResumeChallenger resumes sending challenge txs after PauseChallenger.
+func (v *Validator) ResumeChallenger() {
+	v.challenger.Resume()
+}
+
+// StartExit starts a clean exit of the validator: it stops submitting outputs, waits out the pending
+// outputs and challenges, and unbonds and withdraws its whole balance from the ValidatorPool.
+func (v *Validator) StartExit() error {
+	if v.cfg.BondTopUpEnabled {
+		return errExitWithBondTopUp
+	}
+	if v.cfg.ExitPollInterval == 0 {
+		return errors.New("exit poll interval must not be 0")
+	}
+	return v.exiter.Start(v.ctx)
+}
+
+// ConfirmEscalation submits the pending escalation of the given output to the Security Council.
+func (v *Validator) ConfirmEscalation(ctx context.Context, outputIndex uint64) error {
+	return v.challenger.ConfirmEscalation(ctx, outputIndex)
+}
+
+// RejectEscalation drops the pending escalation of the given output.
+func (v *Validator) RejectEscalation(outputIndex uint64) error {
+	return v.challenger.RejectEscalation(outputIndex)
+}
+
+// Status returns the detailed status of the validator.
+func (v *Validator) Status(ctx context.Context) (*rpc.ValidatorStatus, error) {
+	status := &rpc.ValidatorStatus{
+		SubmitterEnabled:  v.cfg.OutputSubmitterEnabled,
+		SubmitterPaused:   v.l2os.Paused(),
+		ChallengerEnabled: v.cfg.ChallengerEnabled,
+		ChallengerPaused:  v.challenger.Paused(),
+		ActiveChallenges:  v.challenger.ActiveChallenges(),
+		ExitPhase:         v.exiter.Phase(),
+	}
+
+	if v.cfg.OutputSubmitterEnabled {
+		round, err := v.l2os.RoundStatus(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch round status: %w", err)
+		}
+		status.Round = round
+	}
+
+	for _, job := range v.challenger.ProofJobs() {
+		if job.Status == chal.ProofJobPending || job.Status == chal.ProofJobRunning {
+			status.PendingProofs++
+		}
+	}
+
+	deposit, err := v.l2os.DepositAmount(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch validator deposit amount: %w", err)
+	}
+	status.Deposit = (*hexutil.Big)(deposit)
+
+	return status, nil
+}
@@ -0,0 +1,189 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/node/eth"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+)
+
+// InvalidOutputAlert is posted to the webhook when a submitted output does not match the local one.
+type InvalidOutputAlert struct {
+	OutputIndex       *big.Int    `json:"outputIndex"`
+	L2BlockNumber     *big.Int    `json:"l2BlockNumber"`
+	OutputRoot        eth.Bytes32 `json:"outputRoot"`
+	ExpectedRoot      eth.Bytes32 `json:"expectedOutputRoot"`
+	L1BlockNumber     uint64      `json:"l1BlockNumber"`
+	L1TxHash          string      `json:"l1TxHash"`
+	ChallengerEnabled bool        `json:"challengerEnabled"`
+}
+
+// Watcher recomputes the output root of every output submitted to the L2OutputOracle without
+// submitting outputs, and alerts on mismatch in logs, metrics and optionally to a webhook.
+// If the challenger is enabled as well, it challenges the invalid outputs.
+type Watcher struct {
+	log    log.Logger
+	cfg    Config
+	metr   metrics.Metricer
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	l2ooContract *bindings.L2OutputOracle
+	outputs      *OutputService
+	outputSub    ethereum.Subscription
+	outputChan   chan *bindings.L2OutputOracleOutputSubmitted
+
+	client *http.Client
+}
+
+// NewWatcher creates a new Watcher.
+func NewWatcher(cfg Config, l log.Logger, m metrics.Metricer) (*Watcher, error) {
+	l2ooContract, err := bindings.NewL2OutputOracle(cfg.L2OutputOracleAddr, cfg.L1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Watcher{
+		log:          l,
+		cfg:          cfg,
+		metr:         m,
+		l2ooContract: l2ooContract,
+		outputs:      newOutputServiceFromConfig(cfg, l, m),
+		outputChan:   make(chan *bindings.L2OutputOracleOutputSubmitted),
+		client:       &http.Client{Timeout: webhookTimeout},
+	}, nil
+}
+
+func (w *Watcher) Start(ctx context.Context) error {
+	w.ctx, w.cancel = context.WithCancel(ctx)
+	w.log.Info("starting watcher")
+
+	watchOpts := &bind.WatchOpts{Context: w.ctx}
+	w.outputSub = event.ResubscribeErr(time.Second*10, func(ctx context.Context, err error) (event.Subscription, error) {
+		if err != nil {
+			w.log.Warn("resubscribing after failed OutputSubmitted event", "err", err)
+		}
+		return w.l2ooContract.WatchOutputSubmitted(watchOpts, w.outputChan, nil, nil, nil)
+	})
+
+	w.wg.Add(1)
+	go w.handleOutputSubmitted(w.ctx)
+
+	return nil
+}
+
+func (w *Watcher) Stop() error {
+	w.log.Info("stopping watcher")
+
+	if w.outputSub != nil {
+		w.outputSub.Unsubscribe()
+	}
+
+	w.cancel()
+	w.wg.Wait()
+	close(w.outputChan)
+
+	return nil
+}
+
+func (w *Watcher) handleOutputSubmitted(ctx context.Context) {
+	defer w.wg.Done()
+	for {
+		select {
+		case ev := <-w.outputChan:
+			w.wg.Add(1)
+			go w.watchOutput(ctx, ev)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// watchOutput validates the submitted output, retrying until the local node has derived its block.
+func (w *Watcher) watchOutput(ctx context.Context, ev *bindings.L2OutputOracleOutputSubmitted) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.cfg.WatcherPollInterval)
+	defer ticker.Stop()
+
+	for {
+		done, err := w.checkOutput(ctx, ev)
+		if err != nil {
+			w.log.Error("failed to check output", "err", err, "outputIndex", ev.L2OutputIndex)
+		}
+		if done {
+			return
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkOutput compares the submitted output with the local one, and returns whether the output is checked.
+func (w *Watcher) checkOutput(ctx context.Context, ev *bindings.L2OutputOracleOutputSubmitted) (bool, error) {
+	blockNumber := ev.L2BlockNumber.Uint64()
+	output, err := w.outputs.OutputAtBlock(ctx, blockNumber)
+	if err != nil {
+		return false, err
+	}
+	if output.Status != nil && output.Status.SafeL2.Number < blockNumber {
+		w.log.Info("waiting for the local node to derive output block", "outputIndex", ev.L2OutputIndex,
+			"l2BlockNumber", blockNumber, "safeL2", output.Status.SafeL2.Number)
+		return false, nil
+	}
+
+	valid := bytes.Equal(output.OutputRoot[:], ev.OutputRoot[:])
+	w.metr.RecordOutputWatched(ev.L2OutputIndex, valid)
+	if valid {
+		w.log.Info("submitted output is valid", "outputIndex", ev.L2OutputIndex, "l2BlockNumber", blockNumber)
+		return true, nil
+	}
+
+	w.onInvalidOutput(InvalidOutputAlert{
+		OutputIndex:       ev.L2OutputIndex,
+		L2BlockNumber:     ev.L2BlockNumber,
+		OutputRoot:        ev.OutputRoot,
+		ExpectedRoot:      output.OutputRoot,
+		L1BlockNumber:     ev.Raw.BlockNumber,
+		L1TxHash:          ev.Raw.TxHash.Hex(),
+		ChallengerEnabled: w.cfg.ChallengerEnabled,
+	})
+	return true, nil
+}
+
+// onInvalidOutput alerts about the given invalid output. The webhook is notified in the background.
+func (w *Watcher) onInvalidOutput(alert InvalidOutputAlert) {
+	w.log.Error("submitted output does not match the local output", "outputIndex", alert.OutputIndex,
+		"l2BlockNumber", alert.L2BlockNumber, "outputRoot", alert.OutputRoot, "expected", alert.ExpectedRoot,
+		"challengerEnabled", alert.ChallengerEnabled)
+	if w.cfg.WatcherWebhookURL != "" {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			if err := w.notify(w.ctx, alert); err != nil {
+				w.log.Warn("failed to notify watcher webhook", "outputIndex", alert.OutputIndex, "err", err)
+			}
+		}()
+	}
+}
+
+// notify posts the given alert to the webhook.
+func (w *Watcher) notify(ctx context.Context, alert InvalidOutputAlert) error {
+	return postWebhook(ctx, w.client, w.cfg.WatcherWebhookURL, alert)
+}
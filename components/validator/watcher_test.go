@@ -0,0 +1,79 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/bindings/bindings"
+	"github.com/kroma-network/kroma/components/node/rollup"
+	"github.com/kroma-network/kroma/components/node/testlog"
+	"github.com/kroma-network/kroma/components/validator/metrics"
+)
+
+func newTestWatcher(t *testing.T, source OutputSource, webhook string) *Watcher {
+	l := testlog.Logger(t, log.LvlCrit)
+	w := &Watcher{
+		log:     l,
+		cfg:     Config{WatcherWebhookURL: webhook},
+		metr:    metrics.NoopMetrics,
+		outputs: NewOutputService(source, &rollup.Config{}, 16, time.Second, nil, l),
+		client:  &http.Client{Timeout: time.Second},
+	}
+	w.ctx, w.cancel = context.WithCancel(context.Background())
+	t.Cleanup(w.cancel)
+	return w
+}
+
+func TestWatcher_CheckOutput(t *testing.T) {
+	alerts := make(chan InvalidOutputAlert, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		var alert InvalidOutputAlert
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		alerts <- alert
+	}))
+	defer server.Close()
+
+	w := newTestWatcher(t, &fakeOutputSource{finalized: 10}, server.URL)
+
+	// the local node has not derived the block yet.
+	done, err := w.checkOutput(context.Background(), &bindings.L2OutputOracleOutputSubmitted{
+		L2OutputIndex: big.NewInt(2),
+		L2BlockNumber: big.NewInt(20),
+	})
+	require.NoError(t, err)
+	require.False(t, done)
+
+	// the fake source returns empty output roots.
+	done, err = w.checkOutput(context.Background(), &bindings.L2OutputOracleOutputSubmitted{
+		L2OutputIndex: big.NewInt(1),
+		L2BlockNumber: big.NewInt(10),
+	})
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Empty(t, alerts)
+
+	done, err = w.checkOutput(context.Background(), &bindings.L2OutputOracleOutputSubmitted{
+		OutputRoot:    [32]byte{1},
+		L2OutputIndex: big.NewInt(1),
+		L2BlockNumber: big.NewInt(10),
+	})
+	require.NoError(t, err)
+	require.True(t, done)
+
+	select {
+	case alert := <-alerts:
+		require.Equal(t, big.NewInt(1), alert.OutputIndex)
+		require.Equal(t, [32]byte{1}, [32]byte(alert.OutputRoot))
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected invalid output alert")
+	}
+	w.wg.Wait()
+}
@@ -50,7 +50,7 @@ func NewL2Proposer(t Testing, log log.Logger, l1 derive.L1Fetcher, eng L2API, cf
 	}
 	return &L2Proposer{
 		L2Syncer:                *syncer,
-		proposer:                driver.NewProposer(log, cfg, syncer.derivation, attrBuilder, l1OriginSelector, metrics.NoopMetrics),
+		proposer:                driver.NewProposer(log, cfg, syncer.derivation, attrBuilder, l1OriginSelector, driver.ProposerConfig{}, metrics.NoopMetrics),
 		mockL1OriginSelector:    l1OriginSelector,
 		failL2GossipUnsafeBlock: nil,
 	}
@@ -94,7 +94,7 @@ func (p *L2Proposer) ActL2StartBlockCheckErr(t Testing, checkErr error) {
 	}
 
 	if errors.Is(err, derive.ErrReset) {
-		p.derivation.Reset()
+		p.derivation.Reset(derive.ResetReasonOf(err))
 	}
 
 	if err == nil {
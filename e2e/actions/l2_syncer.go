@@ -60,7 +60,7 @@ type L2API interface {
 func NewL2Syncer(t Testing, log log.Logger, l1 derive.L1Fetcher, eng L2API, cfg *rollup.Config) *L2Syncer {
 	metrics := &testutils.TestDerivationMetrics{}
 	pipeline := derive.NewDerivationPipeline(log, cfg, l1, eng, metrics)
-	pipeline.Reset()
+	pipeline.Reset(derive.ResetReasonStartup)
 
 	rollupNode := &L2Syncer{
 		log:            log,
@@ -88,7 +88,7 @@ func NewL2Syncer(t Testing, log log.Logger, l1 derive.L1Fetcher, eng L2API, cfg
 		{
 			Namespace:     "admin",
 			Version:       "",
-			Service:       node.NewAdminAPI(backend, m),
+			Service:       node.NewAdminAPI(backend, m, log),
 			Public:        true, // TODO: this field is deprecated. Do we even need this anymore?
 			Authenticated: false,
 		},
@@ -118,7 +118,7 @@ func (s *l2SyncerBackend) SyncStatus(ctx context.Context) (*eth.SyncStatus, erro
 }
 
 func (s *l2SyncerBackend) ResetDerivationPipeline(ctx context.Context) error {
-	s.syncer.derivation.Reset()
+	s.syncer.derivation.Reset(derive.ResetReasonManual)
 	return nil
 }
 
@@ -130,6 +130,18 @@ func (s *l2SyncerBackend) StopProposer(ctx context.Context) (common.Hash, error)
 	return common.Hash{}, errors.New("stopping the L2Syncer proposer is not supported")
 }
 
+func (s *l2SyncerBackend) DroppedBatches(ctx context.Context) ([]derive.DroppedBatch, error) {
+	return s.syncer.derivation.DroppedBatches(), nil
+}
+
+func (s *l2SyncerBackend) SendConditionalTransaction(ctx context.Context, tx *types.Transaction, cond driver.TransactionConditional) error {
+	return driver.ErrConditionalTxsDisabled
+}
+
+func (s *l2SyncerBackend) ProposerHealth(ctx context.Context) (*eth.ProposerHealth, error) {
+	return &eth.ProposerHealth{}, nil
+}
+
 func (s *L2Syncer) L2Finalized() eth.L2BlockRef {
 	return s.derivation.Finalized()
 }
@@ -153,6 +165,7 @@ func (s *L2Syncer) SyncStatus() *eth.SyncStatus {
 		SafeL2:             s.L2Safe(),
 		FinalizedL2:        s.L2Finalized(),
 		UnsafeL2SyncTarget: s.derivation.UnsafeL2SyncTarget(),
+		LastReset:          s.derivation.LastReset(),
 	}
 }
 
@@ -216,7 +229,7 @@ func (s *L2Syncer) ActL2PipelineStep(t Testing) {
 		return
 	} else if err != nil && errors.Is(err, derive.ErrReset) {
 		s.log.Warn("Derivation pipeline is reset", "err", err)
-		s.derivation.Reset()
+		s.derivation.Reset(derive.ResetReasonOf(err))
 		return
 	} else if err != nil && errors.Is(err, derive.ErrTemporary) {
 		s.log.Warn("Derivation process temporary error", "err", err)
@@ -18,8 +18,8 @@ import (
 	"github.com/kroma-network/kroma/bindings/bindings"
 	"github.com/kroma-network/kroma/components/node/sources"
 	"github.com/kroma-network/kroma/components/validator"
+	chal "github.com/kroma-network/kroma/components/validator/challenge"
 	validatormetrics "github.com/kroma-network/kroma/components/validator/metrics"
-	"github.com/kroma-network/kroma/e2e/e2eutils"
 	kcrypto "github.com/kroma-network/kroma/utils/service/crypto"
 	"github.com/kroma-network/kroma/utils/service/txmgr"
 )
@@ -62,6 +62,9 @@ func NewL2Validator(t Testing, log log.Logger, cfg *ValidatorCfg, l1 *ethclient.
 	rollupConfig, err := rollupCl.RollupConfig(t.Ctx())
 	require.NoError(t, err)
 
+	prover, err := chal.NewMockProver("../testdata/proof", log)
+	require.NoError(t, err)
+
 	validatorCfg := validator.Config{
 		L2OutputOracleAddr:           cfg.OutputOracleAddr,
 		ValidatorPoolAddr:            cfg.ValidatorPoolAddr,
@@ -75,7 +78,7 @@ func NewL2Validator(t Testing, log log.Logger, cfg *ValidatorCfg, l1 *ethclient.
 		RollupClient:                 rollupCl,
 		RollupConfig:                 rollupConfig,
 		AllowNonFinalized:            cfg.AllowNonFinalized,
-		ProofFetcher:                 e2eutils.NewFetcher(log, "../testdata/proof"),
+		ProverBackend:                prover,
 		// We use custom signing here instead of using the transaction manager.
 		TxManager: &txmgr.BufferedTxManager{
 			SimpleTxManager: txmgr.SimpleTxManager{
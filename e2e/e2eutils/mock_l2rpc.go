@@ -61,7 +61,7 @@ func (m *MaliciousL2RPC) CallContext(ctx context.Context, result interface{}, me
 }
 
 func (m *MaliciousL2RPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
-	return m.rpc.BatchCallContext(ctx, b)
+	return batchCallEach(ctx, m, b)
 }
 
 func (m *MaliciousL2RPC) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
@@ -108,9 +108,17 @@ func (m *HonestL2RPC) CallContext(ctx context.Context, result interface{}, metho
 }
 
 func (m *HonestL2RPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
-	return m.rpc.BatchCallContext(ctx, b)
+	return batchCallEach(ctx, m, b)
 }
 
 func (m *HonestL2RPC) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
 	return m.rpc.EthSubscribe(ctx, channel, args...)
 }
+
+// batchCallEach sends the calls of the batch one by one, so that the mocked outputs are returned in batches too.
+func batchCallEach(ctx context.Context, cl client.RPC, b []rpc.BatchElem) error {
+	for i := range b {
+		b[i].Error = cl.CallContext(ctx, b[i].Result, b[i].Method, b[i].Args...)
+	}
+	return nil
+}
@@ -23,12 +23,14 @@ contract SystemConfig is OwnableUpgradeable, Semver {
      * @custom:value GAS_LIMIT            Represents an update to gas limit on L2.
      * @custom:value UNSAFE_BLOCK_SIGNER  Represents an update to the signer key for unsafe
      *                                    block distrubution.
+     * @custom:value FEE_RECIPIENT        Represents an update to the fee recipient of L2 blocks.
      */
     enum UpdateType {
         BATCHER,
         GAS_CONFIG,
         GAS_LIMIT,
-        UNSAFE_BLOCK_SIGNER
+        UNSAFE_BLOCK_SIGNER,
+        FEE_RECIPIENT
     }
 
     /**
@@ -43,6 +45,12 @@ contract SystemConfig is OwnableUpgradeable, Semver {
      */
     bytes32 public constant UNSAFE_BLOCK_SIGNER_SLOT = keccak256("systemconfig.unsafeblocksigner");
 
+    /**
+     * @notice Storage slot that the fee recipient of L2 blocks is stored at. Storing it at this
+     *         deterministic storage slot keeps the storage layout of the upgradeable contract intact.
+     */
+    bytes32 public constant FEE_RECIPIENT_SLOT = keccak256("systemconfig.feerecipient");
+
     /**
      * @notice Fixed L2 gas overhead. Used as part of the L2 fee calculation.
      */
@@ -185,6 +193,36 @@ contract SystemConfig is OwnableUpgradeable, Semver {
         emit ConfigUpdate(VERSION, UpdateType.UNSAFE_BLOCK_SIGNER, data);
     }
 
+    /**
+     * @notice High level getter for the fee recipient address. The fee recipient is the coinbase
+     *         of the L2 blocks, once the fee recipient updates are activated on L2.
+     *
+     * @return Address of the fee recipient.
+     */
+    function feeRecipient() external view returns (address) {
+        address addr;
+        bytes32 slot = FEE_RECIPIENT_SLOT;
+        assembly {
+            addr := sload(slot)
+        }
+        return addr;
+    }
+
+    /**
+     * @notice Updates the fee recipient address.
+     *
+     * @param _feeRecipient New fee recipient address.
+     */
+    function setFeeRecipient(address _feeRecipient) external onlyOwner {
+        bytes32 slot = FEE_RECIPIENT_SLOT;
+        assembly {
+            sstore(slot, _feeRecipient)
+        }
+
+        bytes memory data = abi.encode(_feeRecipient);
+        emit ConfigUpdate(VERSION, UpdateType.FEE_RECIPIENT, data);
+    }
+
     /**
      * @notice Updates the batcher hash.
      *
@@ -81,6 +81,11 @@ contract SystemConfig_Setters_TestFail is SystemConfig_Init {
         sysConf.setUnsafeBlockSigner(address(0x20));
     }
 
+    function test_setFeeRecipient_notOwner_reverts() external {
+        vm.expectRevert("Ownable: caller is not the owner");
+        sysConf.setFeeRecipient(address(0x20));
+    }
+
     function test_setResourceConfig_notOwner_reverts() external {
         ResourceMetering.ResourceConfig memory config = Constants.DEFAULT_RESOURCE_CONFIG();
         vm.expectRevert("Ownable: caller is not the owner");
@@ -202,4 +207,13 @@ contract SystemConfig_Setters_Test is SystemConfig_Init {
         sysConf.setUnsafeBlockSigner(newUnsafeSigner);
         assertEq(sysConf.unsafeBlockSigner(), newUnsafeSigner);
     }
+
+    function testFuzz_setFeeRecipient_succeeds(address newFeeRecipient) external {
+        vm.expectEmit(true, true, true, true);
+        emit ConfigUpdate(0, SystemConfig.UpdateType.FEE_RECIPIENT, abi.encode(newFeeRecipient));
+
+        vm.prank(sysConf.owner());
+        sysConf.setFeeRecipient(newFeeRecipient);
+        assertEq(sysConf.feeRecipient(), newFeeRecipient);
+    }
 }
@@ -100,5 +100,30 @@ func SignerFactoryFromConfig(l log.Logger, privateKey, mnemonic, hdPath string,
 		}
 	}
 
+	if signerConfig.PolicyPath != "" {
+		policies, err := ksigner.LoadPolicies(signerConfig.PolicyPath)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+		if policy := policies.For(fromAddress); policy != nil {
+			l.Info("Applying signing policy", "address", fromAddress)
+			signer = withPolicy(signer, policy)
+		}
+	}
+
 	return signer, fromAddress, nil
 }
+
+// withPolicy wraps the signers created by the factory, so that they refuse the transactions
+// that are not allowed by the policy before signing them.
+func withPolicy(factory SignerFactory, policy *ksigner.Policy) SignerFactory {
+	return func(chainID *big.Int) SignerFn {
+		s := factory(chainID)
+		return func(ctx context.Context, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if err := policy.Check(tx); err != nil {
+				return nil, err
+			}
+			return s(ctx, address, tx)
+		}
+	}
+}
@@ -26,11 +26,23 @@ func WeiToEther(wei *big.Int) float64 {
 // to the balance metric of the namespace. The balance of the account is recorded in Ether (not Wei).
 // Cancel the supplied context to shut down the go routine
 func LaunchBalanceMetrics(ctx context.Context, log log.Logger, r *prometheus.Registry, ns string, client *ethclient.Client, account common.Address) {
+	launchBalanceMetrics(ctx, log, r, ns, "balance (in ether) of account "+account.String(), client, func() common.Address {
+		return account
+	})
+}
+
+// LaunchBalanceMetricsOf is LaunchBalanceMetrics for an account that may change over time,
+// e.g. on a key rotation. The balance of the account returned by the supplied function is recorded.
+func LaunchBalanceMetricsOf(ctx context.Context, log log.Logger, r *prometheus.Registry, ns string, client *ethclient.Client, account func() common.Address) {
+	launchBalanceMetrics(ctx, log, r, ns, "balance (in ether) of the sender account", client, account)
+}
+
+func launchBalanceMetrics(ctx context.Context, log log.Logger, r *prometheus.Registry, ns string, help string, client *ethclient.Client, account func() common.Address) {
 	go func() {
 		balanceGuage := promauto.With(r).NewGauge(prometheus.GaugeOpts{
 			Namespace: ns,
 			Name:      "balance",
-			Help:      "balance (in ether) of account " + account.String(),
+			Help:      help,
 		})
 
 		ticker := time.NewTicker(10 * time.Second)
@@ -40,9 +52,10 @@ func LaunchBalanceMetrics(ctx context.Context, log log.Logger, r *prometheus.Reg
 			select {
 			case <-ticker.C:
 				ctx, cancel := context.WithTimeout(ctx, 1*time.Minute)
-				bigBal, err := client.BalanceAt(ctx, account, nil)
+				addr := account()
+				bigBal, err := client.BalanceAt(ctx, addr, nil)
 				if err != nil {
-					log.Warn("failed to get balance of account", "err", err, "address", account)
+					log.Warn("failed to get balance of account", "err", err, "address", addr)
 					cancel()
 					continue
 				}
@@ -0,0 +1,129 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// AdminNamespace is the RPC namespace that requires authentication, if enabled.
+	AdminNamespace = "admin"
+	// adminTokenMaxAge is the maximum difference between the issuance time of an admin token and the local time.
+	adminTokenMaxAge = 60 * time.Second
+	// adminRequestMaxSize is the maximum size of the requests inspected for authentication,
+	// which matches the limit of the RPC server.
+	adminRequestMaxSize = 5 * 1024 * 1024
+)
+
+// ReadJWTSecret reads a JWT secret of 32 bytes, hex encoded in the given file.
+func ReadJWTSecret(path string) ([]byte, error) {
+	path = strings.TrimSpace(path)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jwt secret: %w", err)
+	}
+	secret := common.FromHex(strings.TrimSpace(string(data)))
+	if len(secret) != 32 {
+		return nil, fmt.Errorf("invalid jwt secret in path %s, not 32 hex-formatted bytes", path)
+	}
+	return secret, nil
+}
+
+type adminIdentityKey struct{}
+
+// AdminIdentity returns the identity of the authenticated caller of an admin method, for audit logging.
+func AdminIdentity(ctx context.Context) string {
+	if identity, ok := ctx.Value(adminIdentityKey{}).(string); ok {
+		return identity
+	}
+	return "unauthenticated"
+}
+
+// NewAdminAuthHandler requires a JWT, signed with the given secret, for requests of methods of the admin namespace.
+// The subject of the token identifies the caller, and is passed to the RPC handlers, see AdminIdentity.
+func NewAdminAuthHandler(log log.Logger, secret []byte, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, adminRequestMaxSize))
+		if err != nil {
+			http.Error(w, "failed to read request", http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if !requiresAdminAuth(body) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		identity, err := verifyAdminToken(secret, r.Header.Get("Authorization"), time.Now())
+		if err != nil {
+			log.Warn("Rejected unauthenticated admin request", "remote", r.RemoteAddr, "err", err)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), adminIdentityKey{}, identity)))
+	})
+}
+
+// requiresAdminAuth returns true if the JSON-RPC request, or any request of the batch, calls an admin method.
+func requiresAdminAuth(body []byte) bool {
+	type request struct {
+		Method string `json:"method"`
+	}
+	var reqs []request
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &reqs); err != nil {
+			return false // the RPC server rejects malformed requests
+		}
+	} else {
+		var req request
+		if err := json.Unmarshal(trimmed, &req); err != nil {
+			return false
+		}
+		reqs = append(reqs, req)
+	}
+	for _, req := range reqs {
+		if strings.HasPrefix(req.Method, AdminNamespace+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyAdminToken verifies the bearer token of the authorization header, and returns the subject of the token.
+func verifyAdminToken(secret []byte, header string, now time.Time) (string, error) {
+	if !strings.HasPrefix(header, "Bearer ") {
+		return "", errors.New("missing bearer token")
+	}
+	tokenStr := strings.TrimPrefix(header, "Bearer ")
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(tokenStr, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return secret, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("invalid token: %w", err)
+	}
+	if claims.IssuedAt == nil {
+		return "", errors.New("missing issuance time")
+	}
+	if diff := now.Sub(claims.IssuedAt.Time); diff > adminTokenMaxAge || diff < -adminTokenMaxAge {
+		return "", fmt.Errorf("token issued at %s is stale", claims.IssuedAt.Time)
+	}
+	if claims.Subject == "" {
+		return "unnamed", nil
+	}
+	return claims.Subject, nil
+}
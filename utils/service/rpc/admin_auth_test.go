@@ -0,0 +1,137 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kroma-network/kroma/components/node/testlog"
+)
+
+func TestAdminAuthHandler(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	var identity string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity = AdminIdentity(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := NewAdminAuthHandler(testlog.Logger(t, log.LvlCrit), secret, next)
+
+	token := func(key []byte, subject string, iat time.Time) string {
+		tok := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+			Subject:  subject,
+			IssuedAt: jwt.NewNumericDate(iat),
+		})
+		s, err := tok.SignedString(key)
+		require.NoError(t, err)
+		return "Bearer " + s
+	}
+	serve := func(body string, auth string) int {
+		identity = ""
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	stop := `{"jsonrpc":"2.0","id":1,"method":"admin_stopProposer","params":[]}`
+	status := `{"jsonrpc":"2.0","id":1,"method":"kroma_syncStatus","params":[]}`
+	batch := `[` + status + `,` + stop + `]`
+
+	t.Run("unprotected method", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, serve(status, ""))
+		require.Equal(t, "unauthenticated", identity)
+	})
+	t.Run("missing token", func(t *testing.T) {
+		require.Equal(t, http.StatusUnauthorized, serve(stop, ""))
+		require.Equal(t, http.StatusUnauthorized, serve(batch, ""))
+	})
+	t.Run("valid token", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, serve(stop, token(secret, "operator", time.Now())))
+		require.Equal(t, "operator", identity)
+		require.Equal(t, http.StatusOK, serve(batch, token(secret, "operator", time.Now())))
+	})
+	t.Run("wrong secret", func(t *testing.T) {
+		require.Equal(t, http.StatusUnauthorized, serve(stop, token([]byte("fedcba9876543210fedcba9876543210"), "operator", time.Now())))
+	})
+	t.Run("stale token", func(t *testing.T) {
+		require.Equal(t, http.StatusUnauthorized, serve(stop, token(secret, "operator", time.Now().Add(-2*adminTokenMaxAge))))
+	})
+}
+
+type testAdminAPI struct{}
+
+func (t *testAdminAPI) Identity(ctx context.Context) string {
+	return AdminIdentity(ctx)
+}
+
+func TestServerAdminJWTSecret(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	server := NewServer(
+		"127.0.0.1",
+		10000+rand.Intn(22768),
+		"test",
+		WithAPIs([]rpc.API{
+			{
+				Namespace: "validator",
+				Service:   new(testAPI),
+			},
+			{
+				Namespace: AdminNamespace,
+				Service:   new(testAdminAPI),
+			},
+		}),
+		WithAdminJWTSecret(secret),
+		WithLogger(testlog.Logger(t, log.LvlCrit)),
+	)
+	require.NoError(t, server.Start())
+	defer func() {
+		server.Stop()
+	}()
+
+	rpcClient, err := rpc.Dial(fmt.Sprintf("http://%s", server.endpoint))
+	require.NoError(t, err)
+	defer rpcClient.Close()
+
+	t.Run("validator namespace without token", func(t *testing.T) {
+		var res int
+		require.NoError(t, rpcClient.Call(&res, "validator_frobnicate", 2))
+		require.Equal(t, 4, res)
+	})
+
+	t.Run("admin namespace without token", func(t *testing.T) {
+		var res string
+		require.Error(t, rpcClient.Call(&res, "admin_identity"))
+	})
+
+	t.Run("admin namespace with token", func(t *testing.T) {
+		tok := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+			Subject:  "operator",
+			IssuedAt: jwt.NewNumericDate(time.Now()),
+		})
+		signed, err := tok.SignedString(secret)
+		require.NoError(t, err)
+
+		authClient, err := rpc.Dial(fmt.Sprintf("http://%s", server.endpoint))
+		require.NoError(t, err)
+		defer authClient.Close()
+		authClient.SetHeader("Authorization", "Bearer "+signed)
+
+		var res string
+		require.NoError(t, authClient.Call(&res, "admin_identity"))
+		require.Equal(t, "operator", res)
+	})
+}
@@ -29,6 +29,7 @@ type Server struct {
 	corsHosts      []string
 	vHosts         []string
 	jwtSecret      []byte
+	adminSecret    []byte
 	rpcPath        string
 	healthzPath    string
 	httpRecorder   kmetrics.HTTPRecorder
@@ -77,6 +78,14 @@ func WithJWTSecret(secret []byte) ServerOption {
 	}
 }
 
+// WithAdminJWTSecret requires the requests of the admin namespace to be authenticated with a JWT signed with
+// the given secret. The methods of the other namespaces stay accessible without a token.
+func WithAdminJWTSecret(secret []byte) ServerOption {
+	return func(b *Server) {
+		b.adminSecret = secret
+	}
+}
+
 func WithRPCPath(path string) ServerOption {
 	return func(b *Server) {
 		b.rpcPath = path
@@ -167,6 +176,9 @@ func (b *Server) Start() error {
 	for _, middleware := range b.middlewares {
 		nodeHdlr = middleware(nodeHdlr)
 	}
+	if b.adminSecret != nil {
+		nodeHdlr = NewAdminAuthHandler(b.log, b.adminSecret, nodeHdlr)
+	}
 	nodeHdlr = node.NewHTTPHandlerStack(nodeHdlr, b.corsHosts, b.vHosts, b.jwtSecret)
 
 	mux := http.NewServeMux()
@@ -32,13 +32,13 @@ type TxResponse struct {
 }
 
 func NewBufferedTxManager(name string, l log.Logger, m metrics.TxMetricer, cfg CLIConfig) (*BufferedTxManager, error) {
-	simpleTxManager, err := NewSimpleTxManager(name, l, m, cfg)
+	conf, err := NewConfig(cfg, l)
 	if err != nil {
 		return nil, err
 	}
 
 	return &BufferedTxManager{
-		SimpleTxManager: *simpleTxManager,
+		SimpleTxManager: newSimpleTxManager(name, l, m, conf),
 	}, nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/urfave/cli"
 
 	kservice "github.com/kroma-network/kroma/utils/service"
@@ -33,6 +34,9 @@ const (
 	TxNotInMempoolTimeoutFlagName     = "txmgr.not-in-mempool-timeout"
 	ReceiptQueryIntervalFlagName      = "txmgr.receipt-query-interval"
 	BufferSizeFlagName                = "txmgr.buffer-size"
+	PriceBumpFlagName                 = "txmgr.price-bump"
+	FeeLimitFlagName                  = "txmgr.fee-limit-gwei"
+	MaxBumpsFlagName                  = "txmgr.max-bumps"
 )
 
 func CLIFlags(envPrefix string) []cli.Flag {
@@ -66,7 +70,7 @@ func CLIFlags(envPrefix string) []cli.Flag {
 		},
 		cli.DurationFlag{
 			Name:   ResubmissionTimeoutFlagName,
-			Usage:  "Duration we will wait before resubmitting a transaction to L1",
+			Usage:  "Duration we will wait before resubmitting a transaction to L1 with bumped fees",
 			Value:  48 * time.Second,
 			EnvVar: kservice.PrefixEnvVar(envPrefix, "RESUBMISSION_TIMEOUT"),
 		},
@@ -100,6 +104,24 @@ func CLIFlags(envPrefix string) []cli.Flag {
 			Value:  10,
 			EnvVar: kservice.PrefixEnvVar(envPrefix, "TXMGR_BUFFER_SIZE"),
 		},
+		cli.Uint64Flag{
+			Name:   PriceBumpFlagName,
+			Usage:  "Minimum percentage by which the fees of a stuck transaction are bumped when it is resubmitted",
+			Value:  DefaultPriceBump,
+			EnvVar: kservice.PrefixEnvVar(envPrefix, "TXMGR_PRICE_BUMP"),
+		},
+		cli.Float64Flag{
+			Name:   FeeLimitFlagName,
+			Usage:  "Maximum gas fee cap (in gwei) of a transaction, fees are not bumped beyond it. If 0 it is unlimited.",
+			Value:  0,
+			EnvVar: kservice.PrefixEnvVar(envPrefix, "TXMGR_FEE_LIMIT_GWEI"),
+		},
+		cli.Uint64Flag{
+			Name:   MaxBumpsFlagName,
+			Usage:  "Number of fee bumps after which a transaction that is still not mined is abandoned. If 0 it is never abandoned.",
+			Value:  0,
+			EnvVar: kservice.PrefixEnvVar(envPrefix, "TXMGR_MAX_BUMPS"),
+		},
 	}, client.CLIFlags(envPrefix)...)
 }
 
@@ -117,6 +139,9 @@ type CLIConfig struct {
 	NetworkTimeout            time.Duration
 	TxSendTimeout             time.Duration
 	TxNotInMempoolTimeout     time.Duration
+	PriceBump                 uint64
+	FeeLimitGwei              float64
+	MaxBumps                  uint64
 }
 
 func (m CLIConfig) Check() error {
@@ -141,6 +166,13 @@ func (m CLIConfig) Check() error {
 	if m.SafeAbortNonceTooLowCount == 0 {
 		return errors.New("SafeAbortNonceTooLowCount must not be 0")
 	}
+	// Geth rejects replacement txs whose fees are bumped by less than 10%.
+	if m.PriceBump != 0 && m.PriceBump < 10 {
+		return errors.New("PriceBump must be at least 10")
+	}
+	if m.FeeLimitGwei < 0 {
+		return errors.New("FeeLimitGwei must not be negative")
+	}
 	if err := m.SignerCLIConfig.Check(); err != nil {
 		return err
 	}
@@ -162,6 +194,9 @@ func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 		TxSendTimeout:             ctx.GlobalDuration(TxSendTimeoutFlagName),
 		TxNotInMempoolTimeout:     ctx.GlobalDuration(TxNotInMempoolTimeoutFlagName),
 		TxBufferSize:              ctx.GlobalUint64(BufferSizeFlagName),
+		PriceBump:                 ctx.GlobalUint64(PriceBumpFlagName),
+		FeeLimitGwei:              ctx.GlobalFloat64(FeeLimitFlagName),
+		MaxBumps:                  ctx.GlobalUint64(MaxBumpsFlagName),
 	}
 }
 
@@ -200,6 +235,9 @@ func NewConfig(cfg CLIConfig, l log.Logger) (Config, error) {
 		NumConfirmations:          cfg.NumConfirmations,
 		SafeAbortNonceTooLowCount: cfg.SafeAbortNonceTooLowCount,
 		TxBufferSize:              cfg.TxBufferSize,
+		PriceBump:                 cfg.PriceBump,
+		FeeLimit:                  gweiToWei(cfg.FeeLimitGwei),
+		MaxBumps:                  cfg.MaxBumps,
 		Signer:                    signerFactory(chainID),
 		From:                      from,
 	}, nil
@@ -247,7 +285,28 @@ type Config struct {
 	// Only used by buffered txmgr.
 	TxBufferSize uint64
 
+	// PriceBump is the minimum percentage by which the fees of a tx are bumped
+	// when it is resubmitted. If 0, DefaultPriceBump is used.
+	PriceBump uint64
+
+	// FeeLimit is the maximum gas fee cap (in wei) of a tx. The fees are not
+	// bumped beyond it. If nil, the fees are unlimited.
+	FeeLimit *big.Int
+
+	// MaxBumps is the number of fee bumps after which a tx that is still not
+	// mined is abandoned with ErrTxAbandoned. If 0, it is never abandoned.
+	MaxBumps uint64
+
 	// Signer is used to sign transactions when the gas price is increased.
 	Signer kcrypto.SignerFn
 	From   common.Address
 }
+
+// gweiToWei converts the amount of gwei to wei, returning nil for 0.
+func gweiToWei(gwei float64) *big.Int {
+	if gwei == 0 {
+		return nil
+	}
+	wei, _ := new(big.Float).Mul(big.NewFloat(gwei), big.NewFloat(params.GWei)).Int(nil)
+	return wei
+}
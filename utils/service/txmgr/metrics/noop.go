@@ -1,12 +1,20 @@
 package metrics
 
-import "github.com/ethereum/go-ethereum/core/types"
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
 
 type NoopTxMetrics struct{}
 
-func (*NoopTxMetrics) RecordNonce(uint64)                {}
-func (*NoopTxMetrics) RecordGasBumpCount(int)            {}
-func (*NoopTxMetrics) RecordTxConfirmationLatency(int64) {}
-func (*NoopTxMetrics) TxConfirmed(*types.Receipt)        {}
-func (*NoopTxMetrics) TxPublished(string)                {}
-func (*NoopTxMetrics) RPCError()                         {}
+func (*NoopTxMetrics) RecordNonce(uint64)                     {}
+func (*NoopTxMetrics) RecordGasBumpCount(int)                 {}
+func (*NoopTxMetrics) RecordTxConfirmationLatency(int64)      {}
+func (*NoopTxMetrics) TxConfirmed(*types.Receipt)             {}
+func (*NoopTxMetrics) TxPublished(string)                     {}
+func (*NoopTxMetrics) RPCError()                              {}
+func (*NoopTxMetrics) RecordTxReplacement(*types.Transaction) {}
+func (*NoopTxMetrics) RecordTxReplacementSkipped(string)      {}
+func (*NoopTxMetrics) RecordSignLatency(time.Duration, error) {}
+func (*NoopTxMetrics) RecordDeadlineMargin(time.Duration)     {}
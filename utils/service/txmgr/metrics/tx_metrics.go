@@ -1,6 +1,9 @@
 package metrics
 
 import (
+	"math/big"
+	"time"
+
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/prometheus/client_golang/prometheus"
@@ -15,6 +18,10 @@ type TxMetricer interface {
 	TxConfirmed(*types.Receipt)
 	TxPublished(string)
 	RPCError()
+	RecordTxReplacement(*types.Transaction)
+	RecordTxReplacementSkipped(string)
+	RecordSignLatency(time.Duration, error)
+	RecordDeadlineMargin(time.Duration)
 }
 
 type TxMetrics struct {
@@ -26,6 +33,12 @@ type TxMetrics struct {
 	publishEvent       metrics.Event
 	confirmEvent       metrics.EventVec
 	rpcError           prometheus.Counter
+	replacementEvent   metrics.Event
+	replacementSkipped *prometheus.CounterVec
+	replacementTipCap  prometheus.Gauge
+	replacementFeeCap  prometheus.Gauge
+	signLatency        *prometheus.HistogramVec
+	deadlineMargin     prometheus.Histogram
 }
 
 func receiptStatusString(receipt *types.Receipt) string {
@@ -81,6 +94,39 @@ func MakeTxMetrics(ns string, factory metrics.Factory) TxMetrics {
 			Help:      "Temporary: Count of RPC errors (like timeouts) that have occurred",
 			Subsystem: "txmgr",
 		}),
+		replacementEvent: metrics.NewEvent(factory, ns, "replacement", "tx replacement with bumped fees"),
+		replacementSkipped: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "tx_replacement_skipped_count",
+			Help:      "Count of resubmissions without bumped fees, or abandoned txs, by reason",
+			Subsystem: "txmgr",
+		}, []string{"reason"}),
+		replacementTipCap: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "tx_replacement_tip_cap_gwei",
+			Help:      "Gas tip cap of the last replacement tx in GWEI",
+			Subsystem: "txmgr",
+		}),
+		replacementFeeCap: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "tx_replacement_fee_cap_gwei",
+			Help:      "Gas fee cap of the last replacement tx in GWEI",
+			Subsystem: "txmgr",
+		}),
+		signLatency: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "sign_latency_seconds",
+			Help:      "Latency of signing a tx, by the local key or the remote signer, and whether it succeeded",
+			Subsystem: "txmgr",
+			Buckets:   []float64{.001, .005, .01, .05, .1, .25, .5, 1, 2.5, 5},
+		}, []string{"status"}),
+		deadlineMargin: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      "tx_deadline_margin_seconds",
+			Help:      "Time remaining to the deadline of a tx at the timestamp of its block, negative if the deadline was missed",
+			Subsystem: "txmgr",
+			Buckets:   []float64{-60, 0, 12, 24, 60, 120, 300, 600, 1800, 3600},
+		}),
 	}
 }
 
@@ -113,3 +159,33 @@ func (t *TxMetrics) TxPublished(errString string) {
 func (t *TxMetrics) RPCError() {
 	t.rpcError.Inc()
 }
+
+// RecordTxReplacement records the fees of a tx that replaces a stuck tx.
+func (t *TxMetrics) RecordTxReplacement(tx *types.Transaction) {
+	t.replacementEvent.Record()
+	t.replacementTipCap.Set(weiToGwei(tx.GasTipCap()))
+	t.replacementFeeCap.Set(weiToGwei(tx.GasFeeCap()))
+}
+
+func (t *TxMetrics) RecordTxReplacementSkipped(reason string) {
+	t.replacementSkipped.WithLabelValues(reason).Inc()
+}
+
+// RecordSignLatency records the latency of signing a tx, labeled by whether it failed.
+func (t *TxMetrics) RecordSignLatency(latency time.Duration, err error) {
+	status := "success"
+	if err != nil {
+		status = "failed"
+	}
+	t.signLatency.WithLabelValues(status).Observe(latency.Seconds())
+}
+
+// RecordDeadlineMargin records the time remaining to the deadline of a tx when it is included.
+func (t *TxMetrics) RecordDeadlineMargin(margin time.Duration) {
+	t.deadlineMargin.Observe(margin.Seconds())
+}
+
+func weiToGwei(wei *big.Int) float64 {
+	gwei, _ := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.GWei)).Float64()
+	return gwei
+}
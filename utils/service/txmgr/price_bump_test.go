@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
@@ -18,13 +19,19 @@ type priceBumpTest struct {
 	newBasefee  int64
 	expectedTip int64
 	expectedFC  int64
+	priceBump   uint64
 }
 
 func (tc *priceBumpTest) run(t *testing.T) {
 	prevFC := calcGasFeeCap(big.NewInt(tc.prevBasefee), big.NewInt(tc.prevGasTip))
 	lgr := testlog.Logger(t, log.LvlCrit)
 
-	tip, fc := updateFees(big.NewInt(tc.prevGasTip), prevFC, big.NewInt(tc.newGasTip), big.NewInt(tc.newBasefee), lgr)
+	priceBump := tc.priceBump
+	if priceBump == 0 {
+		priceBump = DefaultPriceBump
+	}
+
+	tip, fc := updateFees(big.NewInt(tc.prevGasTip), prevFC, big.NewInt(tc.newGasTip), big.NewInt(tc.newBasefee), priceBump, lgr)
 
 	require.Equal(t, tc.expectedTip, tip.Int64(), "tip must be as expected")
 	require.Equal(t, tc.expectedFC, fc.Int64(), "fee cap must be as expected")
@@ -82,6 +89,12 @@ func TestUpdateFees(t *testing.T) {
 			newGasTip: 120, newBasefee: 1200,
 			expectedTip: 120, expectedFC: 2520,
 		},
+		{
+			prevGasTip: 100, prevBasefee: 1000,
+			newGasTip: 101, newBasefee: 1000,
+			expectedTip: 130, expectedFC: 2730,
+			priceBump: 30,
+		},
 	}
 	for i, test := range tests {
 		i := i
@@ -89,3 +102,20 @@ func TestUpdateFees(t *testing.T) {
 		t.Run(fmt.Sprint(i), test.run)
 	}
 }
+
+func TestDeadlinePriceBump(t *testing.T) {
+	total := 100 * time.Second
+	require.Equal(t, uint64(15), deadlinePriceBump(15, total, total))
+	require.Equal(t, uint64(15), deadlinePriceBump(15, total, 50*time.Second))
+	require.Equal(t, uint64(30), deadlinePriceBump(15, total, 25*time.Second))
+	require.Equal(t, uint64(75), deadlinePriceBump(15, total, 10*time.Second))
+	require.Equal(t, maxDeadlinePriceBump, deadlinePriceBump(15, total, time.Second))
+	require.Equal(t, maxDeadlinePriceBump, deadlinePriceBump(15, total, -time.Second))
+}
+
+func TestDeadlineResubmissionTimeout(t *testing.T) {
+	m := &SimpleTxManager{Config: Config{ReceiptQueryInterval: 12 * time.Second}}
+	require.Equal(t, 48*time.Second, m.deadlineResubmissionTimeout(48*time.Second, time.Hour))
+	require.Equal(t, 25*time.Second, m.deadlineResubmissionTimeout(48*time.Second, 100*time.Second))
+	require.Equal(t, 12*time.Second, m.deadlineResubmissionTimeout(48*time.Second, 10*time.Second))
+}
@@ -0,0 +1,70 @@
+package txmgr
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TxReceipt is the result of a transaction sent through a [Queue], tagged with the
+// id the caller sent it with.
+type TxReceipt[T any] struct {
+	// ID is the id that was passed to [Queue.Send].
+	ID T
+	// Receipt is the receipt of the transaction, if it was included.
+	Receipt *types.Receipt
+	// Err is the error of sending the transaction, if any.
+	Err error
+}
+
+// Queue sends transactions through a [TxManager] concurrently, so that multiple
+// transactions can be pending at once.
+type Queue[T any] struct {
+	ctx        context.Context
+	txMgr      TxManager
+	maxPending uint64
+
+	// pending is the number of transactions that are still being sent by the tx manager.
+	pending atomic.Uint64
+	wg      sync.WaitGroup
+}
+
+// NewQueue creates a new transaction queue. At most maxPending transactions are
+// pending at once, or an unlimited number if maxPending is 0.
+// The context is used to send all transactions, cancelling it aborts pending transactions.
+func NewQueue[T any](ctx context.Context, txMgr TxManager, maxPending uint64) *Queue[T] {
+	return &Queue[T]{
+		ctx:        ctx,
+		txMgr:      txMgr,
+		maxPending: maxPending,
+	}
+}
+
+// Full returns whether the maximum number of transactions is pending.
+// A transaction stops being pending right before its receipt is sent to the receipt channel,
+// so Full never blocks callers that wait for receipts before sending more transactions.
+func (q *Queue[T]) Full() bool {
+	return q.maxPending != 0 && q.pending.Load() >= q.maxPending
+}
+
+// Send sends the transaction candidate in a new goroutine. The result is sent to
+// receiptCh once the transaction got confirmed or failed, tagged with the given id.
+// It does not block and does not respect the maximum number of pending
+// transactions, callers should check Full before.
+func (q *Queue[T]) Send(id T, candidate TxCandidate, receiptCh chan<- TxReceipt[T]) {
+	q.pending.Add(1)
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+		receipt, err := q.txMgr.Send(q.ctx, candidate)
+		q.pending.Add(^uint64(0))
+		receiptCh <- TxReceipt[T]{ID: id, Receipt: receipt, Err: err}
+	}()
+}
+
+// Wait waits until the receipts of all sent transactions got received.
+func (q *Queue[T]) Wait() {
+	q.wg.Wait()
+}
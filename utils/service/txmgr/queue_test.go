@@ -0,0 +1,74 @@
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingTxManager is a TxManager whose sends only return once they are released.
+type blockingTxManager struct {
+	release chan error
+}
+
+func (m *blockingTxManager) Send(ctx context.Context, candidate TxCandidate) (*types.Receipt, error) {
+	if err := <-m.release; err != nil {
+		return nil, err
+	}
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful}, nil
+}
+
+func (m *blockingTxManager) From() common.Address {
+	return common.Address{}
+}
+
+// TestQueue_MaxPending ensures that the queue reports to be full once the maximum
+// number of transactions is pending, and that all receipts are delivered.
+func TestQueue_MaxPending(t *testing.T) {
+	txMgr := &blockingTxManager{release: make(chan error)}
+	queue := NewQueue[int](context.Background(), txMgr, 2)
+	receiptCh := make(chan TxReceipt[int])
+
+	require.False(t, queue.Full())
+	queue.Send(0, TxCandidate{}, receiptCh)
+	require.False(t, queue.Full())
+	queue.Send(1, TxCandidate{}, receiptCh)
+	require.True(t, queue.Full())
+
+	errFailed := errors.New("failed")
+	txMgr.release <- errFailed
+	r := <-receiptCh
+	require.ErrorIs(t, r.Err, errFailed)
+	require.Nil(t, r.Receipt)
+	require.False(t, queue.Full(), "a slot got freed by the failed tx")
+	failed := r.ID
+
+	txMgr.release <- nil
+	r = <-receiptCh
+	require.NoError(t, r.Err)
+	require.NotNil(t, r.Receipt)
+	require.Equal(t, 1-failed, r.ID)
+
+	queue.Wait()
+}
+
+// TestQueue_Unlimited ensures that a queue without a maximum is never full.
+func TestQueue_Unlimited(t *testing.T) {
+	txMgr := &blockingTxManager{release: make(chan error, 10)}
+	queue := NewQueue[int](context.Background(), txMgr, 0)
+	receiptCh := make(chan TxReceipt[int], 10)
+
+	for i := 0; i < 10; i++ {
+		queue.Send(i, TxCandidate{}, receiptCh)
+		require.False(t, queue.Full())
+	}
+	for i := 0; i < 10; i++ {
+		txMgr.release <- nil
+	}
+	queue.Wait()
+	require.Len(t, receiptCh, 10)
+}
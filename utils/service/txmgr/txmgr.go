@@ -19,19 +19,23 @@ import (
 	"github.com/kroma-network/kroma/utils/service/txmgr/metrics"
 )
 
+// DefaultPriceBump is the default minimum percentage by which the fees of a tx are bumped.
 // Geth defaults the priceBump to 10
 // Set it to 15% to be more aggressive about including transactions
-const priceBump int64 = 15
+const DefaultPriceBump uint64 = 15
+
+// maxDeadlinePriceBump is the maximum percentage by which the fees of a tx with a deadline are bumped.
+const maxDeadlinePriceBump uint64 = 100
+
+var oneHundred = big.NewInt(100)
 
-// new = old * (100 + priceBump) / 100
 var (
-	priceBumpPercent = big.NewInt(100 + priceBump)
-	oneHundred       = big.NewInt(100)
+	// ErrTxReceiptNotSucceed is the error returned when tx confirmed but the status is not success.
+	ErrTxReceiptNotSucceed = errors.New("transaction confirmed but the status is not success")
+	// ErrTxAbandoned is the error returned when a tx is not confirmed after the maximum number of fee bumps.
+	ErrTxAbandoned = errors.New("transaction abandoned after max fee bumps")
 )
 
-// ErrTxReceiptNotSucceed is the error returned when tx confirmed but the status is not success.
-var ErrTxReceiptNotSucceed = errors.New("transaction confirmed but the status is not success")
-
 // TxManager is an interface that allows callers to reliably publish txs,
 // bumping the gas price if needed, and obtain the receipt of the resulting tx.
 //
@@ -42,7 +46,8 @@ type TxManager interface {
 	// It can be stopped by cancelling the provided context; however, the transaction
 	// may be included on L1 even if the context is cancelled.
 	//
-	// NOTE: Send should be called by AT MOST one caller at a time.
+	// NOTE: Send may be called concurrently, the nonces of concurrent transactions are
+	// assigned in the order the transactions are crafted.
 	Send(ctx context.Context, candidate TxCandidate) (*types.Receipt, error)
 
 	// From returns the sending address associated with the instance of the transaction manager.
@@ -88,6 +93,10 @@ type SimpleTxManager struct {
 	backend ETHBackend
 	l       log.Logger
 	metr    metrics.TxMetricer
+
+	// nonce is the next nonce to use, it is nil if it has to be fetched from the backend.
+	nonce     *uint64
+	nonceLock sync.Mutex
 }
 
 // NewSimpleTxManager initializes a new SimpleTxManager with the passed Config.
@@ -97,20 +106,100 @@ func NewSimpleTxManager(name string, l log.Logger, m metrics.TxMetricer, cfg CLI
 		return nil, err
 	}
 
-	return &SimpleTxManager{
+	mgr := newSimpleTxManager(name, l, m, conf)
+	return &mgr, nil
+}
+
+// NewSimpleTxManagerFromConfig initializes a new SimpleTxManager with the passed Config,
+// e.g. to send through a custom backend.
+func NewSimpleTxManagerFromConfig(name string, l log.Logger, m metrics.TxMetricer, conf Config) *SimpleTxManager {
+	mgr := newSimpleTxManager(name, l, m, conf)
+	return &mgr
+}
+
+// newSimpleTxManager creates the SimpleTxManager by value, so that it can be embedded
+// without copying its nonce lock.
+func newSimpleTxManager(name string, l log.Logger, m metrics.TxMetricer, conf Config) SimpleTxManager {
+	return SimpleTxManager{
 		chainID: conf.ChainID,
 		name:    name,
 		Config:  conf,
 		backend: conf.Backend,
 		l:       l.New("service", name),
 		metr:    m,
-	}, nil
+	}
 }
 
 func (m *SimpleTxManager) From() common.Address {
 	return m.Config.From
 }
 
+// priceBump returns the minimum percentage by which the fees of a tx are bumped.
+func (m *SimpleTxManager) priceBump() uint64 {
+	if m.PriceBump == 0 {
+		return DefaultPriceBump
+	}
+	return m.PriceBump
+}
+
+// deadlineResubmissionTimeout returns the interval of the fee bumps of a tx with the given time remaining
+// to its deadline, so that the fees are bumped a few times before it: a quarter of the remaining time, but
+// not more than the resubmission timeout, nor less than the receipt query interval.
+func (m *SimpleTxManager) deadlineResubmissionTimeout(resubmissionTimeout, remaining time.Duration) time.Duration {
+	timeout := remaining / 4
+	if timeout > resubmissionTimeout {
+		timeout = resubmissionTimeout
+	}
+	if timeout < m.ReceiptQueryInterval {
+		timeout = m.ReceiptQueryInterval
+	}
+	return timeout
+}
+
+// deadlinePriceBump returns the price bump of a tx that must be included total after it was first sent,
+// with the given time remaining. The base price bump is used while at least half of the time remains,
+// then the bump grows in inverse proportion to the remaining time, up to maxDeadlinePriceBump.
+func deadlinePriceBump(base uint64, total, remaining time.Duration) uint64 {
+	if remaining <= 0 {
+		return maxDeadlinePriceBump
+	}
+	if remaining*2 >= total {
+		return base
+	}
+	bump := base * uint64(total/2) / uint64(remaining)
+	if bump > maxDeadlinePriceBump {
+		return maxDeadlinePriceBump
+	}
+	return bump
+}
+
+// recordDeadlineMargin records the time remaining to the deadline of the tx at the timestamp of the block
+// it is included in, negative if the deadline was missed.
+func (m *SimpleTxManager) recordDeadlineMargin(ctx context.Context, receipt *types.Receipt, deadline time.Time) {
+	cCtx, cancel := context.WithTimeout(ctx, m.NetworkTimeout)
+	defer cancel()
+	header, err := m.backend.HeaderByNumber(cCtx, receipt.BlockNumber)
+	if err != nil {
+		m.l.Warn("failed to get the block of the receipt", "hash", receipt.TxHash, "err", err)
+		return
+	}
+	margin := deadline.Sub(time.Unix(int64(header.Time), 0))
+	m.metr.RecordDeadlineMargin(margin)
+	m.l.Info("Transaction included before deadline", "hash", receipt.TxHash, "deadline", deadline, "margin", margin)
+}
+
+// limitFees caps the gas fee cap, and the tip along with it, to the fee limit.
+func (m *SimpleTxManager) limitFees(gasTipCap, gasFeeCap *big.Int) (*big.Int, *big.Int) {
+	if m.FeeLimit == nil || gasFeeCap.Cmp(m.FeeLimit) <= 0 {
+		return gasTipCap, gasFeeCap
+	}
+	gasFeeCap = new(big.Int).Set(m.FeeLimit)
+	if gasTipCap.Cmp(gasFeeCap) > 0 {
+		gasTipCap = gasFeeCap
+	}
+	return gasTipCap, gasFeeCap
+}
+
 // TxCandidate is a transaction candidate that can be submitted to ask the
 // [TxManager] to construct a transaction with gas price bounds.
 type TxCandidate struct {
@@ -124,6 +213,12 @@ type TxCandidate struct {
 	AccessList types.AccessList
 	// Value is the value that is passed to the constructed tx.
 	Value *big.Int
+	// ResubmissionTimeout overrides the interval of the fee bumps of the constructed tx if not 0,
+	// e.g. to get an urgent tx included faster.
+	ResubmissionTimeout time.Duration
+	// Deadline is the time by which the constructed tx must be included, zero if there is none.
+	// As it approaches, the fees are bumped more often and by larger amounts.
+	Deadline time.Time
 }
 
 // Send is used to publish a transaction with incrementally higher gas prices
@@ -134,7 +229,9 @@ type TxCandidate struct {
 // The transaction manager handles all signing. If and only if the gas limit is 0, the
 // transaction manager will do a gas estimation.
 //
-// NOTE: Send should be called by AT MOST one caller at a time.
+// NOTE: Send may be called concurrently. Nonces are tracked locally, so that multiple
+// transactions can be pending at once. If a transaction can not be sent, the nonce is
+// fetched from the backend again for the next transaction.
 func (m *SimpleTxManager) Send(ctx context.Context, candidate TxCandidate) (*types.Receipt, error) {
 	if m.TxSendTimeout != 0 {
 		var cancel context.CancelFunc
@@ -143,9 +240,51 @@ func (m *SimpleTxManager) Send(ctx context.Context, candidate TxCandidate) (*typ
 	}
 	tx, err := m.craftTx(ctx, candidate)
 	if err != nil {
+		m.ResetNonce()
 		return nil, fmt.Errorf("failed to create the tx: %w", err)
 	}
-	return m.send(ctx, tx)
+	resubmissionTimeout := m.ResubmissionTimeout
+	if candidate.ResubmissionTimeout != 0 {
+		resubmissionTimeout = candidate.ResubmissionTimeout
+	}
+	receipt, err := m.sendWithResubmission(ctx, tx, resubmissionTimeout, candidate.Deadline)
+	if receipt == nil && err != nil {
+		// The nonce may not have been consumed, so it must not be skipped by the next transaction.
+		m.ResetNonce()
+	}
+	return receipt, err
+}
+
+// nextNonce returns the nonce to use for the next transaction and increments it.
+// The nonce is fetched from the latest known block if it isn't tracked yet.
+func (m *SimpleTxManager) nextNonce(ctx context.Context) (uint64, error) {
+	m.nonceLock.Lock()
+	defer m.nonceLock.Unlock()
+
+	if m.nonce == nil {
+		// Fetch the sender's nonce from the latest known block (nil `blockNumber`)
+		childCtx, cancel := context.WithTimeout(ctx, m.NetworkTimeout)
+		defer cancel()
+		nonce, err := m.backend.NonceAt(childCtx, m.From(), nil)
+		if err != nil {
+			m.metr.RPCError()
+			return 0, fmt.Errorf("failed to get nonce: %w", err)
+		}
+		m.nonce = &nonce
+	}
+
+	nonce := *m.nonce
+	*m.nonce++
+	m.metr.RecordNonce(nonce)
+	return nonce, nil
+}
+
+// ResetNonce makes the next transaction fetch the nonce from the backend again,
+// e.g. when the transactions sent were reorged out of L1.
+func (m *SimpleTxManager) ResetNonce() {
+	m.nonceLock.Lock()
+	defer m.nonceLock.Unlock()
+	m.nonce = nil
 }
 
 // craftTx creates the signed transaction
@@ -159,17 +298,12 @@ func (m *SimpleTxManager) craftTx(ctx context.Context, candidate TxCandidate) (*
 		m.metr.RPCError()
 		return nil, fmt.Errorf("failed to get gas price info: %w", err)
 	}
-	gasFeeCap := calcGasFeeCap(basefee, gasTipCap)
+	gasTipCap, gasFeeCap := m.limitFees(gasTipCap, calcGasFeeCap(basefee, gasTipCap))
 
-	// Fetch the sender's nonce from the latest known block (nil `blockNumber`)
-	childCtx, cancel := context.WithTimeout(ctx, m.NetworkTimeout)
-	defer cancel()
-	nonce, err := m.backend.NonceAt(childCtx, m.From(), nil)
+	nonce, err := m.nextNonce(ctx)
 	if err != nil {
-		m.metr.RPCError()
-		return nil, fmt.Errorf("failed to get nonce: %w", err)
+		return nil, err
 	}
-	m.metr.RecordNonce(nonce)
 
 	// TODO: If we apply the accessList manually, it's hard to predict and react to other issues,
 	// such as gas prices, due to subsequent code modifications.
@@ -205,14 +339,29 @@ func (m *SimpleTxManager) craftTx(ctx context.Context, candidate TxCandidate) (*
 		rawTx.Gas = gas
 	}
 
-	ctx, cancel = context.WithTimeout(ctx, m.NetworkTimeout)
+	return m.sign(ctx, rawTx)
+}
+
+// sign signs the tx with the configured signer, which may be a remote signer, and records the latency.
+func (m *SimpleTxManager) sign(ctx context.Context, rawTx *types.DynamicFeeTx) (*types.Transaction, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.NetworkTimeout)
 	defer cancel()
-	return m.Signer(ctx, m.From(), types.NewTx(rawTx))
+	start := time.Now()
+	tx, err := m.Signer(ctx, m.From(), types.NewTx(rawTx))
+	m.metr.RecordSignLatency(time.Since(start), err)
+	return tx, err
 }
 
 // send submits the same transaction several times with increasing gas prices as necessary.
 // It waits for the transaction to be confirmed on chain.
 func (m *SimpleTxManager) send(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	return m.sendWithResubmission(ctx, tx, m.ResubmissionTimeout, time.Time{})
+}
+
+// sendWithResubmission is send, bumping the fees every resubmissionTimeout. If the deadline is not zero,
+// the fees are bumped more often and by larger amounts as it approaches, see deadlineResubmissionTimeout
+// and deadlinePriceBump.
+func (m *SimpleTxManager) sendWithResubmission(ctx context.Context, tx *types.Transaction, resubmissionTimeout time.Duration, deadline time.Time) (*types.Receipt, error) {
 	var wg sync.WaitGroup
 	defer wg.Wait()
 	ctx, cancel := context.WithCancel(ctx)
@@ -229,13 +378,24 @@ func (m *SimpleTxManager) send(ctx context.Context, tx *types.Transaction) (*typ
 	wg.Add(1)
 	go sendTxAsync(tx)
 
-	ticker := time.NewTicker(m.ResubmissionTimeout)
+	start := time.Now()
+	interval := resubmissionTimeout
+	if !deadline.IsZero() {
+		interval = m.deadlineResubmissionTimeout(resubmissionTimeout, time.Until(deadline))
+	}
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	bumpCounter := 0
 	for {
 		select {
 		case <-ticker.C:
+			priceBump := m.priceBump()
+			if !deadline.IsZero() {
+				remaining := time.Until(deadline)
+				ticker.Reset(m.deadlineResubmissionTimeout(resubmissionTimeout, remaining))
+				priceBump = deadlinePriceBump(priceBump, deadline.Sub(start), remaining)
+			}
 			// Don't resubmit a transaction if it has been mined, but we are waiting for the conf depth.
 			if sendState.IsWaitingForConfirmation() {
 				continue
@@ -245,8 +405,14 @@ func (m *SimpleTxManager) send(ctx context.Context, tx *types.Transaction) (*typ
 				m.l.Warn("Aborting transaction submission")
 				return nil, errors.New("aborted transaction sending")
 			}
+			// If the tx is still not mined after the maximum number of bumps, give up on it.
+			if m.MaxBumps != 0 && uint64(bumpCounter) >= m.MaxBumps {
+				m.l.Warn("Abandoning transaction submission", "bumps", bumpCounter)
+				m.metr.RecordTxReplacementSkipped("abandoned")
+				return nil, ErrTxAbandoned
+			}
 			// Increase the gas price & submit the new transaction
-			tx = m.increaseGasPrice(ctx, tx)
+			tx = m.bumpGasPrice(ctx, tx, priceBump)
 			wg.Add(1)
 			bumpCounter += 1
 			go sendTxAsync(tx)
@@ -257,6 +423,9 @@ func (m *SimpleTxManager) send(ctx context.Context, tx *types.Transaction) (*typ
 		case receipt := <-receiptChan:
 			m.metr.RecordGasBumpCount(bumpCounter)
 			m.metr.TxConfirmed(receipt)
+			if !deadline.IsZero() {
+				m.recordDeadlineMargin(ctx, receipt, deadline)
+			}
 			// If transaction confirmed but the status is not success, return ErrTxReceiptNotSucceed
 			if receipt.Status != types.ReceiptStatusSuccessful {
 				return receipt, ErrTxReceiptNotSucceed
@@ -391,22 +560,35 @@ func (m *SimpleTxManager) queryReceipt(ctx context.Context, txHash common.Hash,
 
 // increaseGasPrice takes the previous transaction & potentially clones then signs it with a higher tip.
 // If the tip + basefee suggested by the network are not greater than the previous values, the same transaction
-// will be returned. If they are greater, this function will ensure that they are at least greater by 15% than
-// the previous transaction's value to ensure that the price bump is large enough.
+// will be returned. If they are greater, this function will ensure that they are at least greater by the price bump
+// percentage than the previous transaction's value to ensure that the price bump is large enough. The fees are never
+// bumped beyond the fee limit.
 //
 // We do not re-estimate the amount of gas used because for some stateful transactions (like output proposals) the
 // act of including the transaction renders the repeat of the transaction invalid.
 //
 // If it encounters an error with creating the new transaction, it will return the old transaction.
 func (m *SimpleTxManager) increaseGasPrice(ctx context.Context, tx *types.Transaction) *types.Transaction {
+	return m.bumpGasPrice(ctx, tx, m.priceBump())
+}
+
+// bumpGasPrice is increaseGasPrice with the given price bump percentage.
+func (m *SimpleTxManager) bumpGasPrice(ctx context.Context, tx *types.Transaction, priceBump uint64) *types.Transaction {
 	tip, basefee, err := m.suggestGasPriceCaps(ctx)
 	if err != nil {
 		m.l.Warn("failed to get suggested gas tip and basefee", "err", err)
 		return tx
 	}
-	gasTipCap, gasFeeCap := updateFees(tx.GasTipCap(), tx.GasFeeCap(), tip, basefee, m.l)
+	gasTipCap, gasFeeCap := updateFees(tx.GasTipCap(), tx.GasFeeCap(), tip, basefee, priceBump, m.l)
+	if m.FeeLimit != nil && tx.GasFeeCap().Cmp(m.FeeLimit) >= 0 && gasFeeCap.Cmp(m.FeeLimit) > 0 {
+		m.l.Warn("not bumping fees beyond the fee limit", "gasFeeCap", tx.GasFeeCap(), "feeLimit", m.FeeLimit)
+		m.metr.RecordTxReplacementSkipped("fee_limit")
+		return tx
+	}
+	gasTipCap, gasFeeCap = m.limitFees(gasTipCap, gasFeeCap)
 
 	if tx.GasTipCapIntCmp(gasTipCap) == 0 && tx.GasFeeCapIntCmp(gasFeeCap) == 0 {
+		m.metr.RecordTxReplacementSkipped("fees_unchanged")
 		return tx
 	}
 
@@ -421,13 +603,13 @@ func (m *SimpleTxManager) increaseGasPrice(ctx context.Context, tx *types.Transa
 		Data:       tx.Data(),
 		AccessList: tx.AccessList(),
 	}
-	ctx, cancel := context.WithTimeout(ctx, m.NetworkTimeout)
-	defer cancel()
-	newTx, err := m.Signer(ctx, m.From(), types.NewTx(rawTx))
+	newTx, err := m.sign(ctx, rawTx)
 	if err != nil {
 		m.l.Warn("failed to sign new transaction", "err", err)
+		m.metr.RecordTxReplacementSkipped("sign_error")
 		return tx
 	}
+	m.metr.RecordTxReplacement(newTx)
 	return newTx
 }
 
@@ -454,9 +636,9 @@ func (m *SimpleTxManager) suggestGasPriceCaps(ctx context.Context) (*big.Int, *b
 	return tip, head.BaseFee, nil
 }
 
-// calcThresholdValue returns x * priceBumpPercent / 100
-func calcThresholdValue(x *big.Int) *big.Int {
-	threshold := new(big.Int).Mul(priceBumpPercent, x)
+// calcThresholdValue returns x * (100 + priceBump) / 100
+func calcThresholdValue(x *big.Int, priceBump uint64) *big.Int {
+	threshold := new(big.Int).Mul(new(big.Int).SetUint64(100+priceBump), x)
 	threshold = threshold.Div(threshold, oneHundred)
 	return threshold
 }
@@ -464,7 +646,7 @@ func calcThresholdValue(x *big.Int) *big.Int {
 // updateFees takes the old tip/basefee & the new tip/basefee and then suggests
 // a gasTipCap and gasFeeCap that satisfies geth's required fee bumps
 // Geth: FC and Tip must be bumped if any increase
-func updateFees(oldTip, oldFeeCap, newTip, newBaseFee *big.Int, lgr log.Logger) (*big.Int, *big.Int) {
+func updateFees(oldTip, oldFeeCap, newTip, newBaseFee *big.Int, priceBump uint64, lgr log.Logger) (*big.Int, *big.Int) {
 	newFeeCap := calcGasFeeCap(newBaseFee, newTip)
 	lgr = lgr.New("old_tip", oldTip, "old_feecap", oldFeeCap, "new_tip", newTip, "new_feecap", newFeeCap)
 	// If the new prices are less than the old price, reuse the old prices
@@ -473,8 +655,8 @@ func updateFees(oldTip, oldFeeCap, newTip, newBaseFee *big.Int, lgr log.Logger)
 		return oldTip, oldFeeCap
 	}
 	// Determine if we need to increase the suggested values
-	thresholdTip := calcThresholdValue(oldTip)
-	thresholdFeeCap := calcThresholdValue(oldFeeCap)
+	thresholdTip := calcThresholdValue(oldTip, priceBump)
+	thresholdFeeCap := calcThresholdValue(oldFeeCap, priceBump)
 	if newTip.Cmp(thresholdTip) >= 0 && newFeeCap.Cmp(thresholdFeeCap) >= 0 {
 		lgr.Debug("Using new tip and feecap")
 		return newTip, newFeeCap
@@ -317,6 +317,64 @@ func TestTxMgrNeverConfirmCancel(t *testing.T) {
 	require.Nil(t, receipt)
 }
 
+// TestTxMgrAbandonsAfterMaxBumps asserts that Send gives up on a tx that is
+// not mined after the maximum number of fee bumps.
+func TestTxMgrAbandonsAfterMaxBumps(t *testing.T) {
+	t.Parallel()
+
+	cfg := configWithNumConfs(1)
+	cfg.ResubmissionTimeout = 50 * time.Millisecond
+	cfg.MaxBumps = 2
+	h := newTestHarnessWithConfig(t, cfg)
+
+	gasTipCap, gasFeeCap := h.gasPricer.sample()
+	tx := types.NewTx(&types.DynamicFeeTx{
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+	})
+	sendTx := func(ctx context.Context, tx *types.Transaction) error {
+		// Don't publish tx to backend, simulating never being mined.
+		return nil
+	}
+	h.backend.setTxSender(sendTx)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	receipt, err := h.mgr.send(ctx, tx)
+	require.ErrorIs(t, err, ErrTxAbandoned)
+	require.Nil(t, receipt)
+}
+
+// TestTxMgrResubmissionTimeoutOverride asserts that the fees of a tx are bumped at the
+// resubmission timeout given for it instead of the configured one.
+func TestTxMgrResubmissionTimeoutOverride(t *testing.T) {
+	t.Parallel()
+
+	cfg := configWithNumConfs(1)
+	cfg.ResubmissionTimeout = time.Hour
+	cfg.MaxBumps = 2
+	h := newTestHarnessWithConfig(t, cfg)
+
+	gasTipCap, gasFeeCap := h.gasPricer.sample()
+	tx := types.NewTx(&types.DynamicFeeTx{
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+	})
+	sendTx := func(ctx context.Context, tx *types.Transaction) error {
+		// Don't publish tx to backend, simulating never being mined.
+		return nil
+	}
+	h.backend.setTxSender(sendTx)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	receipt, err := h.mgr.sendWithResubmission(ctx, tx, 50*time.Millisecond, time.Time{})
+	require.ErrorIs(t, err, ErrTxAbandoned)
+	require.Nil(t, receipt)
+}
+
 // TestTxMgrConfirmsAtMaxGasPrice asserts that Send properly returns the max gas
 // price receipt if none of the lower gas price txs were mined.
 func TestTxMgrConfirmsAtHigherGasPrice(t *testing.T) {
@@ -874,3 +932,41 @@ func TestErrStringMatch(t *testing.T) {
 		})
 	}
 }
+
+// TestIncreaseGasPriceFeeLimit asserts that the fees are not bumped beyond the fee limit.
+func TestIncreaseGasPriceFeeLimit(t *testing.T) {
+	t.Parallel()
+
+	borkedBackend := failingBackend{
+		gasTip:  big.NewInt(100),
+		baseFee: big.NewInt(1000),
+	}
+	mgr := &SimpleTxManager{
+		Config: Config{
+			ResubmissionTimeout:       time.Second,
+			ReceiptQueryInterval:      50 * time.Millisecond,
+			NumConfirmations:          1,
+			SafeAbortNonceTooLowCount: 3,
+			FeeLimit:                  big.NewInt(1500),
+			Signer: func(ctx context.Context, from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				return tx, nil
+			},
+			From: common.Address{},
+		},
+		name:    "TEST",
+		backend: &borkedBackend,
+		l:       testlog.Logger(t, log.LvlCrit),
+		metr:    &metrics.NoopTxMetrics{},
+	}
+	tx := types.NewTx(&types.DynamicFeeTx{
+		GasTipCap: big.NewInt(10),
+		GasFeeCap: big.NewInt(1000),
+	})
+
+	newTx := mgr.increaseGasPrice(context.Background(), tx)
+	require.Zero(t, newTx.GasFeeCap().Cmp(mgr.FeeLimit), "new tx fee cap must be capped at the fee limit")
+	require.Equal(t, big.NewInt(100), newTx.GasTipCap())
+
+	sameTx := mgr.increaseGasPrice(context.Background(), newTx)
+	require.Equal(t, newTx.Hash(), sameTx.Hash(), "tx at the fee limit must not be bumped")
+}
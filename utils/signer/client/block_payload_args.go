@@ -0,0 +1,27 @@
+package client
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BlockPayloadArgs represents the arguments to sign a p2p application message.
+// The signer computes the signing hash from the domain, the chain ID and the payload hash.
+type BlockPayloadArgs struct {
+	Domain        hexutil.Bytes   `json:"domain"`
+	ChainID       *hexutil.Big    `json:"chainId"`
+	PayloadHash   hexutil.Bytes   `json:"payloadHash"`
+	SenderAddress *common.Address `json:"senderAddress"`
+}
+
+// NewBlockPayloadArgs creates the arguments to sign the hash of the given encoded message.
+func NewBlockPayloadArgs(domain [32]byte, chainID *big.Int, payloadHash []byte, sender common.Address) *BlockPayloadArgs {
+	return &BlockPayloadArgs{
+		Domain:        domain[:],
+		ChainID:       (*hexutil.Big)(chainID),
+		PayloadHash:   payloadHash,
+		SenderAddress: &sender,
+	}
+}
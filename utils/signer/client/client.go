@@ -108,3 +108,26 @@ func (s *SignerClient) SignTransaction(ctx context.Context, chainId *big.Int, fr
 
 	return signed, nil
 }
+
+// SignBlockPayload signs the hash of a p2p application message, e.g. an unsafe block payload,
+// within the given signing domain and chain.
+func (s *SignerClient) SignBlockPayload(ctx context.Context, args *BlockPayloadArgs) (*[65]byte, error) {
+	var result hexutil.Bytes
+	if err := s.client.CallContext(ctx, &result, "kroma_signBlockPayload", args); err != nil {
+		return nil, fmt.Errorf("kroma_signBlockPayload failed: %w", err)
+	}
+	if len(result) != 65 {
+		return nil, fmt.Errorf("invalid signature length: %d", len(result))
+	}
+	return (*[65]byte)(result), nil
+}
+
+// HealthCheck returns an error if the signer is not reachable.
+func (s *SignerClient) HealthCheck(ctx context.Context) error {
+	var v string
+	return s.client.CallContext(ctx, &v, "health_status")
+}
+
+func (s *SignerClient) Close() {
+	s.client.Close()
+}
@@ -12,6 +12,7 @@ import (
 const (
 	EndpointFlagName = "signer.endpoint"
 	AddressFlagName  = "signer.address"
+	PolicyFlagName   = "signer.policy"
 )
 
 func CLIFlags(envPrefix string) []cli.Flag {
@@ -27,15 +28,22 @@ func CLIFlags(envPrefix string) []cli.Flag {
 			Usage:  "Address the signer is signing transactions for",
 			EnvVar: kservice.PrefixEnvVar(envPrefix, "ADDRESS"),
 		},
+		cli.StringFlag{
+			Name:   PolicyFlagName,
+			Usage:  "Path to a JSON file with the signing policies by key address, restricting the recipients, value, gas fee cap and gas of the signed transactions",
+			EnvVar: kservice.PrefixEnvVar(envPrefix, "POLICY"),
+		},
 	}
 	flags = append(flags, ktls.CLIFlagsWithFlagPrefix(envPrefix, "signer")...)
 	return flags
 }
 
 type CLIConfig struct {
-	Endpoint  string
-	Address   string
-	TLSConfig ktls.CLIConfig
+	Endpoint string
+	Address  string
+	// PolicyPath is the path to the signing policies, applied to the remote signer as well as local keys.
+	PolicyPath string
+	TLSConfig  ktls.CLIConfig
 }
 
 func (c CLIConfig) Check() error {
@@ -57,9 +65,10 @@ func (c CLIConfig) Enabled() bool {
 
 func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 	cfg := CLIConfig{
-		Endpoint:  ctx.String(EndpointFlagName),
-		Address:   ctx.String(AddressFlagName),
-		TLSConfig: ktls.ReadCLIConfigWithPrefix(ctx, "signer"),
+		Endpoint:   ctx.String(EndpointFlagName),
+		Address:    ctx.String(AddressFlagName),
+		PolicyPath: ctx.String(PolicyFlagName),
+		TLSConfig:  ktls.ReadCLIConfigWithPrefix(ctx, "signer"),
 	}
 	return cfg
 }
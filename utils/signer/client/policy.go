@@ -0,0 +1,80 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var ErrPolicyViolation = errors.New("signing policy violation")
+
+// Policy restricts the transactions that are signed for a key. The zero value allows any transaction.
+type Policy struct {
+	// AllowedTo is the list of the recipients the key may send transactions to. Empty allows any recipient,
+	// but contract creations are allowed only if AllowContractCreation is set.
+	AllowedTo []common.Address `json:"allowedTo,omitempty"`
+	// AllowContractCreation allows transactions without a recipient.
+	AllowContractCreation bool `json:"allowContractCreation,omitempty"`
+	// MaxValue is the maximum value (in wei) of a transaction. nil is unlimited.
+	MaxValue *big.Int `json:"maxValue,omitempty"`
+	// MaxGasFeeCap is the maximum gas fee cap (in wei) of a transaction. nil is unlimited.
+	MaxGasFeeCap *big.Int `json:"maxGasFeeCap,omitempty"`
+	// MaxGas is the maximum gas limit of a transaction. 0 is unlimited.
+	MaxGas uint64 `json:"maxGas,omitempty"`
+}
+
+// Check returns an error wrapping ErrPolicyViolation if the transaction is not allowed by the policy.
+func (p *Policy) Check(tx *types.Transaction) error {
+	if to := tx.To(); to == nil {
+		if !p.AllowContractCreation {
+			return fmt.Errorf("%w: contract creation is not allowed", ErrPolicyViolation)
+		}
+	} else if len(p.AllowedTo) > 0 && !containsAddress(p.AllowedTo, *to) {
+		return fmt.Errorf("%w: recipient %s is not allowed", ErrPolicyViolation, to)
+	}
+	if p.MaxValue != nil && tx.Value().Cmp(p.MaxValue) > 0 {
+		return fmt.Errorf("%w: value %s exceeds %s", ErrPolicyViolation, tx.Value(), p.MaxValue)
+	}
+	if p.MaxGasFeeCap != nil && tx.GasFeeCap().Cmp(p.MaxGasFeeCap) > 0 {
+		return fmt.Errorf("%w: gas fee cap %s exceeds %s", ErrPolicyViolation, tx.GasFeeCap(), p.MaxGasFeeCap)
+	}
+	if p.MaxGas != 0 && tx.Gas() > p.MaxGas {
+		return fmt.Errorf("%w: gas %d exceeds %d", ErrPolicyViolation, tx.Gas(), p.MaxGas)
+	}
+	return nil
+}
+
+func containsAddress(addrs []common.Address, addr common.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// Policies are the signing policies by the address of the key.
+type Policies map[common.Address]*Policy
+
+// For returns the policy of the key, or nil if the key is not restricted.
+func (p Policies) For(addr common.Address) *Policy {
+	return p[addr]
+}
+
+// LoadPolicies reads the signing policies from a JSON file, which maps the addresses of the keys to their policy.
+func LoadPolicies(path string) (Policies, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing policies: %w", err)
+	}
+	var policies Policies
+	if err := json.Unmarshal(data, &policies); err != nil {
+		return nil, fmt.Errorf("failed to decode signing policies: %w", err)
+	}
+	return policies, nil
+}
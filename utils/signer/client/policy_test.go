@@ -0,0 +1,76 @@
+package client
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	allowed := common.HexToAddress("0x01")
+	other := common.HexToAddress("0x02")
+	policy := &Policy{
+		AllowedTo:    []common.Address{allowed},
+		MaxValue:     big.NewInt(100),
+		MaxGasFeeCap: big.NewInt(1000),
+		MaxGas:       21000,
+	}
+	newTx := func(to *common.Address, value, feeCap int64, gas uint64) *types.Transaction {
+		return types.NewTx(&types.DynamicFeeTx{
+			To:        to,
+			Value:     big.NewInt(value),
+			GasFeeCap: big.NewInt(feeCap),
+			GasTipCap: big.NewInt(1),
+			Gas:       gas,
+		})
+	}
+
+	tests := []struct {
+		name string
+		tx   *types.Transaction
+		ok   bool
+	}{
+		{"allowed", newTx(&allowed, 100, 1000, 21000), true},
+		{"recipient", newTx(&other, 0, 1000, 21000), false},
+		{"contract creation", newTx(nil, 0, 1000, 21000), false},
+		{"value", newTx(&allowed, 101, 1000, 21000), false},
+		{"gas fee cap", newTx(&allowed, 0, 1001, 21000), false},
+		{"gas", newTx(&allowed, 0, 1000, 21001), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Check(tt.tx)
+			if tt.ok {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrPolicyViolation)
+			}
+		})
+	}
+
+	require.NoError(t, (&Policy{}).Check(newTx(&other, 1e18, 1e18, 1e7)))
+}
+
+func TestLoadPolicies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policies.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"0x00000000000000000000000000000000000000a1": {
+			"allowedTo": ["0x0000000000000000000000000000000000000001"],
+			"maxGasFeeCap": 200000000000
+		}
+	}`), 0o600))
+
+	policies, err := LoadPolicies(path)
+	require.NoError(t, err)
+	policy := policies.For(common.HexToAddress("0xa1"))
+	require.NotNil(t, policy)
+	require.Equal(t, []common.Address{common.HexToAddress("0x01")}, policy.AllowedTo)
+	require.Equal(t, big.NewInt(200_000_000_000), policy.MaxGasFeeCap)
+	require.Nil(t, policy.MaxValue)
+	require.Nil(t, policies.For(common.HexToAddress("0xa2")))
+}